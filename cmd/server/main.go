@@ -25,6 +25,12 @@ import (
 	"github.com/pocketbase/pocketbase/core"
 )
 
+// webhookPollInterval is how often queued webhook deliveries are checked for due attempts
+const webhookPollInterval = 10 * time.Second
+
+// recurrencePollInterval is how often recurring tasks are checked for occurrences whose date has arrived
+const recurrencePollInterval = time.Minute
+
 func main() {
 	ctx := context.Background()
 
@@ -57,6 +63,40 @@ func run(ctx context.Context) error {
 		return fmt.Errorf("failed to setup service container: %w", err)
 	}
 
+	// Deliver queued emails and webhooks in the background; both queues live in the database
+	if app != nil {
+		outbox, outboxErr := container.ResolveEmailOutbox(serviceContainer)
+		if outboxErr != nil {
+			return fmt.Errorf("failed to resolve email outbox: %w", outboxErr)
+		}
+		outbox.StartDeliveryRoutine(ctx, cfg.GetMailPollInterval())
+
+		webhookService, webhookErr := container.ResolveWebhookService(serviceContainer)
+		if webhookErr != nil {
+			return fmt.Errorf("failed to resolve webhook service: %w", webhookErr)
+		}
+		webhookService.StartDeliveryRoutine(ctx, webhookPollInterval)
+	}
+
+	// Relay realtime events raised on other replicas to this replica's clients
+	broadcaster, err := container.ResolveEventBroadcaster(serviceContainer)
+	if err != nil {
+		return fmt.Errorf("failed to resolve event broadcaster: %w", err)
+	}
+	if err := broadcaster.StartClusterSync(ctx); err != nil {
+		return fmt.Errorf("failed to start realtime cluster sync: %w", err)
+	}
+
+	// Create the next occurrences of recurring tasks as their dates arrive; resolving the
+	// broadcaster first lets new occurrences be announced
+	if app != nil {
+		recurrenceService, recurrenceErr := container.ResolveRecurrenceService(serviceContainer)
+		if recurrenceErr != nil {
+			return fmt.Errorf("failed to resolve recurrence service: %w", recurrenceErr)
+		}
+		recurrenceService.StartSchedulerRoutine(ctx, recurrencePollInterval)
+	}
+
 	// Setup Gin router with services
 	router, rateLimitManager := setupRouter(ctx, cfg, serviceContainer)
 	defer rateLimitManager.Shutdown()
@@ -148,10 +188,10 @@ func setupRouter(
 		rateLimitManager = manager
 	}
 
-	// Service container is now initialized and available for use
-	// Future handlers can resolve services from the container
-	// Note: serviceContainer parameter will be used when handlers are updated to use DI
-	_ = serviceContainer
+	// Board, bulk and search task APIs are served from the service container
+	if err := registerProjectRoutes(router, serviceContainer); err != nil {
+		log.Printf("Warning: project API disabled: %v", err)
+	}
 
 	// Static files
 	router.Static("/static", "./web/static")
@@ -216,11 +256,20 @@ func setupRouter(
 			"version": "1.0.0",
 			"status":  "operational",
 			"endpoints": gin.H{
-				"health":   "/health",
-				"ping":     "/ping",
-				"auth":     "/api/auth/*",
-				"users":    "/api/users/*",
-				"projects": "/api/projects/*",
+				"health":        "/health",
+				"ping":          "/ping",
+				"auth":          "/api/auth/*",
+				"users":         "/api/users/*",
+				"projects":      "/api/projects/*",
+				"board":         "/api/projects/:projectId/board",
+				"bulk":          "/api/projects/:projectId/tasks/bulk/*",
+				"search":        "/api/projects/:projectId/tasks/search",
+				"critical_path": "/api/projects/:projectId/critical-path",
+				"workflow":      "/api/projects/:projectId/workflow",
+				"webhooks":      "/api/projects/:projectId/webhooks",
+				"notifications": "/api/notifications",
+				"files":         "/api/files/:ownerType/:ownerId/:name",
+				"recurrence":    "/api/tasks/:taskId/recurrence",
 			},
 		})
 	})
@@ -311,6 +360,109 @@ func setupRouter(
 	return router, rateLimitManager
 }
 
+// registerProjectRoutes mounts the kanban board, bulk task, search, critical path, workflow,
+// webhook, notification, comment, attachment, recurrence, invitation, personal access token,
+// two-factor authentication and session APIs under /api. Everything but registering through an invitation
+// and enrolling with a login challenge requires authentication, with either a JWT or a personal
+// access token.
+func registerProjectRoutes(router *gin.Engine, serviceContainer container.Container) error {
+	kanbanService, err := container.ResolveKanbanService(serviceContainer)
+	if err != nil {
+		return fmt.Errorf("failed to resolve kanban service: %w", err)
+	}
+
+	bulkService, err := container.ResolveBulkOperationService(serviceContainer)
+	if err != nil {
+		return fmt.Errorf("failed to resolve bulk operation service: %w", err)
+	}
+
+	searchService, err := container.ResolveSearchService(serviceContainer)
+	if err != nil {
+		return fmt.Errorf("failed to resolve search service: %w", err)
+	}
+
+	criticalPathService, err := container.ResolveCriticalPathService(serviceContainer)
+	if err != nil {
+		return fmt.Errorf("failed to resolve critical path service: %w", err)
+	}
+
+	workflowService, err := container.ResolveWorkflowService(serviceContainer)
+	if err != nil {
+		return fmt.Errorf("failed to resolve workflow service: %w", err)
+	}
+
+	webhookService, err := container.ResolveWebhookService(serviceContainer)
+	if err != nil {
+		return fmt.Errorf("failed to resolve webhook service: %w", err)
+	}
+
+	notificationService, err := container.ResolveNotificationService(serviceContainer)
+	if err != nil {
+		return fmt.Errorf("failed to resolve notification service: %w", err)
+	}
+
+	commentService, err := container.ResolveCommentService(serviceContainer)
+	if err != nil {
+		return fmt.Errorf("failed to resolve comment service: %w", err)
+	}
+
+	attachmentService, err := container.ResolveAttachmentService(serviceContainer)
+	if err != nil {
+		return fmt.Errorf("failed to resolve attachment service: %w", err)
+	}
+
+	recurrenceService, err := container.ResolveRecurrenceService(serviceContainer)
+	if err != nil {
+		return fmt.Errorf("failed to resolve recurrence service: %w", err)
+	}
+
+	invitationService, err := container.ResolveInvitationService(serviceContainer)
+	if err != nil {
+		return fmt.Errorf("failed to resolve invitation service: %w", err)
+	}
+
+	authService, err := container.ResolveAuthService(serviceContainer)
+	if err != nil {
+		return fmt.Errorf("failed to resolve auth service: %w", err)
+	}
+
+	tokenService, err := container.ResolvePersonalAccessTokenService(serviceContainer)
+	if err != nil {
+		return fmt.Errorf("failed to resolve personal access token service: %w", err)
+	}
+
+	mfaService, err := container.ResolveMFAService(serviceContainer)
+	if err != nil {
+		return fmt.Errorf("failed to resolve MFA service: %w", err)
+	}
+
+	sessionService, err := container.ResolveSessionService(serviceContainer)
+	if err != nil {
+		return fmt.Errorf("failed to resolve session service: %w", err)
+	}
+
+	apiGroup := router.Group("/api")
+	authMiddleware := middleware.NewAuthMiddleware(authService)
+	authMiddleware.SetPersonalAccessTokens(tokenService)
+
+	api.NewBoardHandler(kanbanService).RegisterRoutes(apiGroup, authMiddleware)
+	api.NewBulkHandler(bulkService).RegisterRoutes(apiGroup, authMiddleware)
+	api.NewSearchHandler(searchService).RegisterRoutes(apiGroup, authMiddleware)
+	api.NewCriticalPathHandler(criticalPathService).RegisterRoutes(apiGroup, authMiddleware)
+	api.NewWorkflowHandler(workflowService).RegisterRoutes(apiGroup, authMiddleware)
+	api.NewWebhookHandler(webhookService).RegisterRoutes(apiGroup, authMiddleware)
+	api.NewNotificationHandler(notificationService).RegisterRoutes(apiGroup, authMiddleware)
+	api.NewCommentHandler(commentService).RegisterRoutes(apiGroup, authMiddleware)
+	api.NewAttachmentHandler(attachmentService).RegisterRoutes(apiGroup, authMiddleware)
+	api.NewRecurrenceHandler(recurrenceService).RegisterRoutes(apiGroup, authMiddleware)
+	api.NewInvitationHandler(invitationService).RegisterRoutes(apiGroup, authMiddleware)
+	api.NewPersonalAccessTokenHandler(tokenService).RegisterRoutes(apiGroup, authMiddleware)
+	api.NewMFAHandler(mfaService, authService).RegisterRoutes(apiGroup, authMiddleware)
+	api.NewSessionHandler(sessionService).RegisterRoutes(apiGroup, authMiddleware)
+
+	return nil
+}
+
 // rootHandler handles the root path and returns a simple HTML page.
 func rootHandler(c *gin.Context) {
 	c.Header("Content-Type", "text/html")
@@ -0,0 +1,262 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxUploadBodySize bounds an upload request: one attachment plus room for the multipart framing
+const maxUploadBodySize = domain.MaxAttachmentSize + 64<<10
+
+// inlineAttachmentTypes are the content types browsers may display instead of downloading
+var inlineAttachmentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+}
+
+// AttachmentHandler handles task and comment attachment HTTP requests.
+type AttachmentHandler struct {
+	attachmentService services.AttachmentService
+}
+
+// NewAttachmentHandler creates a new attachment handler.
+func NewAttachmentHandler(attachmentService services.AttachmentService) *AttachmentHandler {
+	return &AttachmentHandler{
+		attachmentService: attachmentService,
+	}
+}
+
+// RegisterRoutes registers attachment routes with the router.
+func (h *AttachmentHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *middleware.AuthMiddleware) {
+	tasks := router.Group("/tasks")
+	tasks.Use(authMiddleware.RequireAuth())
+	{
+		tasks.GET("/:taskId/attachments", h.ListTaskAttachments)
+		tasks.POST("/:taskId/attachments", h.AddTaskAttachment)
+		tasks.DELETE("/:taskId/attachments/:name", h.RemoveTaskAttachment)
+	}
+
+	comments := router.Group("/comments")
+	comments.Use(authMiddleware.RequireAuth())
+	{
+		comments.GET("/:commentId/attachments", h.ListCommentAttachments)
+		comments.POST("/:commentId/attachments", h.AddCommentAttachment)
+		comments.DELETE("/:commentId/attachments/:name", h.RemoveCommentAttachment)
+	}
+
+	// Download links carry their own signature, so they work without an auth header
+	router.GET("/files/:ownerType/:ownerId/:name", h.DownloadAttachment)
+}
+
+// ListTaskAttachments handles GET /api/tasks/:taskId/attachments requests.
+func (h *AttachmentHandler) ListTaskAttachments(c *gin.Context) {
+	h.list(c, c.Param("taskId"), h.attachmentService.ListTaskAttachments)
+}
+
+// AddTaskAttachment handles POST /api/tasks/:taskId/attachments requests.
+func (h *AttachmentHandler) AddTaskAttachment(c *gin.Context) {
+	h.add(c, c.Param("taskId"), h.attachmentService.AddTaskAttachment)
+}
+
+// RemoveTaskAttachment handles DELETE /api/tasks/:taskId/attachments/:name requests.
+func (h *AttachmentHandler) RemoveTaskAttachment(c *gin.Context) {
+	h.remove(c, c.Param("taskId"), h.attachmentService.RemoveTaskAttachment)
+}
+
+// ListCommentAttachments handles GET /api/comments/:commentId/attachments requests.
+func (h *AttachmentHandler) ListCommentAttachments(c *gin.Context) {
+	h.list(c, c.Param("commentId"), h.attachmentService.ListCommentAttachments)
+}
+
+// AddCommentAttachment handles POST /api/comments/:commentId/attachments requests.
+func (h *AttachmentHandler) AddCommentAttachment(c *gin.Context) {
+	h.add(c, c.Param("commentId"), h.attachmentService.AddCommentAttachment)
+}
+
+// RemoveCommentAttachment handles DELETE /api/comments/:commentId/attachments/:name requests.
+func (h *AttachmentHandler) RemoveCommentAttachment(c *gin.Context) {
+	h.remove(c, c.Param("commentId"), h.attachmentService.RemoveCommentAttachment)
+}
+
+// DownloadAttachment handles GET /api/files/:ownerType/:ownerId/:name requests.
+// The expires and signature query parameters come from a link the API handed out;
+// thumb asks for the thumbnail of an image.
+func (h *AttachmentHandler) DownloadAttachment(c *gin.Context) {
+	expires, err := strconv.ParseInt(c.Query("expires"), 10, 64)
+	if err != nil {
+		ErrorResponse(c, domain.NewNotFoundError("ATTACHMENT_NOT_FOUND", "Attachment not found or link expired"))
+		return
+	}
+
+	content, err := h.attachmentService.OpenAttachment(c.Request.Context(), domain.AttachmentLink{
+		Owner: domain.AttachmentOwner{
+			Type: domain.AttachmentOwnerType(c.Param("ownerType")),
+			ID:   c.Param("ownerId"),
+		},
+		Name:      c.Param("name"),
+		ThumbSize: c.Query("thumb"),
+		Expires:   expires,
+		Signature: c.Query("signature"),
+	})
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+	defer func() { _ = content.Close() }()
+
+	disposition := "attachment"
+	if inlineAttachmentTypes[content.ContentType] {
+		disposition = "inline"
+	}
+
+	// Uploaded files are never trusted to run anything in the app's origin
+	c.Header("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": content.Name}))
+	c.Header("Content-Type", content.ContentType)
+	c.Header("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'; sandbox")
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Header("Cache-Control", "private, max-age="+strconv.Itoa(max(0, int(time.Until(time.Unix(expires, 0)).Seconds()))))
+
+	http.ServeContent(c.Writer, c.Request, content.Name, content.ModTime, content)
+}
+
+// list writes the attachments of the task or comment in the URL
+func (h *AttachmentHandler) list(
+	c *gin.Context,
+	ownerID string,
+	list func(ctx context.Context, ownerID string, userID string) ([]*domain.Attachment, error),
+) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	attachments, err := list(c.Request.Context(), ownerID, user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    attachments,
+	})
+}
+
+// add attaches the file in the multipart "file" field to the task or comment in the URL
+func (h *AttachmentHandler) add(
+	c *gin.Context,
+	ownerID string,
+	add func(
+		ctx context.Context, ownerID string, upload *domain.AttachmentUpload, userID string,
+	) (*domain.Attachment, error),
+) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	upload, err := h.readUpload(c)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			h.uploadTooLarge(c)
+			return
+		}
+		var domainErr *domain.Error
+		if errors.As(err, &domainErr) {
+			ErrorResponse(c, err)
+			return
+		}
+		invalidRequest(c, err)
+		return
+	}
+
+	attachment, err := add(c.Request.Context(), ownerID, upload, user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    attachment,
+	})
+}
+
+// remove deletes the attachment named in the URL from its task or comment
+func (h *AttachmentHandler) remove(
+	c *gin.Context,
+	ownerID string,
+	remove func(ctx context.Context, ownerID, name, userID string) error,
+) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	if err := remove(c.Request.Context(), ownerID, c.Param("name"), user.ID); err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Attachment removed successfully",
+	})
+}
+
+// readUpload reads the multipart "file" field, refusing bodies larger than one attachment
+func (h *AttachmentHandler) readUpload(c *gin.Context) (*domain.AttachmentUpload, error) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxUploadBodySize)
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		return nil, err
+	}
+	if header.Size > domain.MaxAttachmentSize {
+		return nil, &http.MaxBytesError{Limit: domain.MaxAttachmentSize}
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	content, err := io.ReadAll(io.LimitReader(file, domain.MaxAttachmentSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewAttachmentUpload(header.Filename, content)
+}
+
+// uploadTooLarge writes the response for a file over the attachment size limit.
+func (h *AttachmentHandler) uploadTooLarge(c *gin.Context) {
+	c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+		"success": false,
+		"error": map[string]interface{}{
+			"type":    "VALIDATION_ERROR",
+			"code":    "ATTACHMENT_TOO_LARGE",
+			"message": "Attachment exceeds the maximum size",
+			"details": map[string]interface{}{"max_size": domain.MaxAttachmentSize},
+		},
+	})
+}
@@ -0,0 +1,193 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestAttachmentHandler_Upload(t *testing.T) {
+	tests := []struct {
+		name           string
+		url            string
+		fileName       string
+		content        []byte
+		expectedStatus int
+	}{
+		{
+			name:           "attach text file to task",
+			url:            "/api/tasks/task-1/attachments",
+			fileName:       "notes.txt",
+			content:        []byte("release notes"),
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "attach file to comment",
+			url:            "/api/comments/comment-1/attachments",
+			fileName:       "notes.txt",
+			content:        []byte("release notes"),
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "attach to missing task",
+			url:            "/api/tasks/missing/attachments",
+			fileName:       "notes.txt",
+			content:        []byte("release notes"),
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "disallowed file type",
+			url:            "/api/tasks/task-1/attachments",
+			fileName:       "setup.exe",
+			content:        []byte("MZ\x90\x00\x03\x00\x00\x00\x04\x00\x00\x00\xff\xff"),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "file too large",
+			url:            "/api/tasks/task-1/attachments",
+			fileName:       "huge.txt",
+			content:        bytes.Repeat([]byte("a"), domain.MaxAttachmentSize+1),
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	router := setupAttachmentTestRouter()
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := uploadAttachment(t, router, tc.url, tc.fileName, tc.content)
+			if recorder.Code != tc.expectedStatus {
+				t.Errorf("Expected status %d, got %d: %s", tc.expectedStatus, recorder.Code, recorder.Body.String())
+			}
+		})
+	}
+
+	t.Run("request without a file", func(t *testing.T) {
+		helper := testutil.NewHTTPTestHelper(t, router)
+		recorder := helper.POST("/api/tasks/task-1/attachments", map[string]interface{}{},
+			map[string]string{"Authorization": "Bearer mock-token"})
+		helper.AssertStatus(recorder, http.StatusBadRequest)
+	})
+}
+
+func TestAttachmentHandler_Download(t *testing.T) {
+	router := setupAttachmentTestRouter()
+	helper := testutil.NewHTTPTestHelper(t, router)
+	headers := map[string]string{"Authorization": "Bearer mock-token"}
+
+	recorder := uploadAttachment(t, router, "/api/tasks/task-1/attachments", "notes.txt", []byte("release notes"))
+	helper.AssertStatus(recorder, http.StatusCreated)
+
+	var created struct {
+		Data domain.Attachment `json:"data"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &created); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	t.Run("signed link without auth header", func(t *testing.T) {
+		recorder := helper.GET(created.Data.URL, nil)
+		helper.AssertStatus(recorder, http.StatusOK)
+		helper.AssertHeader(recorder, "X-Content-Type-Options", "nosniff")
+		if recorder.Body.String() != "release notes" {
+			t.Errorf("Expected the uploaded content, got %q", recorder.Body.String())
+		}
+		if !strings.HasPrefix(recorder.Header().Get("Content-Disposition"), "attachment") {
+			t.Errorf("Expected text files to download, got %q", recorder.Header().Get("Content-Disposition"))
+		}
+	})
+
+	t.Run("tampered link", func(t *testing.T) {
+		recorder := helper.GET(strings.Replace(created.Data.URL, "signature=", "signature=0", 1), nil)
+		helper.AssertStatus(recorder, http.StatusNotFound)
+	})
+
+	t.Run("link without expiry", func(t *testing.T) {
+		recorder := helper.GET("/api/files/tasks/task-1/"+created.Data.Name, nil)
+		helper.AssertStatus(recorder, http.StatusNotFound)
+	})
+
+	t.Run("list and remove", func(t *testing.T) {
+		recorder := helper.GET("/api/tasks/task-1/attachments", headers)
+		helper.AssertStatus(recorder, http.StatusOK)
+
+		recorder = helper.DELETE("/api/tasks/task-1/attachments/"+created.Data.Name, headers)
+		helper.AssertStatus(recorder, http.StatusOK)
+
+		recorder = helper.GET(created.Data.URL, nil)
+		helper.AssertStatus(recorder, http.StatusNotFound)
+	})
+}
+
+// uploadAttachment posts a file as multipart form data, authenticated as the test user
+func uploadAttachment(
+	t *testing.T, router *gin.Engine, url, fileName string, content []byte,
+) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", fileName)
+	if err != nil {
+		t.Fatalf("Failed to create form file: %v", err)
+	}
+	if _, err := part.Write(content); err != nil {
+		t.Fatalf("Failed to write form file: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Failed to close multipart writer: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, url, &body)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer mock-token")
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+// setupAttachmentTestRouter wires the attachment handler over a project owned by the test user,
+// with a task and a comment by the test user to attach files to.
+func setupAttachmentTestRouter() *gin.Engine {
+	router := testutil.NewTestRouter()
+
+	testUser := testutil.MockUser("user-1", "test@example.com", "testuser", "Test User")
+
+	projectRepo := testutil.NewMockProjectRepository()
+	projectRepo.AddProject(testutil.MockProject("project-1", "Project", "project", "user-1"))
+
+	taskRepo := testutil.NewMockTaskRepository()
+	taskRepo.AddTask(testutil.MockTask("task-1", "Task", "project-1", "user-1"))
+
+	commentRepo := testutil.NewMockCommentRepository()
+	_ = commentRepo.Create(context.Background(), &domain.Comment{
+		TaskID: "task-1", AuthorID: "user-1", Content: "See attached", Type: domain.CommentTypeRegular,
+	})
+
+	attachmentService := services.NewAttachmentService(
+		testutil.NewMockAttachmentRepository(taskRepo, commentRepo), taskRepo, commentRepo, projectRepo,
+		services.NewAuthorizationService(projectRepo), "test-signing-key",
+	)
+	authMiddleware := middleware.NewAuthMiddleware(&MockAuthService{user: testUser})
+
+	api.NewAttachmentHandler(attachmentService).RegisterRoutes(router.Group("/api"), authMiddleware)
+
+	return router
+}
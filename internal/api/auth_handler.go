@@ -29,6 +29,7 @@ func (h *AuthHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *mi
 	auth := router.Group("/auth")
 	{
 		auth.POST("/login", h.Login)
+		auth.POST("/mfa/verify", h.VerifyMFA)
 		auth.POST("/register", h.Register)
 		auth.POST("/refresh", h.RefreshToken)
 		auth.POST("/logout", authMiddleware.RequireAuth(), h.Logout)
@@ -51,22 +52,68 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	}
 
 	// Authenticate user
-	tokenPair, err := h.authService.Login(c.Request.Context(), req)
+	result, err := h.authService.Login(middleware.ClientContext(c), req)
 	if err != nil {
 		h.handleError(c, err)
 		return
 	}
 
+	// Accounts with two-factor authentication finish logging in at /auth/mfa/verify
+	if result.Challenge != nil {
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data": gin.H{
+				"mfa_required":        true,
+				"mfa_token":           result.Challenge.Token,
+				"enrollment_required": result.Challenge.EnrollmentRequired,
+				"expires_at":          result.Challenge.ExpiresAt,
+			},
+		})
+		return
+	}
+
+	h.respondWithTokens(c, result)
+}
+
+// VerifyMFA handles the second login step for accounts with two-factor authentication.
+func (h *AuthHandler) VerifyMFA(c *gin.Context) {
+	var req domain.MFALoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		// Create a sanitized validation error instead of exposing raw binding errors
+		validationErr := domain.NewValidationError("INVALID_REQUEST", "Invalid request format", map[string]interface{}{
+			"field": "request_body",
+		})
+		SanitizedErrorResponse(c, validationErr)
+		return
+	}
+
+	result, err := h.authService.CompleteMFALogin(middleware.ClientContext(c), req.MFAToken, req.Code)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	h.respondWithTokens(c, result)
+}
+
+// respondWithTokens sets the auth cookies and writes the tokens of a finished login, with the
+// recovery codes if the login also finished enrolling in two-factor authentication.
+func (h *AuthHandler) respondWithTokens(c *gin.Context, result *domain.LoginResult) {
 	// Set secure HTTP-only cookies
-	h.setAuthCookies(c, tokenPair)
+	h.setAuthCookies(c, result.Tokens)
+
+	data := gin.H{
+		"access_token":  result.Tokens.AccessToken,
+		"refresh_token": result.Tokens.RefreshToken,
+		"expires_at":    result.Tokens.ExpiresAt,
+	}
+	if len(result.RecoveryCodes) > 0 {
+		data["recovery_codes"] = result.RecoveryCodes
+	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
-		"data": gin.H{
-			"access_token":  tokenPair.AccessToken,
-			"refresh_token": tokenPair.RefreshToken,
-			"expires_at":    tokenPair.ExpiresAt,
-		},
+		"data":    data,
 	})
 }
 
@@ -122,7 +169,7 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 	}
 
 	// Generate new tokens
-	tokenPair, err := h.authService.RefreshToken(c.Request.Context(), reqData.RefreshToken)
+	tokenPair, err := h.authService.RefreshToken(middleware.ClientContext(c), reqData.RefreshToken)
 	if err != nil {
 		h.handleError(c, err)
 		return
@@ -143,15 +190,14 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 
 // Logout handles user logout requests.
 func (h *AuthHandler) Logout(c *gin.Context) {
-	user, exists := middleware.GetUserFromContext(c)
-	if !exists {
+	if _, exists := middleware.GetUserFromContext(c); !exists {
 		authErr := domain.NewAuthenticationError("USER_NOT_FOUND", "User not found in context")
 		SanitizedErrorResponse(c, authErr)
 		return
 	}
 
-	// Logout user (placeholder for token blacklist)
-	if err := h.authService.Logout(c.Request.Context(), user.ID); err != nil {
+	// Blacklist the token and sign its session out
+	if err := h.authService.Logout(c.Request.Context(), middleware.GetTokenFromRequest(c)); err != nil {
 		h.handleError(c, err)
 		return
 	}
@@ -0,0 +1,330 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BoardHandler handles kanban board HTTP requests.
+type BoardHandler struct {
+	kanbanService services.KanbanService
+}
+
+// NewBoardHandler creates a new board handler.
+func NewBoardHandler(kanbanService services.KanbanService) *BoardHandler {
+	return &BoardHandler{
+		kanbanService: kanbanService,
+	}
+}
+
+// moveRequest is the body accepted by the move and validate-move endpoints.
+type moveRequest struct {
+	WIPOverride     *services.WIPOverride `json:"wip_override,omitempty"`
+	TaskID          string                `json:"task_id" binding:"required"`
+	NewStatus       domain.TaskStatus     `json:"new_status" binding:"required"`
+	NewPosition     int                   `json:"new_position" binding:"min=0"`
+	OverrideBlocked bool                  `json:"override_blocked,omitempty"`
+}
+
+// RegisterRoutes registers board routes with the router.
+func (h *BoardHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *middleware.AuthMiddleware) {
+	projects := router.Group("/projects")
+	projects.Use(authMiddleware.RequireAuth())
+	{
+		board := projects.Group("/:projectId/board")
+		{
+			board.GET("", h.GetBoard)
+			board.GET("/stats", h.GetBoardStatistics)
+			board.POST("/move", h.MoveTask)
+			board.POST("/validate-move", h.ValidateMove)
+			board.PUT("/wip-limits/:status", h.UpdateWIPLimits)
+			board.GET("/wip-overrides", h.ListWIPOverrides)
+		}
+	}
+}
+
+// GetBoard handles GET /api/projects/:projectId/board requests.
+func (h *BoardHandler) GetBoard(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "AUTHENTICATION_ERROR",
+				"code":    "USER_NOT_FOUND",
+				"message": "User not found in context",
+			},
+		})
+		return
+	}
+
+	board, err := h.kanbanService.GetBoard(c.Request.Context(), c.Param("projectId"), user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"board": board,
+		},
+	})
+}
+
+// GetBoardStatistics handles GET /api/projects/:projectId/board/stats requests.
+func (h *BoardHandler) GetBoardStatistics(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "AUTHENTICATION_ERROR",
+				"code":    "USER_NOT_FOUND",
+				"message": "User not found in context",
+			},
+		})
+		return
+	}
+
+	stats, err := h.kanbanService.GetBoardStatistics(c.Request.Context(), c.Param("projectId"), user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"stats": stats,
+		},
+	})
+}
+
+// MoveTask handles POST /api/projects/:projectId/board/move requests.
+// Unlike the task move endpoint, this enforces the project's WIP limits.
+func (h *BoardHandler) MoveTask(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "AUTHENTICATION_ERROR",
+				"code":    "USER_NOT_FOUND",
+				"message": "User not found in context",
+			},
+		})
+		return
+	}
+
+	moveReq, ok := h.bindMoveRequest(c)
+	if !ok {
+		return
+	}
+
+	if err := h.kanbanService.MoveTask(c.Request.Context(), moveReq, user.ID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	// Return the refreshed board so clients don't need a second round trip
+	board, err := h.kanbanService.GetBoard(c.Request.Context(), moveReq.ProjectID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"board": board,
+		},
+	})
+}
+
+// ValidateMove handles POST /api/projects/:projectId/board/validate-move requests.
+// It is a dry run: rule violations are reported in the body rather than as an error status.
+func (h *BoardHandler) ValidateMove(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "AUTHENTICATION_ERROR",
+				"code":    "USER_NOT_FOUND",
+				"message": "User not found in context",
+			},
+		})
+		return
+	}
+
+	moveReq, ok := h.bindMoveRequest(c)
+	if !ok {
+		return
+	}
+
+	err := h.kanbanService.ValidateMove(c.Request.Context(), moveReq, user.ID)
+	if err == nil {
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data": gin.H{
+				"valid": true,
+			},
+		})
+		return
+	}
+
+	// Only rule violations are a dry-run result; missing tasks or denied access are real errors
+	var domainErr *domain.Error
+	if !errors.As(err, &domainErr) ||
+		(domainErr.Type != domain.ValidationError && domainErr.Type != domain.ConflictError) {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"valid":     false,
+			"violation": domainErr,
+		},
+	})
+}
+
+// UpdateWIPLimits handles PUT /api/projects/:projectId/board/wip-limits/:status requests.
+func (h *BoardHandler) UpdateWIPLimits(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "AUTHENTICATION_ERROR",
+				"code":    "USER_NOT_FOUND",
+				"message": "User not found in context",
+			},
+		})
+		return
+	}
+
+	projectID := c.Param("projectId")
+	status := domain.TaskStatus(c.Param("status"))
+	if !status.IsValid() {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "VALIDATION_ERROR",
+				"code":    "INVALID_STATUS",
+				"message": "Invalid task status",
+			},
+		})
+		return
+	}
+
+	var limits services.WIPLimits
+	if err := c.ShouldBindJSON(&limits); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "VALIDATION_ERROR",
+				"code":    "INVALID_REQUEST",
+				"message": "Invalid request format",
+				"details": err.Error(),
+			},
+		})
+		return
+	}
+
+	if err := h.kanbanService.UpdateWIPLimits(c.Request.Context(), projectID, status, limits, user.ID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"status":     status,
+			"wip_limits": limits,
+		},
+	})
+}
+
+// ListWIPOverrides handles GET /api/projects/:projectId/board/wip-overrides requests.
+func (h *BoardHandler) ListWIPOverrides(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "AUTHENTICATION_ERROR",
+				"code":    "USER_NOT_FOUND",
+				"message": "User not found in context",
+			},
+		})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit <= 0 || limit > 100 {
+		limit = 50
+	}
+
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	overrides, err := h.kanbanService.ListWIPOverrides(
+		c.Request.Context(), c.Param("projectId"), user.ID, offset, limit,
+	)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"overrides": overrides,
+			"offset":    offset,
+			"limit":     limit,
+		},
+	})
+}
+
+// bindMoveRequest parses a move body into a service request, writing a 400 response on failure.
+func (h *BoardHandler) bindMoveRequest(c *gin.Context) (services.MoveTaskRequest, bool) {
+	var req moveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "VALIDATION_ERROR",
+				"code":    "INVALID_REQUEST",
+				"message": "Invalid request format",
+				"details": err.Error(),
+			},
+		})
+		return services.MoveTaskRequest{}, false
+	}
+
+	return services.MoveTaskRequest{
+		TaskID:          req.TaskID,
+		ProjectID:       c.Param("projectId"),
+		NewStatus:       req.NewStatus,
+		NewPosition:     req.NewPosition,
+		WIPOverride:     req.WIPOverride,
+		OverrideBlocked: req.OverrideBlocked,
+	}, true
+}
+
+// handleError handles domain errors with appropriate HTTP status codes.
+func (h *BoardHandler) handleError(c *gin.Context, err error) {
+	ErrorResponse(c, err)
+}
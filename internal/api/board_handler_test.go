@@ -0,0 +1,268 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestBoardHandler_GetBoard(t *testing.T) {
+	tests := []testutil.TestCase{
+		{
+			Name:           "get board successfully",
+			Method:         "GET",
+			URL:            "/api/projects/project-1/board",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "get board statistics",
+			Method:         "GET",
+			URL:            "/api/projects/project-1/board/stats",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "private project of another user",
+			Method:         "GET",
+			URL:            "/api/projects/private-project/board",
+			ExpectedStatus: http.StatusForbidden,
+		},
+		{
+			Name:           "project not found",
+			Method:         "GET",
+			URL:            "/api/projects/non-existent/board",
+			ExpectedStatus: http.StatusNotFound,
+		},
+	}
+
+	router := setupBoardTestRouter(t)
+	helper := testutil.NewHTTPTestHelper(t, router)
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(_ *testing.T) {
+			headers := map[string]string{
+				"Authorization": "Bearer mock-token",
+			}
+
+			recorder := helper.Request(tc.Method, tc.URL, tc.Body, headers)
+			helper.AssertStatus(recorder, tc.ExpectedStatus)
+		})
+	}
+}
+
+func TestBoardHandler_ValidateMove(t *testing.T) {
+	tests := []struct {
+		Body           interface{}
+		Name           string
+		URL            string
+		ExpectedStatus int
+		ExpectValid    bool
+	}{
+		{
+			Name:           "move within limits is valid",
+			URL:            "/api/projects/project-1/board/validate-move",
+			Body:           map[string]interface{}{"task_id": "task-3", "new_status": "review"},
+			ExpectedStatus: http.StatusOK,
+			ExpectValid:    true,
+		},
+		{
+			Name:           "move past hard limit reports a violation",
+			URL:            "/api/projects/project-1/board/validate-move",
+			Body:           map[string]interface{}{"task_id": "task-1", "new_status": "developing"},
+			ExpectedStatus: http.StatusOK,
+			ExpectValid:    false,
+		},
+		{
+			Name:           "access denied is not a dry-run result",
+			URL:            "/api/projects/private-project/board/validate-move",
+			Body:           map[string]interface{}{"task_id": "private-task", "new_status": "developing"},
+			ExpectedStatus: http.StatusForbidden,
+		},
+		{
+			Name:           "missing task is not a dry-run result",
+			URL:            "/api/projects/project-1/board/validate-move",
+			Body:           map[string]interface{}{"task_id": "non-existent", "new_status": "review"},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Name:           "missing required fields",
+			URL:            "/api/projects/project-1/board/validate-move",
+			Body:           map[string]interface{}{"new_status": "review"},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	router := setupBoardTestRouter(t)
+	helper := testutil.NewHTTPTestHelper(t, router)
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			headers := map[string]string{
+				"Authorization": "Bearer mock-token",
+			}
+
+			recorder := helper.Request("POST", tc.URL, tc.Body, headers)
+			helper.AssertStatus(recorder, tc.ExpectedStatus)
+			if tc.ExpectedStatus != http.StatusOK {
+				return
+			}
+
+			var response struct {
+				Data struct {
+					Violation *domain.Error `json:"violation"`
+					Valid     bool          `json:"valid"`
+				} `json:"data"`
+				Success bool `json:"success"`
+			}
+			if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+
+			if !response.Success {
+				t.Error("Expected success response")
+			}
+			if response.Data.Valid != tc.ExpectValid {
+				t.Errorf("Expected valid=%v, got %v", tc.ExpectValid, response.Data.Valid)
+			}
+			if !tc.ExpectValid && response.Data.Violation == nil {
+				t.Error("Expected violation details for an invalid move")
+			}
+		})
+	}
+}
+
+func TestBoardHandler_MoveTask(t *testing.T) {
+	tests := []testutil.TestCase{
+		{
+			Name:           "move past hard limit without override",
+			Method:         "POST",
+			URL:            "/api/projects/project-1/board/move",
+			Body:           map[string]interface{}{"task_id": "task-2", "new_status": "developing"},
+			ExpectedStatus: http.StatusConflict,
+		},
+		{
+			Name:   "move past hard limit with override",
+			Method: "POST",
+			URL:    "/api/projects/project-1/board/move",
+			Body: map[string]interface{}{
+				"task_id":      "task-2",
+				"new_status":   "developing",
+				"wip_override": map[string]interface{}{"reason": "hotfix", "comment": "Production outage"},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "move task within limits",
+			Method:         "POST",
+			URL:            "/api/projects/project-1/board/move",
+			Body:           map[string]interface{}{"task_id": "task-3", "new_status": "review", "new_position": 0},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "list recorded overrides",
+			Method:         "GET",
+			URL:            "/api/projects/project-1/board/wip-overrides",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "invalid body",
+			Method:         "POST",
+			URL:            "/api/projects/project-1/board/move",
+			Body:           map[string]interface{}{"new_position": -1},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	router := setupBoardTestRouter(t)
+	helper := testutil.NewHTTPTestHelper(t, router)
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(_ *testing.T) {
+			headers := map[string]string{
+				"Authorization": "Bearer mock-token",
+			}
+
+			recorder := helper.Request(tc.Method, tc.URL, tc.Body, headers)
+			helper.AssertStatus(recorder, tc.ExpectedStatus)
+
+			if tc.ExpectedStatus == http.StatusOK {
+				responseBody := recorder.Body.String()
+				if !contains(responseBody, "success") || !contains(responseBody, "true") {
+					t.Error("Expected success response")
+				}
+			}
+		})
+	}
+}
+
+func TestBoardHandler_Unauthenticated(t *testing.T) {
+	router := setupBoardTestRouter(t)
+	helper := testutil.NewHTTPTestHelper(t, router)
+
+	recorder := helper.GET("/api/projects/project-1/board", nil)
+	helper.AssertStatus(recorder, http.StatusUnauthorized)
+}
+
+// setupBoardTestRouter wires the board handler to a real kanban service over mock repositories,
+// with the developing column already at a hard limit of one task.
+func setupBoardTestRouter(t *testing.T) *gin.Engine {
+	router := testutil.NewTestRouter()
+
+	taskRepo := testutil.NewMockTaskRepository()
+	projectRepo := testutil.NewMockProjectRepository()
+	userRepo := testutil.NewMockUserRepository()
+	wipRepo := testutil.NewMockWIPLimitRepository()
+
+	testUser := testutil.MockUser("user-1", "test@example.com", "testuser", "Test User")
+	otherUser := testutil.MockUser("user-2", "test2@example.com", "testuser2", "Test User 2")
+	userRepo.AddUser(testUser)
+	userRepo.AddUser(otherUser)
+
+	projectRepo.AddProject(testutil.MockProject("project-1", "Test Project", "test-project", "user-1"))
+	privateProject := testutil.MockProject("private-project", "Private Project", "private-project", "user-2")
+	privateProject.Settings.IsPrivate = true
+	projectRepo.AddProject(privateProject)
+
+	taskRepo.AddTask(testutil.MockTask("task-1", "Test Task", "project-1", "user-1"))
+	taskRepo.AddTask(testutil.MockTask("task-2", "Test Task 2", "project-1", "user-1"))
+	inProgress := testutil.MockTask("task-3", "Test Task 3", "project-1", "user-1")
+	inProgress.Status = domain.StatusDeveloping
+	taskRepo.AddTask(inProgress)
+	taskRepo.AddTask(testutil.MockTask("private-task", "Private Task", "private-project", "user-2"))
+
+	wipManager := services.NewWIPManager(taskRepo, projectRepo, wipRepo)
+	if err := wipRepo.Upsert(context.Background(), &domain.WIPLimit{
+		ProjectID: "project-1",
+		Status:    domain.StatusDeveloping,
+		SoftLimit: 1,
+		HardLimit: 1,
+		Enabled:   true,
+	}); err != nil {
+		t.Fatalf("Failed to configure WIP limit: %v", err)
+	}
+
+	taskService := services.NewTaskService(
+		taskRepo, projectRepo, userRepo, testutil.NewMockTaskHistoryRepository(),
+		services.NewAuthorizationService(projectRepo), wipManager, nil,
+	)
+	cache := services.NewCacheManager(services.NewMemoryCacheBackend("test:"), services.DefaultCacheConfig())
+	kanbanService := services.NewKanbanService(
+		taskRepo, projectRepo, taskService, wipManager, cache, services.NewAuthorizationService(projectRepo),
+	)
+
+	authMiddleware := middleware.NewAuthMiddleware(&MockAuthService{user: testUser})
+
+	boardHandler := api.NewBoardHandler(kanbanService)
+	apiGroup := router.Group("/api")
+	boardHandler.RegisterRoutes(apiGroup, authMiddleware)
+
+	return router
+}
@@ -0,0 +1,422 @@
+package api
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxImportSize limits the size of uploaded CSV files
+const maxImportSize = 10 << 20 // 10 MB
+
+// BulkHandler handles bulk task operation HTTP requests.
+type BulkHandler struct {
+	bulkService services.BulkOperationService
+}
+
+// NewBulkHandler creates a new bulk operation handler.
+func NewBulkHandler(bulkService services.BulkOperationService) *BulkHandler {
+	return &BulkHandler{
+		bulkService: bulkService,
+	}
+}
+
+// bulkUpdateRequest is the body accepted by the bulk update endpoint.
+type bulkUpdateRequest struct {
+	Operations []services.BulkTaskOperation `json:"operations" binding:"required,min=1"`
+}
+
+// bulkStatusRequest is the body accepted by the bulk status endpoint.
+type bulkStatusRequest struct {
+	Status  domain.TaskStatus `json:"status" binding:"required"`
+	TaskIDs []string          `json:"task_ids" binding:"required,min=1"`
+}
+
+// bulkAssignRequest is the body accepted by the bulk assign endpoint.
+// An empty assignee_id unassigns the tasks.
+type bulkAssignRequest struct {
+	AssigneeID string   `json:"assignee_id"`
+	TaskIDs    []string `json:"task_ids" binding:"required,min=1"`
+}
+
+// bulkDeleteRequest is the body accepted by the bulk delete endpoint.
+type bulkDeleteRequest struct {
+	TaskIDs []string `json:"task_ids" binding:"required,min=1"`
+	services.BulkDeleteOptions
+}
+
+// RegisterRoutes registers bulk operation routes with the router.
+func (h *BulkHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *middleware.AuthMiddleware) {
+	projects := router.Group("/projects")
+	projects.Use(authMiddleware.RequireAuth())
+	{
+		bulk := projects.Group("/:projectId/tasks/bulk")
+		{
+			bulk.POST("/update", h.BulkUpdate)
+			bulk.POST("/create", h.BulkCreate)
+			bulk.POST("/status", h.BulkStatusUpdate)
+			bulk.POST("/assign", h.BulkAssign)
+			bulk.POST("/tags", h.BulkTagUpdate)
+			bulk.POST("/delete", h.BulkDelete)
+			bulk.POST("/import", h.ImportCSV)
+			bulk.GET("/export", h.ExportCSV)
+		}
+	}
+}
+
+// BulkUpdate handles POST /api/projects/:projectId/tasks/bulk/update requests.
+func (h *BulkHandler) BulkUpdate(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "AUTHENTICATION_ERROR",
+				"code":    "USER_NOT_FOUND",
+				"message": "User not found in context",
+			},
+		})
+		return
+	}
+
+	var req bulkUpdateRequest
+	if !h.bindJSON(c, &req) {
+		return
+	}
+
+	result, err := h.bulkService.BulkUpdate(c.Request.Context(), c.Param("projectId"), req.Operations, user.ID)
+	h.respond(c, result, err)
+}
+
+// BulkCreate handles POST /api/projects/:projectId/tasks/bulk/create requests.
+func (h *BulkHandler) BulkCreate(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "AUTHENTICATION_ERROR",
+				"code":    "USER_NOT_FOUND",
+				"message": "User not found in context",
+			},
+		})
+		return
+	}
+
+	var req services.BulkCreateRequest
+	if !h.bindJSON(c, &req) {
+		return
+	}
+
+	// The path decides the project, whatever the body says
+	req.ProjectID = c.Param("projectId")
+
+	result, err := h.bulkService.BulkCreate(c.Request.Context(), req, user.ID)
+	h.respond(c, result, err)
+}
+
+// BulkStatusUpdate handles POST /api/projects/:projectId/tasks/bulk/status requests.
+func (h *BulkHandler) BulkStatusUpdate(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "AUTHENTICATION_ERROR",
+				"code":    "USER_NOT_FOUND",
+				"message": "User not found in context",
+			},
+		})
+		return
+	}
+
+	var req bulkStatusRequest
+	if !h.bindJSON(c, &req) {
+		return
+	}
+
+	result, err := h.bulkService.BulkStatusUpdate(
+		c.Request.Context(), c.Param("projectId"), req.TaskIDs, req.Status, user.ID,
+	)
+	h.respond(c, result, err)
+}
+
+// BulkAssign handles POST /api/projects/:projectId/tasks/bulk/assign requests.
+func (h *BulkHandler) BulkAssign(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "AUTHENTICATION_ERROR",
+				"code":    "USER_NOT_FOUND",
+				"message": "User not found in context",
+			},
+		})
+		return
+	}
+
+	var req bulkAssignRequest
+	if !h.bindJSON(c, &req) {
+		return
+	}
+
+	result, err := h.bulkService.BulkAssign(
+		c.Request.Context(), c.Param("projectId"), req.TaskIDs, req.AssigneeID, user.ID,
+	)
+	h.respond(c, result, err)
+}
+
+// BulkTagUpdate handles POST /api/projects/:projectId/tasks/bulk/tags requests.
+func (h *BulkHandler) BulkTagUpdate(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "AUTHENTICATION_ERROR",
+				"code":    "USER_NOT_FOUND",
+				"message": "User not found in context",
+			},
+		})
+		return
+	}
+
+	var req services.BulkTagUpdateRequest
+	if !h.bindJSON(c, &req) {
+		return
+	}
+
+	result, err := h.bulkService.BulkTagUpdate(c.Request.Context(), c.Param("projectId"), req, user.ID)
+	h.respond(c, result, err)
+}
+
+// BulkDelete handles POST /api/projects/:projectId/tasks/bulk/delete requests.
+func (h *BulkHandler) BulkDelete(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "AUTHENTICATION_ERROR",
+				"code":    "USER_NOT_FOUND",
+				"message": "User not found in context",
+			},
+		})
+		return
+	}
+
+	var req bulkDeleteRequest
+	if !h.bindJSON(c, &req) {
+		return
+	}
+
+	result, err := h.bulkService.BulkDelete(
+		c.Request.Context(), c.Param("projectId"), req.TaskIDs, req.BulkDeleteOptions, user.ID,
+	)
+	h.respond(c, result, err)
+}
+
+// ImportCSV handles POST /api/projects/:projectId/tasks/bulk/import requests.
+// The CSV is uploaded as the "file" field of a multipart form.
+func (h *BulkHandler) ImportCSV(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "AUTHENTICATION_ERROR",
+				"code":    "USER_NOT_FOUND",
+				"message": "User not found in context",
+			},
+		})
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportSize)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "VALIDATION_ERROR",
+				"code":    "MISSING_FILE",
+				"message": "A CSV file of at most 10 MB is required in the \"file\" field",
+			},
+		})
+		return
+	}
+
+	if !strings.EqualFold(filepath.Ext(fileHeader.Filename), ".csv") {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "VALIDATION_ERROR",
+				"code":    "INVALID_FILE_TYPE",
+				"message": "Only .csv files can be imported",
+			},
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		h.handleError(c, domain.NewInternalError("FILE_OPEN_FAILED", "Failed to open uploaded file", err))
+		return
+	}
+	defer func() { _ = file.Close() }()
+
+	result, err := h.bulkService.ImportFromCSV(c.Request.Context(), file, c.Param("projectId"), user.ID)
+	h.respond(c, result, err)
+}
+
+// ExportCSV handles GET /api/projects/:projectId/tasks/bulk/export requests.
+// Tasks are streamed page by page; filters match the task list endpoint.
+func (h *BulkHandler) ExportCSV(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "AUTHENTICATION_ERROR",
+				"code":    "USER_NOT_FOUND",
+				"message": "User not found in context",
+			},
+		})
+		return
+	}
+
+	projectID := c.Param("projectId")
+	filename := fmt.Sprintf("tasks-%s-%s.csv", projectID, time.Now().UTC().Format("20060102"))
+	stream := &csvStreamWriter{c: c, filename: filename}
+
+	err := h.bulkService.ExportToCSV(c.Request.Context(), stream, projectID, h.parseExportFilters(c), user.ID)
+	if err == nil {
+		if !stream.started {
+			// Nothing was written, e.g. an empty page; still send a valid response
+			stream.writeHeaders()
+		}
+		return
+	}
+
+	if !stream.started {
+		h.handleError(c, err)
+		return
+	}
+
+	// Headers are already sent, so the client sees a truncated file
+	slog.Error("CSV export aborted mid-stream",
+		"project_id", projectID,
+		"error", err)
+	_ = c.Error(err)
+}
+
+// parseExportFilters parses the task filters supported by the export endpoint
+func (h *BulkHandler) parseExportFilters(c *gin.Context) repository.TaskFilters {
+	filters := repository.TaskFilters{
+		SortBy:    repository.SortByCreated,
+		SortOrder: repository.SortOrderAsc,
+	}
+
+	if statusStr := c.Query("status"); statusStr != "" {
+		filters.Status = []domain.TaskStatus{domain.TaskStatus(statusStr)}
+	}
+
+	if priorityStr := c.Query("priority"); priorityStr != "" {
+		filters.Priority = []domain.TaskPriority{domain.TaskPriority(priorityStr)}
+	}
+
+	if assigneeStr := c.Query("assignee"); assigneeStr != "" {
+		filters.AssigneeID = &assigneeStr
+	}
+
+	if searchStr := c.Query("search"); searchStr != "" {
+		filters.Search = searchStr
+	}
+
+	if c.Query("archived") == "true" {
+		archived := true
+		filters.Archived = &archived
+	}
+
+	return filters
+}
+
+// bindJSON binds the request body, writing a 400 response on failure.
+func (h *BulkHandler) bindJSON(c *gin.Context, req interface{}) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "VALIDATION_ERROR",
+				"code":    "INVALID_REQUEST",
+				"message": "Invalid request format",
+				"details": err.Error(),
+			},
+		})
+		return false
+	}
+	return true
+}
+
+// respond writes the per-item breakdown of a bulk operation.
+func (h *BulkHandler) respond(c *gin.Context, result *services.BulkResult, err error) {
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"result": result,
+		},
+	})
+}
+
+// handleError handles domain errors with appropriate HTTP status codes.
+func (h *BulkHandler) handleError(c *gin.Context, err error) {
+	SanitizedErrorResponse(c, err)
+}
+
+// csvStreamWriter sends CSV download headers on the first write, so errors
+// raised before any data is produced can still become a JSON error response.
+type csvStreamWriter struct {
+	c        *gin.Context
+	filename string
+	started  bool
+}
+
+func (w *csvStreamWriter) writeHeaders() {
+	w.started = true
+	w.c.Header("Content-Type", "text/csv; charset=utf-8")
+	w.c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", w.filename))
+	w.c.Header("Cache-Control", "no-cache")
+	w.c.Status(http.StatusOK)
+}
+
+// Write implements io.Writer.
+func (w *csvStreamWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.writeHeaders()
+	}
+	return w.c.Writer.Write(p)
+}
+
+// Flush pushes buffered rows to the client.
+func (w *csvStreamWriter) Flush() {
+	if w.started {
+		w.c.Writer.Flush()
+	}
+}
@@ -0,0 +1,289 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestBulkHandler_Operations(t *testing.T) {
+	tests := []testutil.TestCase{
+		{
+			Name:   "bulk status update",
+			Method: "POST",
+			URL:    "/api/projects/project-1/tasks/bulk/status",
+			Body: map[string]interface{}{
+				"task_ids": []string{"task-1", "task-2"},
+				"status":   "developing",
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:   "bulk assign",
+			Method: "POST",
+			URL:    "/api/projects/project-1/tasks/bulk/assign",
+			Body: map[string]interface{}{
+				"task_ids":    []string{"task-1"},
+				"assignee_id": "user-1",
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:   "bulk tags",
+			Method: "POST",
+			URL:    "/api/projects/project-1/tasks/bulk/tags",
+			Body: map[string]interface{}{
+				"task_ids":    []string{"task-1", "task-2"},
+				"tags_to_add": []string{"release"},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:   "bulk create",
+			Method: "POST",
+			URL:    "/api/projects/project-1/tasks/bulk/create",
+			Body: map[string]interface{}{
+				"tasks": []map[string]interface{}{{"title": "Created in bulk"}},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:   "bulk update",
+			Method: "POST",
+			URL:    "/api/projects/project-1/tasks/bulk/update",
+			Body: map[string]interface{}{
+				"operations": []map[string]interface{}{
+					{"operation": "update", "task_ids": []string{"task-1"}, "data": map[string]interface{}{"title": "Renamed"}},
+				},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:   "bulk delete",
+			Method: "POST",
+			URL:    "/api/projects/project-1/tasks/bulk/delete",
+			Body: map[string]interface{}{
+				"task_ids": []string{"task-3"},
+			},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "missing task ids",
+			Method:         "POST",
+			URL:            "/api/projects/project-1/tasks/bulk/status",
+			Body:           map[string]interface{}{"status": "developing"},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Name:   "project not found",
+			Method: "POST",
+			URL:    "/api/projects/non-existent/tasks/bulk/status",
+			Body: map[string]interface{}{
+				"task_ids": []string{"task-1"},
+				"status":   "developing",
+			},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Name:   "private project of another user",
+			Method: "POST",
+			URL:    "/api/projects/private-project/tasks/bulk/status",
+			Body: map[string]interface{}{
+				"task_ids": []string{"private-task"},
+				"status":   "developing",
+			},
+			ExpectedStatus: http.StatusForbidden,
+		},
+	}
+
+	router := setupBulkTestRouter(t)
+	helper := testutil.NewHTTPTestHelper(t, router)
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(_ *testing.T) {
+			headers := map[string]string{
+				"Authorization": "Bearer mock-token",
+			}
+
+			recorder := helper.Request(tc.Method, tc.URL, tc.Body, headers)
+			helper.AssertStatus(recorder, tc.ExpectedStatus)
+
+			if tc.ExpectedStatus == http.StatusOK {
+				responseBody := recorder.Body.String()
+				if !contains(responseBody, "total_requested") || !contains(responseBody, "successful") {
+					t.Error("Expected bulk result in response")
+				}
+			}
+		})
+	}
+}
+
+func TestBulkHandler_PerItemErrors(t *testing.T) {
+	router := setupBulkTestRouter(t)
+	helper := testutil.NewHTTPTestHelper(t, router)
+
+	recorder := helper.POST("/api/projects/project-1/tasks/bulk/status", map[string]interface{}{
+		"task_ids": []string{"task-1", "private-task"},
+		"status":   "developing",
+	}, map[string]string{"Authorization": "Bearer mock-token"})
+	helper.AssertStatus(recorder, http.StatusOK)
+
+	var response struct {
+		Data struct {
+			Result services.BulkResult `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	result := response.Data.Result
+	if result.Successful != 1 || result.Failed != 1 {
+		t.Fatalf("Expected 1 success and 1 failure, got %d/%d", result.Successful, result.Failed)
+	}
+	if len(result.Errors) != 1 || result.Errors[0].TaskID != "private-task" || result.Errors[0].Index != 1 {
+		t.Errorf("Expected an error for private-task at index 1, got %+v", result.Errors)
+	}
+}
+
+func TestBulkHandler_ImportCSV(t *testing.T) {
+	tests := []struct {
+		Name           string
+		Filename       string
+		Content        string
+		ExpectedStatus int
+	}{
+		{
+			Name:           "import csv file",
+			Filename:       "tasks.csv",
+			Content:        "title,priority\nImported task,high\n",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "reject non-csv file",
+			Filename:       "tasks.txt",
+			Content:        "title\nImported task\n",
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Name:           "reject csv without title column",
+			Filename:       "tasks.csv",
+			Content:        "name\nImported task\n",
+			ExpectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	router := setupBulkTestRouter(t)
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			var body bytes.Buffer
+			writer := multipart.NewWriter(&body)
+			part, err := writer.CreateFormFile("file", tc.Filename)
+			if err != nil {
+				t.Fatalf("Failed to create form file: %v", err)
+			}
+			_, _ = part.Write([]byte(tc.Content))
+			_ = writer.Close()
+
+			req := httptest.NewRequest("POST", "/api/projects/project-1/tasks/bulk/import", &body)
+			req.Header.Set("Content-Type", writer.FormDataContentType())
+			req.Header.Set("Authorization", "Bearer mock-token")
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			if recorder.Code != tc.ExpectedStatus {
+				t.Errorf("Expected status %d, got %d: %s", tc.ExpectedStatus, recorder.Code, recorder.Body.String())
+			}
+		})
+	}
+
+	t.Run("missing file field", func(t *testing.T) {
+		helper := testutil.NewHTTPTestHelper(t, router)
+		recorder := helper.POST("/api/projects/project-1/tasks/bulk/import", nil,
+			map[string]string{"Authorization": "Bearer mock-token"})
+		helper.AssertStatus(recorder, http.StatusBadRequest)
+	})
+}
+
+func TestBulkHandler_ExportCSV(t *testing.T) {
+	router := setupBulkTestRouter(t)
+	helper := testutil.NewHTTPTestHelper(t, router)
+	headers := map[string]string{"Authorization": "Bearer mock-token"}
+
+	t.Run("streams csv download", func(t *testing.T) {
+		recorder := helper.GET("/api/projects/project-1/tasks/bulk/export", headers)
+		helper.AssertStatus(recorder, http.StatusOK)
+
+		if contentType := recorder.Header().Get("Content-Type"); !strings.HasPrefix(contentType, "text/csv") {
+			t.Errorf("Expected text/csv content type, got %q", contentType)
+		}
+		if !strings.Contains(recorder.Header().Get("Content-Disposition"), "attachment") {
+			t.Error("Expected attachment content disposition")
+		}
+
+		lines := strings.Split(strings.TrimSpace(recorder.Body.String()), "\n")
+		if len(lines) != 4 {
+			t.Errorf("Expected header plus 3 tasks, got %d lines", len(lines))
+		}
+	})
+
+	t.Run("access error is a json response", func(t *testing.T) {
+		recorder := helper.GET("/api/projects/private-project/tasks/bulk/export", headers)
+		helper.AssertStatus(recorder, http.StatusForbidden)
+
+		if contentType := recorder.Header().Get("Content-Type"); !strings.HasPrefix(contentType, "application/json") {
+			t.Errorf("Expected JSON error response, got %q", contentType)
+		}
+	})
+}
+
+// setupBulkTestRouter wires the bulk handler next to the task routes it shares a prefix with.
+func setupBulkTestRouter(_ *testing.T) *gin.Engine {
+	router := testutil.NewTestRouter()
+
+	taskRepo := testutil.NewMockTaskRepository()
+	projectRepo := testutil.NewMockProjectRepository()
+	userRepo := testutil.NewMockUserRepository()
+
+	testUser := testutil.MockUser("user-1", "test@example.com", "testuser", "Test User")
+	otherUser := testutil.MockUser("user-2", "test2@example.com", "testuser2", "Test User 2")
+	userRepo.AddUser(testUser)
+	userRepo.AddUser(otherUser)
+
+	projectRepo.AddProject(testutil.MockProject("project-1", "Test Project", "test-project", "user-1"))
+	privateProject := testutil.MockProject("private-project", "Private Project", "private-project", "user-2")
+	privateProject.Settings.IsPrivate = true
+	projectRepo.AddProject(privateProject)
+
+	taskRepo.AddTask(testutil.MockTask("task-1", "Test Task", "project-1", "user-1"))
+	taskRepo.AddTask(testutil.MockTask("task-2", "Test Task 2", "project-1", "user-1"))
+	taskRepo.AddTask(testutil.MockTask("task-3", "Test Task 3", "project-1", "user-1"))
+	taskRepo.AddTask(testutil.MockTask("private-task", "Private Task", "private-project", "user-2"))
+
+	taskService := services.NewTaskService(
+		taskRepo, projectRepo, userRepo, testutil.NewMockTaskHistoryRepository(),
+		services.NewAuthorizationService(projectRepo), nil, nil,
+	)
+	bulkService := services.NewBulkOperationService(taskRepo, projectRepo, taskService, nil)
+
+	authMiddleware := middleware.NewAuthMiddleware(&MockAuthService{user: testUser})
+
+	apiGroup := router.Group("/api")
+	api.NewTaskHandler(taskService, taskRepo).RegisterRoutes(apiGroup, authMiddleware)
+	api.NewBulkHandler(bulkService).RegisterRoutes(apiGroup, authMiddleware)
+
+	return router
+}
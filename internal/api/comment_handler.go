@@ -0,0 +1,303 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CommentHandler handles task comment and mention HTTP requests.
+type CommentHandler struct {
+	commentService services.CommentService
+}
+
+// NewCommentHandler creates a new comment handler.
+func NewCommentHandler(commentService services.CommentService) *CommentHandler {
+	return &CommentHandler{
+		commentService: commentService,
+	}
+}
+
+// RegisterRoutes registers comment and mention routes with the router.
+func (h *CommentHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *middleware.AuthMiddleware) {
+	tasks := router.Group("/tasks")
+	tasks.Use(authMiddleware.RequireAuth())
+	{
+		tasks.GET("/:taskId/comments", h.ListTaskComments)
+		tasks.POST("/:taskId/comments", h.CreateComment)
+	}
+
+	comments := router.Group("/comments")
+	comments.Use(authMiddleware.RequireAuth())
+	{
+		comments.GET("/:commentId", h.GetComment)
+		comments.PUT("/:commentId", h.UpdateComment)
+		comments.DELETE("/:commentId", h.DeleteComment)
+		comments.GET("/:commentId/thread", h.GetCommentThread)
+		comments.POST("/:commentId/reactions", h.AddReaction)
+		comments.DELETE("/:commentId/reactions/:emoji", h.RemoveReaction)
+		comments.POST("/:commentId/resolve", h.ResolveThread)
+		comments.DELETE("/:commentId/resolve", h.ReopenThread)
+	}
+
+	router.GET("/mentions", authMiddleware.RequireAuth(), h.ListMentions)
+}
+
+// ListTaskComments handles GET /api/tasks/:taskId/comments requests.
+// hide_resolved=true leaves out resolved threads.
+func (h *CommentHandler) ListTaskComments(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	offset, limit := h.pagination(c)
+	hideResolved, _ := strconv.ParseBool(c.Query("hide_resolved"))
+
+	comments, err := h.commentService.ListTaskComments(c.Request.Context(), c.Param("taskId"), user.ID,
+		services.ListCommentsRequest{Offset: offset, Limit: limit, HideResolved: hideResolved})
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    comments,
+	})
+}
+
+// CreateComment handles POST /api/tasks/:taskId/comments requests.
+func (h *CommentHandler) CreateComment(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	// The task comes from the path, so the body need not repeat it
+	req := domain.CreateCommentRequest{TaskID: c.Param("taskId")}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		invalidRequest(c, err)
+		return
+	}
+	req.TaskID = c.Param("taskId")
+
+	comment, err := h.commentService.CreateComment(c.Request.Context(), req, user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    comment,
+	})
+}
+
+// GetComment handles GET /api/comments/:commentId requests.
+func (h *CommentHandler) GetComment(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	comment, err := h.commentService.GetComment(c.Request.Context(), c.Param("commentId"), user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    comment,
+	})
+}
+
+// UpdateComment handles PUT /api/comments/:commentId requests.
+func (h *CommentHandler) UpdateComment(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	var req domain.UpdateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		invalidRequest(c, err)
+		return
+	}
+
+	comment, err := h.commentService.UpdateComment(c.Request.Context(), c.Param("commentId"), req, user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    comment,
+	})
+}
+
+// DeleteComment handles DELETE /api/comments/:commentId requests.
+func (h *CommentHandler) DeleteComment(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	if err := h.commentService.DeleteComment(c.Request.Context(), c.Param("commentId"), user.ID); err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Comment deleted successfully",
+	})
+}
+
+// GetCommentThread handles GET /api/comments/:commentId/thread requests.
+func (h *CommentHandler) GetCommentThread(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	thread, err := h.commentService.GetCommentThread(c.Request.Context(), c.Param("commentId"), user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    thread,
+	})
+}
+
+// reactionRequest is the body of a request to react to a comment.
+type reactionRequest struct {
+	Emoji string `json:"emoji" binding:"required"`
+}
+
+// AddReaction handles POST /api/comments/:commentId/reactions requests.
+func (h *CommentHandler) AddReaction(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	var req reactionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		invalidRequest(c, err)
+		return
+	}
+
+	h.respondWithComment(c, func(ctx context.Context) (*domain.Comment, error) {
+		return h.commentService.AddReaction(ctx, c.Param("commentId"), req.Emoji, user.ID)
+	})
+}
+
+// RemoveReaction handles DELETE /api/comments/:commentId/reactions/:emoji requests.
+func (h *CommentHandler) RemoveReaction(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	h.respondWithComment(c, func(ctx context.Context) (*domain.Comment, error) {
+		return h.commentService.RemoveReaction(ctx, c.Param("commentId"), c.Param("emoji"), user.ID)
+	})
+}
+
+// ResolveThread handles POST /api/comments/:commentId/resolve requests.
+func (h *CommentHandler) ResolveThread(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	h.respondWithComment(c, func(ctx context.Context) (*domain.Comment, error) {
+		return h.commentService.ResolveThread(ctx, c.Param("commentId"), user.ID)
+	})
+}
+
+// ReopenThread handles DELETE /api/comments/:commentId/resolve requests.
+func (h *CommentHandler) ReopenThread(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	h.respondWithComment(c, func(ctx context.Context) (*domain.Comment, error) {
+		return h.commentService.ReopenThread(ctx, c.Param("commentId"), user.ID)
+	})
+}
+
+// respondWithComment runs a comment change and writes the resulting comment.
+func (h *CommentHandler) respondWithComment(
+	c *gin.Context, change func(ctx context.Context) (*domain.Comment, error),
+) {
+	comment, err := change(c.Request.Context())
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    comment,
+	})
+}
+
+// ListMentions handles GET /api/mentions requests, listing the comments that @mention the user.
+func (h *CommentHandler) ListMentions(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	offset, limit := h.pagination(c)
+	page, err := h.commentService.ListMentions(c.Request.Context(), user.ID, offset, limit)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    page,
+	})
+}
+
+// pagination reads the offset and limit query parameters, falling back to the first page.
+func (h *CommentHandler) pagination(c *gin.Context) (int, int) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(services.DefaultCommentLimit)))
+	if err != nil || limit <= 0 || limit > services.MaxCommentLimit {
+		limit = services.DefaultCommentLimit
+	}
+
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	return offset, limit
+}
@@ -0,0 +1,202 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestCommentHandler_Routes(t *testing.T) {
+	tests := []testutil.TestCase{
+		{
+			Name:           "create comment",
+			Method:         "POST",
+			URL:            "/api/tasks/task-1/comments",
+			Body:           map[string]interface{}{"content": "@teammate can you review?"},
+			ExpectedStatus: http.StatusCreated,
+		},
+		{
+			Name:           "mention a user outside the project",
+			Method:         "POST",
+			URL:            "/api/tasks/task-1/comments",
+			Body:           map[string]interface{}{"content": "@outsider FYI"},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Name:           "comment on a missing task",
+			Method:         "POST",
+			URL:            "/api/tasks/missing/comments",
+			Body:           map[string]interface{}{"content": "Hello"},
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Name:           "list task comments",
+			Method:         "GET",
+			URL:            "/api/tasks/task-1/comments?limit=10",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "get comment",
+			Method:         "GET",
+			URL:            "/api/comments/comment-1",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "comment not found",
+			Method:         "GET",
+			URL:            "/api/comments/missing",
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Name:           "update comment",
+			Method:         "PUT",
+			URL:            "/api/comments/comment-1",
+			Body:           map[string]interface{}{"content": "@teammate never mind"},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "comment thread",
+			Method:         "GET",
+			URL:            "/api/comments/comment-1/thread",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "react to comment",
+			Method:         "POST",
+			URL:            "/api/comments/comment-1/reactions",
+			Body:           map[string]interface{}{"emoji": "👍"},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "react without an emoji",
+			Method:         "POST",
+			URL:            "/api/comments/comment-1/reactions",
+			Body:           map[string]interface{}{},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Name:           "remove reaction",
+			Method:         "DELETE",
+			URL:            "/api/comments/comment-1/reactions/%F0%9F%91%8D",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "resolve thread",
+			Method:         "POST",
+			URL:            "/api/comments/comment-1/resolve",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "list unresolved comments",
+			Method:         "GET",
+			URL:            "/api/tasks/task-1/comments?hide_resolved=true",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "reopen thread",
+			Method:         "DELETE",
+			URL:            "/api/comments/comment-1/resolve",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "list mentions",
+			Method:         "GET",
+			URL:            "/api/mentions",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "delete comment",
+			Method:         "DELETE",
+			URL:            "/api/comments/comment-1",
+			ExpectedStatus: http.StatusOK,
+		},
+	}
+
+	router := setupCommentTestRouter()
+	helper := testutil.NewHTTPTestHelper(t, router)
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(_ *testing.T) {
+			headers := map[string]string{
+				"Authorization": "Bearer mock-token",
+			}
+
+			recorder := helper.Request(tc.Method, tc.URL, tc.Body, headers)
+			helper.AssertStatus(recorder, tc.ExpectedStatus)
+		})
+	}
+}
+
+func TestCommentHandler_Mentions(t *testing.T) {
+	router := setupCommentTestRouter()
+	helper := testutil.NewHTTPTestHelper(t, router)
+	headers := map[string]string{"Authorization": "Bearer mock-token"}
+
+	recorder := helper.POST("/api/tasks/task-1/comments",
+		map[string]interface{}{"content": "Note to self, @testuser: ask @teammate"}, headers)
+	helper.AssertStatus(recorder, http.StatusCreated)
+
+	var created struct {
+		Data domain.Comment `json:"data"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &created); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(created.Data.Mentions) != 2 || created.Data.Mentions[1].UserID != "user-2" {
+		t.Errorf("Expected the comment to mention user-1 and user-2, got %+v", created.Data.Mentions)
+	}
+
+	recorder = helper.GET("/api/mentions", headers)
+	helper.AssertStatus(recorder, http.StatusOK)
+
+	var mentions struct {
+		Data services.MentionPage `json:"data"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &mentions); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(mentions.Data.Comments) != 1 || mentions.Data.Comments[0].ID != created.Data.ID {
+		t.Errorf("Expected the new comment to be listed, got %+v", mentions.Data.Comments)
+	}
+}
+
+// setupCommentTestRouter wires the comment handler over a private project owned by the test user,
+// with a teammate as member and a task to comment on.
+func setupCommentTestRouter() *gin.Engine {
+	router := testutil.NewTestRouter()
+
+	testUser := testutil.MockUser("user-1", "test@example.com", "testuser", "Test User")
+
+	userRepo := testutil.NewMockUserRepository()
+	userRepo.AddUser(testUser)
+	userRepo.AddUser(testutil.MockUser("user-2", "teammate@example.com", "teammate", "Teammate"))
+	userRepo.AddUser(testutil.MockUser("user-3", "outsider@example.com", "outsider", "Outsider"))
+
+	project := testutil.MockProject("project-1", "Project", "project", "user-1")
+	project.MemberIDs = []string{"user-2"}
+	project.Settings.IsPrivate = true
+	projectRepo := testutil.NewMockProjectRepository()
+	projectRepo.AddProject(project)
+
+	taskRepo := testutil.NewMockTaskRepository()
+	taskRepo.AddTask(testutil.MockTask("task-1", "Task", "project-1", "user-1"))
+
+	commentService := services.NewCommentService(
+		testutil.NewMockCommentRepository(), testutil.NewMockCommentMentionRepository(),
+		testutil.NewMockCommentReactionRepository(), taskRepo, projectRepo, userRepo,
+		services.NewAuthorizationService(projectRepo), nil,
+	)
+	authMiddleware := middleware.NewAuthMiddleware(&MockAuthService{user: testUser})
+
+	api.NewCommentHandler(commentService).RegisterRoutes(router.Group("/api"), authMiddleware)
+
+	return router
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CriticalPathHandler handles dependency graph analysis HTTP requests.
+type CriticalPathHandler struct {
+	criticalPathService services.CriticalPathService
+}
+
+// NewCriticalPathHandler creates a new critical path handler.
+func NewCriticalPathHandler(criticalPathService services.CriticalPathService) *CriticalPathHandler {
+	return &CriticalPathHandler{
+		criticalPathService: criticalPathService,
+	}
+}
+
+// RegisterRoutes registers critical path routes with the router.
+func (h *CriticalPathHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *middleware.AuthMiddleware) {
+	projects := router.Group("/projects")
+	projects.Use(authMiddleware.RequireAuth())
+	{
+		projects.GET("/:projectId/critical-path", h.GetCriticalPath)
+	}
+}
+
+// GetCriticalPath handles GET /api/projects/:projectId/critical-path requests.
+// format=dot returns a Graphviz digraph instead of JSON; start (RFC 3339 or YYYY-MM-DD)
+// anchors the schedule and hours_per_day sets how much effort fits in a day.
+func (h *CriticalPathHandler) GetCriticalPath(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "AUTHENTICATION_ERROR",
+				"code":    "USER_NOT_FOUND",
+				"message": "User not found in context",
+			},
+		})
+		return
+	}
+
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "dot" {
+		ErrorResponse(c, domain.NewValidationError("INVALID_FORMAT", "Format must be json or dot", nil))
+		return
+	}
+
+	var options domain.ScheduleOptions
+	if value := c.Query("hours_per_day"); value != "" {
+		hours, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			ErrorResponse(c, domain.NewValidationError("INVALID_HOURS_PER_DAY", "Hours per day must be a number", nil))
+			return
+		}
+		options.HoursPerDay = hours
+	}
+	if value := c.Query("start"); value != "" {
+		start, err := parseScheduleStart(value)
+		if err != nil {
+			ErrorResponse(c, domain.NewValidationError("INVALID_START",
+				"Start must be an RFC 3339 timestamp or a YYYY-MM-DD date", nil))
+			return
+		}
+		options.StartsAt = start
+	}
+
+	analysis, err := h.criticalPathService.Analyze(c.Request.Context(), c.Param("projectId"), options, user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	if format == "dot" {
+		c.Data(http.StatusOK, "text/vnd.graphviz; charset=utf-8", []byte(analysis.DOT()))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    analysis,
+	})
+}
+
+// parseScheduleStart accepts a full timestamp or a date at midnight UTC
+func parseScheduleStart(value string) (time.Time, error) {
+	if start, err := time.Parse(time.RFC3339, value); err == nil {
+		return start.UTC(), nil
+	}
+	return time.Parse(time.DateOnly, value)
+}
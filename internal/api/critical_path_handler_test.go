@@ -0,0 +1,137 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestCriticalPathHandler_GetCriticalPath(t *testing.T) {
+	tests := []testutil.TestCase{
+		{
+			Name:           "json analysis",
+			Method:         "GET",
+			URL:            "/api/projects/project-1/critical-path",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "dot export with schedule options",
+			Method:         "GET",
+			URL:            "/api/projects/project-1/critical-path?format=dot&start=2025-09-01&hours_per_day=6",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "unknown format",
+			Method:         "GET",
+			URL:            "/api/projects/project-1/critical-path?format=png",
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Name:           "invalid start",
+			Method:         "GET",
+			URL:            "/api/projects/project-1/critical-path?start=next-week",
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Name:           "hours per day out of range",
+			Method:         "GET",
+			URL:            "/api/projects/project-1/critical-path?hours_per_day=30",
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Name:           "private project of another user",
+			Method:         "GET",
+			URL:            "/api/projects/private-project/critical-path",
+			ExpectedStatus: http.StatusForbidden,
+		},
+		{
+			Name:           "project not found",
+			Method:         "GET",
+			URL:            "/api/projects/non-existent/critical-path",
+			ExpectedStatus: http.StatusNotFound,
+		},
+	}
+
+	router := setupCriticalPathTestRouter(t)
+	helper := testutil.NewHTTPTestHelper(t, router)
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(_ *testing.T) {
+			headers := map[string]string{
+				"Authorization": "Bearer mock-token",
+			}
+
+			recorder := helper.Request(tc.Method, tc.URL, tc.Body, headers)
+			helper.AssertStatus(recorder, tc.ExpectedStatus)
+		})
+	}
+}
+
+func TestCriticalPathHandler_Formats(t *testing.T) {
+	router := setupCriticalPathTestRouter(t)
+	helper := testutil.NewHTTPTestHelper(t, router)
+	headers := map[string]string{"Authorization": "Bearer mock-token"}
+
+	recorder := helper.GET("/api/projects/project-1/critical-path?start=2025-09-01T00:00:00Z", headers)
+	helper.AssertStatus(recorder, http.StatusOK)
+
+	var response struct {
+		Data domain.CriticalPathAnalysis `json:"data"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(response.Data.CriticalPath) != 2 || response.Data.CriticalPath[0] != "task-1" {
+		t.Errorf("Expected critical path [task-1 task-2], got %v", response.Data.CriticalPath)
+	}
+
+	recorder = helper.GET("/api/projects/project-1/critical-path?format=dot", headers)
+	helper.AssertStatus(recorder, http.StatusOK)
+
+	if contentType := recorder.Header().Get("Content-Type"); !strings.HasPrefix(contentType, "text/vnd.graphviz") {
+		t.Errorf("Expected Graphviz content type, got %s", contentType)
+	}
+	if !strings.Contains(recorder.Body.String(), `"task-1" -> "task-2"`) {
+		t.Errorf("Expected dependency edge in DOT output, got:\n%s", recorder.Body.String())
+	}
+}
+
+// setupCriticalPathTestRouter wires the critical path handler over a two task chain.
+func setupCriticalPathTestRouter(_ *testing.T) *gin.Engine {
+	router := testutil.NewTestRouter()
+
+	taskRepo := testutil.NewMockTaskRepository()
+	projectRepo := testutil.NewMockProjectRepository()
+
+	testUser := testutil.MockUser("user-1", "test@example.com", "testuser", "Test User")
+
+	projectRepo.AddProject(testutil.MockProject("project-1", "Test Project", "test-project", "user-1"))
+	privateProject := testutil.MockProject("private-project", "Private Project", "private-project", "user-2")
+	privateProject.Settings.IsPrivate = true
+	projectRepo.AddProject(privateProject)
+
+	effort := 4.0
+	first := testutil.MockTask("task-1", "First", "project-1", "user-1")
+	first.EffortEstimate = &effort
+	second := testutil.MockTask("task-2", "Second", "project-1", "user-1")
+	second.EffortEstimate = &effort
+	second.Dependencies = []string{"task-1"}
+	taskRepo.AddTask(first)
+	taskRepo.AddTask(second)
+
+	criticalPathService := services.NewCriticalPathService(taskRepo, projectRepo)
+	authMiddleware := middleware.NewAuthMiddleware(&MockAuthService{user: testUser})
+
+	api.NewCriticalPathHandler(criticalPathService).RegisterRoutes(router.Group("/api"), authMiddleware)
+
+	return router
+}
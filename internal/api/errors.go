@@ -130,6 +130,9 @@ func (s *ErrorSanitizer) logErrorWithContext(
 	}
 }
 
+// safeConflictDetails are conflict details that only hold task IDs, statuses or counts and are safe to return
+var safeConflictDetails = []string{"cycle", "blocked_by", "status", "tasks"}
+
 // sanitizeErrorForClient returns safe error response for client consumption
 func (s *ErrorSanitizer) sanitizeErrorForClient(
 	domainErr *domain.Error,
@@ -169,6 +172,13 @@ func (s *ErrorSanitizer) sanitizeErrorForClient(
 			errorMap["message"] = "Requested resource not found"
 		case domain.ConflictError:
 			errorMap["message"] = "Resource conflict occurred"
+			// Dependency and workflow conflicts name the tasks or status involved so clients can point at them
+			for _, key := range safeConflictDetails {
+				if value, ok := domainErr.Details[key]; ok {
+					errorMap["message"] = domainErr.Message
+					errorMap[key] = value
+				}
+			}
 		case domain.AuthenticationError:
 			errorMap["message"] = "Authentication failed"
 		case domain.AuthorizationError:
@@ -237,3 +247,28 @@ func isSensitiveField(field string) bool {
 	}
 	return sensitiveFields[field]
 }
+
+// userNotFound writes the response for a request without an authenticated user
+func userNotFound(c *gin.Context) {
+	c.JSON(http.StatusUnauthorized, gin.H{
+		"success": false,
+		"error": map[string]interface{}{
+			"type":    "AUTHENTICATION_ERROR",
+			"code":    "USER_NOT_FOUND",
+			"message": "User not found in context",
+		},
+	})
+}
+
+// invalidRequest writes the response for a request that can't be bound
+func invalidRequest(c *gin.Context, err error) {
+	c.JSON(http.StatusBadRequest, gin.H{
+		"success": false,
+		"error": map[string]interface{}{
+			"type":    "VALIDATION_ERROR",
+			"code":    "INVALID_REQUEST",
+			"message": "Invalid request format",
+			"details": err.Error(),
+		},
+	})
+}
@@ -117,6 +117,54 @@ func TestSanitizedErrorResponse(t *testing.T) {
 	}
 }
 
+func TestSanitizedErrorResponse_DependencyCycle(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	req, _ := http.NewRequestWithContext(context.Background(), "POST", "/test", nil)
+	c.Request = req
+
+	SanitizedErrorResponse(c, domain.NewDependencyCycleError([]string{"a", "b", "c", "a"}))
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	var response struct {
+		Error struct {
+			Code    string   `json:"code"`
+			Message string   `json:"message"`
+			Cycle   []string `json:"cycle"`
+		} `json:"error"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "CIRCULAR_DEPENDENCY", response.Error.Code)
+	assert.Equal(t, []string{"a", "b", "c", "a"}, response.Error.Cycle)
+	assert.Contains(t, response.Error.Message, "a → b → c → a")
+}
+
+func TestSanitizedErrorResponse_TaskBlocked(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	req, _ := http.NewRequestWithContext(context.Background(), "PUT", "/test", nil)
+	c.Request = req
+
+	SanitizedErrorResponse(c, domain.NewTaskBlockedError([]string{"b", "c"}))
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	var response struct {
+		Error struct {
+			Code      string   `json:"code"`
+			BlockedBy []string `json:"blocked_by"`
+		} `json:"error"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "TASK_BLOCKED", response.Error.Code)
+	assert.Equal(t, []string{"b", "c"}, response.Error.BlockedBy)
+}
+
 func TestErrorSanitization_SensitiveFields(t *testing.T) {
 	sensitiveFields := []string{
 		"password", "token", "secret", "key", "authorization",
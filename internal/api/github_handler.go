@@ -48,18 +48,22 @@ type GitHubHandler struct {
 	githubOAuthService   *services.GitHubOAuthService
 	githubService        *services.GitHubService
 	githubWebhookService *services.GitHubWebhookService
+	authz                services.AuthorizationService
 }
 
-// NewGitHubHandler creates a new GitHub handler
+// NewGitHubHandler creates a new GitHub handler.
+// Managing a project's integration takes domain.PermissionManageIntegrations in the project.
 func NewGitHubHandler(
 	githubOAuthService *services.GitHubOAuthService,
 	githubService *services.GitHubService,
 	githubWebhookService *services.GitHubWebhookService,
+	authz services.AuthorizationService,
 ) *GitHubHandler {
 	return &GitHubHandler{
 		githubOAuthService:   githubOAuthService,
 		githubService:        githubService,
 		githubWebhookService: githubWebhookService,
+		authz:                authz,
 	}
 }
 
@@ -251,6 +255,10 @@ func (h *GitHubHandler) CreateIntegration(c *gin.Context) {
 		return
 	}
 
+	if !h.authorizeProject(c, req.ProjectID, userID, domain.PermissionManageIntegrations) {
+		return
+	}
+
 	// Try to get token from cookie first, then from service
 	accessToken, err := c.Cookie("github_token")
 	if err != nil || accessToken == "" {
@@ -291,6 +299,10 @@ func (h *GitHubHandler) CreateIntegration(c *gin.Context) {
 func (h *GitHubHandler) GetIntegrationByProject(c *gin.Context) {
 	projectID := c.Param("projectId")
 
+	if !h.authorizeProject(c, projectID, getUserIDFromContext(c), domain.PermissionViewProject) {
+		return
+	}
+
 	integration, err := h.githubService.GetIntegrationByProjectID(c.Request.Context(), projectID)
 	if err != nil {
 		if err == repository.ErrNotFound {
@@ -330,6 +342,10 @@ func (h *GitHubHandler) SyncIssueToTask(c *gin.Context) {
 		return
 	}
 
+	if _, ok := h.authorizeIntegration(c, integrationID, domain.PermissionEditTasks); !ok {
+		return
+	}
+
 	err := h.githubService.SyncIssueToTask(
 		c.Request.Context(),
 		integrationID,
@@ -354,6 +370,10 @@ func (h *GitHubHandler) CreateIssueFromTask(c *gin.Context) {
 	integrationID := c.Param("integrationId")
 	taskID := c.Param("taskId")
 
+	if _, ok := h.authorizeIntegration(c, integrationID, domain.PermissionEditTasks); !ok {
+		return
+	}
+
 	// Get task details - this would need to be implemented
 	task, err := h.getTaskByID(c.Request.Context(), taskID)
 	if err != nil {
@@ -388,6 +408,10 @@ func (h *GitHubHandler) CreateBranchForTask(c *gin.Context) {
 	integrationID := c.Param("integrationId")
 	taskID := c.Param("taskId")
 
+	if _, ok := h.authorizeIntegration(c, integrationID, domain.PermissionEditTasks); !ok {
+		return
+	}
+
 	// Get task details
 	task, err := h.getTaskByID(c.Request.Context(), taskID)
 	if err != nil {
@@ -478,26 +502,15 @@ func (h *GitHubHandler) UpdateIntegrationSettings(c *gin.Context) {
 		return
 	}
 
-	integration, err := h.githubService.GetIntegrationByID(c.Request.Context(), integrationID)
-	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{
-			"error": "Integration not found",
-		})
-		return
-	}
-
-	// Verify ownership
-	if integration.UserID != userID {
-		c.JSON(http.StatusForbidden, gin.H{
-			"error": "You are not authorized to update this integration",
-		})
+	integration, ok := h.authorizeIntegration(c, integrationID, domain.PermissionManageIntegrations)
+	if !ok {
 		return
 	}
 
 	integration.Settings = req.Settings
 	integration.UpdatedAt = time.Now()
 
-	err = h.githubService.UpdateIntegration(c.Request.Context(), integration)
+	err := h.githubService.UpdateIntegration(c.Request.Context(), integration)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Failed to update integration settings",
@@ -525,31 +538,11 @@ func (h *GitHubHandler) DeleteIntegration(c *gin.Context) {
 		return
 	}
 
-	// Load the integration to verify ownership
-	integration, err := h.githubService.GetIntegrationByID(c.Request.Context(), integrationID)
-	if err != nil {
-		if err == repository.ErrNotFound {
-			c.JSON(http.StatusNotFound, gin.H{
-				"error": "Integration not found",
-			})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to get integration",
-			"details": err.Error(),
-		})
-		return
-	}
-
-	// Verify ownership
-	if integration.UserID != userID {
-		c.JSON(http.StatusForbidden, gin.H{
-			"error": "You are not authorized to delete this integration",
-		})
+	if _, ok := h.authorizeIntegration(c, integrationID, domain.PermissionManageIntegrations); !ok {
 		return
 	}
 
-	err = h.githubService.DeleteIntegration(c.Request.Context(), integrationID)
+	err := h.githubService.DeleteIntegration(c.Request.Context(), integrationID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Failed to delete integration",
@@ -571,6 +564,48 @@ func (h *GitHubHandler) HandleWebhook(c *gin.Context) {
 
 // Helper methods
 
+// authorizeProject checks the user holds the permission in the project, responding with the error if not
+func (h *GitHubHandler) authorizeProject(
+	c *gin.Context, projectID, userID string, permission domain.Permission,
+) bool {
+	if _, err := h.authz.Authorize(c.Request.Context(), projectID, userID, permission); err != nil {
+		status := http.StatusForbidden
+		if domainErr, ok := err.(*domain.Error); ok && domainErr.Type == domain.NotFoundError {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, gin.H{
+			"error": err.Error(),
+		})
+		return false
+	}
+	return true
+}
+
+// authorizeIntegration loads an integration, checking the user holds the permission in its project
+func (h *GitHubHandler) authorizeIntegration(
+	c *gin.Context, integrationID string, permission domain.Permission,
+) (*domain.GitHubIntegration, bool) {
+	integration, err := h.githubService.GetIntegrationByID(c.Request.Context(), integrationID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": "Integration not found",
+			})
+			return nil, false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get integration",
+			"details": err.Error(),
+		})
+		return nil, false
+	}
+
+	if !h.authorizeProject(c, integration.ProjectID, getUserIDFromContext(c), permission) {
+		return nil, false
+	}
+	return integration, true
+}
+
 func (h *GitHubHandler) getTaskByID(_ context.Context, taskID string) (*domain.Task, error) {
 	// This would need to be implemented to get task from task service
 	// For now, return a placeholder
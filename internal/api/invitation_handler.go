@@ -0,0 +1,191 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// InvitationHandler handles project invitation HTTP requests.
+type InvitationHandler struct {
+	invitationService services.InvitationService
+}
+
+// NewInvitationHandler creates a new invitation handler.
+func NewInvitationHandler(invitationService services.InvitationService) *InvitationHandler {
+	return &InvitationHandler{
+		invitationService: invitationService,
+	}
+}
+
+// invitationTokenRequest carries an invitation token. Tokens go in the body rather than the
+// URL so they don't end up in access logs.
+type invitationTokenRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// RegisterRoutes registers invitation routes with the router.
+func (h *InvitationHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *middleware.AuthMiddleware) {
+	projects := router.Group("/projects")
+	projects.Use(authMiddleware.RequireAuth())
+	{
+		projects.GET("/:projectId/invitations", h.ListInvitations)
+		projects.POST("/:projectId/invitations", h.CreateInvitation)
+		projects.DELETE("/:projectId/invitations/:invitationId", h.RevokeInvitation)
+	}
+
+	invitations := router.Group("/invitations")
+	{
+		invitations.POST("/accept", authMiddleware.RequireAuth(), h.AcceptInvitation)
+		invitations.POST("/decline", authMiddleware.RequireAuth(), h.DeclineInvitation)
+		// New users create their account and join the project in one step
+		invitations.POST("/register", h.RegisterWithInvitation)
+	}
+}
+
+// ListInvitations handles GET /api/projects/:projectId/invitations requests.
+func (h *InvitationHandler) ListInvitations(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	invitations, err := h.invitationService.ListInvitations(c.Request.Context(), c.Param("projectId"), user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    invitations,
+	})
+}
+
+// CreateInvitation handles POST /api/projects/:projectId/invitations requests.
+func (h *InvitationHandler) CreateInvitation(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	var req domain.CreateInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		invalidRequest(c, err)
+		return
+	}
+
+	invitation, err := h.invitationService.CreateInvitation(c.Request.Context(), c.Param("projectId"), req, user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    invitation,
+	})
+}
+
+// RevokeInvitation handles DELETE /api/projects/:projectId/invitations/:invitationId requests.
+func (h *InvitationHandler) RevokeInvitation(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	err := h.invitationService.RevokeInvitation(
+		c.Request.Context(), c.Param("projectId"), c.Param("invitationId"), user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Invitation revoked successfully",
+	})
+}
+
+// AcceptInvitation handles POST /api/invitations/accept requests.
+func (h *InvitationHandler) AcceptInvitation(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	var req invitationTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		invalidRequest(c, err)
+		return
+	}
+
+	project, err := h.invitationService.AcceptInvitation(c.Request.Context(), req.Token, user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    project,
+	})
+}
+
+// DeclineInvitation handles POST /api/invitations/decline requests.
+func (h *InvitationHandler) DeclineInvitation(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	var req invitationTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		invalidRequest(c, err)
+		return
+	}
+
+	if err := h.invitationService.DeclineInvitation(c.Request.Context(), req.Token, user.ID); err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Invitation declined",
+	})
+}
+
+// RegisterWithInvitation handles POST /api/invitations/register requests.
+func (h *InvitationHandler) RegisterWithInvitation(c *gin.Context) {
+	var req domain.RegisterWithInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		// Don't echo binding errors, which may include the password
+		SanitizedErrorResponse(c, domain.NewValidationError("INVALID_REQUEST", "Invalid request format",
+			map[string]interface{}{"field": "request_body"}))
+		return
+	}
+
+	user, project, err := h.invitationService.AcceptInvitationAsNewUser(c.Request.Context(), req)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data": gin.H{
+			"user":    user,
+			"project": project,
+		},
+	})
+}
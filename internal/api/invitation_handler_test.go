@@ -0,0 +1,117 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestInvitationHandler(t *testing.T) {
+	router := setupInvitationTestRouter()
+	helper := testutil.NewHTTPTestHelper(t, router)
+	headers := map[string]string{"Authorization": "Bearer mock-token"}
+
+	recorder := helper.POST("/api/projects/project-1/invitations", map[string]interface{}{
+		"role": "viewer", "max_uses": 5,
+	}, headers)
+	helper.AssertStatus(recorder, http.StatusCreated)
+
+	var created struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &created); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	token, _ := created.Data["token"].(string)
+	invitationID, _ := created.Data["id"].(string)
+	if token == "" || invitationID == "" {
+		t.Fatalf("Expected the link invitation's token and ID, got %v", created.Data)
+	}
+
+	t.Run("invalid role", func(t *testing.T) {
+		recorder := helper.POST("/api/projects/project-1/invitations", map[string]interface{}{
+			"role": "owner",
+		}, headers)
+		helper.AssertStatus(recorder, http.StatusBadRequest)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		recorder := helper.GET("/api/projects/project-1/invitations", headers)
+		helper.AssertStatus(recorder, http.StatusOK)
+
+		var listed struct {
+			Data []map[string]interface{} `json:"data"`
+		}
+		if err := json.Unmarshal(recorder.Body.Bytes(), &listed); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if len(listed.Data) != 1 || listed.Data[0]["token"] != nil {
+			t.Errorf("Expected one invitation without its token, got %v", listed.Data)
+		}
+	})
+
+	t.Run("accepting requires authentication", func(t *testing.T) {
+		recorder := helper.POST("/api/invitations/accept", map[string]interface{}{"token": token}, nil)
+		helper.AssertStatus(recorder, http.StatusUnauthorized)
+	})
+
+	t.Run("owner is already a member", func(t *testing.T) {
+		recorder := helper.POST("/api/invitations/accept", map[string]interface{}{"token": token}, headers)
+		helper.AssertStatus(recorder, http.StatusConflict)
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		recorder := helper.POST("/api/invitations/accept", map[string]interface{}{"token": "nope"}, headers)
+		helper.AssertStatus(recorder, http.StatusNotFound)
+	})
+
+	t.Run("links can't be declined", func(t *testing.T) {
+		recorder := helper.POST("/api/invitations/decline", map[string]interface{}{"token": token}, headers)
+		helper.AssertStatus(recorder, http.StatusBadRequest)
+	})
+
+	t.Run("register needs a password", func(t *testing.T) {
+		recorder := helper.POST("/api/invitations/register", map[string]interface{}{
+			"token": token, "username": "newbie", "name": "New",
+		}, nil)
+		helper.AssertStatus(recorder, http.StatusBadRequest)
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		recorder := helper.DELETE("/api/projects/project-1/invitations/"+invitationID, headers)
+		helper.AssertStatus(recorder, http.StatusOK)
+
+		recorder = helper.DELETE("/api/projects/project-1/invitations/"+invitationID, headers)
+		helper.AssertStatus(recorder, http.StatusBadRequest)
+	})
+}
+
+// setupInvitationTestRouter wires the invitation handler over a project owned by the test user.
+func setupInvitationTestRouter() *gin.Engine {
+	router := testutil.NewTestRouter()
+
+	testUser := testutil.MockUser("user-1", "test@example.com", "testuser", "Test User")
+	userRepo := testutil.NewMockUserRepository()
+	userRepo.AddUser(testUser)
+
+	projectRepo := testutil.NewMockProjectRepository()
+	projectRepo.AddProject(testutil.MockProject("project-1", "Project", "project", "user-1"))
+
+	authService := &MockAuthService{user: testUser}
+	invitationService := services.NewInvitationService(
+		testutil.NewMockProjectInvitationRepository(), projectRepo, userRepo, authService,
+		services.NewAuthorizationService(projectRepo), nil,
+	)
+	authMiddleware := middleware.NewAuthMiddleware(authService)
+
+	api.NewInvitationHandler(invitationService).RegisterRoutes(router.Group("/api"), authMiddleware)
+
+	return router
+}
@@ -0,0 +1,190 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MFAHandler handles two-factor authentication HTTP requests.
+// The second login step itself is served by AuthHandler.
+type MFAHandler struct {
+	mfaService  services.MFAService
+	authService services.AuthService
+}
+
+// NewMFAHandler creates a new two-factor authentication handler.
+func NewMFAHandler(mfaService services.MFAService, authService services.AuthService) *MFAHandler {
+	return &MFAHandler{
+		mfaService:  mfaService,
+		authService: authService,
+	}
+}
+
+// mfaChallengeRequest carries a login challenge token
+type mfaChallengeRequest struct {
+	MFAToken string `json:"mfa_token" binding:"required"`
+}
+
+// RegisterRoutes registers two-factor authentication routes with the router.
+func (h *MFAHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *middleware.AuthMiddleware) {
+	mfa := router.Group("/auth/mfa")
+	{
+		mfa.GET("", authMiddleware.RequireAuth(), h.GetStatus)
+		mfa.POST("/enroll", authMiddleware.RequireAuth(), h.BeginEnrollment)
+		mfa.POST("/confirm", authMiddleware.RequireAuth(), h.ConfirmEnrollment)
+		mfa.POST("/recovery-codes", authMiddleware.RequireAuth(), h.RegenerateRecoveryCodes)
+		mfa.POST("/disable", authMiddleware.RequireAuth(), h.Disable)
+		// Users who must set up two-factor authentication before they can log in enroll with
+		// their login challenge, then finish logging in at /auth/mfa/verify
+		mfa.POST("/challenge/enroll", h.BeginChallengeEnrollment)
+	}
+}
+
+// GetStatus handles GET /api/auth/mfa requests.
+func (h *MFAHandler) GetStatus(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	status, err := h.mfaService.Status(c.Request.Context(), user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    status,
+	})
+}
+
+// BeginEnrollment handles POST /api/auth/mfa/enroll requests.
+func (h *MFAHandler) BeginEnrollment(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	h.respondWithEnrollment(c, user.ID)
+}
+
+// BeginChallengeEnrollment handles POST /api/auth/mfa/challenge/enroll requests.
+func (h *MFAHandler) BeginChallengeEnrollment(c *gin.Context) {
+	var req mfaChallengeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		invalidRequest(c, err)
+		return
+	}
+
+	user, err := h.authService.ValidateMFAChallenge(c.Request.Context(), req.MFAToken)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	h.respondWithEnrollment(c, user.ID)
+}
+
+// ConfirmEnrollment handles POST /api/auth/mfa/confirm requests.
+func (h *MFAHandler) ConfirmEnrollment(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	var req domain.MFACodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		invalidRequest(c, err)
+		return
+	}
+
+	codes, err := h.mfaService.ConfirmEnrollment(c.Request.Context(), user.ID, req.Code)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	h.respondWithRecoveryCodes(c, codes)
+}
+
+// RegenerateRecoveryCodes handles POST /api/auth/mfa/recovery-codes requests.
+func (h *MFAHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	var req domain.MFACodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		invalidRequest(c, err)
+		return
+	}
+
+	codes, err := h.mfaService.RegenerateRecoveryCodes(c.Request.Context(), user.ID, req.Code)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	h.respondWithRecoveryCodes(c, codes)
+}
+
+// Disable handles POST /api/auth/mfa/disable requests.
+func (h *MFAHandler) Disable(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	var req domain.MFACodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		invalidRequest(c, err)
+		return
+	}
+
+	if err := h.mfaService.Disable(c.Request.Context(), user.ID, req.Code); err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Two-factor authentication disabled; sign in again on your other devices",
+	})
+}
+
+// respondWithEnrollment starts enrolling a user and writes the secret to add to their app.
+func (h *MFAHandler) respondWithEnrollment(c *gin.Context, userID string) {
+	enrollment, err := h.mfaService.BeginEnrollment(c.Request.Context(), userID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    enrollment,
+	})
+}
+
+// respondWithRecoveryCodes writes recovery codes, which are only ever shown once.
+func (h *MFAHandler) respondWithRecoveryCodes(c *gin.Context, codes []string) {
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"recovery_codes": codes,
+		},
+		"message": "Store these recovery codes somewhere safe, they won't be shown again",
+	})
+}
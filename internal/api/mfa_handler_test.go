@@ -0,0 +1,87 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/config"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestMFAHandler(t *testing.T) {
+	router := setupMFATestRouter()
+	helper := testutil.NewHTTPTestHelper(t, router)
+	headers := map[string]string{"Authorization": "Bearer mock-token"}
+
+	t.Run("requires authentication", func(t *testing.T) {
+		helper.AssertStatus(helper.GET("/api/auth/mfa", nil), http.StatusUnauthorized)
+	})
+
+	t.Run("status", func(t *testing.T) {
+		recorder := helper.GET("/api/auth/mfa", headers)
+		helper.AssertStatus(recorder, http.StatusOK)
+
+		var status struct {
+			Data map[string]interface{} `json:"data"`
+		}
+		if err := json.Unmarshal(recorder.Body.Bytes(), &status); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if status.Data["enabled"] != false {
+			t.Errorf("Expected two-factor authentication to be off, got %v", status.Data)
+		}
+	})
+
+	t.Run("enroll", func(t *testing.T) {
+		recorder := helper.POST("/api/auth/mfa/enroll", nil, headers)
+		helper.AssertStatus(recorder, http.StatusOK)
+
+		var enrollment struct {
+			Data map[string]interface{} `json:"data"`
+		}
+		if err := json.Unmarshal(recorder.Body.Bytes(), &enrollment); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if enrollment.Data["secret"] == "" || enrollment.Data["otpauth_uri"] == "" {
+			t.Errorf("Expected a secret and otpauth URI, got %v", enrollment.Data)
+		}
+	})
+
+	t.Run("wrong code", func(t *testing.T) {
+		recorder := helper.POST("/api/auth/mfa/confirm", map[string]interface{}{"code": "000000"}, headers)
+		helper.AssertStatus(recorder, http.StatusUnauthorized)
+	})
+
+	t.Run("code required", func(t *testing.T) {
+		recorder := helper.POST("/api/auth/mfa/disable", map[string]interface{}{}, headers)
+		helper.AssertStatus(recorder, http.StatusBadRequest)
+	})
+
+	t.Run("invalid challenge", func(t *testing.T) {
+		recorder := helper.POST("/api/auth/mfa/challenge/enroll", map[string]interface{}{"mfa_token": "nope"}, nil)
+		helper.AssertStatus(recorder, http.StatusUnauthorized)
+	})
+}
+
+// setupMFATestRouter wires the two-factor authentication handler for the test user.
+func setupMFATestRouter() *gin.Engine {
+	router := testutil.NewTestRouter()
+
+	testUser := testutil.MockUser("user-1", "test@example.com", "testuser", "Test User")
+	userRepo := testutil.NewMockUserRepository()
+	userRepo.AddUser(testUser)
+
+	authService := &MockAuthService{user: testUser}
+	mfaService := services.NewMFAService(testutil.NewMockUserMFARepository(), userRepo, config.NewConfig())
+	authMiddleware := middleware.NewAuthMiddleware(authService)
+
+	api.NewMFAHandler(mfaService, authService).RegisterRoutes(router.Group("/api"), authMiddleware)
+
+	return router
+}
@@ -16,9 +16,13 @@ import (
 // UserContextKey is the key used to store user in request context.
 const UserContextKey = "user"
 
+// TokenContextKey is the key used to store the personal access token a request was made with.
+const TokenContextKey = "personal_access_token"
+
 // AuthMiddleware provides authentication middleware functionality.
 type AuthMiddleware struct {
 	authService services.AuthService
+	tokens      services.PersonalAccessTokenService
 }
 
 // NewAuthMiddleware creates a new authentication middleware.
@@ -28,6 +32,11 @@ func NewAuthMiddleware(authService services.AuthService) *AuthMiddleware {
 	}
 }
 
+// SetPersonalAccessTokens lets requests authenticate with personal access tokens as well as JWTs.
+func (m *AuthMiddleware) SetPersonalAccessTokens(tokens services.PersonalAccessTokenService) {
+	m.tokens = tokens
+}
+
 // RequireAuth middleware that requires valid JWT authentication.
 func (m *AuthMiddleware) RequireAuth() gin.HandlerFunc {
 	return gin.HandlerFunc(func(c *gin.Context) {
@@ -88,19 +97,17 @@ func (m *AuthMiddleware) RequireAdmin() gin.HandlerFunc {
 
 // extractUser extracts and validates user from request.
 func (m *AuthMiddleware) extractUser(c *gin.Context) (*domain.User, error) {
-	// Try to get token from Authorization header
-	token := m.extractTokenFromHeader(c)
+	token := GetTokenFromRequest(c)
 	if token == "" {
-		// Try to get token from cookie
-		token = m.extractTokenFromCookie(c)
+		return nil, domain.NewAuthenticationError("MISSING_TOKEN", "Authentication token required")
 	}
 
-	if token == "" {
-		return nil, domain.NewAuthenticationError("MISSING_TOKEN", "Authentication token required")
+	if domain.IsPersonalAccessToken(token) {
+		return m.authenticatePersonalAccessToken(c, token)
 	}
 
 	// Validate token and get user
-	user, err := m.authService.ValidateToken(c.Request.Context(), token)
+	user, err := m.authService.ValidateToken(ClientContext(c), token)
 	if err != nil {
 		return nil, err
 	}
@@ -108,8 +115,77 @@ func (m *AuthMiddleware) extractUser(c *gin.Context) (*domain.User, error) {
 	return user, nil
 }
 
-// extractTokenFromHeader extracts JWT token from Authorization header.
-func (m *AuthMiddleware) extractTokenFromHeader(c *gin.Context) string {
+// authenticatePersonalAccessToken validates a personal access token and checks its scope allows the request.
+func (m *AuthMiddleware) authenticatePersonalAccessToken(c *gin.Context, rawToken string) (*domain.User, error) {
+	if m.tokens == nil {
+		return nil, domain.NewAuthenticationError("INVALID_TOKEN", "Invalid or expired token")
+	}
+
+	user, token, err := m.tokens.Authenticate(c.Request.Context(), rawToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if !token.Scope.Includes(requiredScope(c)) {
+		return nil, domain.NewAuthorizationError(
+			"INSUFFICIENT_TOKEN_SCOPE", "This token's scope doesn't allow this request",
+		)
+	}
+
+	c.Set(TokenContextKey, token)
+	return user, nil
+}
+
+// taskWriteRoutes are the route prefixes a tasks:write token may change. Of the board, that's
+// moving tasks; its WIP limits are project settings.
+var taskWriteRoutes = []string{
+	"/api/tasks/",
+	"/api/comments/",
+	"/api/projects/:projectId/tasks",
+	"/api/projects/:projectId/board/move",
+	"/api/projects/:projectId/board/validate-move",
+}
+
+// requiredScope returns the narrowest token scope that allows the request.
+func requiredScope(c *gin.Context) domain.TokenScope {
+	switch c.Request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return domain.ScopeReadOnly
+	}
+
+	route := c.FullPath()
+	if route == "" {
+		route = c.Request.URL.Path
+	}
+	for _, prefix := range taskWriteRoutes {
+		if strings.HasPrefix(route, prefix) {
+			return domain.ScopeTasksWrite
+		}
+	}
+
+	return domain.ScopeAdmin
+}
+
+// GetTokenFromRequest returns the JWT or personal access token a request carries, from the
+// Authorization header or else the access token cookie.
+func GetTokenFromRequest(c *gin.Context) string {
+	if token := extractTokenFromHeader(c); token != "" {
+		return token
+	}
+	return extractTokenFromCookie(c)
+}
+
+// ClientContext returns the request's context with the device it came from, for the sessions
+// it starts or uses.
+func ClientContext(c *gin.Context) context.Context {
+	return services.WithClientInfo(c.Request.Context(), domain.ClientInfo{
+		UserAgent: c.Request.UserAgent(),
+		IPAddress: c.ClientIP(),
+	})
+}
+
+// extractTokenFromHeader extracts a JWT or personal access token from the Authorization header.
+func extractTokenFromHeader(c *gin.Context) string {
 	authHeader := c.GetHeader("Authorization")
 	if authHeader == "" {
 		return ""
@@ -125,9 +201,10 @@ func (m *AuthMiddleware) extractTokenFromHeader(c *gin.Context) string {
 }
 
 // extractTokenFromCookie extracts JWT token from cookie.
-func (m *AuthMiddleware) extractTokenFromCookie(c *gin.Context) string {
+// Personal access tokens are for scripts, so they're only accepted in the Authorization header.
+func extractTokenFromCookie(c *gin.Context) string {
 	cookie, err := c.Cookie("access_token")
-	if err != nil {
+	if err != nil || domain.IsPersonalAccessToken(cookie) {
 		return ""
 	}
 	return cookie
@@ -0,0 +1,79 @@
+package middleware_test
+
+//nolint:gofumpt
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/config"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+
+	"github.com/gin-gonic/gin"
+)
+
+// emptyBlacklist is a token blacklist with nothing on it
+type emptyBlacklist struct{}
+
+func (emptyBlacklist) BlacklistToken(_ context.Context, _ *domain.BlacklistedToken) error { return nil }
+
+func (emptyBlacklist) IsTokenBlacklisted(_ context.Context, _ string) (bool, error) {
+	return false, nil
+}
+
+func (emptyBlacklist) CleanupExpiredTokens(_ context.Context) error { return nil }
+
+func (emptyBlacklist) BlacklistAllUserTokens(_ context.Context, _ string, _ time.Time) error {
+	return nil
+}
+
+func TestAuthMiddleware_RefreshTokenIsNotABearerToken(t *testing.T) {
+	userRepo := testutil.NewMockUserRepository()
+	user := testutil.MockUser("ada", "ada@example.com", "ada", "Ada")
+	if err := user.SetPassword("password123"); err != nil {
+		t.Fatalf("Failed to set password: %v", err)
+	}
+	userRepo.AddUser(user)
+
+	authService := services.NewAuthService(userRepo, emptyBlacklist{}, nil, config.NewConfig(), nil)
+	authService.SetSessions(testutil.NewMockSessionRepository())
+
+	result, err := authService.Login(context.Background(), domain.LoginRequest{
+		Email: "ada@example.com", Password: "password123",
+	})
+	if err != nil {
+		t.Fatalf("Failed to log in: %v", err)
+	}
+
+	router := testutil.NewTestRouter()
+	router.GET("/test", middleware.NewAuthMiddleware(authService).RequireAuth(), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	tests := []struct {
+		name       string
+		token      string
+		wantStatus int
+	}{
+		{name: "access token", token: result.Tokens.AccessToken, wantStatus: http.StatusOK},
+		{name: "refresh token", token: result.Tokens.RefreshToken, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NotificationHandler handles notification inbox HTTP requests.
+type NotificationHandler struct {
+	notificationService services.NotificationService
+}
+
+// NewNotificationHandler creates a new notification handler.
+func NewNotificationHandler(notificationService services.NotificationService) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+	}
+}
+
+// RegisterRoutes registers notification routes with the router.
+func (h *NotificationHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *middleware.AuthMiddleware) {
+	notifications := router.Group("/notifications")
+	notifications.Use(authMiddleware.RequireAuth())
+	{
+		notifications.GET("", h.ListNotifications)
+		notifications.GET("/unread-count", h.UnreadCount)
+		notifications.POST("/read-all", h.MarkAllRead)
+		notifications.POST("/:notificationId/read", h.MarkRead)
+	}
+}
+
+// ListNotifications handles GET /api/notifications requests.
+// unread=true lists only unread notifications; limit and offset page through the results.
+func (h *NotificationHandler) ListNotifications(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(services.DefaultNotificationLimit)))
+	if err != nil || limit <= 0 || limit > services.MaxNotificationLimit {
+		limit = services.DefaultNotificationLimit
+	}
+
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	unreadOnly, _ := strconv.ParseBool(c.Query("unread"))
+
+	page, err := h.notificationService.ListNotifications(c.Request.Context(), services.ListNotificationsRequest{
+		Limit:      limit,
+		Offset:     offset,
+		UnreadOnly: unreadOnly,
+	}, user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    page,
+	})
+}
+
+// UnreadCount handles GET /api/notifications/unread-count requests.
+func (h *NotificationHandler) UnreadCount(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	count, err := h.notificationService.UnreadCount(c.Request.Context(), user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    gin.H{"unread": count},
+	})
+}
+
+// MarkRead handles POST /api/notifications/:notificationId/read requests.
+func (h *NotificationHandler) MarkRead(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	notification, err := h.notificationService.MarkRead(c.Request.Context(), c.Param("notificationId"), user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    notification,
+	})
+}
+
+// MarkAllRead handles POST /api/notifications/read-all requests.
+func (h *NotificationHandler) MarkAllRead(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	changed, err := h.notificationService.MarkAllRead(c.Request.Context(), user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    gin.H{"marked_read": changed},
+	})
+}
@@ -0,0 +1,127 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestNotificationHandler_Routes(t *testing.T) {
+	tests := []testutil.TestCase{
+		{
+			Name:           "list notifications",
+			Method:         "GET",
+			URL:            "/api/notifications?limit=10&offset=0",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "unread count",
+			Method:         "GET",
+			URL:            "/api/notifications/unread-count",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "mark read",
+			Method:         "POST",
+			URL:            "/api/notifications/notification-1/read",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "another user's notification",
+			Method:         "POST",
+			URL:            "/api/notifications/notification-3/read",
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Name:           "notification not found",
+			Method:         "POST",
+			URL:            "/api/notifications/missing/read",
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Name:           "mark all read",
+			Method:         "POST",
+			URL:            "/api/notifications/read-all",
+			ExpectedStatus: http.StatusOK,
+		},
+	}
+
+	router := setupNotificationTestRouter(t)
+	helper := testutil.NewHTTPTestHelper(t, router)
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(_ *testing.T) {
+			headers := map[string]string{
+				"Authorization": "Bearer mock-token",
+			}
+
+			recorder := helper.Request(tc.Method, tc.URL, tc.Body, headers)
+			helper.AssertStatus(recorder, tc.ExpectedStatus)
+		})
+	}
+}
+
+func TestNotificationHandler_UnreadFilter(t *testing.T) {
+	router := setupNotificationTestRouter(t)
+	helper := testutil.NewHTTPTestHelper(t, router)
+	headers := map[string]string{"Authorization": "Bearer mock-token"}
+
+	recorder := helper.Request("POST", "/api/notifications/notification-1/read", nil, headers)
+	helper.AssertStatus(recorder, http.StatusOK)
+
+	recorder = helper.GET("/api/notifications?unread=true", headers)
+	helper.AssertStatus(recorder, http.StatusOK)
+
+	var response struct {
+		Data services.NotificationPage `json:"data"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(response.Data.Notifications) != 1 || response.Data.Notifications[0].ID != "notification-2" {
+		t.Errorf("Expected only notification-2 to be unread, got %+v", response.Data.Notifications)
+	}
+	if response.Data.Total != 1 || response.Data.Unread != 1 {
+		t.Errorf("Expected one unread notification, got total %d unread %d", response.Data.Total, response.Data.Unread)
+	}
+}
+
+// setupNotificationTestRouter wires the notification handler over two unread notifications for the test user
+// and one for another user.
+func setupNotificationTestRouter(t *testing.T) *gin.Engine {
+	router := testutil.NewTestRouter()
+
+	notificationRepo := testutil.NewMockNotificationRepository()
+	for _, userID := range []string{"user-1", "user-1", "user-2"} {
+		err := notificationRepo.Create(t.Context(), &domain.Notification{
+			UserID:    userID,
+			ProjectID: "project-1",
+			TaskID:    "task-1",
+			ActorID:   "user-3",
+			Kind:      domain.NotificationAssigned,
+			Message:   `Someone assigned you to "Task"`,
+		})
+		if err != nil {
+			t.Fatalf("Failed to seed notification: %v", err)
+		}
+	}
+
+	testUser := testutil.MockUser("user-1", "test@example.com", "testuser", "Test User")
+
+	notificationService := services.NewNotificationService(
+		notificationRepo, testutil.NewMockProjectRepository(), testutil.NewMockUserRepository(),
+	)
+	authMiddleware := middleware.NewAuthMiddleware(&MockAuthService{user: testUser})
+
+	api.NewNotificationHandler(notificationService).RegisterRoutes(router.Group("/api"), authMiddleware)
+
+	return router
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PersonalAccessTokenHandler handles personal access token HTTP requests.
+type PersonalAccessTokenHandler struct {
+	tokenService services.PersonalAccessTokenService
+}
+
+// NewPersonalAccessTokenHandler creates a new personal access token handler.
+func NewPersonalAccessTokenHandler(tokenService services.PersonalAccessTokenService) *PersonalAccessTokenHandler {
+	return &PersonalAccessTokenHandler{
+		tokenService: tokenService,
+	}
+}
+
+// RegisterRoutes registers personal access token routes with the router.
+func (h *PersonalAccessTokenHandler) RegisterRoutes(
+	router *gin.RouterGroup, authMiddleware *middleware.AuthMiddleware,
+) {
+	tokens := router.Group("/auth/tokens")
+	tokens.Use(authMiddleware.RequireAuth())
+	{
+		tokens.GET("", h.ListTokens)
+		tokens.POST("", h.CreateToken)
+		tokens.DELETE("/:tokenId", h.RevokeToken)
+	}
+}
+
+// ListTokens handles GET /api/auth/tokens requests.
+func (h *PersonalAccessTokenHandler) ListTokens(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	tokens, err := h.tokenService.ListTokens(c.Request.Context(), user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    tokens,
+	})
+}
+
+// CreateToken handles POST /api/auth/tokens requests.
+// The response is the only time the token's value is returned.
+func (h *PersonalAccessTokenHandler) CreateToken(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	var req domain.CreatePersonalAccessTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		invalidRequest(c, err)
+		return
+	}
+
+	token, err := h.tokenService.CreateToken(c.Request.Context(), user.ID, req)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    token,
+		"message": "Copy the token now, it won't be shown again",
+	})
+}
+
+// RevokeToken handles DELETE /api/auth/tokens/:tokenId requests.
+func (h *PersonalAccessTokenHandler) RevokeToken(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	if err := h.tokenService.RevokeToken(c.Request.Context(), user.ID, c.Param("tokenId")); err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Token revoked successfully",
+	})
+}
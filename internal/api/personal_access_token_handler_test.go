@@ -0,0 +1,122 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestPersonalAccessTokenHandler(t *testing.T) {
+	router := setupPersonalAccessTokenTestRouter()
+	helper := testutil.NewHTTPTestHelper(t, router)
+	jwt := map[string]string{"Authorization": "Bearer mock-token"}
+
+	// createToken creates a token with the JWT and returns its value and ID
+	createToken := func(t *testing.T, scope string) (string, string) {
+		t.Helper()
+		recorder := helper.POST("/api/auth/tokens", map[string]interface{}{"name": "CI", "scope": scope}, jwt)
+		helper.AssertStatus(recorder, http.StatusCreated)
+
+		var created struct {
+			Data map[string]interface{} `json:"data"`
+		}
+		if err := json.Unmarshal(recorder.Body.Bytes(), &created); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		token, _ := created.Data["token"].(string)
+		id, _ := created.Data["id"].(string)
+		if token == "" || id == "" {
+			t.Fatalf("Expected the token's value and ID, got %v", created.Data)
+		}
+		return token, id
+	}
+
+	readToken, _ := createToken(t, "read")
+	writeToken, writeTokenID := createToken(t, "tasks:write")
+	adminToken, _ := createToken(t, "admin")
+	bearer := func(token string) map[string]string {
+		return map[string]string{"Authorization": "Bearer " + token}
+	}
+
+	t.Run("invalid scope", func(t *testing.T) {
+		recorder := helper.POST("/api/auth/tokens", map[string]interface{}{"name": "CI", "scope": "write"}, jwt)
+		helper.AssertStatus(recorder, http.StatusBadRequest)
+	})
+
+	t.Run("list hides values", func(t *testing.T) {
+		recorder := helper.GET("/api/auth/tokens", bearer(readToken))
+		helper.AssertStatus(recorder, http.StatusOK)
+
+		var listed struct {
+			Data []map[string]interface{} `json:"data"`
+		}
+		if err := json.Unmarshal(recorder.Body.Bytes(), &listed); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if len(listed.Data) != 3 || listed.Data[0]["token"] != nil {
+			t.Errorf("Expected three tokens without their values, got %v", listed.Data)
+		}
+	})
+
+	t.Run("scopes", func(t *testing.T) {
+		helper.AssertStatus(helper.POST("/api/tasks/task-1/comments", nil, bearer(readToken)), http.StatusForbidden)
+		helper.AssertStatus(helper.POST("/api/tasks/task-1/comments", nil, bearer(writeToken)), http.StatusOK)
+		helper.AssertStatus(helper.POST("/api/projects/project-1/members", nil, bearer(writeToken)), http.StatusForbidden)
+		helper.AssertStatus(helper.POST("/api/projects/project-1/members", nil, bearer(adminToken)), http.StatusOK)
+
+		helper.AssertStatus(helper.POST("/api/projects/project-1/board/move", nil, bearer(writeToken)), http.StatusOK)
+		wipLimit := "/api/projects/project-1/board/wip-limits/todo"
+		helper.AssertStatus(helper.PUT(wipLimit, nil, bearer(writeToken)), http.StatusForbidden)
+		helper.AssertStatus(helper.PUT(wipLimit, nil, bearer(adminToken)), http.StatusOK)
+	})
+
+	t.Run("not from cookies", func(t *testing.T) {
+		recorder := helper.Request(http.MethodGet, "/api/auth/tokens", nil, map[string]string{
+			"Cookie": "access_token=" + adminToken,
+		})
+		helper.AssertStatus(recorder, http.StatusUnauthorized)
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		recorder := helper.DELETE("/api/auth/tokens/"+writeTokenID, jwt)
+		helper.AssertStatus(recorder, http.StatusOK)
+
+		recorder = helper.GET("/api/auth/tokens", bearer(writeToken))
+		helper.AssertStatus(recorder, http.StatusUnauthorized)
+
+		recorder = helper.DELETE("/api/auth/tokens/"+writeTokenID, jwt)
+		helper.AssertStatus(recorder, http.StatusNotFound)
+	})
+}
+
+// setupPersonalAccessTokenTestRouter wires the token handler, plus task, board and project
+// routes to check token scopes against.
+func setupPersonalAccessTokenTestRouter() *gin.Engine {
+	router := testutil.NewTestRouter()
+
+	testUser := testutil.MockUser("user-1", "test@example.com", "testuser", "Test User")
+	userRepo := testutil.NewMockUserRepository()
+	userRepo.AddUser(testUser)
+
+	tokenService := services.NewPersonalAccessTokenService(testutil.NewMockPersonalAccessTokenRepository(), userRepo)
+	authMiddleware := middleware.NewAuthMiddleware(&MockAuthService{user: testUser})
+	authMiddleware.SetPersonalAccessTokens(tokenService)
+
+	apiGroup := router.Group("/api")
+	api.NewPersonalAccessTokenHandler(tokenService).RegisterRoutes(apiGroup, authMiddleware)
+
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	apiGroup.POST("/tasks/:taskId/comments", authMiddleware.RequireAuth(), ok)
+	apiGroup.POST("/projects/:projectId/members", authMiddleware.RequireAuth(), ok)
+	apiGroup.POST("/projects/:projectId/board/move", authMiddleware.RequireAuth(), ok)
+	apiGroup.PUT("/projects/:projectId/board/wip-limits/:status", authMiddleware.RequireAuth(), ok)
+
+	return router
+}
@@ -40,8 +40,10 @@ func (h *ProjectHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware
 		projects.GET("/:id", h.GetProject)
 		projects.PUT("/:id", authMiddleware.RequireOwnership(h.extractProjectOwnerID), h.UpdateProject)
 		projects.DELETE("/:id", authMiddleware.RequireOwnership(h.extractProjectOwnerID), h.DeleteProject)
-		projects.POST("/:id/members", authMiddleware.RequireOwnership(h.extractProjectOwnerID), h.AddMember)
-		projects.DELETE("/:id/members/:memberID", authMiddleware.RequireOwnership(h.extractProjectOwnerID), h.RemoveMember)
+		// Member changes are checked against the requester's project role by the project service
+		projects.POST("/:id/members", h.AddMember)
+		projects.PUT("/:id/members/:memberID", h.SetMemberRole)
+		projects.DELETE("/:id/members/:memberID", h.RemoveMember)
 	}
 }
 
@@ -342,7 +344,8 @@ func (h *ProjectHandler) AddMember(c *gin.Context) {
 	}
 
 	var req struct {
-		UserID string `json:"user_id" binding:"required"`
+		UserID string             `json:"user_id" binding:"required"`
+		Role   domain.ProjectRole `json:"role,omitempty"` // defaults to member
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -372,7 +375,7 @@ func (h *ProjectHandler) AddMember(c *gin.Context) {
 	}
 
 	// Add member using service (includes validation)
-	err := h.projectService.AddMember(c.Request.Context(), projectID, req.UserID, user.ID)
+	err := h.projectService.AddMember(c.Request.Context(), projectID, req.UserID, req.Role, user.ID)
 	if err != nil {
 		h.handleError(c, err)
 		return
@@ -410,18 +413,87 @@ func (h *ProjectHandler) RemoveMember(c *gin.Context) {
 		return
 	}
 
-	// Get project
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "AUTHENTICATION_ERROR",
+				"code":    "USER_NOT_FOUND",
+				"message": "User not found in context",
+			},
+		})
+		return
+	}
+
+	// Remove member using service (members may remove themselves)
+	if err := h.projectService.RemoveMember(c.Request.Context(), projectID, memberID, user.ID); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	// Members who left may no longer see the project, so it's loaded directly
 	project, err := h.projectRepo.GetByID(c.Request.Context(), projectID)
 	if err != nil {
 		h.handleError(c, err)
 		return
 	}
 
-	// Remove member
-	project.RemoveMember(memberID)
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"project": project,
+		},
+	})
+}
 
-	// Update project
-	err = h.projectRepo.Update(c.Request.Context(), project)
+// SetMemberRole handles PUT /api/projects/:id/members/:memberID requests.
+func (h *ProjectHandler) SetMemberRole(c *gin.Context) {
+	projectID := c.Param("id")
+	memberID := c.Param("memberID")
+
+	if projectID == "" || memberID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "VALIDATION_ERROR",
+				"code":    "MISSING_PARAMETERS",
+				"message": "Project ID and member ID are required",
+			},
+		})
+		return
+	}
+
+	var req struct {
+		Role domain.ProjectRole `json:"role" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "VALIDATION_ERROR",
+				"code":    "INVALID_REQUEST",
+				"message": "Invalid request format",
+				"details": err.Error(),
+			},
+		})
+		return
+	}
+
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "AUTHENTICATION_ERROR",
+				"code":    "USER_NOT_FOUND",
+				"message": "User not found in context",
+			},
+		})
+		return
+	}
+
+	project, err := h.projectService.SetMemberRole(c.Request.Context(), projectID, memberID, req.Role, user.ID)
 	if err != nil {
 		h.handleError(c, err)
 		return
@@ -9,6 +9,7 @@ import (
 	"github.com/ericfisherdev/simple-easy-tasks/internal/api"
 	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
 	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
 	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
 	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
 
@@ -279,6 +280,67 @@ func TestProjectHandler_DeleteProject(t *testing.T) {
 	}
 }
 
+func TestProjectHandler_MemberRoles(t *testing.T) {
+	router := setupProjectMembersTestRouter()
+	helper := testutil.NewHTTPTestHelper(t, router)
+	headers := map[string]string{"Authorization": "Bearer mock-token"}
+
+	t.Run("add member with a role", func(_ *testing.T) {
+		recorder := helper.POST("/api/projects/project-1/members", map[string]interface{}{
+			"user_id": "user-2",
+			"role":    "commenter",
+		}, headers)
+		helper.AssertStatus(recorder, http.StatusOK)
+	})
+
+	t.Run("change role", func(_ *testing.T) {
+		recorder := helper.PUT("/api/projects/project-1/members/user-2", map[string]interface{}{
+			"role": "maintainer",
+		}, headers)
+		helper.AssertStatus(recorder, http.StatusOK)
+	})
+
+	t.Run("owner role cannot be given", func(_ *testing.T) {
+		recorder := helper.PUT("/api/projects/project-1/members/user-2", map[string]interface{}{
+			"role": "owner",
+		}, headers)
+		helper.AssertStatus(recorder, http.StatusBadRequest)
+	})
+
+	t.Run("unknown member", func(_ *testing.T) {
+		recorder := helper.PUT("/api/projects/project-1/members/user-3", map[string]interface{}{
+			"role": "viewer",
+		}, headers)
+		helper.AssertStatus(recorder, http.StatusNotFound)
+	})
+
+	t.Run("remove member", func(_ *testing.T) {
+		recorder := helper.DELETE("/api/projects/project-1/members/user-2", headers)
+		helper.AssertStatus(recorder, http.StatusOK)
+	})
+}
+
+// setupProjectMembersTestRouter wires the project handler over the real project service, with
+// the test user owning the project.
+func setupProjectMembersTestRouter() *gin.Engine {
+	router := testutil.NewTestRouter()
+
+	projectRepo := testutil.NewMockProjectRepository()
+	userRepo := testutil.NewMockUserRepository()
+
+	testUser := testutil.MockUser("user-1", "test@example.com", "testuser", "Test User")
+	userRepo.AddUser(testUser)
+	userRepo.AddUser(testutil.MockUser("user-2", "member@example.com", "member", "Member"))
+	projectRepo.AddProject(testutil.MockProject("project-1", "Test Project", "test-project", "user-1"))
+
+	projectService := services.NewProjectService(projectRepo, userRepo, services.NewAuthorizationService(projectRepo))
+	authMiddleware := middleware.NewAuthMiddleware(&MockAuthService{user: testUser})
+
+	api.NewProjectHandler(projectService, projectRepo).RegisterRoutes(router.Group("/api"), authMiddleware)
+
+	return router
+}
+
 // setupProjectTestRouter creates a test router with project endpoints and mock dependencies.
 func setupProjectTestRouter(_ *testing.T) *gin.Engine {
 	router := testutil.NewTestRouter()
@@ -328,10 +390,22 @@ func (m *MockAuthService) ValidateToken(_ context.Context, token string) (*domai
 	return nil, domain.NewAuthenticationError("INVALID_TOKEN", "Invalid token")
 }
 
-func (m *MockAuthService) Login(_ context.Context, _ domain.LoginRequest) (*domain.TokenPair, error) {
+func (m *MockAuthService) Login(_ context.Context, _ domain.LoginRequest) (*domain.LoginResult, error) {
 	return nil, domain.NewInternalError("NOT_IMPLEMENTED", "Not implemented in mock", nil)
 }
 
+func (m *MockAuthService) CompleteMFALogin(_ context.Context, _, _ string) (*domain.LoginResult, error) {
+	return nil, domain.NewInternalError("NOT_IMPLEMENTED", "Not implemented in mock", nil)
+}
+
+func (m *MockAuthService) ValidateMFAChallenge(_ context.Context, _ string) (*domain.User, error) {
+	return nil, domain.NewAuthenticationError("INVALID_MFA_TOKEN", "Invalid login challenge")
+}
+
+func (m *MockAuthService) SetMFA(_ services.MFAService) {}
+
+func (m *MockAuthService) SetSessions(_ repository.SessionRepository) {}
+
 func (m *MockAuthService) Register(_ context.Context, _ domain.CreateUserRequest) (*domain.User, error) {
 	return nil, domain.NewInternalError("NOT_IMPLEMENTED", "Not implemented in mock", nil)
 }
@@ -399,10 +473,18 @@ func (m *MockProjectService) ListUserProjects(_ context.Context, _ string, _, _
 	return m.projects, nil
 }
 
-func (m *MockProjectService) AddMember(_ context.Context, _ string, _ string, _ string) error {
+func (m *MockProjectService) AddMember(
+	_ context.Context, _ string, _ string, _ domain.ProjectRole, _ string,
+) error {
 	return domain.NewInternalError("NOT_IMPLEMENTED", "Not implemented in mock", nil)
 }
 
+func (m *MockProjectService) SetMemberRole(
+	_ context.Context, _ string, _ string, _ domain.ProjectRole, _ string,
+) (*domain.Project, error) {
+	return nil, domain.NewInternalError("NOT_IMPLEMENTED", "Not implemented in mock", nil)
+}
+
 func (m *MockProjectService) RemoveMember(_ context.Context, _ string, _ string, _ string) error {
 	return domain.NewInternalError("NOT_IMPLEMENTED", "Not implemented in mock", nil)
 }
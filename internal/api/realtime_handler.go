@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,6 +22,7 @@ import (
 type RealtimeHandler struct {
 	subscriptionManager services.SubscriptionManager
 	eventBroadcaster    services.EventBroadcaster
+	eventLog            services.EventLog
 	app                 core.App
 }
 
@@ -27,11 +30,13 @@ type RealtimeHandler struct {
 func NewRealtimeHandler(
 	subscriptionManager services.SubscriptionManager,
 	eventBroadcaster services.EventBroadcaster,
+	eventLog services.EventLog,
 	app core.App,
 ) *RealtimeHandler {
 	return &RealtimeHandler{
 		subscriptionManager: subscriptionManager,
 		eventBroadcaster:    eventBroadcaster,
+		eventLog:            eventLog,
 		app:                 app,
 	}
 }
@@ -301,6 +306,19 @@ func (h *RealtimeHandler) StreamEvents(c *gin.Context) {
 		return
 	}
 
+	lastEventID, resuming, err := parseLastEventID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "VALIDATION_ERROR",
+				"code":    "INVALID_LAST_EVENT_ID",
+				"message": "Last-Event-ID must be a non-negative event sequence",
+			},
+		})
+		return
+	}
+
 	subscription, err := h.setupStreamSubscription(c, user)
 	if err != nil {
 		ErrorResponse(c, err)
@@ -308,11 +326,53 @@ func (h *RealtimeHandler) StreamEvents(c *gin.Context) {
 	}
 	defer h.cleanupSubscription(subscription.ID, user.ID)
 
+	// Listen before reading the log so no event falls between the replay and live delivery
+	eventChan, stopListening, err := h.eventBroadcaster.Listen(subscription.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+	defer stopListening()
+
+	var replay *services.EventReplay
+	if resuming {
+		replay, err = h.replaySince(c.Request.Context(), lastEventID)
+		if err != nil {
+			ErrorResponse(c, err)
+			return
+		}
+	}
+
 	h.setupSSEHeaders(c)
-	eventChan := make(chan *domain.TaskEvent, 100)
-	defer close(eventChan)
+	h.handleSSEStream(c, subscription, replay, eventChan)
+}
+
+// parseLastEventID reads the sequence of the last event a reconnecting client received.
+// Browsers send the Last-Event-ID header on reconnect; the query parameter lets a fresh
+// page resume from a sequence it stored itself.
+func parseLastEventID(c *gin.Context) (int64, bool, error) {
+	value := c.GetHeader("Last-Event-ID")
+	if value == "" {
+		value = c.Query("last_event_id")
+	}
+	if value == "" {
+		return 0, false, nil
+	}
 
-	h.handleSSEStream(c, subscription, eventChan)
+	sequence, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || sequence < 0 {
+		return 0, false, fmt.Errorf("invalid last event ID %q", value)
+	}
+	return sequence, true, nil
+}
+
+// replaySince loads the events a reconnecting client missed
+func (h *RealtimeHandler) replaySince(ctx context.Context, lastEventID int64) (*services.EventReplay, error) {
+	if h.eventLog == nil {
+		// Without a log nothing can be replayed, so the client has to reload
+		return &services.EventReplay{Reset: true}, nil
+	}
+	return h.eventLog.Since(ctx, lastEventID)
 }
 
 // setupStreamSubscription creates a subscription for the SSE connection
@@ -332,6 +392,11 @@ func (h *RealtimeHandler) setupStreamSubscription(
 		EventTypes: eventTypes,
 	}
 
+	// watching=true limits the stream to tasks the user watches
+	if watching, _ := strconv.ParseBool(c.Query(domain.WatchingFilter)); watching {
+		subscriptionReq.Filters = map[string]string{domain.WatchingFilter: "true"}
+	}
+
 	return h.subscriptionManager.CreateSubscription(c.Request.Context(), subscriptionReq)
 }
 
@@ -356,6 +421,10 @@ func (h *RealtimeHandler) parseEventTypes(eventTypesParam string) []domain.TaskE
 			domain.TaskAssigned,
 			domain.TaskDeleted,
 			domain.TaskCommented,
+			domain.TaskUnblocked,
+			domain.TaskMentioned,
+			domain.TaskCommentReacted,
+			domain.TaskCommentResolved,
 		}
 	}
 	return eventTypes
@@ -376,9 +445,13 @@ func (h *RealtimeHandler) setupSSEHeaders(c *gin.Context) {
 	c.Header("X-Accel-Buffering", "no") // Disable nginx buffering for SSE
 }
 
-// handleSSEStream manages the SSE event streaming loop
+// handleSSEStream manages the SSE event streaming loop. A resuming client first
+// gets the events it missed, or a reset event when they can't be replayed.
 func (h *RealtimeHandler) handleSSEStream(
-	c *gin.Context, subscription *domain.EventSubscription, eventChan chan *domain.TaskEvent,
+	c *gin.Context,
+	subscription *domain.EventSubscription,
+	replay *services.EventReplay,
+	eventChan <-chan *domain.TaskEvent,
 ) {
 	ticker := time.NewTicker(30 * time.Second)
 	defer ticker.Stop()
@@ -394,6 +467,16 @@ func (h *RealtimeHandler) handleSSEStream(
 	if _, err := fmt.Fprintf(w, "data: %s\n\n", connMsg); err != nil {
 		return // Connection closed
 	}
+
+	// Live events already delivered by the replay are skipped. Later events are
+	// not compared by sequence: with several replicas they can arrive out of order.
+	var replayedUpTo int64
+	if replay != nil {
+		if !h.writeReplay(w, subscription, replay) {
+			return // Connection closed
+		}
+		replayedUpTo = replay.LatestSequence
+	}
 	f.Flush()
 
 	for {
@@ -407,12 +490,14 @@ func (h *RealtimeHandler) handleSSEStream(
 			}
 			f.Flush()
 
-		case event := <-eventChan:
-			eventJSON, err := event.ToJSON()
-			if err != nil {
-				continue // Skip malformed events
+		case event, open := <-eventChan:
+			if !open {
+				return // Listener dropped; the client reconnects and resumes from the log
 			}
-			if _, err := fmt.Fprintf(w, "data: %s\n\n", string(eventJSON)); err != nil {
+			if event.Sequence != 0 && event.Sequence <= replayedUpTo {
+				continue
+			}
+			if !writeSSEEvent(w, event) {
 				return // Connection closed
 			}
 			f.Flush()
@@ -420,6 +505,42 @@ func (h *RealtimeHandler) handleSSEStream(
 	}
 }
 
+// writeReplay writes the missed events matching the subscription, or a reset event
+func (h *RealtimeHandler) writeReplay(
+	w io.Writer, subscription *domain.EventSubscription, replay *services.EventReplay,
+) bool {
+	if replay.Reset {
+		resetMsg := fmt.Sprintf(`{"type":"reset","latest_sequence":%d}`, replay.LatestSequence)
+		_, err := fmt.Fprintf(w, "id: %d\nevent: reset\ndata: %s\n\n", replay.LatestSequence, resetMsg)
+		return err == nil
+	}
+
+	for _, event := range replay.Events {
+		if !subscription.MatchesEvent(event) {
+			continue
+		}
+		if !writeSSEEvent(w, event) {
+			return false
+		}
+	}
+	return true
+}
+
+// writeSSEEvent writes a task event, with its log sequence as the SSE event ID
+func writeSSEEvent(w io.Writer, event *domain.TaskEvent) bool {
+	eventJSON, err := event.ToJSON()
+	if err != nil {
+		return true // Skip malformed events
+	}
+	if event.Sequence > 0 {
+		if _, err := fmt.Fprintf(w, "id: %d\n", event.Sequence); err != nil {
+			return false
+		}
+	}
+	_, err = fmt.Fprintf(w, "data: %s\n\n", string(eventJSON))
+	return err == nil
+}
+
 // PocketBaseTaskEvents integrates with PocketBase's built-in real-time system
 func (h *RealtimeHandler) PocketBaseTaskEvents(c *gin.Context) {
 	// Get authenticated user
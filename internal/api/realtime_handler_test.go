@@ -0,0 +1,160 @@
+package api_test
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+func TestRealtimeHandler_StreamEventsReplay(t *testing.T) {
+	server, broadcaster := setupRealtimeTestServer(t)
+
+	// Three events are logged while the client is away
+	for i := 0; i < 3; i++ {
+		broadcastTaskUpdate(t, broadcaster, "project-1")
+	}
+
+	t.Run("replays missed events then goes live", func(t *testing.T) {
+		stream := openEventStream(t, server.URL+"/api/realtime/events?project_id=project-1", "1")
+
+		stream.expectLine(t, `data: {"type":"connected"`)
+		stream.expectLine(t, "id: 2")
+		stream.expectLine(t, "id: 3")
+
+		broadcastTaskUpdate(t, broadcaster, "project-1")
+		stream.expectLine(t, "id: 4")
+	})
+
+	t.Run("sends a reset when the gap can't be replayed", func(t *testing.T) {
+		stream := openEventStream(t, server.URL+"/api/realtime/events?project_id=project-1", "99")
+
+		stream.expectLine(t, `data: {"type":"connected"`)
+		stream.expectLine(t, "id: 4")
+		stream.expectLine(t, "event: reset")
+		stream.expectLine(t, `data: {"type":"reset","latest_sequence":4}`)
+	})
+
+	t.Run("rejects a malformed Last-Event-ID", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/api/realtime/events", nil)
+		if err != nil {
+			t.Fatalf("Failed to build request: %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer mock-token")
+		req.Header.Set("Last-Event-ID", "yesterday")
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Expected status 400, got %d", resp.StatusCode)
+		}
+	})
+}
+
+// eventStream reads an SSE response line by line
+type eventStream struct {
+	lines chan string
+}
+
+func openEventStream(t *testing.T, url, lastEventID string) *eventStream {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatalf("Failed to build request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer mock-token")
+	req.Header.Set("Last-Event-ID", lastEventID)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	t.Cleanup(func() { _ = resp.Body.Close() })
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+
+	stream := &eventStream{lines: make(chan string, 100)}
+	go func() {
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			stream.lines <- scanner.Text()
+		}
+		close(stream.lines)
+	}()
+	return stream
+}
+
+// expectLine skips ahead to the next line starting with prefix
+func (s *eventStream) expectLine(t *testing.T, prefix string) {
+	t.Helper()
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case line, open := <-s.lines:
+			if !open {
+				t.Fatalf("Stream ended before %q", prefix)
+			}
+			if strings.HasPrefix(line, prefix) {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("Timed out waiting for %q", prefix)
+		}
+	}
+}
+
+func broadcastTaskUpdate(t *testing.T, broadcaster services.EventBroadcaster, projectID string) {
+	t.Helper()
+
+	event, err := domain.NewTaskEvent(domain.TaskUpdated, "task-1", projectID, "user-1", nil)
+	if err != nil {
+		t.Fatalf("Failed to create event: %v", err)
+	}
+	if err := broadcaster.BroadcastEvent(context.Background(), event); err != nil {
+		t.Fatalf("Failed to broadcast event: %v", err)
+	}
+}
+
+// setupRealtimeTestServer serves the realtime handler over an in-memory event log.
+func setupRealtimeTestServer(t *testing.T) (*httptest.Server, services.EventBroadcaster) {
+	router := testutil.NewTestRouter()
+
+	projectRepo := testutil.NewMockProjectRepository()
+	userRepo := testutil.NewMockUserRepository()
+
+	testUser := testutil.MockUser("user-1", "test@example.com", "testuser", "Test User")
+	userRepo.AddUser(testUser)
+	projectRepo.AddProject(testutil.MockProject("project-1", "Test Project", "test-project", "user-1"))
+
+	eventLog := services.NewEventLog(testutil.NewMockEventLogRepository(), services.DefaultEventLogConfig())
+	broadcaster := services.NewEventBroadcaster(nil, services.EventBroadcasterConfig{EventLog: eventLog})
+	subscriptions := services.NewSubscriptionManager(
+		broadcaster, projectRepo, userRepo, services.SubscriptionManagerConfig{})
+	authMiddleware := middleware.NewAuthMiddleware(&MockAuthService{user: testUser})
+
+	api.NewRealtimeHandler(subscriptions, broadcaster, eventLog, nil).RegisterRoutes(router.Group("/api"), authMiddleware)
+
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	return server, broadcaster
+}
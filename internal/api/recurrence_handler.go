@@ -0,0 +1,135 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RecurrenceHandler handles recurring task HTTP requests.
+type RecurrenceHandler struct {
+	recurrenceService services.RecurrenceService
+}
+
+// NewRecurrenceHandler creates a new recurrence handler.
+func NewRecurrenceHandler(recurrenceService services.RecurrenceService) *RecurrenceHandler {
+	return &RecurrenceHandler{
+		recurrenceService: recurrenceService,
+	}
+}
+
+// RegisterRoutes registers recurrence routes with the router.
+func (h *RecurrenceHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *middleware.AuthMiddleware) {
+	tasks := router.Group("/tasks")
+	tasks.Use(authMiddleware.RequireAuth())
+	{
+		tasks.GET("/:taskId/recurrence", h.GetRecurrence)
+		tasks.PUT("/:taskId/recurrence", h.SetRecurrence)
+		tasks.DELETE("/:taskId/recurrence", h.StopRecurrence)
+		tasks.PUT("/:taskId/occurrence", h.UpdateOccurrence)
+	}
+}
+
+// GetRecurrence handles GET /api/tasks/:taskId/recurrence requests.
+func (h *RecurrenceHandler) GetRecurrence(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	recurrence, err := h.recurrenceService.GetRecurrence(c.Request.Context(), c.Param("taskId"), user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    recurrence,
+	})
+}
+
+// SetRecurrence handles PUT /api/tasks/:taskId/recurrence requests.
+// The rule is given either as fields under "rule" or as an RRULE value under "rrule".
+func (h *RecurrenceHandler) SetRecurrence(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	var req domain.SetRecurrenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		invalidRequest(c, err)
+		return
+	}
+
+	rule, err := req.ToRule()
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	recurrence, err := h.recurrenceService.SetRecurrence(c.Request.Context(), c.Param("taskId"), *rule, user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    recurrence,
+	})
+}
+
+// StopRecurrence handles DELETE /api/tasks/:taskId/recurrence requests.
+func (h *RecurrenceHandler) StopRecurrence(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	if err := h.recurrenceService.StopRecurrence(c.Request.Context(), c.Param("taskId"), user.ID); err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Recurrence stopped successfully",
+	})
+}
+
+// UpdateOccurrence handles PUT /api/tasks/:taskId/occurrence?scope=this|future requests.
+// The body is a task update; scope defaults to this occurrence only.
+func (h *RecurrenceHandler) UpdateOccurrence(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	var req domain.UpdateTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		invalidRequest(c, err)
+		return
+	}
+
+	task, err := h.recurrenceService.UpdateOccurrence(
+		c.Request.Context(), c.Param("taskId"), domain.RecurrenceScope(c.Query("scope")), req, user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    task,
+	})
+}
@@ -0,0 +1,99 @@
+package api_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestRecurrenceHandler(t *testing.T) {
+	router := setupRecurrenceTestRouter()
+	helper := testutil.NewHTTPTestHelper(t, router)
+	headers := map[string]string{"Authorization": "Bearer mock-token"}
+
+	t.Run("not recurring yet", func(t *testing.T) {
+		recorder := helper.GET("/api/tasks/task-1/recurrence", headers)
+		helper.AssertStatus(recorder, http.StatusNotFound)
+	})
+
+	t.Run("rule and rrule together", func(t *testing.T) {
+		recorder := helper.PUT("/api/tasks/task-1/recurrence", map[string]interface{}{
+			"rule":  map[string]interface{}{"frequency": "daily"},
+			"rrule": "FREQ=DAILY",
+		}, headers)
+		helper.AssertStatus(recorder, http.StatusBadRequest)
+	})
+
+	t.Run("unsupported rrule", func(t *testing.T) {
+		recorder := helper.PUT("/api/tasks/task-1/recurrence", map[string]interface{}{
+			"rrule": "FREQ=YEARLY",
+		}, headers)
+		helper.AssertStatus(recorder, http.StatusBadRequest)
+	})
+
+	t.Run("set from rrule", func(t *testing.T) {
+		recorder := helper.PUT("/api/tasks/task-1/recurrence", map[string]interface{}{
+			"rrule": "FREQ=WEEKLY;BYDAY=MO,FR",
+		}, headers)
+		helper.AssertStatus(recorder, http.StatusOK)
+
+		recorder = helper.GET("/api/tasks/task-1/recurrence", headers)
+		helper.AssertStatus(recorder, http.StatusOK)
+	})
+
+	t.Run("invalid scope", func(t *testing.T) {
+		recorder := helper.PUT("/api/tasks/task-1/occurrence?scope=all", map[string]interface{}{
+			"title": "Weekly review",
+		}, headers)
+		helper.AssertStatus(recorder, http.StatusBadRequest)
+	})
+
+	t.Run("edit future occurrences", func(t *testing.T) {
+		recorder := helper.PUT("/api/tasks/task-1/occurrence?scope=future", map[string]interface{}{
+			"title": "Weekly review",
+		}, headers)
+		helper.AssertStatus(recorder, http.StatusOK)
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		recorder := helper.DELETE("/api/tasks/task-1/recurrence", headers)
+		helper.AssertStatus(recorder, http.StatusOK)
+	})
+}
+
+// setupRecurrenceTestRouter wires the recurrence handler over a project owned by the test
+// user with a task due next week.
+func setupRecurrenceTestRouter() *gin.Engine {
+	router := testutil.NewTestRouter()
+
+	testUser := testutil.MockUser("user-1", "test@example.com", "testuser", "Test User")
+
+	projectRepo := testutil.NewMockProjectRepository()
+	projectRepo.AddProject(testutil.MockProject("project-1", "Project", "project", "user-1"))
+
+	taskRepo := testutil.NewMockTaskRepository()
+	task := testutil.MockTask("task-1", "Weekly sync", "project-1", "user-1")
+	due := time.Now().UTC().Add(7 * 24 * time.Hour)
+	task.DueDate = &due
+	taskRepo.AddTask(task)
+
+	authz := services.NewAuthorizationService(projectRepo)
+	taskService := services.NewTaskService(
+		taskRepo, projectRepo, testutil.NewMockUserRepository(), testutil.NewMockTaskHistoryRepository(),
+		authz, nil, nil,
+	)
+	recurrenceService := services.NewRecurrenceService(
+		taskService, taskRepo, projectRepo, testutil.NewMockTaskSeriesRepository(), authz)
+	authMiddleware := middleware.NewAuthMiddleware(&MockAuthService{user: testUser})
+
+	api.NewRecurrenceHandler(recurrenceService).RegisterRoutes(router.Group("/api"), authMiddleware)
+
+	return router
+}
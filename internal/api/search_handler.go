@@ -0,0 +1,88 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SearchHandler handles full-text search HTTP requests.
+type SearchHandler struct {
+	searchService services.SearchService
+}
+
+// NewSearchHandler creates a new search handler.
+func NewSearchHandler(searchService services.SearchService) *SearchHandler {
+	return &SearchHandler{
+		searchService: searchService,
+	}
+}
+
+// RegisterRoutes registers search routes with the router.
+func (h *SearchHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *middleware.AuthMiddleware) {
+	projects := router.Group("/projects")
+	projects.Use(authMiddleware.RequireAuth())
+	{
+		projects.GET("/:projectId/tasks/search", h.Search)
+	}
+}
+
+// Search handles GET /api/projects/:projectId/tasks/search requests.
+// The q parameter accepts phrases, prefixes and title:, tag: or assignee: scopes;
+// type narrows results to tasks or comments and may be repeated or comma separated.
+func (h *SearchHandler) Search(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "AUTHENTICATION_ERROR",
+				"code":    "USER_NOT_FOUND",
+				"message": "User not found in context",
+			},
+		})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(services.DefaultSearchLimit)))
+	if err != nil || limit <= 0 || limit > services.MaxSearchLimit {
+		limit = services.DefaultSearchLimit
+	}
+
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	var types []domain.SearchDocumentType
+	for _, value := range c.QueryArray("type") {
+		for _, docType := range strings.Split(value, ",") {
+			if docType = strings.TrimSpace(docType); docType != "" {
+				types = append(types, domain.SearchDocumentType(docType))
+			}
+		}
+	}
+
+	response, err := h.searchService.Search(c.Request.Context(), services.SearchRequest{
+		ProjectID: c.Param("projectId"),
+		Query:     c.Query("q"),
+		Types:     types,
+		Limit:     limit,
+		Offset:    offset,
+	}, user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    response,
+	})
+}
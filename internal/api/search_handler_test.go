@@ -0,0 +1,149 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestSearchHandler_Search(t *testing.T) {
+	tests := []testutil.TestCase{
+		{
+			Name:           "search project",
+			Method:         "GET",
+			URL:            "/api/projects/project-1/tasks/search?q=login",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "search with field scope and type",
+			Method:         "GET",
+			URL:            "/api/projects/project-1/tasks/search?q=title:login&type=task,comment",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "missing query",
+			Method:         "GET",
+			URL:            "/api/projects/project-1/tasks/search",
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Name:           "unterminated phrase",
+			Method:         "GET",
+			URL:            "/api/projects/project-1/tasks/search?q=%22login",
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Name:           "unknown type",
+			Method:         "GET",
+			URL:            "/api/projects/project-1/tasks/search?q=login&type=user",
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Name:           "private project of another user",
+			Method:         "GET",
+			URL:            "/api/projects/private-project/tasks/search?q=login",
+			ExpectedStatus: http.StatusForbidden,
+		},
+		{
+			Name:           "project not found",
+			Method:         "GET",
+			URL:            "/api/projects/non-existent/tasks/search?q=login",
+			ExpectedStatus: http.StatusNotFound,
+		},
+	}
+
+	router, _ := setupSearchTestRouter(t)
+	helper := testutil.NewHTTPTestHelper(t, router)
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(_ *testing.T) {
+			headers := map[string]string{
+				"Authorization": "Bearer mock-token",
+			}
+
+			recorder := helper.Request(tc.Method, tc.URL, tc.Body, headers)
+			helper.AssertStatus(recorder, tc.ExpectedStatus)
+		})
+	}
+}
+
+func TestSearchHandler_Results(t *testing.T) {
+	router, searchRepo := setupSearchTestRouter(t)
+	helper := testutil.NewHTTPTestHelper(t, router)
+	headers := map[string]string{"Authorization": "Bearer mock-token"}
+
+	recorder := helper.GET("/api/projects/project-1/tasks/search?q=login&type=comment&limit=5", headers)
+	helper.AssertStatus(recorder, http.StatusOK)
+
+	var response struct {
+		Data services.SearchResponse `json:"data"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	if response.Data.Total != 1 || len(response.Data.Results) != 1 {
+		t.Fatalf("Expected one comment hit, got %+v", response.Data)
+	}
+	if response.Data.Results[0].Snippet != "the <mark>login</mark> page" {
+		t.Errorf("Expected highlighted snippet, got %q", response.Data.Results[0].Snippet)
+	}
+	if response.Data.Limit != 5 {
+		t.Errorf("Expected limit 5, got %d", response.Data.Limit)
+	}
+	if len(searchRepo.LastQuery.Types) != 1 || searchRepo.LastQuery.Types[0] != domain.SearchDocComment {
+		t.Errorf("Expected comment type filter, got %v", searchRepo.LastQuery.Types)
+	}
+}
+
+func TestSearchHandler_Unauthenticated(t *testing.T) {
+	router, _ := setupSearchTestRouter(t)
+	helper := testutil.NewHTTPTestHelper(t, router)
+
+	recorder := helper.GET("/api/projects/project-1/tasks/search?q=login", nil)
+	helper.AssertStatus(recorder, http.StatusUnauthorized)
+}
+
+// setupSearchTestRouter wires the search handler over a mock search index.
+func setupSearchTestRouter(_ *testing.T) (*gin.Engine, *testutil.MockSearchRepository) {
+	router := testutil.NewTestRouter()
+
+	searchRepo := testutil.NewMockSearchRepository()
+	projectRepo := testutil.NewMockProjectRepository()
+	userRepo := testutil.NewMockUserRepository()
+
+	testUser := testutil.MockUser("user-1", "test@example.com", "testuser", "Test User")
+	userRepo.AddUser(testUser)
+
+	projectRepo.AddProject(testutil.MockProject("project-1", "Test Project", "test-project", "user-1"))
+	privateProject := testutil.MockProject("private-project", "Private Project", "private-project", "user-2")
+	privateProject.Settings.IsPrivate = true
+	projectRepo.AddProject(privateProject)
+
+	searchRepo.AddResult(&domain.SearchResult{
+		Type: domain.SearchDocTask, TaskID: "task-1", ProjectID: "project-1",
+		Title: "<mark>Login</mark> fails", Snippet: "<mark>Login</mark> fails", Score: 2,
+	})
+	searchRepo.AddResult(&domain.SearchResult{
+		Type: domain.SearchDocComment, TaskID: "task-1", CommentID: "comment-1", ProjectID: "project-1",
+		Title: "Login fails", Snippet: "the <mark>login</mark> page", Score: 1,
+	})
+
+	searchService := services.NewSearchService(searchRepo, projectRepo, userRepo)
+	authMiddleware := middleware.NewAuthMiddleware(&MockAuthService{user: testUser})
+
+	// The task routes share the /tasks prefix; mounting both checks they coexist
+	apiGroup := router.Group("/api")
+	api.NewTaskHandler(nil, nil).RegisterRoutes(apiGroup, authMiddleware)
+	api.NewSearchHandler(searchService).RegisterRoutes(apiGroup, authMiddleware)
+
+	return router, searchRepo
+}
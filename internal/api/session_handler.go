@@ -0,0 +1,71 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SessionHandler handles HTTP requests for the devices a user is signed in on.
+type SessionHandler struct {
+	sessionService services.SessionService
+}
+
+// NewSessionHandler creates a new session handler.
+func NewSessionHandler(sessionService services.SessionService) *SessionHandler {
+	return &SessionHandler{
+		sessionService: sessionService,
+	}
+}
+
+// RegisterRoutes registers session routes with the router.
+func (h *SessionHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *middleware.AuthMiddleware) {
+	sessions := router.Group("/auth/sessions")
+	sessions.Use(authMiddleware.RequireAuth())
+	{
+		sessions.GET("", h.ListSessions)
+		sessions.DELETE("/:sessionId", h.RevokeSession)
+	}
+}
+
+// ListSessions handles GET /api/auth/sessions requests.
+func (h *SessionHandler) ListSessions(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	sessions, err := h.sessionService.ListSessions(c.Request.Context(), user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    sessions,
+	})
+}
+
+// RevokeSession handles DELETE /api/auth/sessions/:sessionId requests.
+func (h *SessionHandler) RevokeSession(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	if err := h.sessionService.RevokeSession(c.Request.Context(), user.ID, c.Param("sessionId")); err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Session signed out successfully",
+	})
+}
@@ -0,0 +1,90 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestSessionHandler(t *testing.T) {
+	sessionRepo := testutil.NewMockSessionRepository()
+	router := setupSessionTestRouter(sessionRepo)
+	helper := testutil.NewHTTPTestHelper(t, router)
+	headers := map[string]string{"Authorization": "Bearer mock-token"}
+
+	laptop := &domain.Session{
+		UserID:         "user-1",
+		RefreshTokenID: "refresh-1",
+		UserAgent:      "Firefox on Linux",
+		IPAddress:      "192.0.2.1",
+		LastSeenAt:     time.Now().UTC(),
+		ExpiresAt:      time.Now().UTC().Add(time.Hour),
+	}
+	if err := sessionRepo.Create(context.Background(), laptop); err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+
+	t.Run("requires authentication", func(t *testing.T) {
+		helper.AssertStatus(helper.GET("/api/auth/sessions", nil), http.StatusUnauthorized)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		recorder := helper.GET("/api/auth/sessions", headers)
+		helper.AssertStatus(recorder, http.StatusOK)
+
+		var listed struct {
+			Data []map[string]interface{} `json:"data"`
+		}
+		if err := json.Unmarshal(recorder.Body.Bytes(), &listed); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if len(listed.Data) != 1 || listed.Data[0]["user_agent"] != "Firefox on Linux" {
+			t.Fatalf("Expected the laptop's session, got %v", listed.Data)
+		}
+		if _, leaked := listed.Data[0]["refresh_token_id"]; leaked {
+			t.Errorf("Expected the refresh token ID to be left out, got %v", listed.Data[0])
+		}
+	})
+
+	t.Run("unknown session", func(t *testing.T) {
+		helper.AssertStatus(helper.DELETE("/api/auth/sessions/missing", headers), http.StatusNotFound)
+	})
+
+	t.Run("sign out", func(t *testing.T) {
+		helper.AssertStatus(helper.DELETE("/api/auth/sessions/"+laptop.ID, headers), http.StatusOK)
+
+		session, err := sessionRepo.GetByID(context.Background(), laptop.ID)
+		if err != nil {
+			t.Fatalf("Failed to get session: %v", err)
+		}
+		if session.RevokedAt == nil {
+			t.Error("Expected the session to be revoked")
+		}
+	})
+}
+
+// setupSessionTestRouter wires the session handler for the test user.
+func setupSessionTestRouter(sessionRepo *testutil.MockSessionRepository) *gin.Engine {
+	router := testutil.NewTestRouter()
+
+	testUser := testutil.MockUser("user-1", "test@example.com", "testuser", "Test User")
+	userRepo := testutil.NewMockUserRepository()
+	userRepo.AddUser(testUser)
+
+	authMiddleware := middleware.NewAuthMiddleware(&MockAuthService{user: testUser})
+	sessionService := services.NewSessionService(sessionRepo, userRepo)
+
+	api.NewSessionHandler(sessionService).RegisterRoutes(router.Group("/api"), authMiddleware)
+
+	return router
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"net/http"
 	"strconv"
 	"time"
@@ -54,6 +55,10 @@ func (h *TaskHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *mi
 			tasks.POST("/:id/assign", h.AssignTask)
 			tasks.DELETE("/:id/assign", h.UnassignTask)
 
+			// Task watcher operations
+			tasks.POST("/:id/watch", h.WatchTask)
+			tasks.DELETE("/:id/watch", h.UnwatchTask)
+
 			// Advanced task operations
 			tasks.POST("/:id/duplicate", h.DuplicateTask)
 			tasks.GET("/:id/history", h.GetTaskHistory)
@@ -411,8 +416,9 @@ func (h *TaskHandler) MoveTask(c *gin.Context) {
 	}
 
 	var req struct {
-		NewStatus   domain.TaskStatus `json:"new_status" binding:"required"`
-		NewPosition int               `json:"new_position" binding:"min=0"`
+		NewStatus       domain.TaskStatus `json:"new_status" binding:"required"`
+		NewPosition     int               `json:"new_position" binding:"min=0"`
+		OverrideBlocked bool              `json:"override_blocked,omitempty"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -429,10 +435,11 @@ func (h *TaskHandler) MoveTask(c *gin.Context) {
 
 	// Create move request
 	moveReq := services.MoveTaskRequest{
-		TaskID:      taskID,
-		ProjectID:   projectID,
-		NewStatus:   req.NewStatus,
-		NewPosition: req.NewPosition,
+		TaskID:          taskID,
+		ProjectID:       projectID,
+		NewStatus:       req.NewStatus,
+		NewPosition:     req.NewPosition,
+		OverrideBlocked: req.OverrideBlocked,
 	}
 
 	err := h.taskService.MoveTask(c.Request.Context(), moveReq, user.ID)
@@ -489,6 +496,8 @@ func (h *TaskHandler) UpdateTaskStatus(c *gin.Context) {
 
 	var req struct {
 		Status domain.TaskStatus `json:"status" binding:"required"`
+		// OverrideBlocked moves the task forward even while its dependencies are open
+		OverrideBlocked bool `json:"override_blocked,omitempty"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -516,7 +525,16 @@ func (h *TaskHandler) UpdateTaskStatus(c *gin.Context) {
 		return
 	}
 
-	task, err := h.taskService.UpdateTaskStatus(c.Request.Context(), taskID, req.Status, user.ID)
+	var task *domain.Task
+	var err error
+	if req.OverrideBlocked {
+		task, err = h.taskService.UpdateTask(c.Request.Context(), taskID, domain.UpdateTaskRequest{
+			Status:          &req.Status,
+			OverrideBlocked: true,
+		}, user.ID)
+	} else {
+		task, err = h.taskService.UpdateTaskStatus(c.Request.Context(), taskID, req.Status, user.ID)
+	}
 	if err != nil {
 		h.handleError(c, err)
 		return
@@ -772,6 +790,83 @@ func (h *TaskHandler) UnassignTask(c *gin.Context) {
 	})
 }
 
+// WatchTask handles POST /api/projects/:projectId/tasks/:id/watch requests.
+func (h *TaskHandler) WatchTask(c *gin.Context) {
+	h.changeWatching(c, h.taskService.WatchTask, "Task watched successfully")
+}
+
+// UnwatchTask handles DELETE /api/projects/:projectId/tasks/:id/watch requests.
+func (h *TaskHandler) UnwatchTask(c *gin.Context) {
+	h.changeWatching(c, h.taskService.UnwatchTask, "Task unwatched successfully")
+}
+
+// changeWatching makes the current user start or stop watching the task in the URL
+func (h *TaskHandler) changeWatching(
+	c *gin.Context,
+	change func(ctx context.Context, taskID string, userID string) (*domain.Task, error),
+	message string,
+) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "AUTHENTICATION_ERROR",
+				"code":    "USER_NOT_FOUND",
+				"message": "User not found in context",
+			},
+		})
+		return
+	}
+
+	projectID := c.Param("projectId")
+	taskID := c.Param("id")
+
+	if projectID == "" || taskID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "VALIDATION_ERROR",
+				"code":    "MISSING_PARAMETERS",
+				"message": "Project ID and task ID are required",
+			},
+		})
+		return
+	}
+
+	// Verify task belongs to the specified project before changing it
+	task, err := h.taskService.GetTask(c.Request.Context(), taskID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	if task.ProjectID != projectID {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "NOT_FOUND_ERROR",
+				"code":    "TASK_NOT_FOUND",
+				"message": "Task not found in specified project",
+			},
+		})
+		return
+	}
+
+	task, err = change(c.Request.Context(), taskID, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"task": task,
+		},
+		"message": message,
+	})
+}
+
 // DuplicateTask handles POST /api/projects/:projectId/tasks/:id/duplicate requests.
 func (h *TaskHandler) DuplicateTask(c *gin.Context) {
 	user, exists := middleware.GetUserFromContext(c)
@@ -893,49 +988,81 @@ func (h *TaskHandler) GetTaskHistory(c *gin.Context) {
 	}
 
 	// Parse query parameters for history filtering
-	limitStr := c.DefaultQuery("limit", "50")
-	offsetStr := c.DefaultQuery("offset", "0")
+	filter, err := h.parseHistoryFilter(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
 
-	limit, err := strconv.Atoi(limitStr)
+	history, total, err := h.taskService.GetTaskHistory(c.Request.Context(), taskID, filter, user.ID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"history": history,
+			"meta": gin.H{
+				"total":  total,
+				"limit":  filter.Limit,
+				"offset": filter.Offset,
+			},
+		},
+	})
+}
+
+// parseHistoryFilter parses task history filter and pagination parameters
+func (h *TaskHandler) parseHistoryFilter(c *gin.Context) (domain.TaskHistoryFilter, error) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
 	if err != nil || limit <= 0 || limit > 100 {
 		limit = 50
 	}
 
-	offset, err := strconv.Atoi(offsetStr)
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
 	if err != nil || offset < 0 {
 		offset = 0
 	}
 
-	// Create history filter
-	_ = domain.TaskHistoryFilter{
-		TaskID: &taskID,
+	filter := domain.TaskHistoryFilter{
 		Limit:  limit,
 		Offset: offset,
 	}
 
-	// For now, we'll return a placeholder response
-	// In a real implementation, you'd query from a task history repository
-	history := []domain.TaskHistoryEntry{
-		{
-			ID:        "history-1",
-			TaskID:    taskID,
-			UserID:    task.ReporterID,
-			Action:    domain.ActionCreated,
-			CreatedAt: task.CreatedAt,
-		},
+	if userStr := c.Query("user"); userStr != "" {
+		filter.UserID = &userStr
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"success": true,
-		"data": gin.H{
-			"history": history,
-			"meta": gin.H{
-				"total":  len(history),
-				"limit":  limit,
-				"offset": offset,
-			},
-		},
-	})
+	if fieldStr := c.Query("field"); fieldStr != "" {
+		filter.FieldName = &fieldStr
+	}
+
+	for _, action := range c.QueryArray("action") {
+		filter.Actions = append(filter.Actions, domain.TaskHistoryAction(action))
+	}
+
+	if sinceStr := c.Query("since"); sinceStr != "" {
+		since, err := time.Parse(time.RFC3339, sinceStr)
+		if err != nil {
+			return filter, domain.NewValidationError("INVALID_SINCE", "since must be an RFC3339 timestamp", map[string]interface{}{
+				"since": sinceStr,
+			})
+		}
+		filter.StartDate = &since
+	}
+
+	if untilStr := c.Query("until"); untilStr != "" {
+		until, err := time.Parse(time.RFC3339, untilStr)
+		if err != nil {
+			return filter, domain.NewValidationError("INVALID_UNTIL", "until must be an RFC3339 timestamp", map[string]interface{}{
+				"until": untilStr,
+			})
+		}
+		filter.EndDate = &until
+	}
+
+	return filter, nil
 }
 
 // LogTimeSpent handles POST /api/projects/:projectId/tasks/:id/time-log requests.
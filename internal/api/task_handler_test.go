@@ -420,7 +420,7 @@ func TestTaskHandler_MoveTask(t *testing.T) {
 			Method: "POST",
 			URL:    "/api/projects/project-1/tasks/task-1/move",
 			Body: map[string]interface{}{
-				"new_status":   "invalid",
+				"new_status":   "Not A Status",
 				"new_position": 1,
 			},
 			ExpectedStatus: http.StatusBadRequest,
@@ -497,7 +497,7 @@ func TestTaskHandler_UpdateTaskStatus(t *testing.T) {
 			Method: "PUT",
 			URL:    "/api/projects/project-1/tasks/task-1/status",
 			Body: map[string]interface{}{
-				"status": "invalid",
+				"status": "Not A Status",
 			},
 			ExpectedStatus: http.StatusBadRequest,
 		},
@@ -605,6 +605,49 @@ func TestTaskHandler_UnassignTask(t *testing.T) {
 	}
 }
 
+func TestTaskHandler_WatchTask(t *testing.T) {
+	tests := []testutil.TestCase{
+		{
+			Name:           "watch task",
+			Method:         "POST",
+			URL:            "/api/projects/project-1/tasks/task-1/watch",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "unwatch task",
+			Method:         "DELETE",
+			URL:            "/api/projects/project-1/tasks/task-1/watch",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "watch non-existent task",
+			Method:         "POST",
+			URL:            "/api/projects/project-1/tasks/non-existent/watch",
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Name:           "watch task in another project",
+			Method:         "POST",
+			URL:            "/api/projects/project-2/tasks/task-1/watch",
+			ExpectedStatus: http.StatusNotFound,
+		},
+	}
+
+	router := setupTaskTestRouter(t)
+	helper := testutil.NewHTTPTestHelper(t, router)
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(_ *testing.T) {
+			headers := map[string]string{
+				"Authorization": "Bearer mock-token",
+			}
+
+			recorder := helper.Request(tc.Method, tc.URL, tc.Body, headers)
+			helper.AssertStatus(recorder, tc.ExpectedStatus)
+		})
+	}
+}
+
 func TestTaskHandler_DuplicateTask(t *testing.T) {
 	tests := []testutil.TestCase{
 		{
@@ -671,6 +714,24 @@ func TestTaskHandler_GetTaskHistory(t *testing.T) {
 			URL:            "/api/projects/project-1/tasks/task-1/history?limit=10&offset=0",
 			ExpectedStatus: http.StatusOK,
 		},
+		{
+			Name:           "get task history with date range",
+			Method:         "GET",
+			URL:            "/api/projects/project-1/tasks/task-1/history?since=2025-01-01T00:00:00Z&until=2025-12-31T00:00:00Z",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "malformed since is rejected",
+			Method:         "GET",
+			URL:            "/api/projects/project-1/tasks/task-1/history?since=yesterday",
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Name:           "malformed until is rejected",
+			Method:         "GET",
+			URL:            "/api/projects/project-1/tasks/task-1/history?until=2025-13-01",
+			ExpectedStatus: http.StatusBadRequest,
+		},
 		{
 			Name:           "get history of non-existent task",
 			Method:         "GET",
@@ -999,6 +1060,10 @@ func (m *MockTaskService) GetTaskDependencies(_ context.Context, _ string, _ str
 	return []*domain.Task{}, nil
 }
 
+func (m *MockTaskService) GetTaskDependents(_ context.Context, _ string, _ string) ([]*domain.Task, error) {
+	return []*domain.Task{}, nil
+}
+
 func (m *MockTaskService) DuplicateTask(
 	_ context.Context, taskID string, options services.DuplicationOptions, userID string,
 ) (*domain.Task, error) {
@@ -1157,6 +1222,38 @@ func (m *MockTaskService) RemoveDependency(_ context.Context, taskID string, dep
 	return nil
 }
 
+func (m *MockTaskService) GetTaskHistory(
+	ctx context.Context, taskID string, _ domain.TaskHistoryFilter, userID string,
+) ([]*domain.TaskHistoryEntry, int, error) {
+	task, err := m.GetTask(ctx, taskID, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	history := []*domain.TaskHistoryEntry{
+		domain.NewTaskHistoryEntry(task.ID, task.ReporterID, domain.ActionCreated),
+	}
+	return history, len(history), nil
+}
+
+func (m *MockTaskService) WatchTask(ctx context.Context, taskID string, userID string) (*domain.Task, error) {
+	task, err := m.GetTask(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+	task.AddWatcher(userID)
+	return task, nil
+}
+
+func (m *MockTaskService) UnwatchTask(ctx context.Context, taskID string, userID string) (*domain.Task, error) {
+	task, err := m.GetTask(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+	task.RemoveWatcher(userID)
+	return task, nil
+}
+
 func TestTaskHandler_CreateSubtask(t *testing.T) {
 	tests := []testutil.TestCase{
 		{
@@ -0,0 +1,208 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// WebhookHandler handles outbound webhook HTTP requests.
+type WebhookHandler struct {
+	webhookService services.WebhookService
+}
+
+// NewWebhookHandler creates a new webhook handler.
+func NewWebhookHandler(webhookService services.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+// createdWebhookResponse reveals the signing secret, which is only returned when the webhook is created.
+type createdWebhookResponse struct {
+	*domain.Webhook
+	Secret string `json:"secret"`
+}
+
+// RegisterRoutes registers webhook routes with the router.
+func (h *WebhookHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *middleware.AuthMiddleware) {
+	projects := router.Group("/projects")
+	projects.Use(authMiddleware.RequireAuth())
+	{
+		projects.GET("/:projectId/webhooks", h.ListWebhooks)
+		projects.POST("/:projectId/webhooks", h.CreateWebhook)
+		projects.GET("/:projectId/webhooks/:webhookId", h.GetWebhook)
+		projects.PUT("/:projectId/webhooks/:webhookId", h.UpdateWebhook)
+		projects.DELETE("/:projectId/webhooks/:webhookId", h.DeleteWebhook)
+		projects.GET("/:projectId/webhooks/:webhookId/deliveries", h.ListDeliveries)
+		projects.POST("/:projectId/webhooks/:webhookId/deliveries/:deliveryId/redeliver", h.Redeliver)
+	}
+}
+
+// ListWebhooks handles GET /api/projects/:projectId/webhooks requests.
+func (h *WebhookHandler) ListWebhooks(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	webhooks, err := h.webhookService.ListWebhooks(c.Request.Context(), c.Param("projectId"), user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    webhooks,
+	})
+}
+
+// CreateWebhook handles POST /api/projects/:projectId/webhooks requests.
+// The response carries the signing secret; it is not returned again.
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	var req services.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		invalidRequest(c, err)
+		return
+	}
+
+	webhook, err := h.webhookService.CreateWebhook(c.Request.Context(), c.Param("projectId"), req, user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"data":    createdWebhookResponse{Webhook: webhook, Secret: webhook.Secret},
+	})
+}
+
+// GetWebhook handles GET /api/projects/:projectId/webhooks/:webhookId requests.
+func (h *WebhookHandler) GetWebhook(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	webhook, err := h.webhookService.GetWebhook(
+		c.Request.Context(), c.Param("projectId"), c.Param("webhookId"), user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    webhook,
+	})
+}
+
+// UpdateWebhook handles PUT /api/projects/:projectId/webhooks/:webhookId requests.
+// Omitted fields are left unchanged; "active": true re-enables a disabled webhook.
+func (h *WebhookHandler) UpdateWebhook(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	var req services.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		invalidRequest(c, err)
+		return
+	}
+
+	webhook, err := h.webhookService.UpdateWebhook(
+		c.Request.Context(), c.Param("projectId"), c.Param("webhookId"), req, user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    webhook,
+	})
+}
+
+// DeleteWebhook handles DELETE /api/projects/:projectId/webhooks/:webhookId requests.
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	err := h.webhookService.DeleteWebhook(c.Request.Context(), c.Param("projectId"), c.Param("webhookId"), user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Webhook deleted successfully",
+	})
+}
+
+// ListDeliveries handles GET /api/projects/:projectId/webhooks/:webhookId/deliveries requests.
+func (h *WebhookHandler) ListDeliveries(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(services.DefaultWebhookDeliveryLimit)))
+	if err != nil || limit <= 0 || limit > services.MaxWebhookDeliveryLimit {
+		limit = services.DefaultWebhookDeliveryLimit
+	}
+
+	deliveries, err := h.webhookService.ListDeliveries(
+		c.Request.Context(), c.Param("projectId"), c.Param("webhookId"), limit, user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    deliveries,
+	})
+}
+
+// Redeliver handles POST /api/projects/:projectId/webhooks/:webhookId/deliveries/:deliveryId/redeliver requests.
+func (h *WebhookHandler) Redeliver(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	delivery, err := h.webhookService.Redeliver(
+		c.Request.Context(), c.Param("projectId"), c.Param("webhookId"), c.Param("deliveryId"), user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"success": true,
+		"data":    delivery,
+	})
+}
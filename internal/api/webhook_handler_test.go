@@ -0,0 +1,188 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestWebhookHandler_Routes(t *testing.T) {
+	tests := []testutil.TestCase{
+		{
+			Name:           "list webhooks",
+			Method:         "GET",
+			URL:            "/api/projects/project-1/webhooks",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "missing url",
+			Method:         "POST",
+			URL:            "/api/projects/project-1/webhooks",
+			Body:           map[string]interface{}{"description": "CI"},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Name:           "invalid url",
+			Method:         "POST",
+			URL:            "/api/projects/project-1/webhooks",
+			Body:           map[string]interface{}{"url": "ftp://example.com/hooks"},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Name:           "not the owner",
+			Method:         "POST",
+			URL:            "/api/projects/private-project/webhooks",
+			Body:           map[string]interface{}{"url": "https://example.com/hooks"},
+			ExpectedStatus: http.StatusForbidden,
+		},
+		{
+			Name:           "project not found",
+			Method:         "GET",
+			URL:            "/api/projects/non-existent/webhooks",
+			ExpectedStatus: http.StatusNotFound,
+		},
+		{
+			Name:           "webhook not found",
+			Method:         "GET",
+			URL:            "/api/projects/project-1/webhooks/missing",
+			ExpectedStatus: http.StatusNotFound,
+		},
+	}
+
+	router := setupWebhookTestRouter(t)
+	helper := testutil.NewHTTPTestHelper(t, router)
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(_ *testing.T) {
+			headers := map[string]string{
+				"Authorization": "Bearer mock-token",
+			}
+
+			recorder := helper.Request(tc.Method, tc.URL, tc.Body, headers)
+			helper.AssertStatus(recorder, tc.ExpectedStatus)
+		})
+	}
+}
+
+func TestWebhookHandler_SecretOnlyOnCreate(t *testing.T) {
+	router := setupWebhookTestRouter(t)
+	helper := testutil.NewHTTPTestHelper(t, router)
+	headers := map[string]string{"Authorization": "Bearer mock-token"}
+
+	body := map[string]interface{}{
+		"url":         "https://example.com/hooks",
+		"secret":      "a-shared-secret-value",
+		"event_types": []string{"task.moved"},
+	}
+	recorder := helper.Request("POST", "/api/projects/project-1/webhooks", body, headers)
+	helper.AssertStatus(recorder, http.StatusCreated)
+
+	var created struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &created); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if created.Data["secret"] != "a-shared-secret-value" {
+		t.Errorf("Expected the secret in the create response, got %v", created.Data["secret"])
+	}
+
+	webhookID, _ := created.Data["id"].(string)
+	recorder = helper.GET("/api/projects/project-1/webhooks/"+webhookID, headers)
+	helper.AssertStatus(recorder, http.StatusOK)
+
+	var fetched struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &fetched); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if _, ok := fetched.Data["secret"]; ok {
+		t.Errorf("Expected the secret to be hidden after creation, got %v", fetched.Data)
+	}
+}
+
+func TestWebhookHandler_Redeliver(t *testing.T) {
+	router := setupWebhookTestRouter(t)
+	helper := testutil.NewHTTPTestHelper(t, router)
+	headers := map[string]string{"Authorization": "Bearer mock-token"}
+
+	url := "/api/projects/project-1/webhooks/delivery-webhook/deliveries/delivery-001/redeliver"
+	recorder := helper.Request("POST", url, nil, headers)
+	helper.AssertStatus(recorder, http.StatusAccepted)
+
+	var response struct {
+		Data domain.WebhookDelivery `json:"data"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if response.Data.RedeliveryOf != "delivery-001" || response.Data.Status != domain.WebhookDeliveryPending {
+		t.Errorf("Expected a pending redelivery of delivery-001, got %+v", response.Data)
+	}
+
+	recorder = helper.GET("/api/projects/project-1/webhooks/delivery-webhook/deliveries?limit=10", headers)
+	helper.AssertStatus(recorder, http.StatusOK)
+
+	var deliveries struct {
+		Data []domain.WebhookDelivery `json:"data"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &deliveries); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(deliveries.Data) != 2 {
+		t.Errorf("Expected the original delivery and its redelivery, got %d", len(deliveries.Data))
+	}
+}
+
+// setupWebhookTestRouter wires the webhook handler over a project with one webhook and one failed delivery.
+func setupWebhookTestRouter(_ *testing.T) *gin.Engine {
+	router := testutil.NewTestRouter()
+
+	projectRepo := testutil.NewMockProjectRepository()
+	webhookRepo := testutil.NewMockWebhookRepository()
+	deliveryRepo := testutil.NewMockWebhookDeliveryRepository()
+
+	testUser := testutil.MockUser("user-1", "test@example.com", "testuser", "Test User")
+
+	projectRepo.AddProject(testutil.MockProject("project-1", "Test Project", "test-project", "user-1"))
+	privateProject := testutil.MockProject("private-project", "Private Project", "private-project", "user-2")
+	privateProject.Settings.IsPrivate = true
+	projectRepo.AddProject(privateProject)
+
+	webhookRepo.Webhooks["delivery-webhook"] = &domain.Webhook{
+		ID:        "delivery-webhook",
+		ProjectID: "project-1",
+		URL:       "https://example.com/hooks",
+		Secret:    "a-shared-secret-value",
+		CreatedBy: "user-1",
+		Active:    true,
+	}
+	deliveryRepo.Deliveries["delivery-001"] = &domain.WebhookDelivery{
+		ID:          "delivery-001",
+		WebhookID:   "delivery-webhook",
+		ProjectID:   "project-1",
+		EventID:     "event-1",
+		EventType:   domain.TaskMoved,
+		Status:      domain.WebhookDeliveryFailed,
+		Payload:     []byte(`{"type":"task.moved"}`),
+		Attempts:    8,
+		MaxAttempts: 8,
+	}
+
+	webhookService := services.NewWebhookService(
+		webhookRepo, deliveryRepo, services.NewAuthorizationService(projectRepo), services.DefaultWebhookConfig())
+	authMiddleware := middleware.NewAuthMiddleware(&MockAuthService{user: testUser})
+
+	api.NewWebhookHandler(webhookService).RegisterRoutes(router.Group("/api"), authMiddleware)
+
+	return router
+}
@@ -18,11 +18,15 @@ import (
 	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
 )
 
+// NotificationUnreadCountMessage is the WebSocket message type that carries a user's unread notification count
+const NotificationUnreadCountMessage = "notification.unread_count"
+
 // WebSocketHandler provides WebSocket functionality for real-time features
 type WebSocketHandler struct {
 	upgrader            websocket.Upgrader
 	subscriptionManager services.SubscriptionManager
 	eventBroadcaster    services.EventBroadcaster
+	notificationService services.NotificationService
 	app                 core.App
 	connectionManager   *ConnectionManager
 }
@@ -44,6 +48,7 @@ type WebSocketConnection struct {
 	Connection    *websocket.Conn
 	Send          chan []byte
 	EventTypes    []domain.TaskEventType
+	WatchingOnly  bool // only receive events about tasks the user watches
 	LastPing      time.Time
 	Subscriptions map[string]*domain.EventSubscription
 	mutex         sync.RWMutex
@@ -165,6 +170,13 @@ func (h *WebSocketHandler) HandleWebSocketUpgrade(c *gin.Context) {
 	// Register connection
 	h.connectionManager.register <- wsConn
 
+	// Start the client off with its unread notification count; changes are pushed as they happen
+	if h.notificationService != nil {
+		if count, countErr := h.notificationService.UnreadCount(c.Request.Context(), user.ID); countErr == nil {
+			h.sendMessage(wsConn, unreadCountMessage(count))
+		}
+	}
+
 	// Start connection handlers
 	go h.handleWebSocketConnection(wsConn)
 	go h.handleWebSocketWrites(wsConn)
@@ -276,12 +288,17 @@ func (h *WebSocketHandler) handleSubscribeMessage(
 		eventTypes = h.getDefaultEventTypes()
 	}
 
+	watching, _ := msg.Data[domain.WatchingFilter].(bool)
+
 	// Create subscription
 	subReq := services.CreateSubscriptionRequest{
 		UserID:     conn.UserID,
 		ProjectID:  &projectID,
 		EventTypes: eventTypes,
 	}
+	if watching {
+		subReq.Filters = map[string]string{domain.WatchingFilter: "true"}
+	}
 
 	subscription, err := h.subscriptionManager.CreateSubscription(ctx, subReq)
 	if err != nil {
@@ -292,6 +309,7 @@ func (h *WebSocketHandler) handleSubscribeMessage(
 	conn.mutex.Lock()
 	conn.Subscriptions[subscription.ID] = subscription
 	conn.EventTypes = eventTypes
+	conn.WatchingOnly = watching
 	if projectID != "" {
 		conn.ProjectID = projectID
 	}
@@ -301,9 +319,10 @@ func (h *WebSocketHandler) handleSubscribeMessage(
 	response := WebSocketMessage{
 		Type: "subscription_created",
 		Data: map[string]interface{}{
-			"subscription_id": subscription.ID,
-			"project_id":      projectID,
-			"event_types":     eventTypes,
+			"subscription_id":     subscription.ID,
+			"project_id":          projectID,
+			"event_types":         eventTypes,
+			domain.WatchingFilter: watching,
 		},
 		Timestamp: time.Now(),
 	}
@@ -494,6 +513,10 @@ func (h *WebSocketHandler) getDefaultEventTypes() []domain.TaskEventType {
 		domain.TaskDeleted,
 		domain.TaskAssigned,
 		domain.TaskCommented,
+		domain.TaskUnblocked,
+		domain.TaskMentioned,
+		domain.TaskCommentReacted,
+		domain.TaskCommentResolved,
 	}
 }
 
@@ -631,6 +654,13 @@ func (cm *ConnectionManager) broadcastMessage(msg *BroadcastMessage) {
 					break
 				}
 			}
+			if conn.WatchingOnly {
+				event, isTaskEvent := msg.Data.(*domain.TaskEvent)
+				eventTypeMatches = eventTypeMatches && isTaskEvent && event.IsWatchedBy(conn.UserID)
+			}
+			if event, isTaskEvent := msg.Data.(*domain.TaskEvent); isTaskEvent && !event.IsFor(conn.UserID) {
+				eventTypeMatches = false
+			}
 			conn.mutex.RUnlock()
 
 			if eventTypeMatches {
@@ -649,6 +679,23 @@ func (cm *ConnectionManager) broadcastMessage(msg *BroadcastMessage) {
 	log.Printf("Broadcast message sent to %d connections", sentCount)
 }
 
+// sendToUser queues a message on every connection of a user. A connection whose buffer is
+// full misses the message; the next one supersedes it.
+func (cm *ConnectionManager) sendToUser(userID string, message []byte) {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+
+	for _, conn := range cm.connections {
+		if conn.UserID != userID {
+			continue
+		}
+		select {
+		case conn.Send <- message:
+		default:
+		}
+	}
+}
+
 // getStats returns connection statistics
 func (cm *ConnectionManager) getStats() *ConnectionStats {
 	cm.mutex.RLock()
@@ -726,6 +773,33 @@ func (h *WebSocketHandler) SendToUser(userID string, eventType domain.TaskEventT
 	h.connectionManager.broadcast <- broadcastMsg
 }
 
+// SetNotificationService makes the handler push unread notification counts to each user's connections
+func (h *WebSocketHandler) SetNotificationService(notificationService services.NotificationService) {
+	h.notificationService = notificationService
+	notificationService.SetUnreadCountPublisher(h)
+}
+
+// PublishUnreadCount sends a user's unread notification count to all of their connections.
+// Unlike events it is not subject to the connection's event type filter.
+func (h *WebSocketHandler) PublishUnreadCount(userID string, count int) {
+	messageBytes, err := json.Marshal(unreadCountMessage(count))
+	if err != nil {
+		log.Printf("Failed to marshal unread count message: %v", err)
+		return
+	}
+
+	h.connectionManager.sendToUser(userID, messageBytes)
+}
+
+// unreadCountMessage builds the message that carries an unread notification count
+func unreadCountMessage(count int) *WebSocketMessage {
+	return &WebSocketMessage{
+		Type:      NotificationUnreadCountMessage,
+		Data:      map[string]interface{}{"unread": count},
+		Timestamp: time.Now(),
+	}
+}
+
 // SendToProject sends a message to all connections for a specific project
 func (h *WebSocketHandler) SendToProject(projectID string, eventType domain.TaskEventType, data interface{}) {
 	broadcastMsg := &BroadcastMessage{
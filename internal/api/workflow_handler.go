@@ -0,0 +1,87 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// WorkflowHandler handles project workflow HTTP requests.
+type WorkflowHandler struct {
+	workflowService services.WorkflowService
+}
+
+// NewWorkflowHandler creates a new workflow handler.
+func NewWorkflowHandler(workflowService services.WorkflowService) *WorkflowHandler {
+	return &WorkflowHandler{
+		workflowService: workflowService,
+	}
+}
+
+// RegisterRoutes registers workflow routes with the router.
+func (h *WorkflowHandler) RegisterRoutes(router *gin.RouterGroup, authMiddleware *middleware.AuthMiddleware) {
+	projects := router.Group("/projects")
+	projects.Use(authMiddleware.RequireAuth())
+	{
+		projects.GET("/:projectId/workflow", h.GetWorkflow)
+		projects.PUT("/:projectId/workflow", h.UpdateWorkflow)
+	}
+}
+
+// GetWorkflow handles GET /api/projects/:projectId/workflow requests.
+func (h *WorkflowHandler) GetWorkflow(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	workflow, err := h.workflowService.GetWorkflow(c.Request.Context(), c.Param("projectId"), user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    workflow,
+	})
+}
+
+// UpdateWorkflow handles PUT /api/projects/:projectId/workflow requests.
+// Tasks in statuses the new workflow drops must be mapped with status_mapping.
+func (h *WorkflowHandler) UpdateWorkflow(c *gin.Context) {
+	user, exists := middleware.GetUserFromContext(c)
+	if !exists {
+		userNotFound(c)
+		return
+	}
+
+	var req services.UpdateWorkflowRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": map[string]interface{}{
+				"type":    "VALIDATION_ERROR",
+				"code":    "INVALID_REQUEST",
+				"message": "Invalid request format",
+				"details": err.Error(),
+			},
+		})
+		return
+	}
+
+	workflow, err := h.workflowService.UpdateWorkflow(c.Request.Context(), c.Param("projectId"), req, user.ID)
+	if err != nil {
+		ErrorResponse(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    workflow,
+	})
+}
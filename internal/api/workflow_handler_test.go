@@ -0,0 +1,153 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/api/middleware"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/services"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestWorkflowHandler_Routes(t *testing.T) {
+	qaWorkflow := map[string]interface{}{
+		"workflow": map[string]interface{}{
+			"statuses": []map[string]interface{}{
+				{"id": "todo", "name": "To Do"},
+				{"id": "qa", "name": "QA"},
+				{"id": "done", "name": "Done", "done": true},
+			},
+		},
+	}
+
+	tests := []testutil.TestCase{
+		{
+			Name:           "get default workflow",
+			Method:         "GET",
+			URL:            "/api/projects/project-1/workflow",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "private project of another user",
+			Method:         "GET",
+			URL:            "/api/projects/private-project/workflow",
+			ExpectedStatus: http.StatusForbidden,
+		},
+		{
+			Name:           "malformed body",
+			Method:         "PUT",
+			URL:            "/api/projects/project-1/workflow",
+			Body:           `{"workflow": "kanban"}`,
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Name:           "invalid workflow",
+			Method:         "PUT",
+			URL:            "/api/projects/project-1/workflow",
+			Body:           map[string]interface{}{"workflow": map[string]interface{}{"statuses": []interface{}{}}},
+			ExpectedStatus: http.StatusBadRequest,
+		},
+		{
+			Name:           "not the owner",
+			Method:         "PUT",
+			URL:            "/api/projects/private-project/workflow",
+			Body:           qaWorkflow,
+			ExpectedStatus: http.StatusForbidden,
+		},
+		{
+			Name:           "project not found",
+			Method:         "PUT",
+			URL:            "/api/projects/non-existent/workflow",
+			Body:           qaWorkflow,
+			ExpectedStatus: http.StatusNotFound,
+		},
+	}
+
+	router := setupWorkflowTestRouter(t)
+	helper := testutil.NewHTTPTestHelper(t, router)
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(_ *testing.T) {
+			headers := map[string]string{
+				"Authorization": "Bearer mock-token",
+			}
+
+			recorder := helper.Request(tc.Method, tc.URL, tc.Body, headers)
+			helper.AssertStatus(recorder, tc.ExpectedStatus)
+		})
+	}
+}
+
+func TestWorkflowHandler_StatusInUse(t *testing.T) {
+	router := setupWorkflowTestRouter(t)
+	helper := testutil.NewHTTPTestHelper(t, router)
+	headers := map[string]string{"Authorization": "Bearer mock-token"}
+
+	body := map[string]interface{}{
+		"workflow": map[string]interface{}{
+			"statuses": []map[string]interface{}{
+				{"id": "backlog", "name": "Backlog"},
+				{"id": "done", "name": "Done", "done": true},
+			},
+		},
+	}
+
+	recorder := helper.Request("PUT", "/api/projects/project-1/workflow", body, headers)
+	helper.AssertStatus(recorder, http.StatusConflict)
+
+	var response struct {
+		Error map[string]interface{} `json:"error"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if response.Error["code"] != "WORKFLOW_STATUS_IN_USE" || response.Error["status"] != "todo" {
+		t.Errorf("Expected todo to be reported in use, got %v", response.Error)
+	}
+
+	body["status_mapping"] = map[string]string{"todo": "backlog"}
+	recorder = helper.Request("PUT", "/api/projects/project-1/workflow", body, headers)
+	helper.AssertStatus(recorder, http.StatusOK)
+
+	recorder = helper.GET("/api/projects/project-1/workflow", headers)
+	var updated struct {
+		Data domain.Workflow `json:"data"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &updated); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(updated.Data.Statuses) != 2 || updated.Data.DoneStatus() != "done" {
+		t.Errorf("Expected the new workflow, got %+v", updated.Data)
+	}
+}
+
+// setupWorkflowTestRouter wires the workflow handler over a project with one todo task.
+func setupWorkflowTestRouter(_ *testing.T) *gin.Engine {
+	router := testutil.NewTestRouter()
+
+	taskRepo := testutil.NewMockTaskRepository()
+	projectRepo := testutil.NewMockProjectRepository()
+
+	testUser := testutil.MockUser("user-1", "test@example.com", "testuser", "Test User")
+
+	projectRepo.AddProject(testutil.MockProject("project-1", "Test Project", "test-project", "user-1"))
+	privateProject := testutil.MockProject("private-project", "Private Project", "private-project", "user-2")
+	privateProject.Settings.IsPrivate = true
+	projectRepo.AddProject(privateProject)
+
+	taskRepo.AddTask(testutil.MockTask("task-1", "First", "project-1", "user-1"))
+
+	cache := services.NewCacheManager(services.NewMemoryCacheBackend("test:"), services.DefaultCacheConfig())
+	workflowService := services.NewWorkflowService(
+		taskRepo, projectRepo, services.NewAuthorizationService(projectRepo), cache)
+	authMiddleware := middleware.NewAuthMiddleware(&MockAuthService{user: testUser})
+
+	api.NewWorkflowHandler(workflowService).RegisterRoutes(router.Group("/api"), authMiddleware)
+
+	return router
+}
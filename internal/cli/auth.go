@@ -1,12 +1,18 @@
 package cli
 
 import (
+	"bufio"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"strings"
 	"syscall"
 
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
 )
 
 func init() {
@@ -28,6 +34,7 @@ func init() {
 	loginCmd.Flags().StringP("password", "p", "", "Password (not recommended, use interactive prompt)")
 	loginCmd.Flags().StringP("server", "s", "http://localhost:8090", "Server URL")
 	loginCmd.Flags().StringP("profile", "", "default", "Profile name")
+	loginCmd.Flags().StringP("token", "t", "", "Personal access token to log in with, or - to read it from stdin")
 
 	// Profile create flags
 	profileCreateCmd.Flags().StringP("server", "s", "", "Server URL")
@@ -45,15 +52,26 @@ var authCmd = &cobra.Command{
 var loginCmd = &cobra.Command{
 	Use:   "login",
 	Short: "Login to Simple Easy Tasks API",
-	Long: `Authenticate with the Simple Easy Tasks API using email and password.
+	Long: `Authenticate with the Simple Easy Tasks API using email and password,
+or with a personal access token.
 
 This command will prompt for credentials if not provided via flags.
-The authentication token will be stored securely for future use.`,
+The authentication token will be stored securely for future use.
+
+Tokens from email and password login expire; for scripts and CI, create a
+personal access token and log in with --token instead:
+
+  echo "$SET_TOKEN" | set-cli auth login --token - --server https://tasks.example.com`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		email, _ := cmd.Flags().GetString("email")
 		password, _ := cmd.Flags().GetString("password")
 		serverURL, _ := cmd.Flags().GetString("server")
 		profileName, _ := cmd.Flags().GetString("profile")
+		token, _ := cmd.Flags().GetString("token")
+
+		if token != "" {
+			return loginWithToken(serverURL, profileName, token)
+		}
 
 		// Prompt for email if not provided
 		if email == "" {
@@ -114,6 +132,50 @@ The authentication token will be stored securely for future use.`,
 	},
 }
 
+// loginWithToken checks a personal access token works and stores it in a profile
+func loginWithToken(serverURL, profileName, token string) error {
+	if token == "-" {
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("failed to read token: %w", err)
+		}
+		token = line
+	}
+	token = strings.TrimSpace(token)
+
+	if !domain.IsPersonalAccessToken(token) {
+		return fmt.Errorf("not a personal access token, they start with %q", domain.PersonalAccessTokenPrefix)
+	}
+
+	client := NewAPIClient(serverURL, token)
+
+	fmt.Printf("Authenticating with %s...\n", serverURL)
+	tokens, err := client.ListPersonalAccessTokens()
+	if err != nil {
+		return fmt.Errorf("login failed: %w", err)
+	}
+
+	if err := AddProfile(Profile{Name: profileName, ServerURL: serverURL, Token: token}); err != nil {
+		return fmt.Errorf("failed to save profile: %w", err)
+	}
+
+	hint := domain.TokenHint(token)
+	for _, t := range tokens {
+		if t.Hint == hint {
+			fmt.Printf("✓ Successfully authenticated with token '%s' (%s)\n", t.Name, t.Scope)
+			break
+		}
+	}
+
+	if err := SetCurrentProfile(profileName); err != nil {
+		fmt.Printf("✓ Profile '%s' created but could not set as default: %v\n", profileName, err)
+	} else {
+		fmt.Printf("✓ Profile '%s' created and set as default\n", profileName)
+	}
+
+	return nil
+}
+
 var logoutCmd = &cobra.Command{
 	Use:   "logout [profile]",
 	Short: "Logout and remove authentication token",
@@ -6,8 +6,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
@@ -65,14 +68,9 @@ func (c *APIClient) doRequest(ctx context.Context, method, endpoint string, body
 		reqBody = bytes.NewBuffer(jsonData)
 	}
 
-	baseURL, err := url.Parse(c.BaseURL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse base URL: %w", err)
-	}
-
-	fullURL, err := url.JoinPath(baseURL.String(), endpoint)
+	fullURL, err := c.endpointURL(endpoint)
 	if err != nil {
-		return nil, fmt.Errorf("failed to join URL path: %w", err)
+		return nil, err
 	}
 
 	req, err := http.NewRequestWithContext(ctx, method, fullURL, reqBody)
@@ -97,6 +95,20 @@ func (c *APIClient) doRequest(ctx context.Context, method, endpoint string, body
 	return resp, nil
 }
 
+// endpointURL resolves an API endpoint against the base URL
+func (c *APIClient) endpointURL(endpoint string) (string, error) {
+	baseURL, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse base URL: %w", err)
+	}
+
+	fullURL, err := url.JoinPath(baseURL.String(), endpoint)
+	if err != nil {
+		return "", fmt.Errorf("failed to join URL path: %w", err)
+	}
+	return fullURL, nil
+}
+
 // handleResponse processes the HTTP response and handles errors
 // Note: This function automatically closes the response body
 //
@@ -189,6 +201,22 @@ type LoginResponse struct {
 	User  domain.User `json:"user"`
 }
 
+// ListPersonalAccessTokens retrieves the current user's personal access tokens
+func (c *APIClient) ListPersonalAccessTokens() ([]domain.PersonalAccessToken, error) {
+	ctx := context.Background()
+	//nolint:bodyclose // Response body is closed by handleResponse
+	resp, err := c.doRequest(ctx, "GET", "/api/auth/tokens", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Data []domain.PersonalAccessToken `json:"data"`
+	}
+	err = c.handleResponse(resp, &result)
+	return result.Data, err
+}
+
 // GetProjects retrieves all projects
 func (c *APIClient) GetProjects() ([]domain.Project, error) {
 	ctx := context.Background()
@@ -218,6 +246,21 @@ func (c *APIClient) GetProject(projectID string) (*domain.Project, error) {
 	return &project, err
 }
 
+// GetWorkflow retrieves the status workflow of a project
+func (c *APIClient) GetWorkflow(projectID string) (*domain.Workflow, error) {
+	endpoint := fmt.Sprintf("/api/projects/%s/workflow", url.PathEscape(projectID))
+	ctx := context.Background()
+	//nolint:bodyclose // Response body is closed by handleResponse
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var workflow domain.Workflow
+	err = c.handleResponse(resp, &workflow)
+	return &workflow, err
+}
+
 // CreateProject creates a new project
 func (c *APIClient) CreateProject(req *CreateProjectRequest) (*domain.Project, error) {
 	ctx := context.Background()
@@ -297,6 +340,52 @@ type TaskListOptions struct {
 	Limit    int
 }
 
+// SearchTasks runs a ranked full-text search over a project's tasks and comments
+func (c *APIClient) SearchTasks(projectID string, options *SearchOptions) (*SearchResults, error) {
+	params := url.Values{}
+	params.Add("q", options.Query)
+	for _, docType := range options.Types {
+		params.Add("type", docType)
+	}
+	if options.Limit > 0 {
+		params.Add("limit", fmt.Sprintf("%d", options.Limit))
+	}
+	if options.Offset > 0 {
+		params.Add("offset", fmt.Sprintf("%d", options.Offset))
+	}
+
+	endpoint := fmt.Sprintf("/api/projects/%s/tasks/search?%s", url.PathEscape(projectID), params.Encode())
+	ctx := context.Background()
+	//nolint:bodyclose // Response body is closed by handleResponse
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var envelope struct {
+		Data SearchResults `json:"data"`
+	}
+	err = c.handleResponse(resp, &envelope)
+	return &envelope.Data, err
+}
+
+// SearchOptions represents options for searching tasks
+type SearchOptions struct {
+	Query  string
+	Types  []string
+	Limit  int
+	Offset int
+}
+
+// SearchResults represents one page of search hits
+type SearchResults struct {
+	Query   string                `json:"query"`
+	Results []domain.SearchResult `json:"results"`
+	Total   int                   `json:"total"`
+	Limit   int                   `json:"limit"`
+	Offset  int                   `json:"offset"`
+}
+
 // CreateTask creates a new task
 func (c *APIClient) CreateTask(projectID string, req *CreateTaskRequest) (*domain.Task, error) {
 	endpoint := fmt.Sprintf("/api/projects/%s/tasks", url.PathEscape(projectID))
@@ -358,6 +447,84 @@ func (c *APIClient) DeleteTask(projectID, taskID string) error {
 	return c.handleResponse(resp, nil)
 }
 
+// WatchTask makes the current user follow a task
+func (c *APIClient) WatchTask(projectID, taskID string) (*domain.Task, error) {
+	return c.changeWatching("POST", projectID, taskID)
+}
+
+// UnwatchTask stops the current user following a task
+func (c *APIClient) UnwatchTask(projectID, taskID string) (*domain.Task, error) {
+	return c.changeWatching("DELETE", projectID, taskID)
+}
+
+// changeWatching sends a watch or unwatch request and returns the updated task
+func (c *APIClient) changeWatching(method, projectID, taskID string) (*domain.Task, error) {
+	endpoint := fmt.Sprintf("/api/projects/%s/tasks/%s/watch", url.PathEscape(projectID), url.PathEscape(taskID))
+	ctx := context.Background()
+	//nolint:bodyclose // Response body is closed by handleResponse
+	resp, err := c.doRequest(ctx, method, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Data struct {
+			Task domain.Task `json:"task"`
+		} `json:"data"`
+	}
+	err = c.handleResponse(resp, &result)
+	return &result.Data.Task, err
+}
+
+// AttachFile uploads a local file as an attachment of a task
+func (c *APIClient) AttachFile(taskID, path string) (*domain.Attachment, error) {
+	file, err := os.Open(path) //nolint:gosec // Uploading the file the user named is the point
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", filepath.Base(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create upload: %w", err)
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to create upload: %w", err)
+	}
+
+	fullURL, err := c.endpointURL(fmt.Sprintf("/api/tasks/%s/attachments", url.PathEscape(taskID)))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), "POST", fullURL, &body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+
+	//nolint:bodyclose // Response body is closed by handleResponse
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	var result struct {
+		Data domain.Attachment `json:"data"`
+	}
+	err = c.handleResponse(resp, &result)
+	return &result.Data, err
+}
+
 // TestConnection tests the connection to the API
 func (c *APIClient) TestConnection() error {
 	return c.Health()
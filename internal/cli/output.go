@@ -4,12 +4,14 @@ import (
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"html"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
 	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
 	"gopkg.in/yaml.v3"
 )
 
@@ -71,6 +73,20 @@ func RenderTaskDetails(task interface{}, format string) error {
 	}
 }
 
+// RenderSearchResults renders search hits in the specified format
+func RenderSearchResults(results *SearchResults, format string) error {
+	switch strings.ToLower(format) {
+	case formatJSON:
+		return renderSearchResultsJSON(results)
+	case formatYAML, formatYML:
+		return renderSearchResultsYAML(results)
+	case "csv":
+		return renderSearchResultsCSV(results)
+	default:
+		return renderSearchResultsTable(results)
+	}
+}
+
 // Table rendering functions
 func renderProjectsTable(projects []domain.Project, defaultProjectID string) error {
 	t := table.NewWriter()
@@ -392,6 +408,89 @@ func renderTasksCSV(tasks []domain.Task) error {
 	return nil
 }
 
+// Search result rendering functions
+func renderSearchResultsTable(results *SearchResults) error {
+	t := table.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.AppendHeader(table.Row{"Type", "Task", "Title", "Match"})
+
+	for _, result := range results.Results {
+		t.AppendRow(table.Row{
+			string(result.Type),
+			result.TaskID,
+			highlightForTerminal(result.Title),
+			highlightForTerminal(result.Snippet),
+		})
+	}
+
+	t.SetStyle(table.StyleLight)
+	t.Render()
+
+	fmt.Printf("Showing %d-%d of %d results\n",
+		min(results.Offset+1, results.Total), results.Offset+len(results.Results), results.Total)
+	return nil
+}
+
+func renderSearchResultsJSON(results *SearchResults) error {
+	data, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s\n", data)
+	return nil
+}
+
+func renderSearchResultsYAML(results *SearchResults) error {
+	data, err := yaml.Marshal(results)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s", data)
+	return nil
+}
+
+func renderSearchResultsCSV(results *SearchResults) error {
+	writer := csv.NewWriter(os.Stdout)
+
+	if err := writer.Write([]string{"Type", "TaskID", "CommentID", "Title", "Snippet", "Score"}); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, result := range results.Results {
+		if err := writer.Write([]string{
+			string(result.Type),
+			result.TaskID,
+			result.CommentID,
+			stripHighlight(result.Title),
+			stripHighlight(result.Snippet),
+			fmt.Sprintf("%.4f", result.Score),
+		}); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to flush CSV writer: %w", err)
+	}
+
+	return nil
+}
+
+// highlightForTerminal renders the API's <mark> highlights as bold text
+func highlightForTerminal(s string) string {
+	s = strings.ReplaceAll(s, "<mark>", text.Bold.EscapeSeq())
+	s = strings.ReplaceAll(s, "</mark>", text.Reset.EscapeSeq())
+	return html.UnescapeString(s)
+}
+
+// stripHighlight turns highlighted HTML from the API back into plain text
+func stripHighlight(s string) string {
+	s = strings.ReplaceAll(s, "<mark>", "")
+	s = strings.ReplaceAll(s, "</mark>", "")
+	return html.UnescapeString(s)
+}
+
 // Utility functions
 
 // Success prints a success message with a checkmark
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	taskCmd.AddCommand(taskSearchCmd)
+
+	// Task search flags
+	taskSearchCmd.Flags().StringSliceP("type", "T", nil, "Limit results to a type (task, comment)")
+	taskSearchCmd.Flags().IntP("limit", "l", 0, "Limit number of results")
+	taskSearchCmd.Flags().Int("offset", 0, "Skip the first results")
+	taskSearchCmd.Flags().StringP("project", "", "", "Project ID (overrides default)")
+}
+
+var taskSearchCmd = &cobra.Command{
+	Use:   "search [query]",
+	Short: "Search tasks and comments",
+	Long: `Search task titles, descriptions, tags, custom fields and comments, best match first.
+
+Terms must all match. Quote a "phrase", end a term with * to match by prefix,
+and scope a term with title:, tag: or assignee: (username, email or user ID).
+
+Examples:
+  set-cli task search 'login crash*'
+  set-cli task search 'title:"sign in" tag:bug'
+  set-cli task search 'assignee:alice deploy' --type task`,
+	Aliases: []string{"find"},
+	Args:    cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		profile, err := GetCurrentProfile()
+		if err != nil {
+			return fmt.Errorf("not authenticated: %w", err)
+		}
+
+		projectID, _ := cmd.Flags().GetString("project")
+		if projectID == "" {
+			projectID = profile.ProjectID
+			if projectID == "" {
+				return fmt.Errorf("no project specified and no default project set")
+			}
+		}
+
+		options := &SearchOptions{Query: strings.Join(args, " ")}
+		options.Types, _ = cmd.Flags().GetStringSlice("type")
+		options.Limit, _ = cmd.Flags().GetInt("limit")
+		options.Offset, _ = cmd.Flags().GetInt("offset")
+
+		client := NewAPIClientFromProfile(profile)
+		results, err := client.SearchTasks(projectID, options)
+		if err != nil {
+			return fmt.Errorf("failed to search tasks: %w", err)
+		}
+
+		if len(results.Results) == 0 {
+			fmt.Println("No results found")
+			return nil
+		}
+
+		return RenderSearchResults(results, outputFormat)
+	},
+}
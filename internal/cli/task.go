@@ -20,6 +20,9 @@ func init() {
 	taskCmd.AddCommand(taskCloseCmd)
 	taskCmd.AddCommand(taskReopenCmd)
 	taskCmd.AddCommand(taskDeleteCmd)
+	taskCmd.AddCommand(taskWatchCmd)
+	taskCmd.AddCommand(taskUnwatchCmd)
+	taskCmd.AddCommand(taskAttachCmd)
 
 	// Task list flags
 	taskListCmd.Flags().StringSliceP("status", "s", nil, "Filter by status (todo, developing, review, complete)")
@@ -50,6 +53,10 @@ func init() {
 	taskCloseCmd.Flags().StringP("project", "", "", "Project ID (overrides default)")
 	taskReopenCmd.Flags().StringP("project", "", "", "Project ID (overrides default)")
 	taskDeleteCmd.Flags().StringP("project", "", "", "Project ID (overrides default)")
+
+	// Task watch/unwatch flags
+	taskWatchCmd.Flags().StringP("project", "", "", "Project ID (overrides default)")
+	taskUnwatchCmd.Flags().StringP("project", "", "", "Project ID (overrides default)")
 }
 
 var taskCmd = &cobra.Command{
@@ -209,8 +216,9 @@ var taskEditCmd = &cobra.Command{
 var taskMoveCmd = &cobra.Command{
 	Use:   "move [task-id] [status]",
 	Short: "Move task to a different status",
-	Long:  `Move a task to a different status column (todo, developing, review, complete).`,
-	Args:  cobra.ExactArgs(2),
+	Long: `Move a task to a different status column. The statuses come from the project's
+workflow; by default they are backlog, todo, developing, review and complete.`,
+	Args: cobra.ExactArgs(2),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		profile, err := GetCurrentProfile()
 		if err != nil {
@@ -228,16 +236,18 @@ var taskMoveCmd = &cobra.Command{
 		taskID := args[0]
 		newStatus := args[1]
 
-		// Validate status
-		validStatuses := []string{"todo", "developing", "review", "complete"}
-		isValid := false
-		for _, status := range validStatuses {
-			if status == newStatus {
-				isValid = true
-				break
-			}
+		client := NewAPIClientFromProfile(profile)
+
+		// Validate status against the project's workflow
+		workflow, err := client.GetWorkflow(projectID)
+		if err != nil {
+			return fmt.Errorf("failed to get project workflow: %w", err)
 		}
-		if !isValid {
+		if !workflow.HasStatus(domain.TaskStatus(newStatus)) {
+			validStatuses := make([]string, 0, len(workflow.Statuses))
+			for _, status := range workflow.StatusIDs() {
+				validStatuses = append(validStatuses, string(status))
+			}
 			return fmt.Errorf("invalid status '%s'. Valid statuses: %s", newStatus, strings.Join(validStatuses, ", "))
 		}
 
@@ -245,7 +255,6 @@ var taskMoveCmd = &cobra.Command{
 			Status: &newStatus,
 		}
 
-		client := NewAPIClientFromProfile(profile)
 		task, err := client.UpdateTask(projectID, taskID, req)
 		if err != nil {
 			return fmt.Errorf("failed to move task: %w", err)
@@ -329,13 +338,19 @@ var taskCloseCmd = &cobra.Command{
 		}
 
 		taskID := args[0]
-		status := "complete"
+
+		// Closing moves the task to the first done status of the project's workflow
+		client := NewAPIClientFromProfile(profile)
+		workflow, err := client.GetWorkflow(projectID)
+		if err != nil {
+			return fmt.Errorf("failed to get project workflow: %w", err)
+		}
+		status := string(workflow.DoneStatus())
 
 		req := &UpdateTaskRequest{
 			Status: &status,
 		}
 
-		client := NewAPIClientFromProfile(profile)
 		task, err := client.UpdateTask(projectID, taskID, req)
 		if err != nil {
 			return fmt.Errorf("failed to close task: %w", err)
@@ -349,7 +364,7 @@ var taskCloseCmd = &cobra.Command{
 var taskReopenCmd = &cobra.Command{
 	Use:   "reopen [task-id]",
 	Short: "Reopen a completed task",
-	Long:  `Reopen a completed task by moving it back to todo status.`,
+	Long:  `Reopen a completed task by moving it back to the initial status of the project's workflow.`,
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		profile, err := GetCurrentProfile()
@@ -366,13 +381,18 @@ var taskReopenCmd = &cobra.Command{
 		}
 
 		taskID := args[0]
-		status := "todo"
+
+		client := NewAPIClientFromProfile(profile)
+		workflow, err := client.GetWorkflow(projectID)
+		if err != nil {
+			return fmt.Errorf("failed to get project workflow: %w", err)
+		}
+		status := string(workflow.Initial())
 
 		req := &UpdateTaskRequest{
 			Status: &status,
 		}
 
-		client := NewAPIClientFromProfile(profile)
 		task, err := client.UpdateTask(projectID, taskID, req)
 		if err != nil {
 			return fmt.Errorf("failed to reopen task: %w", err)
@@ -424,3 +444,89 @@ var taskDeleteCmd = &cobra.Command{
 		return nil
 	},
 }
+
+var taskWatchCmd = &cobra.Command{
+	Use:   "watch [task-id]",
+	Short: "Watch a task",
+	Long:  `Follow a task to be notified about its comments and status changes.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		profile, err := GetCurrentProfile()
+		if err != nil {
+			return fmt.Errorf("not authenticated: %w", err)
+		}
+
+		projectID, _ := cmd.Flags().GetString("project")
+		if projectID == "" {
+			projectID = profile.ProjectID
+			if projectID == "" {
+				return fmt.Errorf("no project specified and no default project set")
+			}
+		}
+
+		client := NewAPIClientFromProfile(profile)
+		task, err := client.WatchTask(projectID, args[0])
+		if err != nil {
+			return fmt.Errorf("failed to watch task: %w", err)
+		}
+
+		fmt.Printf("✓ Watching task '%s'\n", task.Title)
+		return nil
+	},
+}
+
+var taskUnwatchCmd = &cobra.Command{
+	Use:   "unwatch [task-id]",
+	Short: "Stop watching a task",
+	Long:  `Stop following a task.`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		profile, err := GetCurrentProfile()
+		if err != nil {
+			return fmt.Errorf("not authenticated: %w", err)
+		}
+
+		projectID, _ := cmd.Flags().GetString("project")
+		if projectID == "" {
+			projectID = profile.ProjectID
+			if projectID == "" {
+				return fmt.Errorf("no project specified and no default project set")
+			}
+		}
+
+		client := NewAPIClientFromProfile(profile)
+		task, err := client.UnwatchTask(projectID, args[0])
+		if err != nil {
+			return fmt.Errorf("failed to unwatch task: %w", err)
+		}
+
+		fmt.Printf("✓ Stopped watching task '%s'\n", task.Title)
+		return nil
+	},
+}
+
+var taskAttachCmd = &cobra.Command{
+	Use:   "attach [task-id] [file]",
+	Short: "Attach a file to a task",
+	Long: `Upload a file as an attachment of a task.
+
+Images, PDFs and text files up to 5 MB can be attached, at most 10 per task.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		profile, err := GetCurrentProfile()
+		if err != nil {
+			return fmt.Errorf("not authenticated: %w", err)
+		}
+
+		client := NewAPIClientFromProfile(profile)
+		attachment, err := client.AttachFile(args[0], args[1])
+		if err != nil {
+			return fmt.Errorf("failed to attach file: %w", err)
+		}
+
+		fmt.Printf("✓ Attached %s to task %s\n", attachment.Name, args[0])
+		fmt.Printf("  Download (valid until %s): %s\n",
+			attachment.ExpiresAt.Local().Format("15:04"), strings.TrimSuffix(profile.ServerURL, "/")+attachment.URL)
+		return nil
+	},
+}
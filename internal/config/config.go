@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"fmt"
+	"net/mail"
 	"net/url"
 	"os"
 	"strconv"
@@ -50,6 +51,7 @@ type SecurityConfig interface {
 	GetJWTExpiration() time.Duration
 	GetRefreshTokenExpiration() time.Duration
 	GetPasswordResetSecret() string
+	GetRequireMFA() bool
 }
 
 // RateLimitConfig interface for rate limiting configuration.
@@ -63,6 +65,18 @@ type RateLimitConfig interface {
 	GetRedisDB() int
 }
 
+// RealtimeConfig interface for realtime event configuration.
+// With cluster fan-out enabled, events are relayed between replicas over the Redis configured in RateLimitConfig.
+type RealtimeConfig interface {
+	GetRealtimeClusterEnabled() bool
+}
+
+// WebhookConfig interface for outbound webhook configuration.
+// Webhooks can't reach addresses inside the server's network unless private networks are allowed.
+type WebhookConfig interface {
+	GetWebhookAllowPrivateNetworks() bool
+}
+
 // GitHubConfig interface for GitHub integration configuration.
 type GitHubConfig interface {
 	GetGitHubClientID() string
@@ -71,6 +85,21 @@ type GitHubConfig interface {
 	GetGitHubWebhookSecret() string
 }
 
+// MailConfig interface for outgoing email configuration.
+// Without an SMTP host, emails are logged (recipient and subject only) instead of sent.
+type MailConfig interface {
+	GetSMTPHost() string
+	GetSMTPPort() int
+	GetSMTPUsername() string
+	GetSMTPPassword() string
+	GetSMTPStartTLS() bool
+	GetMailFrom() string
+	GetAppBaseURL() string
+	GetMailMaxAttempts() int
+	GetMailRetryInterval() time.Duration
+	GetMailPollInterval() time.Duration
+}
+
 // AppConfig implements all configuration interfaces.
 type AppConfig struct {
 	serverPort                 string
@@ -85,18 +114,31 @@ type AppConfig struct {
 	githubClientSecret         string
 	githubRedirectURL          string
 	githubWebhookSecret        string
+	smtpHost                   string
+	smtpUsername               string
+	smtpPassword               string
+	mailFrom                   string
+	appBaseURL                 string
 	readTimeout                time.Duration
 	writeTimeout               time.Duration
 	idleTimeout                time.Duration
 	connectionTimeout          time.Duration
 	jwtExpiration              time.Duration
 	refreshTokenExpiration     time.Duration
+	mailRetryInterval          time.Duration
+	mailPollInterval           time.Duration
 	maxConnections             int
 	rateLimitRequestsPerMinute int
 	rateLimitCacheCapacity     int
 	redisDB                    int
+	smtpPort                   int
+	mailMaxAttempts            int
 	rateLimitEnabled           bool
 	redisEnabled               bool
+	smtpStartTLS               bool
+	realtimeClusterEnabled     bool
+	requireMFA                 bool
+	webhookAllowPrivate        bool
 }
 
 // NewConfig creates a new configuration instance with default values
@@ -123,6 +165,7 @@ func NewConfig() *AppConfig {
 		connectionTimeout:          getEnvDuration("CONNECTION_TIMEOUT", "30s"),
 		jwtExpiration:              getEnvDuration("JWT_EXPIRATION", "24h"),
 		refreshTokenExpiration:     getEnvDuration("REFRESH_TOKEN_EXPIRATION", "168h"), // 7 days
+		requireMFA:                 getEnvBool("REQUIRE_MFA", false),
 		rateLimitEnabled:           getEnvBool("RATE_LIMIT_ENABLED", true),
 		rateLimitRequestsPerMinute: getEnvInt("RATE_LIMIT_REQUESTS_PER_MINUTE", 100),
 		rateLimitCacheCapacity:     getEnvInt("RATE_LIMIT_CACHE_CAPACITY", 10000),
@@ -130,6 +173,18 @@ func NewConfig() *AppConfig {
 		redisAddr:                  getEnvString("REDIS_ADDR", "localhost:6379"),
 		redisPassword:              getEnvString("REDIS_PASSWORD", ""),
 		redisDB:                    getEnvInt("REDIS_DB", 0),
+		realtimeClusterEnabled:     getEnvBool("REALTIME_CLUSTER_ENABLED", false),
+		webhookAllowPrivate:        getEnvBool("WEBHOOK_ALLOW_PRIVATE_NETWORKS", false),
+		smtpHost:                   getEnvString("SMTP_HOST", ""),
+		smtpPort:                   getEnvInt("SMTP_PORT", 587),
+		smtpUsername:               getEnvString("SMTP_USERNAME", ""),
+		smtpPassword:               getEnvString("SMTP_PASSWORD", ""),
+		smtpStartTLS:               getEnvBool("SMTP_STARTTLS", true),
+		mailFrom:                   getEnvString("MAIL_FROM", "Simple Easy Tasks <no-reply@localhost>"),
+		appBaseURL:                 getEnvString("APP_BASE_URL", "http://localhost:8080"),
+		mailMaxAttempts:            getEnvInt("MAIL_MAX_ATTEMPTS", 5),
+		mailRetryInterval:          getEnvDuration("MAIL_RETRY_INTERVAL", "1m"),
+		mailPollInterval:           getEnvDuration("MAIL_POLL_INTERVAL", "10s"),
 	}
 }
 
@@ -203,6 +258,11 @@ func (c *AppConfig) GetPasswordResetSecret() string {
 	return c.passwordResetSecret
 }
 
+// GetRequireMFA returns whether every user must use two-factor authentication.
+func (c *AppConfig) GetRequireMFA() bool {
+	return c.requireMFA
+}
+
 // GetRateLimitEnabled returns whether rate limiting is enabled.
 func (c *AppConfig) GetRateLimitEnabled() bool {
 	return c.rateLimitEnabled
@@ -238,6 +298,17 @@ func (c *AppConfig) GetRedisDB() int {
 	return c.redisDB
 }
 
+// GetRealtimeClusterEnabled returns whether realtime events are relayed between replicas through Redis.
+func (c *AppConfig) GetRealtimeClusterEnabled() bool {
+	return c.realtimeClusterEnabled
+}
+
+// GetWebhookAllowPrivateNetworks returns whether webhooks may be delivered to loopback, link-local and
+// private addresses.
+func (c *AppConfig) GetWebhookAllowPrivateNetworks() bool {
+	return c.webhookAllowPrivate
+}
+
 // GetGitHubClientID returns the GitHub OAuth client ID.
 func (c *AppConfig) GetGitHubClientID() string {
 	return c.githubClientID
@@ -258,6 +329,56 @@ func (c *AppConfig) GetGitHubWebhookSecret() string {
 	return c.githubWebhookSecret
 }
 
+// GetSMTPHost returns the SMTP server host; empty disables SMTP delivery.
+func (c *AppConfig) GetSMTPHost() string {
+	return c.smtpHost
+}
+
+// GetSMTPPort returns the SMTP server port.
+func (c *AppConfig) GetSMTPPort() int {
+	return c.smtpPort
+}
+
+// GetSMTPUsername returns the SMTP username; empty disables authentication.
+func (c *AppConfig) GetSMTPUsername() string {
+	return c.smtpUsername
+}
+
+// GetSMTPPassword returns the SMTP password.
+func (c *AppConfig) GetSMTPPassword() string {
+	return c.smtpPassword
+}
+
+// GetSMTPStartTLS returns whether the SMTP connection must be upgraded with STARTTLS.
+func (c *AppConfig) GetSMTPStartTLS() bool {
+	return c.smtpStartTLS
+}
+
+// GetMailFrom returns the sender address of outgoing email.
+func (c *AppConfig) GetMailFrom() string {
+	return c.mailFrom
+}
+
+// GetAppBaseURL returns the public URL that links in emails point to.
+func (c *AppConfig) GetAppBaseURL() string {
+	return c.appBaseURL
+}
+
+// GetMailMaxAttempts returns how many times an email is tried before it is given up.
+func (c *AppConfig) GetMailMaxAttempts() int {
+	return c.mailMaxAttempts
+}
+
+// GetMailRetryInterval returns the delay before the first retry of a failed email.
+func (c *AppConfig) GetMailRetryInterval() time.Duration {
+	return c.mailRetryInterval
+}
+
+// GetMailPollInterval returns how often the email outbox is checked for due emails.
+func (c *AppConfig) GetMailPollInterval() time.Duration {
+	return c.mailPollInterval
+}
+
 // Validate checks if the configuration is valid.
 func (c *AppConfig) Validate() error {
 	if err := c.validateBasicConfig(); err != nil {
@@ -275,6 +396,9 @@ func (c *AppConfig) Validate() error {
 	if err := c.validateRateLimitConfig(); err != nil {
 		return err
 	}
+	if err := c.validateMailConfig(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -331,6 +455,9 @@ func (c *AppConfig) validateRedisConfig() error {
 	if c.redisEnabled && c.redisAddr == "" {
 		return fmt.Errorf("redis address cannot be empty when Redis is enabled")
 	}
+	if c.realtimeClusterEnabled && !c.redisEnabled {
+		return fmt.Errorf("realtime cluster fan-out requires Redis - set REDIS_ENABLED=true")
+	}
 	return nil
 }
 
@@ -345,6 +472,31 @@ func (c *AppConfig) validateRateLimitConfig() error {
 	return nil
 }
 
+// validateMailConfig validates outgoing email configuration.
+func (c *AppConfig) validateMailConfig() error {
+	if _, err := mail.ParseAddress(c.mailFrom); err != nil {
+		return fmt.Errorf("MAIL_FROM must be a valid email address: %w", err)
+	}
+	u, err := url.Parse(c.appBaseURL)
+	if err != nil || !u.IsAbs() {
+		return fmt.Errorf("APP_BASE_URL must be a valid absolute URL")
+	}
+	if c.smtpHost != "" && (c.smtpPort <= 0 || c.smtpPort > 65535) {
+		return fmt.Errorf("SMTP_PORT must be between 1 and 65535")
+	}
+	if c.mailMaxAttempts <= 0 {
+		return fmt.Errorf("mail max attempts must be positive")
+	}
+	if c.mailRetryInterval <= 0 || c.mailPollInterval <= 0 {
+		return fmt.Errorf("mail retry and poll intervals must be positive")
+	}
+	// Password reset emails can't be delivered without SMTP
+	if c.IsProduction() && c.smtpHost == "" {
+		return fmt.Errorf("SMTP_HOST is required in production")
+	}
+	return nil
+}
+
 // validateGitHubConfig validates GitHub integration configuration.
 func (c *AppConfig) validateGitHubConfig() error {
 	// Allow empty in non-prod to ease local dev.
@@ -4,6 +4,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestGetJWTSecret_Production_RequiresEnvVar(t *testing.T) {
@@ -116,3 +117,66 @@ func TestGenerateSecureJWTSecret(t *testing.T) {
 		t.Error("Generated secret should not match any default secret")
 	}
 }
+
+func TestConfig_ValidateMailConfig(t *testing.T) {
+	valid := func() *AppConfig {
+		return &AppConfig{
+			environment:       EnvDevelopment,
+			mailFrom:          "Simple Easy Tasks <no-reply@example.com>",
+			appBaseURL:        "https://tasks.example.com",
+			smtpPort:          587,
+			mailMaxAttempts:   5,
+			mailRetryInterval: time.Minute,
+			mailPollInterval:  10 * time.Second,
+		}
+	}
+
+	tests := []struct {
+		modify  func(c *AppConfig)
+		name    string
+		wantErr string
+	}{
+		{name: "log mailer in development", modify: func(_ *AppConfig) {}},
+		{name: "invalid sender", modify: func(c *AppConfig) { c.mailFrom = "no-reply" }, wantErr: "MAIL_FROM"},
+		{name: "relative base URL", modify: func(c *AppConfig) { c.appBaseURL = "/app" }, wantErr: "APP_BASE_URL"},
+		{
+			name:    "invalid port",
+			modify:  func(c *AppConfig) { c.smtpHost, c.smtpPort = "smtp.example.com", 0 },
+			wantErr: "SMTP_PORT",
+		},
+		{name: "no attempts", modify: func(c *AppConfig) { c.mailMaxAttempts = 0 }, wantErr: "attempts"},
+		{name: "SMTP required in production", modify: func(c *AppConfig) { c.environment = EnvProduction }, wantErr: "SMTP_HOST"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := valid()
+			tt.modify(config)
+
+			err := config.validateMailConfig()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Expected valid mail config, got: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error mentioning %s, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestConfig_ValidateRedisConfig_ClusterRequiresRedis(t *testing.T) {
+	config := &AppConfig{redisAddr: "localhost:6379", realtimeClusterEnabled: true}
+
+	err := config.validateRedisConfig()
+	if err == nil || !strings.Contains(err.Error(), "REDIS_ENABLED") {
+		t.Errorf("Expected cluster fan-out without Redis to be rejected, got: %v", err)
+	}
+
+	config.redisEnabled = true
+	if err := config.validateRedisConfig(); err != nil {
+		t.Errorf("Expected cluster fan-out with Redis to be valid, got: %v", err)
+	}
+}
@@ -3,6 +3,7 @@ package container
 import (
 	"context"
 	"fmt"
+	"log/slog"
 
 	"github.com/pocketbase/pocketbase/core"
 
@@ -14,13 +15,29 @@ import (
 
 // ServiceNames contains constants for service names used in DI container
 const (
-	ConfigService                       = "config"
-	UserRepositoryService               = "user_repository"
-	ProjectRepositoryService            = "project_repository"
-	TaskRepositoryService               = "task_repository"
-	CommentRepositoryService            = "comment_repository"
-	TokenBlacklistRepositoryService     = "token_blacklist_repository"
-	PasswordResetTokenRepositoryService = "password_reset_token_repository"
+	ConfigService                        = "config"
+	UserRepositoryService                = "user_repository"
+	ProjectRepositoryService             = "project_repository"
+	TaskRepositoryService                = "task_repository"
+	CommentRepositoryService             = "comment_repository"
+	TaskHistoryRepositoryService         = "task_history_repository"
+	WIPLimitRepositoryService            = "wip_limit_repository"
+	SearchRepositoryService              = "search_repository"
+	TokenBlacklistRepositoryService      = "token_blacklist_repository"
+	PasswordResetTokenRepositoryService  = "password_reset_token_repository"
+	EmailOutboxRepositoryService         = "email_outbox_repository"
+	EventLogRepositoryService            = "event_log_repository"
+	WebhookRepositoryService             = "webhook_repository"
+	WebhookDeliveryRepositoryService     = "webhook_delivery_repository"
+	NotificationRepositoryService        = "notification_repository"
+	CommentMentionRepositoryService      = "comment_mention_repository"
+	CommentReactionRepositoryService     = "comment_reaction_repository"
+	AttachmentRepositoryService          = "attachment_repository"
+	TaskSeriesRepositoryService          = "task_series_repository"
+	ProjectInvitationRepositoryService   = "project_invitation_repository"
+	PersonalAccessTokenRepositoryService = "personal_access_token_repository"
+	UserMFARepositoryService             = "user_mfa_repository"
+	SessionRepositoryService             = "session_repository"
 	// GitHub repositories
 	GitHubIntegrationRepositoryService  = "github_integration_repository"
 	GitHubOAuthStateRepositoryService   = "github_oauth_state_repository"
@@ -30,12 +47,31 @@ const (
 	GitHubPRMappingRepositoryService    = "github_pr_mapping_repository"
 	GitHubWebhookEventRepositoryService = "github_webhook_event_repository"
 	// Services
-	AuthService    = "auth_service"
-	UserService    = "user_service"
-	ProjectService = "project_service"
-	TaskService    = "task_service"
-	CommentService = "comment_service"
-	HealthService  = "health_service"
+	AuthService                = "auth_service"
+	UserService                = "user_service"
+	AuthorizationService       = "authorization_service"
+	ProjectService             = "project_service"
+	InvitationService          = "invitation_service"
+	PersonalAccessTokenService = "personal_access_token_service"
+	MFAService                 = "mfa_service"
+	SessionService             = "session_service"
+	TaskService                = "task_service"
+	CommentService             = "comment_service"
+	AttachmentService          = "attachment_service"
+	RecurrenceService          = "recurrence_service"
+	WIPManager                 = "wip_manager"
+	KanbanService              = "kanban_service"
+	BulkOperationService       = "bulk_operation_service"
+	SearchService              = "search_service"
+	CriticalPathService        = "critical_path_service"
+	WorkflowService            = "workflow_service"
+	EmailOutbox                = "email_outbox"
+	EventBroadcaster           = "event_broadcaster"
+	EventLog                   = "event_log"
+	WebhookService             = "webhook_service"
+	NotificationService        = "notification_service"
+	HealthService              = "health_service"
+	CacheManager               = "cache_manager"
 	// GitHub services
 	GitHubOAuthService   = "github_oauth_service"
 	GitHubService        = "github_service"
@@ -151,6 +187,11 @@ func RegisterServices(container Container, cfg config.Config, app core.App) erro
 		return fmt.Errorf("failed to register config service: %w", err)
 	}
 
+	// Register cache manager
+	if err := registerCacheManager(container, cfg); err != nil {
+		return fmt.Errorf("failed to register cache manager: %w", err)
+	}
+
 	// Register repositories
 	if err := registerRepositories(container, app); err != nil {
 		return fmt.Errorf("failed to register repositories: %w", err)
@@ -164,6 +205,29 @@ func RegisterServices(container Container, cfg config.Config, app core.App) erro
 	return nil
 }
 
+// registerCacheManager registers the cache manager. Redis is used when configured so
+// that every replica sees the same cached boards and invalidations; otherwise, or when
+// Redis is unreachable, entries live in process memory.
+func registerCacheManager(container Container, cfg config.Config) error {
+	return container.RegisterSingleton(CacheManager, func(ctx context.Context, _ Container) (interface{}, error) {
+		var backend services.CacheBackend = services.NewMemoryCacheBackend("set:")
+
+		redisCfg, ok := cfg.(config.RateLimitConfig)
+		if ok && redisCfg.GetRedisEnabled() && redisCfg.GetRedisAddr() != "" {
+			redisBackend := services.NewRedisCacheBackend(
+				redisCfg.GetRedisAddr(), redisCfg.GetRedisPassword(), redisCfg.GetRedisDB(), "set:")
+			if err := redisBackend.Ping(ctx); err != nil {
+				slog.Warn("Failed to connect to Redis, falling back to in-memory cache", "error", err)
+				_ = redisBackend.Close()
+			} else {
+				backend = redisBackend
+			}
+		}
+
+		return services.NewCacheManager(backend, services.DefaultCacheConfig()), nil
+	})
+}
+
 // registerRepositories registers all repository implementations
 func registerRepositories(container Container, app core.App) error {
 	// User Repository
@@ -182,9 +246,14 @@ func registerRepositories(container Container, app core.App) error {
 		return fmt.Errorf("failed to register project repository: %w", err)
 	}
 
-	// Task Repository
-	err = container.RegisterSingleton(TaskRepositoryService, func(_ context.Context, _ Container) (interface{}, error) {
-		return repository.NewPocketBaseTaskRepository(app), nil
+	// Task Repository, wrapped so every task write invalidates the cached board
+	err = container.RegisterSingleton(TaskRepositoryService, func(ctx context.Context, c Container) (interface{}, error) {
+		cacheManager, cacheErr := resolveAndCast[services.CacheManager](ctx, c, CacheManager, "cache manager")
+		if cacheErr != nil {
+			return nil, cacheErr
+		}
+		return services.NewCacheInvalidatingTaskRepository(
+			repository.NewPocketBaseTaskRepository(app), cacheManager), nil
 	})
 	if err != nil {
 		return fmt.Errorf("failed to register task repository: %w", err)
@@ -198,6 +267,36 @@ func registerRepositories(container Container, app core.App) error {
 		return fmt.Errorf("failed to register comment repository: %w", err)
 	}
 
+	// Task History Repository
+	err = container.RegisterSingleton(
+		TaskHistoryRepositoryService,
+		func(_ context.Context, _ Container) (interface{}, error) {
+			return repository.NewPocketBaseTaskHistoryRepository(app), nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed to register task history repository: %w", err)
+	}
+
+	// WIP Limit Repository
+	err = container.RegisterSingleton(
+		WIPLimitRepositoryService,
+		func(_ context.Context, _ Container) (interface{}, error) {
+			return repository.NewPocketBaseWIPLimitRepository(app), nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed to register WIP limit repository: %w", err)
+	}
+
+	// Search Repository
+	err = container.RegisterSingleton(
+		SearchRepositoryService,
+		func(_ context.Context, _ Container) (interface{}, error) {
+			return repository.NewPocketBaseSearchRepository(app), nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed to register search repository: %w", err)
+	}
+
 	// Token Blacklist Repository
 	err = container.RegisterSingleton(
 		TokenBlacklistRepositoryService,
@@ -223,6 +322,143 @@ func registerRepositories(container Container, app core.App) error {
 		return fmt.Errorf("failed to register password reset token repository: %w", err)
 	}
 
+	// Email Outbox Repository
+	err = container.RegisterSingleton(
+		EmailOutboxRepositoryService,
+		func(_ context.Context, _ Container) (interface{}, error) {
+			return repository.NewPocketBaseEmailOutboxRepository(app), nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed to register email outbox repository: %w", err)
+	}
+
+	// Event Log Repository
+	err = container.RegisterSingleton(
+		EventLogRepositoryService,
+		func(_ context.Context, _ Container) (interface{}, error) {
+			return repository.NewPocketBaseEventLogRepository(app), nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed to register event log repository: %w", err)
+	}
+
+	// Webhook Repositories
+	err = container.RegisterSingleton(
+		WebhookRepositoryService,
+		func(_ context.Context, _ Container) (interface{}, error) {
+			return repository.NewPocketBaseWebhookRepository(app), nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed to register webhook repository: %w", err)
+	}
+
+	err = container.RegisterSingleton(
+		WebhookDeliveryRepositoryService,
+		func(_ context.Context, _ Container) (interface{}, error) {
+			return repository.NewPocketBaseWebhookDeliveryRepository(app), nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed to register webhook delivery repository: %w", err)
+	}
+
+	// Notification Repository
+	err = container.RegisterSingleton(
+		NotificationRepositoryService,
+		func(_ context.Context, _ Container) (interface{}, error) {
+			return repository.NewPocketBaseNotificationRepository(app), nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed to register notification repository: %w", err)
+	}
+
+	// Comment Mention Repository
+	err = container.RegisterSingleton(
+		CommentMentionRepositoryService,
+		func(_ context.Context, _ Container) (interface{}, error) {
+			return repository.NewPocketBaseCommentMentionRepository(app), nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed to register comment mention repository: %w", err)
+	}
+
+	// Comment Reaction Repository
+	err = container.RegisterSingleton(
+		CommentReactionRepositoryService,
+		func(_ context.Context, _ Container) (interface{}, error) {
+			return repository.NewPocketBaseCommentReactionRepository(app), nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed to register comment reaction repository: %w", err)
+	}
+
+	// Attachment Repository
+	err = container.RegisterSingleton(
+		AttachmentRepositoryService,
+		func(_ context.Context, _ Container) (interface{}, error) {
+			return repository.NewPocketBaseAttachmentRepository(app), nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed to register attachment repository: %w", err)
+	}
+
+	// Task Series Repository
+	err = container.RegisterSingleton(
+		TaskSeriesRepositoryService,
+		func(_ context.Context, _ Container) (interface{}, error) {
+			return repository.NewPocketBaseTaskSeriesRepository(app), nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed to register task series repository: %w", err)
+	}
+
+	// Project Invitation Repository, hashing tokens like the password reset token repository
+	err = container.RegisterSingleton(
+		ProjectInvitationRepositoryService,
+		func(ctx context.Context, c Container) (interface{}, error) {
+			cfgService, cfgErr := resolveAndCast[config.SecurityConfig](ctx, c, ConfigService, "config service")
+			if cfgErr != nil {
+				return nil, cfgErr
+			}
+			return repository.NewPocketBaseProjectInvitationRepository(app, cfgService.GetPasswordResetSecret()), nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed to register project invitation repository: %w", err)
+	}
+
+	// Personal Access Token Repository, hashing tokens the same way
+	err = container.RegisterSingleton(
+		PersonalAccessTokenRepositoryService,
+		func(ctx context.Context, c Container) (interface{}, error) {
+			cfgService, cfgErr := resolveAndCast[config.SecurityConfig](ctx, c, ConfigService, "config service")
+			if cfgErr != nil {
+				return nil, cfgErr
+			}
+			return repository.NewPocketBasePersonalAccessTokenRepository(app, cfgService.GetPasswordResetSecret()), nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed to register personal access token repository: %w", err)
+	}
+
+	// User MFA Repository
+	err = container.RegisterSingleton(
+		UserMFARepositoryService,
+		func(_ context.Context, _ Container) (interface{}, error) {
+			return repository.NewPocketBaseUserMFARepository(app), nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed to register user MFA repository: %w", err)
+	}
+
+	// Session Repository
+	err = container.RegisterSingleton(
+		SessionRepositoryService,
+		func(_ context.Context, _ Container) (interface{}, error) {
+			return repository.NewPocketBaseSessionRepository(app), nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed to register session repository: %w", err)
+	}
+
 	// GitHub repositories
 	if err := registerGitHubRepositories(container, app); err != nil {
 		return fmt.Errorf("failed to register GitHub repositories: %w", err)
@@ -275,12 +511,32 @@ func registerAuthService(container Container) error {
 			return nil, fmt.Errorf("failed to cast config to security config type")
 		}
 
-		return services.NewAuthService(
+		outbox, err := resolveAndCast[services.EmailOutbox](ctx, c, EmailOutbox, "email outbox")
+		if err != nil {
+			return nil, err
+		}
+
+		mfa, err := resolveAndCast[services.MFAService](ctx, c, MFAService, "MFA service")
+		if err != nil {
+			return nil, err
+		}
+
+		sessions, err := resolveAndCast[repository.SessionRepository](
+			ctx, c, SessionRepositoryService, "session repository")
+		if err != nil {
+			return nil, err
+		}
+
+		authService := services.NewAuthService(
 			userRepoTyped,
 			blacklistRepoTyped,
 			resetTokenRepoTyped,
 			cfgTyped,
-		), nil
+			outbox,
+		)
+		authService.SetMFA(mfa)
+		authService.SetSessions(sessions)
+		return authService, nil
 	})
 	if err != nil {
 		return fmt.Errorf("failed to register auth service: %w", err)
@@ -288,6 +544,34 @@ func registerAuthService(container Container) error {
 	return nil
 }
 
+// registerMFAService registers the two-factor authentication service
+func registerMFAService(container Container) error {
+	err := container.RegisterSingleton(MFAService, func(ctx context.Context, c Container) (interface{}, error) {
+		mfaRepo, err := resolveAndCast[repository.UserMFARepository](
+			ctx, c, UserMFARepositoryService, "user MFA repository")
+		if err != nil {
+			return nil, err
+		}
+
+		userRepo, err := resolveAndCast[repository.UserRepository](ctx, c, UserRepositoryService, "user repository")
+		if err != nil {
+			return nil, err
+		}
+
+		cfg, err := resolveAndCast[config.SecurityConfig](ctx, c, ConfigService, "config service")
+		if err != nil {
+			return nil, err
+		}
+
+		return services.NewMFAService(mfaRepo, userRepo, cfg), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register MFA service: %w", err)
+	}
+
+	return nil
+}
+
 // registerUserService registers the user service
 func registerUserService(container Container) error {
 	// User Service
@@ -306,6 +590,31 @@ func registerUserService(container Container) error {
 	return nil
 }
 
+// registerAuthorizationService registers the project role and permission checks shared by the services
+func registerAuthorizationService(container Container) error {
+	err := container.RegisterSingleton(AuthorizationService, func(ctx context.Context, c Container) (interface{}, error) {
+		projectRepo, err := resolveAndCast[repository.ProjectRepository](
+			ctx, c, ProjectRepositoryService, "project repository")
+		if err != nil {
+			return nil, err
+		}
+
+		mfa, err := resolveAndCast[services.MFAService](ctx, c, MFAService, "MFA service")
+		if err != nil {
+			return nil, err
+		}
+
+		authz := services.NewAuthorizationService(projectRepo)
+		authz.SetMFAChecker(mfa)
+		return authz, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register authorization service: %w", err)
+	}
+
+	return nil
+}
+
 // registerProjectService registers the project service
 func registerProjectService(container Container) error {
 	// Project Service
@@ -315,7 +624,13 @@ func registerProjectService(container Container) error {
 			return nil, err
 		}
 
-		return services.NewProjectService(projectRepo, userRepo), nil
+		authz, err := resolveAndCast[services.AuthorizationService](
+			ctx, c, AuthorizationService, "authorization service")
+		if err != nil {
+			return nil, err
+		}
+
+		return services.NewProjectService(projectRepo, userRepo, authz), nil
 	})
 	if err != nil {
 		return fmt.Errorf("failed to register project service: %w", err)
@@ -324,96 +639,713 @@ func registerProjectService(container Container) error {
 	return nil
 }
 
-// registerTaskService registers the task service
-func registerTaskService(container Container) error {
-	// Task Service
-	err := container.RegisterSingleton(TaskService, func(ctx context.Context, c Container) (interface{}, error) {
-		taskRepo, projectRepo, userRepo, err := resolveCommonRepositories(ctx, c)
+// registerInvitationService registers the project invitation service
+func registerInvitationService(container Container) error {
+	err := container.RegisterSingleton(InvitationService, func(ctx context.Context, c Container) (interface{}, error) {
+		invitationRepo, err := resolveAndCast[repository.ProjectInvitationRepository](
+			ctx, c, ProjectInvitationRepositoryService, "project invitation repository")
+		if err != nil {
+			return nil, err
+		}
+
+		projectRepo, userRepo, err := resolveProjectAndUserRepos(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		authService, err := resolveAndCast[services.AuthService](ctx, c, AuthService, "auth service")
+		if err != nil {
+			return nil, err
+		}
+
+		authz, err := resolveAndCast[services.AuthorizationService](
+			ctx, c, AuthorizationService, "authorization service")
+		if err != nil {
+			return nil, err
+		}
+
+		outbox, err := resolveAndCast[services.EmailOutbox](ctx, c, EmailOutbox, "email outbox")
 		if err != nil {
 			return nil, err
 		}
 
-		return services.NewTaskService(taskRepo, projectRepo, userRepo), nil
+		return services.NewInvitationService(invitationRepo, projectRepo, userRepo, authService, authz, outbox), nil
 	})
 	if err != nil {
-		return fmt.Errorf("failed to register task service: %w", err)
+		return fmt.Errorf("failed to register invitation service: %w", err)
 	}
 
 	return nil
 }
 
-// registerCommentService registers the comment service
-func registerCommentService(container Container) error {
-	// Comment Service
-	err := container.RegisterSingleton(CommentService, func(ctx context.Context, c Container) (interface{}, error) {
-		commentRepo, commentErr := c.ResolveWithContext(ctx, CommentRepositoryService)
-		if commentErr != nil {
-			return nil, fmt.Errorf("failed to resolve comment repository: %w", commentErr)
-		}
+// registerPersonalAccessTokenService registers the personal access token service
+func registerPersonalAccessTokenService(container Container) error {
+	err := container.RegisterSingleton(
+		PersonalAccessTokenService,
+		func(ctx context.Context, c Container) (interface{}, error) {
+			tokenRepo, err := resolveAndCast[repository.PersonalAccessTokenRepository](
+				ctx, c, PersonalAccessTokenRepositoryService, "personal access token repository")
+			if err != nil {
+				return nil, err
+			}
 
-		taskRepo, _, userRepo, repoErr := resolveCommonRepositories(ctx, c)
-		if repoErr != nil {
-			return nil, repoErr
+			userRepo, err := resolveAndCast[repository.UserRepository](ctx, c, UserRepositoryService, "user repository")
+			if err != nil {
+				return nil, err
+			}
+
+			return services.NewPersonalAccessTokenService(tokenRepo, userRepo), nil
+		})
+	if err != nil {
+		return fmt.Errorf("failed to register personal access token service: %w", err)
+	}
+
+	return nil
+}
+
+// registerSessionService registers the session service
+func registerSessionService(container Container) error {
+	err := container.RegisterSingleton(SessionService, func(ctx context.Context, c Container) (interface{}, error) {
+		sessionRepo, err := resolveAndCast[repository.SessionRepository](
+			ctx, c, SessionRepositoryService, "session repository")
+		if err != nil {
+			return nil, err
 		}
 
-		commentRepoTyped, ok := commentRepo.(repository.CommentRepository)
-		if !ok {
-			return nil, fmt.Errorf("failed to cast comment repository to correct type")
+		userRepo, err := resolveAndCast[repository.UserRepository](ctx, c, UserRepositoryService, "user repository")
+		if err != nil {
+			return nil, err
 		}
 
-		return services.NewCommentService(
-			commentRepoTyped,
-			taskRepo,
-			userRepo,
-		), nil
+		return services.NewSessionService(sessionRepo, userRepo), nil
 	})
 	if err != nil {
-		return fmt.Errorf("failed to register comment service: %w", err)
+		return fmt.Errorf("failed to register session service: %w", err)
 	}
 
 	return nil
 }
 
-// registerHealthService registers the health service
-func registerHealthService(container Container) error {
-	// Health Service
-	err := container.RegisterSingleton(HealthService, func(ctx context.Context, c Container) (interface{}, error) {
-		cfg, cfgErr := c.ResolveWithContext(ctx, ConfigService)
-		if cfgErr != nil {
-			return nil, fmt.Errorf("failed to resolve config: %w", cfgErr)
+// registerTaskService registers the task service
+func registerTaskService(container Container) error {
+	// Task Service
+	err := container.RegisterSingleton(TaskService, func(ctx context.Context, c Container) (interface{}, error) {
+		taskRepo, projectRepo, userRepo, err := resolveCommonRepositories(ctx, c)
+		if err != nil {
+			return nil, err
 		}
 
-		cfgTyped, ok := cfg.(config.Config)
-		if !ok {
-			return nil, fmt.Errorf("failed to cast config to correct type")
+		historyRepo, err := resolveAndCast[repository.TaskHistoryRepository](
+			ctx, c, TaskHistoryRepositoryService, "task history repository")
+		if err != nil {
+			return nil, err
 		}
 
-		return services.NewHealthService(cfgTyped), nil
+		wipManager, err := resolveAndCast[services.WIPManager](ctx, c, WIPManager, "WIP manager")
+		if err != nil {
+			return nil, err
+		}
+
+		attachmentRepo, err := resolveAndCast[repository.AttachmentRepository](
+			ctx, c, AttachmentRepositoryService, "attachment repository")
+		if err != nil {
+			return nil, err
+		}
+
+		authz, err := resolveAndCast[services.AuthorizationService](
+			ctx, c, AuthorizationService, "authorization service")
+		if err != nil {
+			return nil, err
+		}
+
+		return services.NewTaskService(
+			taskRepo, projectRepo, userRepo, historyRepo, authz, wipManager, attachmentRepo,
+		), nil
 	})
 	if err != nil {
-		return fmt.Errorf("failed to register health service: %w", err)
+		return fmt.Errorf("failed to register task service: %w", err)
 	}
 
 	return nil
 }
 
-// registerBusinessServices registers all business logic services
-func registerBusinessServices(container Container) error {
-	if err := registerAuthService(container); err != nil {
-		return err
+// registerWIPManager registers the WIP limit manager
+func registerWIPManager(container Container) error {
+	err := container.RegisterSingleton(WIPManager, func(ctx context.Context, c Container) (interface{}, error) {
+		taskRepo, projectRepo, _, err := resolveCommonRepositories(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		wipLimitRepo, err := resolveAndCast[repository.WIPLimitRepository](
+			ctx, c, WIPLimitRepositoryService, "WIP limit repository")
+		if err != nil {
+			return nil, err
+		}
+
+		return services.NewWIPManager(taskRepo, projectRepo, wipLimitRepo), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register WIP manager: %w", err)
+	}
+
+	return nil
+}
+
+// registerKanbanService registers the kanban board service
+func registerKanbanService(container Container) error {
+	err := container.RegisterSingleton(KanbanService, func(ctx context.Context, c Container) (interface{}, error) {
+		taskRepo, projectRepo, _, err := resolveCommonRepositories(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		taskService, err := resolveAndCast[services.TaskService](ctx, c, TaskService, "task service")
+		if err != nil {
+			return nil, err
+		}
+
+		wipManager, err := resolveAndCast[services.WIPManager](ctx, c, WIPManager, "WIP manager")
+		if err != nil {
+			return nil, err
+		}
+
+		cacheManager, err := resolveAndCast[services.CacheManager](ctx, c, CacheManager, "cache manager")
+		if err != nil {
+			return nil, err
+		}
+
+		authz, err := resolveAndCast[services.AuthorizationService](
+			ctx, c, AuthorizationService, "authorization service")
+		if err != nil {
+			return nil, err
+		}
+
+		return services.NewKanbanService(taskRepo, projectRepo, taskService, wipManager, cacheManager, authz), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register kanban service: %w", err)
+	}
+
+	return nil
+}
+
+// registerEventBroadcaster registers the realtime event broadcaster and the event log it sequences events in.
+// With cluster fan-out enabled, events are relayed between replicas through Redis pub/sub.
+// Every event broadcast is also queued for the project's webhooks and notifies the users it concerns,
+// and completing the latest occurrence of a recurring task creates the next one.
+func registerEventBroadcaster(container Container) error {
+	err := container.RegisterSingleton(EventLog, func(ctx context.Context, c Container) (interface{}, error) {
+		eventLogRepo, err := resolveAndCast[repository.EventLogRepository](
+			ctx, c, EventLogRepositoryService, "event log repository")
+		if err != nil {
+			return nil, err
+		}
+
+		return services.NewEventLog(eventLogRepo, services.DefaultEventLogConfig()), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register event log: %w", err)
+	}
+
+	err = container.RegisterSingleton(EventBroadcaster, func(ctx context.Context, c Container) (interface{}, error) {
+		eventLog, err := resolveAndCast[services.EventLog](ctx, c, EventLog, "event log")
+		if err != nil {
+			return nil, err
+		}
+
+		cfg, err := resolveAndCast[config.Config](ctx, c, ConfigService, "config")
+		if err != nil {
+			return nil, err
+		}
+
+		webhookService, err := resolveAndCast[services.WebhookService](ctx, c, WebhookService, "webhook service")
+		if err != nil {
+			return nil, err
+		}
+
+		notificationService, err := resolveAndCast[services.NotificationService](
+			ctx, c, NotificationService, "notification service")
+		if err != nil {
+			return nil, err
+		}
+
+		recurrenceService, err := resolveAndCast[services.RecurrenceService](
+			ctx, c, RecurrenceService, "recurrence service")
+		if err != nil {
+			return nil, err
+		}
+
+		broadcaster := services.NewEventBroadcaster(nil, services.EventBroadcasterConfig{
+			EventLog: eventLog,
+			Cluster:  newClusterTransport(ctx, cfg),
+		})
+		decorated := services.NewRecurrenceEventBroadcaster(
+			services.NewNotificationEventBroadcaster(
+				services.NewWebhookEventBroadcaster(broadcaster, webhookService), notificationService),
+			recurrenceService)
+		recurrenceService.SetEventBroadcaster(decorated)
+		return decorated, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register event broadcaster: %w", err)
+	}
+
+	return nil
+}
+
+// newClusterTransport connects to Redis for cluster fan-out, or returns nil to run as a single node
+// when fan-out is disabled or Redis is unreachable
+func newClusterTransport(ctx context.Context, cfg config.Config) services.ClusterTransport {
+	realtimeCfg, ok := cfg.(config.RealtimeConfig)
+	if !ok || !realtimeCfg.GetRealtimeClusterEnabled() {
+		return nil
+	}
+
+	redisCfg, ok := cfg.(config.RateLimitConfig)
+	if !ok || !redisCfg.GetRedisEnabled() || redisCfg.GetRedisAddr() == "" {
+		return nil
+	}
+
+	transport := services.NewRedisClusterTransport(
+		redisCfg.GetRedisAddr(), redisCfg.GetRedisPassword(), redisCfg.GetRedisDB(), services.DefaultClusterChannel)
+	if err := transport.Ping(ctx); err != nil {
+		slog.Warn("Failed to connect to Redis, realtime events will only reach clients on this replica",
+			"error", err)
+		_ = transport.Close()
+		return nil
+	}
+
+	return transport
+}
+
+// registerWebhookService registers the outbound webhook service
+func registerWebhookService(container Container) error {
+	err := container.RegisterSingleton(WebhookService, func(ctx context.Context, c Container) (interface{}, error) {
+		webhookRepo, err := resolveAndCast[repository.WebhookRepository](
+			ctx, c, WebhookRepositoryService, "webhook repository")
+		if err != nil {
+			return nil, err
+		}
+
+		deliveryRepo, err := resolveAndCast[repository.WebhookDeliveryRepository](
+			ctx, c, WebhookDeliveryRepositoryService, "webhook delivery repository")
+		if err != nil {
+			return nil, err
+		}
+
+		authz, err := resolveAndCast[services.AuthorizationService](
+			ctx, c, AuthorizationService, "authorization service")
+		if err != nil {
+			return nil, err
+		}
+
+		webhookCfg := services.DefaultWebhookConfig()
+		if cfg, cfgErr := resolveAndCast[config.WebhookConfig](ctx, c, ConfigService, "config"); cfgErr == nil {
+			webhookCfg.AllowPrivateNetworks = cfg.GetWebhookAllowPrivateNetworks()
+		}
+
+		return services.NewWebhookService(webhookRepo, deliveryRepo, authz, webhookCfg), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register webhook service: %w", err)
+	}
+
+	return nil
+}
+
+// registerNotificationService registers the in-app notification service
+func registerNotificationService(container Container) error {
+	err := container.RegisterSingleton(NotificationService, func(ctx context.Context, c Container) (interface{}, error) {
+		notificationRepo, err := resolveAndCast[repository.NotificationRepository](
+			ctx, c, NotificationRepositoryService, "notification repository")
+		if err != nil {
+			return nil, err
+		}
+
+		projectRepo, userRepo, err := resolveProjectAndUserRepos(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		return services.NewNotificationService(notificationRepo, projectRepo, userRepo), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register notification service: %w", err)
+	}
+
+	return nil
+}
+
+// registerBulkOperationService registers the bulk task operation service
+func registerBulkOperationService(container Container) error {
+	err := container.RegisterSingleton(BulkOperationService, func(ctx context.Context, c Container) (interface{}, error) {
+		taskRepo, projectRepo, _, err := resolveCommonRepositories(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		// The plain task service: bulk calls publish one aggregated event themselves
+		taskService, err := resolveAndCast[services.TaskService](ctx, c, TaskService, "task service")
+		if err != nil {
+			return nil, err
+		}
+
+		broadcaster, err := resolveAndCast[services.EventBroadcaster](ctx, c, EventBroadcaster, "event broadcaster")
+		if err != nil {
+			return nil, err
+		}
+
+		return services.NewBulkOperationService(taskRepo, projectRepo, taskService, broadcaster), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register bulk operation service: %w", err)
+	}
+
+	return nil
+}
+
+// registerSearchService registers the full-text search service
+func registerSearchService(container Container) error {
+	err := container.RegisterSingleton(SearchService, func(ctx context.Context, c Container) (interface{}, error) {
+		_, projectRepo, userRepo, err := resolveCommonRepositories(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		searchRepo, err := resolveAndCast[repository.SearchRepository](
+			ctx, c, SearchRepositoryService, "search repository")
+		if err != nil {
+			return nil, err
+		}
+
+		return services.NewSearchService(searchRepo, projectRepo, userRepo), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register search service: %w", err)
+	}
+
+	return nil
+}
+
+// registerCriticalPathService registers the dependency graph analysis service
+func registerCriticalPathService(container Container) error {
+	err := container.RegisterSingleton(CriticalPathService, func(ctx context.Context, c Container) (interface{}, error) {
+		taskRepo, projectRepo, _, err := resolveCommonRepositories(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		return services.NewCriticalPathService(taskRepo, projectRepo), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register critical path service: %w", err)
+	}
+
+	return nil
+}
+
+// registerWorkflowService registers the project workflow service
+func registerWorkflowService(container Container) error {
+	err := container.RegisterSingleton(WorkflowService, func(ctx context.Context, c Container) (interface{}, error) {
+		taskRepo, projectRepo, _, err := resolveCommonRepositories(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		cacheManager, err := resolveAndCast[services.CacheManager](ctx, c, CacheManager, "cache manager")
+		if err != nil {
+			return nil, err
+		}
+
+		authz, err := resolveAndCast[services.AuthorizationService](
+			ctx, c, AuthorizationService, "authorization service")
+		if err != nil {
+			return nil, err
+		}
+
+		return services.NewWorkflowService(taskRepo, projectRepo, authz, cacheManager), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register workflow service: %w", err)
+	}
+
+	return nil
+}
+
+// registerEmailOutbox registers the email outbox, delivering over SMTP when a host is configured
+func registerEmailOutbox(container Container) error {
+	err := container.RegisterSingleton(EmailOutbox, func(ctx context.Context, c Container) (interface{}, error) {
+		outboxRepo, err := resolveAndCast[repository.EmailOutboxRepository](
+			ctx, c, EmailOutboxRepositoryService, "email outbox repository")
+		if err != nil {
+			return nil, err
+		}
+
+		mailCfg, err := resolveAndCast[config.MailConfig](ctx, c, ConfigService, "config")
+		if err != nil {
+			return nil, err
+		}
+
+		templates, err := services.NewEmailTemplates(mailCfg.GetAppBaseURL())
+		if err != nil {
+			return nil, err
+		}
+
+		var mailer services.Mailer
+		if mailCfg.GetSMTPHost() != "" {
+			mailer = services.NewSMTPMailer(services.SMTPSettings{
+				Host:     mailCfg.GetSMTPHost(),
+				Port:     mailCfg.GetSMTPPort(),
+				Username: mailCfg.GetSMTPUsername(),
+				Password: mailCfg.GetSMTPPassword(),
+				From:     mailCfg.GetMailFrom(),
+				StartTLS: mailCfg.GetSMTPStartTLS(),
+			})
+		} else {
+			mailer = services.NewLogMailer(nil)
+		}
+
+		return services.NewEmailOutbox(outboxRepo, mailer, templates, services.EmailOutboxConfig{
+			RetryInterval: mailCfg.GetMailRetryInterval(),
+			MaxAttempts:   mailCfg.GetMailMaxAttempts(),
+		}), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register email outbox: %w", err)
+	}
+
+	return nil
+}
+
+// registerCommentService registers the comment service
+func registerCommentService(container Container) error {
+	// Comment Service
+	err := container.RegisterSingleton(CommentService, func(ctx context.Context, c Container) (interface{}, error) {
+		commentRepo, commentErr := c.ResolveWithContext(ctx, CommentRepositoryService)
+		if commentErr != nil {
+			return nil, fmt.Errorf("failed to resolve comment repository: %w", commentErr)
+		}
+
+		taskRepo, projectRepo, userRepo, repoErr := resolveCommonRepositories(ctx, c)
+		if repoErr != nil {
+			return nil, repoErr
+		}
+
+		commentRepoTyped, ok := commentRepo.(repository.CommentRepository)
+		if !ok {
+			return nil, fmt.Errorf("failed to cast comment repository to correct type")
+		}
+
+		mentionRepo, mentionErr := resolveAndCast[repository.CommentMentionRepository](
+			ctx, c, CommentMentionRepositoryService, "comment mention repository")
+		if mentionErr != nil {
+			return nil, mentionErr
+		}
+
+		reactionRepo, reactionErr := resolveAndCast[repository.CommentReactionRepository](
+			ctx, c, CommentReactionRepositoryService, "comment reaction repository")
+		if reactionErr != nil {
+			return nil, reactionErr
+		}
+
+		broadcaster, broadcasterErr := resolveAndCast[services.EventBroadcaster](
+			ctx, c, EventBroadcaster, "event broadcaster")
+		if broadcasterErr != nil {
+			return nil, broadcasterErr
+		}
+
+		authz, authzErr := resolveAndCast[services.AuthorizationService](
+			ctx, c, AuthorizationService, "authorization service")
+		if authzErr != nil {
+			return nil, authzErr
+		}
+
+		return services.NewCommentService(
+			commentRepoTyped,
+			mentionRepo,
+			reactionRepo,
+			taskRepo,
+			projectRepo,
+			userRepo,
+			authz,
+			broadcaster,
+		), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register comment service: %w", err)
+	}
+
+	return nil
+}
+
+// registerAttachmentService registers the task and comment attachment service
+func registerAttachmentService(container Container) error {
+	err := container.RegisterSingleton(AttachmentService, func(ctx context.Context, c Container) (interface{}, error) {
+		attachmentRepo, err := resolveAndCast[repository.AttachmentRepository](
+			ctx, c, AttachmentRepositoryService, "attachment repository")
+		if err != nil {
+			return nil, err
+		}
+
+		taskRepo, projectRepo, _, err := resolveCommonRepositories(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		commentRepo, err := resolveAndCast[repository.CommentRepository](
+			ctx, c, CommentRepositoryService, "comment repository")
+		if err != nil {
+			return nil, err
+		}
+
+		cfg, err := resolveAndCast[config.SecurityConfig](ctx, c, ConfigService, "config")
+		if err != nil {
+			return nil, err
+		}
+
+		authz, err := resolveAndCast[services.AuthorizationService](
+			ctx, c, AuthorizationService, "authorization service")
+		if err != nil {
+			return nil, err
+		}
+
+		return services.NewAttachmentService(
+			attachmentRepo, taskRepo, commentRepo, projectRepo, authz, cfg.GetJWTSecret()), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register attachment service: %w", err)
+	}
+
+	return nil
+}
+
+// registerRecurrenceService registers the recurring task service.
+// It broadcasts new occurrences through the event broadcaster, which is set when that is built.
+func registerRecurrenceService(container Container) error {
+	err := container.RegisterSingleton(RecurrenceService, func(ctx context.Context, c Container) (interface{}, error) {
+		taskRepo, projectRepo, _, err := resolveCommonRepositories(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		seriesRepo, err := resolveAndCast[repository.TaskSeriesRepository](
+			ctx, c, TaskSeriesRepositoryService, "task series repository")
+		if err != nil {
+			return nil, err
+		}
+
+		taskService, err := resolveAndCast[services.TaskService](ctx, c, TaskService, "task service")
+		if err != nil {
+			return nil, err
+		}
+
+		authz, err := resolveAndCast[services.AuthorizationService](
+			ctx, c, AuthorizationService, "authorization service")
+		if err != nil {
+			return nil, err
+		}
+
+		return services.NewRecurrenceService(taskService, taskRepo, projectRepo, seriesRepo, authz), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register recurrence service: %w", err)
+	}
+
+	return nil
+}
+
+// registerHealthService registers the health service
+func registerHealthService(container Container) error {
+	// Health Service
+	err := container.RegisterSingleton(HealthService, func(ctx context.Context, c Container) (interface{}, error) {
+		cfg, cfgErr := c.ResolveWithContext(ctx, ConfigService)
+		if cfgErr != nil {
+			return nil, fmt.Errorf("failed to resolve config: %w", cfgErr)
+		}
+
+		cfgTyped, ok := cfg.(config.Config)
+		if !ok {
+			return nil, fmt.Errorf("failed to cast config to correct type")
+		}
+
+		return services.NewHealthService(cfgTyped), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register health service: %w", err)
+	}
+
+	return nil
+}
+
+// registerBusinessServices registers all business logic services
+func registerBusinessServices(container Container) error {
+	if err := registerEmailOutbox(container); err != nil {
+		return err
+	}
+	if err := registerMFAService(container); err != nil {
+		return err
+	}
+	if err := registerAuthService(container); err != nil {
+		return err
 	}
 	if err := registerUserService(container); err != nil {
 		return err
 	}
+	if err := registerPersonalAccessTokenService(container); err != nil {
+		return err
+	}
+	if err := registerSessionService(container); err != nil {
+		return err
+	}
+	if err := registerAuthorizationService(container); err != nil {
+		return err
+	}
 	if err := registerProjectService(container); err != nil {
 		return err
 	}
+	if err := registerInvitationService(container); err != nil {
+		return err
+	}
 	if err := registerTaskService(container); err != nil {
 		return err
 	}
 	if err := registerCommentService(container); err != nil {
 		return err
 	}
+	if err := registerAttachmentService(container); err != nil {
+		return err
+	}
+	if err := registerRecurrenceService(container); err != nil {
+		return err
+	}
+	if err := registerWIPManager(container); err != nil {
+		return err
+	}
+	if err := registerKanbanService(container); err != nil {
+		return err
+	}
+	if err := registerWebhookService(container); err != nil {
+		return err
+	}
+	if err := registerNotificationService(container); err != nil {
+		return err
+	}
+	if err := registerEventBroadcaster(container); err != nil {
+		return err
+	}
+	if err := registerBulkOperationService(container); err != nil {
+		return err
+	}
+	if err := registerSearchService(container); err != nil {
+		return err
+	}
+	if err := registerCriticalPathService(container); err != nil {
+		return err
+	}
+	if err := registerWorkflowService(container); err != nil {
+		return err
+	}
 	if err := registerHealthService(container); err != nil {
 		return err
 	}
@@ -449,6 +1381,19 @@ func ResolveUserService(container Container) (services.UserService, error) {
 	return serviceTyped, nil
 }
 
+// ResolveAuthorizationService resolves the authorization service from the container
+func ResolveAuthorizationService(container Container) (services.AuthorizationService, error) {
+	service, err := container.Resolve(AuthorizationService)
+	if err != nil {
+		return nil, err
+	}
+	serviceTyped, ok := service.(services.AuthorizationService)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast service to AuthorizationService")
+	}
+	return serviceTyped, nil
+}
+
 // ResolveProjectService resolves the project service from the container
 func ResolveProjectService(container Container) (services.ProjectService, error) {
 	service, err := container.Resolve(ProjectService)
@@ -462,6 +1407,58 @@ func ResolveProjectService(container Container) (services.ProjectService, error)
 	return serviceTyped, nil
 }
 
+// ResolveInvitationService resolves the invitation service from the container
+func ResolveInvitationService(container Container) (services.InvitationService, error) {
+	service, err := container.Resolve(InvitationService)
+	if err != nil {
+		return nil, err
+	}
+	serviceTyped, ok := service.(services.InvitationService)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast service to InvitationService")
+	}
+	return serviceTyped, nil
+}
+
+// ResolveMFAService resolves the two-factor authentication service from the container
+func ResolveMFAService(container Container) (services.MFAService, error) {
+	service, err := container.Resolve(MFAService)
+	if err != nil {
+		return nil, err
+	}
+	serviceTyped, ok := service.(services.MFAService)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast service to MFAService")
+	}
+	return serviceTyped, nil
+}
+
+// ResolvePersonalAccessTokenService resolves the personal access token service from the container
+func ResolvePersonalAccessTokenService(container Container) (services.PersonalAccessTokenService, error) {
+	service, err := container.Resolve(PersonalAccessTokenService)
+	if err != nil {
+		return nil, err
+	}
+	serviceTyped, ok := service.(services.PersonalAccessTokenService)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast service to PersonalAccessTokenService")
+	}
+	return serviceTyped, nil
+}
+
+// ResolveSessionService resolves the session service from the container
+func ResolveSessionService(container Container) (services.SessionService, error) {
+	service, err := container.Resolve(SessionService)
+	if err != nil {
+		return nil, err
+	}
+	serviceTyped, ok := service.(services.SessionService)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast service to SessionService")
+	}
+	return serviceTyped, nil
+}
+
 // ResolveTaskService resolves the task service from the container
 func ResolveTaskService(container Container) (services.TaskService, error) {
 	service, err := container.Resolve(TaskService)
@@ -475,6 +1472,136 @@ func ResolveTaskService(container Container) (services.TaskService, error) {
 	return serviceTyped, nil
 }
 
+// ResolveKanbanService resolves the kanban service from the container
+func ResolveKanbanService(container Container) (services.KanbanService, error) {
+	service, err := container.Resolve(KanbanService)
+	if err != nil {
+		return nil, err
+	}
+	serviceTyped, ok := service.(services.KanbanService)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast service to KanbanService")
+	}
+	return serviceTyped, nil
+}
+
+// ResolveSearchService resolves the search service from the container
+func ResolveSearchService(container Container) (services.SearchService, error) {
+	service, err := container.Resolve(SearchService)
+	if err != nil {
+		return nil, err
+	}
+	serviceTyped, ok := service.(services.SearchService)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast service to SearchService")
+	}
+	return serviceTyped, nil
+}
+
+// ResolveCriticalPathService resolves the critical path service from the container
+func ResolveCriticalPathService(container Container) (services.CriticalPathService, error) {
+	service, err := container.Resolve(CriticalPathService)
+	if err != nil {
+		return nil, err
+	}
+	serviceTyped, ok := service.(services.CriticalPathService)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast service to CriticalPathService")
+	}
+	return serviceTyped, nil
+}
+
+// ResolveEmailOutbox resolves the email outbox from the container
+func ResolveEmailOutbox(container Container) (services.EmailOutbox, error) {
+	service, err := container.Resolve(EmailOutbox)
+	if err != nil {
+		return nil, err
+	}
+	serviceTyped, ok := service.(services.EmailOutbox)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast service to EmailOutbox")
+	}
+	return serviceTyped, nil
+}
+
+// ResolveEventBroadcaster resolves the realtime event broadcaster from the container
+func ResolveEventBroadcaster(container Container) (services.EventBroadcaster, error) {
+	service, err := container.Resolve(EventBroadcaster)
+	if err != nil {
+		return nil, err
+	}
+	serviceTyped, ok := service.(services.EventBroadcaster)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast service to EventBroadcaster")
+	}
+	return serviceTyped, nil
+}
+
+// ResolveWebhookService resolves the webhook service from the container
+func ResolveWebhookService(container Container) (services.WebhookService, error) {
+	service, err := container.Resolve(WebhookService)
+	if err != nil {
+		return nil, err
+	}
+	serviceTyped, ok := service.(services.WebhookService)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast service to WebhookService")
+	}
+	return serviceTyped, nil
+}
+
+// ResolveNotificationService resolves the notification service from the container
+func ResolveNotificationService(container Container) (services.NotificationService, error) {
+	service, err := container.Resolve(NotificationService)
+	if err != nil {
+		return nil, err
+	}
+	serviceTyped, ok := service.(services.NotificationService)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast service to NotificationService")
+	}
+	return serviceTyped, nil
+}
+
+// ResolveEventLog resolves the event log from the container
+func ResolveEventLog(container Container) (services.EventLog, error) {
+	service, err := container.Resolve(EventLog)
+	if err != nil {
+		return nil, err
+	}
+	serviceTyped, ok := service.(services.EventLog)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast service to EventLog")
+	}
+	return serviceTyped, nil
+}
+
+// ResolveWorkflowService resolves the workflow service from the container
+func ResolveWorkflowService(container Container) (services.WorkflowService, error) {
+	service, err := container.Resolve(WorkflowService)
+	if err != nil {
+		return nil, err
+	}
+	serviceTyped, ok := service.(services.WorkflowService)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast service to WorkflowService")
+	}
+	return serviceTyped, nil
+}
+
+// ResolveBulkOperationService resolves the bulk operation service from the container
+func ResolveBulkOperationService(container Container) (services.BulkOperationService, error) {
+	service, err := container.Resolve(BulkOperationService)
+	if err != nil {
+		return nil, err
+	}
+	serviceTyped, ok := service.(services.BulkOperationService)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast service to BulkOperationService")
+	}
+	return serviceTyped, nil
+}
+
 // ResolveCommentService resolves the comment service from the container
 func ResolveCommentService(container Container) (services.CommentService, error) {
 	service, err := container.Resolve(CommentService)
@@ -488,6 +1615,32 @@ func ResolveCommentService(container Container) (services.CommentService, error)
 	return serviceTyped, nil
 }
 
+// ResolveRecurrenceService resolves the recurrence service from the container
+func ResolveRecurrenceService(container Container) (services.RecurrenceService, error) {
+	service, err := container.Resolve(RecurrenceService)
+	if err != nil {
+		return nil, err
+	}
+	serviceTyped, ok := service.(services.RecurrenceService)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast service to RecurrenceService")
+	}
+	return serviceTyped, nil
+}
+
+// ResolveAttachmentService resolves the attachment service from the container
+func ResolveAttachmentService(container Container) (services.AttachmentService, error) {
+	service, err := container.Resolve(AttachmentService)
+	if err != nil {
+		return nil, err
+	}
+	serviceTyped, ok := service.(services.AttachmentService)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast service to AttachmentService")
+	}
+	return serviceTyped, nil
+}
+
 // ResolveHealthService resolves the health service from the container
 func ResolveHealthService(container Container) (services.HealthServiceInterface, error) {
 	service, err := container.Resolve(HealthService)
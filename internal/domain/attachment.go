@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// AttachmentOwnerType is the kind of record files are attached to. Its values are the names of
+// the collections the files are stored on.
+type AttachmentOwnerType string
+
+const (
+	// AttachmentOwnerTask marks files attached to a task
+	AttachmentOwnerTask AttachmentOwnerType = "tasks"
+	// AttachmentOwnerComment marks files attached to a comment
+	AttachmentOwnerComment AttachmentOwnerType = "comments"
+)
+
+const (
+	// MaxAttachmentSize is the largest file that can be attached, in bytes
+	MaxAttachmentSize = 5 << 20
+	// MaxAttachments is the number of files a single task or comment can hold
+	MaxAttachments = 10
+	// AttachmentThumbSize is the size of the thumbnails generated for image attachments
+	AttachmentThumbSize = "100x100"
+)
+
+// attachmentContentTypes are the types an attachment may have, as sniffed from its content.
+// JSON and markdown files sniff as text/plain.
+var attachmentContentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+	"text/plain":      true,
+}
+
+// imageExtensions are the file extensions thumbnails are generated for
+var imageExtensions = map[string]bool{".jpg": true, ".jpeg": true, ".png": true, ".gif": true, ".webp": true}
+
+// IsValid checks if the owner type is one files can be attached to
+func (t AttachmentOwnerType) IsValid() bool {
+	return t == AttachmentOwnerTask || t == AttachmentOwnerComment
+}
+
+// AttachmentOwner identifies the task or comment a file is attached to
+type AttachmentOwner struct {
+	Type AttachmentOwnerType
+	ID   string
+}
+
+// TaskAttachments returns the owner of the files attached to a task
+func TaskAttachments(taskID string) AttachmentOwner {
+	return AttachmentOwner{Type: AttachmentOwnerTask, ID: taskID}
+}
+
+// CommentAttachments returns the owner of the files attached to a comment
+func CommentAttachments(commentID string) AttachmentOwner {
+	return AttachmentOwner{Type: AttachmentOwnerComment, ID: commentID}
+}
+
+// AttachmentUpload is a file about to be attached
+type AttachmentUpload struct {
+	Name        string // the name the file was uploaded with
+	ContentType string // sniffed from Content
+	Content     []byte
+}
+
+// NewAttachmentUpload checks a file against the attachment size and type limits
+func NewAttachmentUpload(name string, content []byte) (*AttachmentUpload, error) {
+	name = strings.TrimSpace(filepath.Base(strings.ReplaceAll(name, `\`, "/")))
+	if name == "" || name == "." || name == "/" {
+		return nil, NewValidationError("INVALID_ATTACHMENT_NAME", "Attachment must have a file name", nil)
+	}
+	if len(content) == 0 {
+		return nil, NewValidationError("EMPTY_ATTACHMENT", "Attachment must not be empty", nil)
+	}
+	if len(content) > MaxAttachmentSize {
+		return nil, NewValidationError("ATTACHMENT_TOO_LARGE", "Attachment exceeds the maximum size",
+			map[string]interface{}{"max_size": MaxAttachmentSize})
+	}
+
+	contentType, _, _ := strings.Cut(http.DetectContentType(content), ";")
+	if !attachmentContentTypes[contentType] {
+		return nil, NewValidationError("ATTACHMENT_TYPE_NOT_ALLOWED", "This type of file cannot be attached",
+			map[string]interface{}{"content_type": contentType})
+	}
+
+	return &AttachmentUpload{Name: name, ContentType: contentType, Content: content}, nil
+}
+
+// IsImageAttachment reports whether a stored attachment is an image thumbnails can be made of
+func IsImageAttachment(name string) bool {
+	return imageExtensions[strings.ToLower(filepath.Ext(name))]
+}
+
+// Attachment is a file stored on a task or comment, with links to download it that expire
+type Attachment struct {
+	ExpiresAt    time.Time `json:"expires_at"` // when URL and ThumbnailURL stop working
+	Name         string    `json:"name"`
+	URL          string    `json:"url"`
+	ThumbnailURL string    `json:"thumbnail_url,omitempty"` // set for images
+}
+
+// AttachmentLink is a signed request to download an attachment
+type AttachmentLink struct {
+	Owner     AttachmentOwner
+	Name      string
+	ThumbSize string // set to download the thumbnail of an image
+	Expires   int64  // unix time the link expires at
+	Signature string
+}
+
+// AttachmentContent is an opened attachment file
+type AttachmentContent struct {
+	io.ReadSeekCloser
+	ModTime     time.Time
+	Name        string
+	ContentType string
+}
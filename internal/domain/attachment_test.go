@@ -0,0 +1,85 @@
+package domain_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+func TestNewAttachmentUpload(t *testing.T) {
+	tests := []struct {
+		name         string
+		fileName     string
+		content      []byte
+		expectedName string
+		expectedType string
+		expectedCode string
+	}{
+		{
+			name:         "plain text",
+			fileName:     "notes.txt",
+			content:      []byte("release notes"),
+			expectedName: "notes.txt",
+			expectedType: "text/plain",
+		},
+		{
+			name:         "path is stripped from the name",
+			fileName:     `C:\Users\me\..\diagram.png`,
+			content:      []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"),
+			expectedName: "diagram.png",
+			expectedType: "image/png",
+		},
+		{
+			name:         "type is sniffed, not taken from the name",
+			fileName:     "report.pdf",
+			content:      []byte("MZ\x90\x00\x03\x00\x00\x00\x04\x00\x00\x00\xff\xff"),
+			expectedCode: "ATTACHMENT_TYPE_NOT_ALLOWED",
+		},
+		{
+			name:         "html is refused",
+			fileName:     "page.txt",
+			content:      []byte("<html><script>alert(1)</script></html>"),
+			expectedCode: "ATTACHMENT_TYPE_NOT_ALLOWED",
+		},
+		{
+			name:         "empty file",
+			fileName:     "empty.txt",
+			expectedCode: "EMPTY_ATTACHMENT",
+		},
+		{
+			name:         "too large",
+			fileName:     "huge.txt",
+			content:      bytes.Repeat([]byte("a"), domain.MaxAttachmentSize+1),
+			expectedCode: "ATTACHMENT_TOO_LARGE",
+		},
+		{
+			name:         "missing name",
+			fileName:     "  ",
+			content:      []byte("hello"),
+			expectedCode: "INVALID_ATTACHMENT_NAME",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upload, err := domain.NewAttachmentUpload(tt.fileName, tt.content)
+
+			if tt.expectedCode != "" {
+				var domainErr *domain.Error
+				if !errors.As(err, &domainErr) || domainErr.Code != tt.expectedCode {
+					t.Fatalf("Expected error %s, got %v", tt.expectedCode, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if upload.Name != tt.expectedName || upload.ContentType != tt.expectedType {
+				t.Errorf("Expected %s (%s), got %s (%s)", tt.expectedName, tt.expectedType, upload.Name, upload.ContentType)
+			}
+		})
+	}
+}
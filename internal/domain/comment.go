@@ -1,10 +1,14 @@
 package domain
 
 import (
+	"regexp"
 	"strings"
 	"time"
 )
 
+// mentionPattern matches an @username that is not part of an email address or another word
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@.])@([A-Za-z0-9_][A-Za-z0-9_.-]{1,49})`)
+
 // CommentType represents the type of comment.
 type CommentType string
 
@@ -22,17 +26,22 @@ const (
 // Comment represents a user comment on a task
 type Comment struct {
 	// 8-byte aligned fields first
-	ParentCommentID *string   `json:"parent_comment_id" db:"parent_comment"`
-	CreatedAt       time.Time `json:"created_at" db:"created"`
-	UpdatedAt       time.Time `json:"updated_at" db:"updated"`
+	ParentCommentID *string    `json:"parent_comment_id" db:"parent_comment"`
+	ResolvedAt      *time.Time `json:"resolved_at,omitempty" db:"resolved_at"` // set on resolved root comments
+	ResolvedBy      *string    `json:"resolved_by,omitempty" db:"resolved_by"`
+	CreatedAt       time.Time  `json:"created_at" db:"created"`
+	UpdatedAt       time.Time  `json:"updated_at" db:"updated"`
 
 	// String and slice fields
-	ID          string      `json:"id" db:"id"`
-	Content     string      `json:"content" db:"content"`
-	TaskID      string      `json:"task_id" db:"task"`
-	AuthorID    string      `json:"author_id" db:"author"`
-	Type        CommentType `json:"type" db:"type"`
-	Attachments []string    `json:"attachments" db:"-"`
+	ID          string            `json:"id" db:"id"`
+	Content     string            `json:"content" db:"content"`
+	ContentHTML string            `json:"content_html" db:"content_html"` // sanitized rendering of Content
+	TaskID      string            `json:"task_id" db:"task"`
+	AuthorID    string            `json:"author_id" db:"author"`
+	Type        CommentType       `json:"type" db:"type"`
+	Attachments []string          `json:"attachments" db:"-"`
+	Mentions    []*CommentMention `json:"mentions" db:"-"`  // the users @mentioned in Content
+	Reactions   []*ReactionGroup  `json:"reactions" db:"-"` // reactions grouped by emoji
 
 	// 1-byte aligned fields
 	IsEdited bool `json:"is_edited" db:"is_edited"`
@@ -70,7 +79,7 @@ func (c *Comment) Validate() error {
 		return NewConflictError("circular_reference",
 			"Comment cannot be its own parent")
 	}
-	if len(c.Attachments) > 10 {
+	if len(c.Attachments) > MaxAttachments {
 		return NewValidationError("attachments", "Maximum 10 attachments allowed per comment", nil)
 	}
 	return nil
@@ -111,7 +120,7 @@ func (c *Comment) UpdateContent(content string) error {
 
 // AddAttachment adds a file attachment to the comment
 func (c *Comment) AddAttachment(attachmentID string) error {
-	if len(c.Attachments) >= 10 {
+	if len(c.Attachments) >= MaxAttachments {
 		return NewValidationError("attachments", "Maximum 10 attachments allowed per comment", nil)
 	}
 	for _, existing := range c.Attachments {
@@ -142,6 +151,116 @@ func (c *Comment) IsReply() bool {
 	return c.ParentCommentID != nil
 }
 
+// IsResolved reports whether the comment's thread has been resolved
+func (c *Comment) IsResolved() bool {
+	return c.ResolvedAt != nil
+}
+
+// Resolve marks the thread started by the comment resolved, reporting whether it was open.
+// Only root comments carry a thread's state.
+func (c *Comment) Resolve(userID string, at time.Time) (bool, error) {
+	if c.IsReply() {
+		return false, NewValidationError("NOT_A_THREAD", "Only root comments can be resolved", nil)
+	}
+	if c.IsResolved() {
+		return false, nil
+	}
+	resolvedAt := at.UTC()
+	c.ResolvedAt = &resolvedAt
+	c.ResolvedBy = &userID
+	return true, nil
+}
+
+// Reopen marks a resolved thread open again, reporting whether it was resolved
+func (c *Comment) Reopen() bool {
+	if !c.IsResolved() {
+		return false
+	}
+	c.ResolvedAt = nil
+	c.ResolvedBy = nil
+	return true
+}
+
+// MentionedUserIDs returns the IDs of the users @mentioned in the comment
+func (c *Comment) MentionedUserIDs() []string {
+	userIDs := make([]string, len(c.Mentions))
+	for i, mention := range c.Mentions {
+		userIDs[i] = mention.UserID
+	}
+	return userIDs
+}
+
+// CommentMention records a user @mentioned in a comment
+type CommentMention struct {
+	CreatedAt time.Time `json:"created_at" db:"created"`
+	ID        string    `json:"id" db:"id"`
+	CommentID string    `json:"comment_id" db:"comment"`
+	TaskID    string    `json:"task_id" db:"task"`
+	UserID    string    `json:"user_id" db:"user"`
+	Username  string    `json:"username" db:"username"` // as written in the comment
+}
+
+// maxReactionEmojiLength bounds the bytes of a reaction, enough for multi-codepoint emoji and :shortcodes:
+const maxReactionEmojiLength = 64
+
+// CommentReaction records one user reacting to a comment with an emoji
+type CommentReaction struct {
+	CreatedAt time.Time `json:"created_at" db:"created"`
+	ID        string    `json:"id" db:"id"`
+	CommentID string    `json:"comment_id" db:"comment"`
+	UserID    string    `json:"user_id" db:"user"`
+	Emoji     string    `json:"emoji" db:"emoji"`
+}
+
+// ValidateReactionEmoji checks an emoji is short and free of whitespace
+func ValidateReactionEmoji(emoji string) error {
+	if emoji == "" || len(emoji) > maxReactionEmojiLength || strings.ContainsAny(emoji, " \t\r\n") {
+		return NewValidationError("INVALID_EMOJI", "Reaction must be a single emoji or :shortcode:", nil)
+	}
+	return nil
+}
+
+// ReactionGroup aggregates the reactions to a comment that use the same emoji
+type ReactionGroup struct {
+	Emoji   string   `json:"emoji"`
+	UserIDs []string `json:"user_ids"` // in the order they reacted
+	Count   int      `json:"count"`
+}
+
+// GroupReactions aggregates reactions per emoji, ordered by each emoji's first use
+func GroupReactions(reactions []*CommentReaction) []*ReactionGroup {
+	groups := []*ReactionGroup{}
+	byEmoji := make(map[string]*ReactionGroup)
+	for _, reaction := range reactions {
+		group, ok := byEmoji[reaction.Emoji]
+		if !ok {
+			group = &ReactionGroup{Emoji: reaction.Emoji}
+			byEmoji[reaction.Emoji] = group
+			groups = append(groups, group)
+		}
+		group.UserIDs = append(group.UserIDs, reaction.UserID)
+		group.Count++
+	}
+	return groups
+}
+
+// ParseMentions returns the usernames @mentioned in content, in order of first appearance.
+// Trailing punctuation is not part of a username, and repeated mentions are reported once.
+func ParseMentions(content string) []string {
+	var usernames []string
+	seen := make(map[string]bool)
+	for _, match := range mentionPattern.FindAllStringSubmatch(content, -1) {
+		username := strings.TrimRight(match[1], ".-")
+		key := strings.ToLower(username)
+		if len(username) < 2 || seen[key] {
+			continue
+		}
+		seen[key] = true
+		usernames = append(usernames, username)
+	}
+	return usernames
+}
+
 // CreateCommentRequest represents the data needed to create a new comment.
 type CreateCommentRequest struct {
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
@@ -0,0 +1,73 @@
+package domain_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+func TestComment_ResolveAndReopen(t *testing.T) {
+	root := &domain.Comment{ID: "root"}
+	at := time.Date(2025, 9, 8, 12, 0, 0, 0, time.UTC)
+
+	changed, err := root.Resolve("user-1", at)
+	if err != nil || !changed {
+		t.Fatalf("Expected the open thread to be resolved, got %v, %v", changed, err)
+	}
+	if !root.IsResolved() || *root.ResolvedBy != "user-1" || !root.ResolvedAt.Equal(at) {
+		t.Errorf("Expected the resolution to be recorded, got %v by %v", root.ResolvedAt, root.ResolvedBy)
+	}
+
+	if changed, _ := root.Resolve("user-2", at.Add(time.Hour)); changed || *root.ResolvedBy != "user-1" {
+		t.Error("Expected resolving a resolved thread to keep the original resolution")
+	}
+
+	if !root.Reopen() || root.IsResolved() || root.ResolvedBy != nil {
+		t.Error("Expected the thread to be reopened")
+	}
+	if root.Reopen() {
+		t.Error("Expected reopening an open thread to change nothing")
+	}
+
+	reply := &domain.Comment{ID: "reply", ParentCommentID: &root.ID}
+	if _, err := reply.Resolve("user-1", at); err == nil {
+		t.Error("Expected replies to be rejected")
+	}
+}
+
+func TestGroupReactions(t *testing.T) {
+	groups := domain.GroupReactions([]*domain.CommentReaction{
+		{UserID: "user-1", Emoji: "👍"},
+		{UserID: "user-2", Emoji: "🎉"},
+		{UserID: "user-2", Emoji: "👍"},
+	})
+
+	expected := []*domain.ReactionGroup{
+		{Emoji: "👍", UserIDs: []string{"user-1", "user-2"}, Count: 2},
+		{Emoji: "🎉", UserIDs: []string{"user-2"}, Count: 1},
+	}
+	if !reflect.DeepEqual(groups, expected) {
+		t.Errorf("Unexpected groups: %+v", groups)
+	}
+
+	if groups := domain.GroupReactions(nil); groups == nil || len(groups) != 0 {
+		t.Errorf("Expected an empty list without reactions, got %v", groups)
+	}
+}
+
+func TestValidateReactionEmoji(t *testing.T) {
+	for _, emoji := range []string{"👍", ":shipit:", "👩‍💻"} {
+		if err := domain.ValidateReactionEmoji(emoji); err != nil {
+			t.Errorf("Expected %q to be accepted, got %v", emoji, err)
+		}
+	}
+
+	tooLong := string(make([]byte, 65))
+	for _, emoji := range []string{"", "thumbs up", tooLong} {
+		if err := domain.ValidateReactionEmoji(emoji); err == nil {
+			t.Errorf("Expected %q to be rejected", emoji)
+		}
+	}
+}
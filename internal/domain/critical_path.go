@@ -0,0 +1,405 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultScheduleHoursPerDay is how many effort hours a schedule fits into one calendar day
+const DefaultScheduleHoursPerDay = 8.0
+
+// scheduleEpsilon absorbs floating point noise when comparing schedule offsets
+const scheduleEpsilon = 1e-9
+
+// ScheduleOptions configures a critical path analysis
+type ScheduleOptions struct {
+	// StartsAt anchors the schedule; all offsets are measured from it
+	StartsAt time.Time
+	// Workflow decides which statuses count as done; nil means the default workflow
+	Workflow *Workflow
+	// HoursPerDay converts effort hours into calendar days; zero means DefaultScheduleHoursPerDay
+	HoursPerDay float64
+}
+
+// Validate checks the options can produce a schedule
+func (o ScheduleOptions) Validate() error {
+	if o.HoursPerDay != 0 && (o.HoursPerDay < 1 || o.HoursPerDay > 24) {
+		return NewValidationError("INVALID_HOURS_PER_DAY", "Hours per day must be between 1 and 24", map[string]interface{}{
+			"field": "hours_per_day",
+		})
+	}
+	return nil
+}
+
+// TaskSchedule is the computed schedule of one task.
+// Start, finish and slack values are working hours from the schedule start.
+type TaskSchedule struct {
+	DueDate         *time.Time `json:"due_date,omitempty"`
+	ProjectedStart  time.Time  `json:"projected_start"`
+	ProjectedFinish time.Time  `json:"projected_finish"`
+	TaskID          string     `json:"task_id"`
+	Title           string     `json:"title"`
+	Status          TaskStatus `json:"status"`
+	// Dependencies are the analysed tasks this task waits for
+	Dependencies []string `json:"dependencies,omitempty"`
+	// ExternalDependencies are dependencies outside the analysed tasks, such as other projects
+	ExternalDependencies []string `json:"external_dependencies,omitempty"`
+	// DueDateSlack is how many working hours the projected finish leaves before the due date; negative when late
+	DueDateSlack   *float64 `json:"due_date_slack,omitempty"`
+	Duration       float64  `json:"duration"` // remaining effort: estimate minus time spent
+	EarliestStart  float64  `json:"earliest_start"`
+	EarliestFinish float64  `json:"earliest_finish"`
+	LatestStart    float64  `json:"latest_start"`
+	LatestFinish   float64  `json:"latest_finish"`
+	Slack          float64  `json:"slack"`
+	Critical       bool     `json:"critical"`
+	Done           bool     `json:"done"`        // in a done status of the workflow
+	Unestimated    bool     `json:"unestimated"` // no effort estimate, scheduled as zero hours
+	DueDateAtRisk  bool     `json:"due_date_at_risk"`
+}
+
+// DependencyEdge links a dependency to the task waiting for it
+type DependencyEdge struct {
+	From     string `json:"from"` // the dependency
+	To       string `json:"to"`   // the dependent task
+	Critical bool   `json:"critical"`
+}
+
+// CriticalPathAnalysis is the schedule of a project's dependency graph
+type CriticalPathAnalysis struct {
+	StartsAt        time.Time         `json:"starts_at"`
+	ProjectedFinish time.Time         `json:"projected_finish"`
+	ProjectID       string            `json:"project_id"`
+	CriticalPath    []string          `json:"critical_path"`
+	AtRisk          []string          `json:"at_risk"`
+	Tasks           []*TaskSchedule   `json:"tasks"` // in dependency order
+	Edges           []*DependencyEdge `json:"edges"`
+	HoursPerDay     float64           `json:"hours_per_day"`
+	Duration        float64           `json:"duration"` // working hours until every task is done
+}
+
+// AnalyzeCriticalPath schedules tasks as early as their dependencies, start dates and
+// remaining effort allow, then works back from the project finish to find each task's
+// latest start and slack. Tasks without slack form the critical path. Archived tasks
+// are left out; a dependency cycle is reported as a CIRCULAR_DEPENDENCY conflict.
+func AnalyzeCriticalPath(projectID string, tasks []*Task, options ScheduleOptions) (*CriticalPathAnalysis, error) {
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+	if options.HoursPerDay == 0 {
+		options.HoursPerDay = DefaultScheduleHoursPerDay
+	}
+	if options.Workflow == nil {
+		options.Workflow = DefaultWorkflow()
+	}
+
+	graph := newScheduleGraph(tasks, options.Workflow)
+	order, err := graph.topologicalOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	analysis := &CriticalPathAnalysis{
+		ProjectID:    projectID,
+		StartsAt:     options.StartsAt,
+		HoursPerDay:  options.HoursPerDay,
+		CriticalPath: []string{},
+		AtRisk:       []string{},
+		Tasks:        make([]*TaskSchedule, 0, len(order)),
+		Edges:        []*DependencyEdge{},
+	}
+
+	// Forward pass: earliest start and finish
+	for _, id := range order {
+		node := graph.nodes[id]
+		node.EarliestStart = graph.startConstraint(node, graph.tasks[id], options)
+		for _, depID := range node.Dependencies {
+			node.EarliestStart = math.Max(node.EarliestStart, graph.nodes[depID].EarliestFinish)
+		}
+		node.EarliestFinish = node.EarliestStart + node.Duration
+		analysis.Duration = math.Max(analysis.Duration, node.EarliestFinish)
+	}
+
+	// Backward pass: latest start and finish that keep the project finish
+	for i := len(order) - 1; i >= 0; i-- {
+		node := graph.nodes[order[i]]
+		node.LatestFinish = analysis.Duration
+		for _, dependentID := range graph.dependents[node.TaskID] {
+			node.LatestFinish = math.Min(node.LatestFinish, graph.nodes[dependentID].LatestStart)
+		}
+		node.LatestStart = node.LatestFinish - node.Duration
+		node.Slack = node.LatestStart - node.EarliestStart
+		node.Critical = !node.Done && node.Slack < scheduleEpsilon
+	}
+
+	for _, id := range order {
+		node := graph.nodes[id]
+		node.ProjectedStart = options.calendarTime(node.EarliestStart)
+		node.ProjectedFinish = options.calendarTime(node.EarliestFinish)
+
+		if node.DueDate != nil && !node.Done {
+			dueSlack := options.workingOffset(*node.DueDate) - node.EarliestFinish
+			node.DueDateSlack = &dueSlack
+			if dueSlack < -scheduleEpsilon {
+				node.DueDateAtRisk = true
+				analysis.AtRisk = append(analysis.AtRisk, id)
+			}
+		}
+
+		for _, depID := range node.Dependencies {
+			dependency := graph.nodes[depID]
+			analysis.Edges = append(analysis.Edges, &DependencyEdge{
+				From:     depID,
+				To:       id,
+				Critical: dependency.Critical && node.Critical && isSameOffset(dependency.EarliestFinish, node.EarliestStart),
+			})
+		}
+
+		analysis.Tasks = append(analysis.Tasks, node)
+	}
+
+	analysis.ProjectedFinish = options.calendarTime(analysis.Duration)
+	analysis.CriticalPath = graph.criticalPath(order)
+
+	return analysis, nil
+}
+
+// DOT renders the analysis as a Graphviz digraph. Critical tasks and edges are drawn
+// in red and tasks that will miss their due date are filled.
+func (a *CriticalPathAnalysis) DOT() string {
+	var b strings.Builder
+
+	b.WriteString("digraph critical_path {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=rounded];\n")
+
+	for _, task := range a.Tasks {
+		label := fmt.Sprintf("%s\n%sh, slack %sh", task.Title, formatHours(task.Duration), formatHours(task.Slack))
+		attrs := []string{"label=" + dotQuote(label)}
+
+		switch {
+		case task.DueDateAtRisk:
+			attrs = append(attrs, `style="rounded,filled"`, `fillcolor="#f8d7da"`)
+		case task.Done:
+			attrs = append(attrs, "fontcolor=gray")
+		}
+		if task.Critical {
+			attrs = append(attrs, "color=red", "penwidth=2")
+		}
+
+		fmt.Fprintf(&b, "  %s [%s];\n", dotQuote(task.TaskID), strings.Join(attrs, ", "))
+	}
+
+	for _, edge := range a.Edges {
+		fmt.Fprintf(&b, "  %s -> %s", dotQuote(edge.From), dotQuote(edge.To))
+		if edge.Critical {
+			b.WriteString(" [color=red, penwidth=2]")
+		}
+		b.WriteString(";\n")
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// scheduleGraph is the dependency DAG of the analysed tasks
+type scheduleGraph struct {
+	tasks      map[string]*Task
+	nodes      map[string]*TaskSchedule
+	dependents map[string][]string
+	ids        []string // input order, so results are stable
+}
+
+// newScheduleGraph builds nodes and edges for every non-archived task
+func newScheduleGraph(tasks []*Task, workflow *Workflow) *scheduleGraph {
+	graph := &scheduleGraph{
+		tasks:      make(map[string]*Task, len(tasks)),
+		nodes:      make(map[string]*TaskSchedule, len(tasks)),
+		dependents: make(map[string][]string),
+	}
+
+	for _, task := range tasks {
+		if task.IsArchived() || graph.tasks[task.ID] != nil {
+			continue
+		}
+		graph.tasks[task.ID] = task
+		graph.ids = append(graph.ids, task.ID)
+	}
+
+	for _, id := range graph.ids {
+		task := graph.tasks[id]
+		node := &TaskSchedule{
+			TaskID:  task.ID,
+			Title:   task.Title,
+			Status:  task.Status,
+			DueDate: task.DueDate,
+			Done:    workflow.IsDone(task.Status),
+		}
+
+		switch {
+		case node.Done:
+			// Finished work takes no more time
+		case task.EffortEstimate == nil:
+			node.Unestimated = true
+		default:
+			node.Duration = math.Max(*task.EffortEstimate-task.TimeSpent, 0)
+		}
+
+		seen := make(map[string]bool, len(task.Dependencies))
+		for _, depID := range task.Dependencies {
+			if seen[depID] {
+				continue
+			}
+			seen[depID] = true
+
+			if _, ok := graph.tasks[depID]; ok {
+				node.Dependencies = append(node.Dependencies, depID)
+				graph.dependents[depID] = append(graph.dependents[depID], id)
+			} else {
+				node.ExternalDependencies = append(node.ExternalDependencies, depID)
+			}
+		}
+
+		graph.nodes[id] = node
+	}
+
+	return graph
+}
+
+// topologicalOrder sorts tasks so every task follows its dependencies
+func (g *scheduleGraph) topologicalOrder() ([]string, error) {
+	waiting := make(map[string]int, len(g.ids))
+	var ready []string
+	for _, id := range g.ids {
+		waiting[id] = len(g.nodes[id].Dependencies)
+		if waiting[id] == 0 {
+			ready = append(ready, id)
+		}
+	}
+
+	order := make([]string, 0, len(g.ids))
+	for len(ready) > 0 {
+		id := ready[0]
+		ready = ready[1:]
+		order = append(order, id)
+
+		for _, dependentID := range g.dependents[id] {
+			waiting[dependentID]--
+			if waiting[dependentID] == 0 {
+				ready = append(ready, dependentID)
+			}
+		}
+	}
+
+	if len(order) < len(g.ids) {
+		return nil, NewDependencyCycleError(g.findCycle(waiting))
+	}
+	return order, nil
+}
+
+// findCycle follows unresolved dependencies until a task repeats. Every task still
+// waiting has a waiting dependency, so the walk always closes a loop.
+func (g *scheduleGraph) findCycle(waiting map[string]int) []string {
+	var start string
+	for _, id := range g.ids {
+		if waiting[id] > 0 {
+			start = id
+			break
+		}
+	}
+
+	position := map[string]int{}
+	var path []string
+	for id := start; ; {
+		if at, seen := position[id]; seen {
+			return append(path[at:], id)
+		}
+		position[id] = len(path)
+		path = append(path, id)
+
+		next := ""
+		for _, depID := range g.nodes[id].Dependencies {
+			if waiting[depID] > 0 {
+				next = depID
+				break
+			}
+		}
+		if next == "" {
+			return path
+		}
+		id = next
+	}
+}
+
+// startConstraint is the earliest offset a task may start at given its start date
+func (g *scheduleGraph) startConstraint(node *TaskSchedule, task *Task, options ScheduleOptions) float64 {
+	if node.Done || task.StartDate == nil {
+		return 0
+	}
+	return math.Max(options.workingOffset(*task.StartDate), 0)
+}
+
+// criticalPath walks back from the last critical task through the dependencies that
+// set each start, returning the chain from its first task to its last
+func (g *scheduleGraph) criticalPath(order []string) []string {
+	var current *TaskSchedule
+	for _, id := range order {
+		node := g.nodes[id]
+		if node.Critical && (current == nil || node.EarliestFinish > current.EarliestFinish+scheduleEpsilon) {
+			current = node
+		}
+	}
+
+	var reversed []string
+	for current != nil {
+		reversed = append(reversed, current.TaskID)
+
+		var previous *TaskSchedule
+		for _, depID := range current.Dependencies {
+			dependency := g.nodes[depID]
+			if dependency.Critical && isSameOffset(dependency.EarliestFinish, current.EarliestStart) {
+				previous = dependency
+				break
+			}
+		}
+		current = previous
+	}
+
+	path := make([]string, 0, len(reversed))
+	for i := len(reversed) - 1; i >= 0; i-- {
+		path = append(path, reversed[i])
+	}
+	return path
+}
+
+// calendarTime converts a working hour offset into a date
+func (o ScheduleOptions) calendarTime(offset float64) time.Time {
+	days := offset / o.HoursPerDay
+	return o.StartsAt.Add(time.Duration(days * float64(24*time.Hour)))
+}
+
+// workingOffset converts a date into a working hour offset
+func (o ScheduleOptions) workingOffset(t time.Time) float64 {
+	return t.Sub(o.StartsAt).Hours() / 24 * o.HoursPerDay
+}
+
+// isSameOffset compares schedule offsets within floating point noise
+func isSameOffset(a, b float64) bool {
+	return math.Abs(a-b) < scheduleEpsilon
+}
+
+// formatHours prints hours with at most two decimals
+func formatHours(hours float64) string {
+	return strconv.FormatFloat(math.Round(hours*100)/100, 'f', -1, 64)
+}
+
+// dotQuote quotes a Graphviz ID or label
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
@@ -0,0 +1,210 @@
+package domain_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+func scheduledTask(id string, effort float64, dependencies ...string) *domain.Task {
+	return &domain.Task{
+		ID:             id,
+		Title:          "Task " + id,
+		Status:         domain.StatusTodo,
+		EffortEstimate: &effort,
+		Dependencies:   dependencies,
+	}
+}
+
+func TestAnalyzeCriticalPath(t *testing.T) {
+	start := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	// a → b → d is 4+8+2 hours; a → c → d is 4+2+2, leaving c 6 hours of slack
+	tasks := []*domain.Task{
+		scheduledTask("d", 2, "b", "c"),
+		scheduledTask("a", 4),
+		scheduledTask("b", 8, "a"),
+		scheduledTask("c", 2, "a"),
+	}
+
+	analysis, err := domain.AnalyzeCriticalPath("project-1", tasks, domain.ScheduleOptions{StartsAt: start})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if analysis.Duration != 14 {
+		t.Errorf("Expected 14 hours, got %v", analysis.Duration)
+	}
+	if !reflect.DeepEqual(analysis.CriticalPath, []string{"a", "b", "d"}) {
+		t.Errorf("Expected critical path [a b d], got %v", analysis.CriticalPath)
+	}
+
+	schedules := make(map[string]*domain.TaskSchedule)
+	for _, schedule := range analysis.Tasks {
+		schedules[schedule.TaskID] = schedule
+	}
+
+	c := schedules["c"]
+	if c.EarliestStart != 4 || c.LatestStart != 10 || c.Slack != 6 || c.Critical {
+		t.Errorf("Unexpected schedule for c: %+v", c)
+	}
+
+	// 14 working hours at 8 hours a day finish 1.75 days after the start
+	if expected := start.Add(42 * time.Hour); !analysis.ProjectedFinish.Equal(expected) {
+		t.Errorf("Expected projected finish %v, got %v", expected, analysis.ProjectedFinish)
+	}
+
+	if analysis.Tasks[0].TaskID != "a" || analysis.Tasks[len(analysis.Tasks)-1].TaskID != "d" {
+		t.Errorf("Expected tasks in dependency order, got %v first and %v last",
+			analysis.Tasks[0].TaskID, analysis.Tasks[len(analysis.Tasks)-1].TaskID)
+	}
+}
+
+func TestAnalyzeCriticalPath_DueDates(t *testing.T) {
+	start := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	tomorrow := start.Add(24 * time.Hour)
+
+	late := scheduledTask("late", 4, "first")
+	late.DueDate = &tomorrow
+	onTime := scheduledTask("on-time", 2)
+	onTime.DueDate = &tomorrow
+
+	tasks := []*domain.Task{scheduledTask("first", 6), late, onTime}
+
+	analysis, err := domain.AnalyzeCriticalPath("project-1", tasks, domain.ScheduleOptions{StartsAt: start})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(analysis.AtRisk, []string{"late"}) {
+		t.Errorf("Expected only late at risk, got %v", analysis.AtRisk)
+	}
+	for _, schedule := range analysis.Tasks {
+		if schedule.TaskID == "late" && (schedule.DueDateSlack == nil || *schedule.DueDateSlack != -2) {
+			t.Errorf("Expected late to miss its due date by 2 hours, got %v", schedule.DueDateSlack)
+		}
+	}
+}
+
+func TestAnalyzeCriticalPath_ProgressAndStartDates(t *testing.T) {
+	start := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	nextDay := start.Add(24 * time.Hour)
+
+	done := scheduledTask("done", 40)
+	done.Status = domain.StatusComplete
+	started := scheduledTask("started", 10, "done")
+	started.TimeSpent = 6
+	later := scheduledTask("later", 1)
+	later.StartDate = &nextDay
+	unestimated := &domain.Task{ID: "unestimated", Title: "Unestimated", Status: domain.StatusTodo}
+	archived := scheduledTask("archived", 100)
+	archived.Archived = true
+
+	analysis, err := domain.AnalyzeCriticalPath("project-1",
+		[]*domain.Task{done, started, later, unestimated, archived}, domain.ScheduleOptions{StartsAt: start})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	schedules := make(map[string]*domain.TaskSchedule)
+	for _, schedule := range analysis.Tasks {
+		schedules[schedule.TaskID] = schedule
+	}
+
+	if _, ok := schedules["archived"]; ok {
+		t.Error("Expected archived tasks to be left out")
+	}
+	if schedules["done"].Duration != 0 || schedules["started"].Duration != 4 {
+		t.Errorf("Expected remaining effort only, got done=%v started=%v",
+			schedules["done"].Duration, schedules["started"].Duration)
+	}
+	if schedules["later"].EarliestStart != 8 {
+		t.Errorf("Expected start date to delay later by one working day, got %v", schedules["later"].EarliestStart)
+	}
+	if !schedules["unestimated"].Unestimated {
+		t.Error("Expected task without estimate to be flagged")
+	}
+	if !reflect.DeepEqual(analysis.CriticalPath, []string{"later"}) {
+		t.Errorf("Expected critical path [later], got %v", analysis.CriticalPath)
+	}
+}
+
+func TestAnalyzeCriticalPath_Cycle(t *testing.T) {
+	tasks := []*domain.Task{
+		scheduledTask("a", 1, "c"),
+		scheduledTask("b", 1, "a"),
+		scheduledTask("c", 1, "b"),
+		scheduledTask("d", 1),
+	}
+
+	_, err := domain.AnalyzeCriticalPath("project-1", tasks, domain.ScheduleOptions{})
+
+	var domainErr *domain.Error
+	if !errors.As(err, &domainErr) || domainErr.Code != "CIRCULAR_DEPENDENCY" {
+		t.Fatalf("Expected CIRCULAR_DEPENDENCY, got %v", err)
+	}
+	if !reflect.DeepEqual(domainErr.Details["cycle"], []string{"a", "c", "b", "a"}) {
+		t.Errorf("Expected cycle a → c → b → a, got %v", domainErr.Details["cycle"])
+	}
+}
+
+func TestAnalyzeCriticalPath_InvalidHoursPerDay(t *testing.T) {
+	_, err := domain.AnalyzeCriticalPath("project-1", nil, domain.ScheduleOptions{HoursPerDay: 25})
+	if err == nil {
+		t.Fatal("Expected error for 25 hours per day")
+	}
+}
+
+func TestCriticalPathAnalysis_DOT(t *testing.T) {
+	tasks := []*domain.Task{
+		scheduledTask("a", 4),
+		scheduledTask("b", 2, "a"),
+		scheduledTask("c", 1, "a"),
+	}
+	tasks[1].Title = `Ship "v2"`
+
+	analysis, err := domain.AnalyzeCriticalPath("project-1", tasks, domain.ScheduleOptions{StartsAt: time.Now()})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	dot := analysis.DOT()
+	for _, expected := range []string{
+		"digraph critical_path {",
+		`"b" [label="Ship \"v2\"\n2h, slack 0h", color=red, penwidth=2];`,
+		`"a" -> "b" [color=red, penwidth=2];`,
+		`"a" -> "c";`,
+	} {
+		if !strings.Contains(dot, expected) {
+			t.Errorf("Expected DOT output to contain %q, got:\n%s", expected, dot)
+		}
+	}
+}
+
+func TestAnalyzeCriticalPath_CustomWorkflow(t *testing.T) {
+	workflow := &domain.Workflow{
+		Statuses: []domain.WorkflowStatus{
+			{ID: "open", Name: "Open"},
+			{ID: "shipped", Name: "Shipped", Done: true},
+		},
+	}
+
+	shipped := scheduledTask("shipped", 40)
+	shipped.Status = "shipped"
+	next := scheduledTask("next", 2, "shipped")
+	next.Status = "open"
+
+	analysis, err := domain.AnalyzeCriticalPath("project-1", []*domain.Task{shipped, next},
+		domain.ScheduleOptions{StartsAt: time.Now(), Workflow: workflow})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if analysis.Duration != 2 {
+		t.Errorf("Expected tasks in a done status to take no time, got %v hours", analysis.Duration)
+	}
+}
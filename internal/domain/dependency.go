@@ -0,0 +1,127 @@
+package domain
+
+import "strings"
+
+// MaxDependencyGraphSize bounds how many tasks a cycle search may visit
+const MaxDependencyGraphSize = 10000
+
+// CrossProjectDependencyPolicy controls whether tasks may depend on tasks of other projects
+type CrossProjectDependencyPolicy string
+
+const (
+	// CrossProjectDependenciesDeny only allows dependencies within the same project (default)
+	CrossProjectDependenciesDeny CrossProjectDependencyPolicy = "deny"
+	// CrossProjectDependenciesAllow allows dependencies on tasks of any project the user can access
+	CrossProjectDependenciesAllow CrossProjectDependencyPolicy = "allow"
+)
+
+// IsValid checks if the policy is one of the allowed values; empty means the default
+func (p CrossProjectDependencyPolicy) IsValid() bool {
+	return p == "" || p == CrossProjectDependenciesDeny || p == CrossProjectDependenciesAllow
+}
+
+// BlockingDependencies returns the IDs of the dependencies that are not done yet.
+// isDone decides per task, since each dependency's project has its own workflow.
+func BlockingDependencies(dependencies []*Task, isDone func(*Task) bool) []string {
+	var blocking []string
+	for _, dependency := range dependencies {
+		if !isDone(dependency) {
+			blocking = append(blocking, dependency.ID)
+		}
+	}
+	return blocking
+}
+
+// SetBlockedBy records the computed blocked state of the task
+func (t *Task) SetBlockedBy(dependencyIDs []string) {
+	t.BlockedBy = dependencyIDs
+	t.Blocked = len(dependencyIDs) > 0
+}
+
+// NewTaskBlockedError creates the conflict error for moving a blocked task forward
+func NewTaskBlockedError(blockedBy []string) *Error {
+	return &Error{
+		Type:    ConflictError,
+		Code:    "TASK_BLOCKED",
+		Message: "Task is blocked by incomplete dependencies: " + strings.Join(blockedBy, ", "),
+		Details: map[string]interface{}{
+			"blocked_by": blockedBy,
+		},
+	}
+}
+
+// DependencyLookup returns the IDs of the tasks a task depends on
+type DependencyLookup func(taskID string) ([]string, error)
+
+// FindDependencyCycle reports the cycle that making taskID depend on dependencyID
+// would create. The cycle is returned as a path that starts and ends with taskID,
+// e.g. [A, B, C, A]; it is nil when the new dependency is safe. The search is
+// breadth-first, so the shortest cycle is reported.
+func FindDependencyCycle(taskID, dependencyID string, lookup DependencyLookup) ([]string, error) {
+	if taskID == dependencyID {
+		return []string{taskID, taskID}, nil
+	}
+
+	// previous records how each visited task was reached, for path reconstruction
+	previous := map[string]string{dependencyID: ""}
+	queue := []string{dependencyID}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		next, err := lookup(current)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range next {
+			if _, seen := previous[id]; seen {
+				continue
+			}
+			previous[id] = current
+
+			if id == taskID {
+				return buildCyclePath(taskID, previous), nil
+			}
+
+			if len(previous) > MaxDependencyGraphSize {
+				return nil, NewValidationError("DEPENDENCY_GRAPH_TOO_LARGE",
+					"Dependency graph is too large to check for cycles", map[string]interface{}{
+						"max": MaxDependencyGraphSize,
+					})
+			}
+			queue = append(queue, id)
+		}
+	}
+
+	return nil, nil
+}
+
+// buildCyclePath walks previous back from taskID to the new dependency and
+// prepends taskID, giving taskID → dependency → ... → taskID
+func buildCyclePath(taskID string, previous map[string]string) []string {
+	reversed := []string{taskID}
+	for id := previous[taskID]; id != ""; id = previous[id] {
+		reversed = append(reversed, id)
+	}
+
+	path := make([]string, 0, len(reversed)+1)
+	path = append(path, taskID)
+	for i := len(reversed) - 1; i >= 0; i-- {
+		path = append(path, reversed[i])
+	}
+	return path
+}
+
+// NewDependencyCycleError creates the conflict error for a dependency that would close a cycle
+func NewDependencyCycleError(cycle []string) *Error {
+	return &Error{
+		Type:    ConflictError,
+		Code:    "CIRCULAR_DEPENDENCY",
+		Message: "Dependency would create a cycle: " + strings.Join(cycle, " → "),
+		Details: map[string]interface{}{
+			"cycle": cycle,
+		},
+	}
+}
@@ -0,0 +1,119 @@
+package domain_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+func TestFindDependencyCycle(t *testing.T) {
+	// Existing edges: a task maps to the tasks it depends on
+	graph := map[string][]string{
+		"b": {"c"},
+		"c": {"a", "d"},
+		"d": {"e"},
+		"x": {"y"},
+	}
+	lookup := func(taskID string) ([]string, error) {
+		return graph[taskID], nil
+	}
+
+	tests := []struct {
+		name         string
+		taskID       string
+		dependencyID string
+		expected     []string
+	}{
+		{name: "self dependency", taskID: "a", dependencyID: "a", expected: []string{"a", "a"}},
+		{name: "transitive cycle", taskID: "a", dependencyID: "b", expected: []string{"a", "b", "c", "a"}},
+		{name: "direct back edge", taskID: "c", dependencyID: "b", expected: []string{"c", "b", "c"}},
+		{name: "shared descendant is not a cycle", taskID: "e", dependencyID: "x", expected: nil},
+		{name: "longer chain back to task", taskID: "e", dependencyID: "b", expected: []string{"e", "b", "c", "d", "e"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cycle, err := domain.FindDependencyCycle(tt.taskID, tt.dependencyID, lookup)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(cycle, tt.expected) {
+				t.Errorf("Expected cycle %v, got %v", tt.expected, cycle)
+			}
+		})
+	}
+}
+
+func TestFindDependencyCycle_LookupError(t *testing.T) {
+	lookupErr := errors.New("database unavailable")
+	_, err := domain.FindDependencyCycle("a", "b", func(string) ([]string, error) {
+		return nil, lookupErr
+	})
+	if !errors.Is(err, lookupErr) {
+		t.Errorf("Expected lookup error, got %v", err)
+	}
+}
+
+func TestNewDependencyCycleError(t *testing.T) {
+	err := domain.NewDependencyCycleError([]string{"a", "b", "a"})
+
+	if err.Type != domain.ConflictError || err.Code != "CIRCULAR_DEPENDENCY" {
+		t.Errorf("Expected CIRCULAR_DEPENDENCY conflict, got %s %s", err.Type, err.Code)
+	}
+	if err.Message != "Dependency would create a cycle: a → b → a" {
+		t.Errorf("Expected cycle path in message, got %q", err.Message)
+	}
+}
+
+func TestProject_CrossProjectDependencyPolicy(t *testing.T) {
+	project := &domain.Project{Title: "P", Slug: "p", OwnerID: "u", Status: domain.ActiveProject}
+
+	if project.AllowsCrossProjectDependencies() {
+		t.Error("Cross-project dependencies should be denied by default")
+	}
+
+	project.Settings.CrossProjectDependencies = domain.CrossProjectDependenciesAllow
+	if !project.AllowsCrossProjectDependencies() {
+		t.Error("Expected cross-project dependencies to be allowed")
+	}
+
+	project.Settings.CrossProjectDependencies = "sometimes"
+	if err := project.Validate(); err == nil {
+		t.Error("Expected unknown policy to fail validation")
+	}
+}
+
+func TestBlockingDependencies(t *testing.T) {
+	task := &domain.Task{ID: "a"}
+	dependencies := []*domain.Task{
+		{ID: "b", Status: domain.StatusComplete},
+		{ID: "c", Status: domain.StatusReview},
+		{ID: "d", Status: domain.StatusBacklog},
+	}
+
+	workflow := domain.DefaultWorkflow()
+	isDone := func(dependency *domain.Task) bool { return workflow.IsDone(dependency.Status) }
+
+	task.SetBlockedBy(domain.BlockingDependencies(dependencies, isDone))
+	if !task.Blocked || !reflect.DeepEqual(task.BlockedBy, []string{"c", "d"}) {
+		t.Errorf("Expected task blocked by [c d], got %v %v", task.Blocked, task.BlockedBy)
+	}
+
+	task.SetBlockedBy(domain.BlockingDependencies(dependencies[:1], isDone))
+	if task.Blocked || len(task.BlockedBy) != 0 {
+		t.Errorf("Expected task unblocked once dependencies are complete, got %v", task.BlockedBy)
+	}
+}
+
+func TestNewTaskBlockedError(t *testing.T) {
+	err := domain.NewTaskBlockedError([]string{"b", "c"})
+
+	if err.Type != domain.ConflictError || err.Code != "TASK_BLOCKED" {
+		t.Errorf("Expected TASK_BLOCKED conflict, got %s %s", err.Type, err.Code)
+	}
+	if !reflect.DeepEqual(err.Details["blocked_by"], []string{"b", "c"}) {
+		t.Errorf("Expected blocking dependencies in details, got %v", err.Details)
+	}
+}
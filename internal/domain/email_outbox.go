@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Email outbox constraints
+const (
+	MaxEmailRetryDelay   = 6 * time.Hour // MaxEmailRetryDelay caps the backoff between delivery attempts
+	MaxEmailErrorLen     = 500           // MaxEmailErrorLen defines the maximum stored length of a delivery error
+	MaxEmailSubjectLen   = 200           // MaxEmailSubjectLen defines the maximum length for email subjects
+	emailErrorEllipsis   = "..."
+	emailBackoffMaxShift = 20
+)
+
+// EmailStatus represents the delivery state of an outbox email
+type EmailStatus string
+
+const (
+	// EmailPending means the email is waiting for its next delivery attempt
+	EmailPending EmailStatus = "pending"
+	// EmailSent means the email was accepted by the mail server
+	EmailSent EmailStatus = "sent"
+	// EmailFailed means every delivery attempt failed and the email was given up
+	EmailFailed EmailStatus = "failed"
+)
+
+// OutboxEmail is a rendered email waiting in, or delivered from, the email outbox.
+// Bodies may hold one-time links, so they are dropped once the email is sent or given up.
+type OutboxEmail struct {
+	// 8-byte aligned fields first
+	NextAttemptAt time.Time  `json:"next_attempt_at" db:"next_attempt_at"`
+	CreatedAt     time.Time  `json:"created_at" db:"created"`
+	UpdatedAt     time.Time  `json:"updated_at" db:"updated"`
+	SentAt        *time.Time `json:"sent_at,omitempty" db:"sent_at"`
+
+	// String fields
+	ID        string      `json:"id" db:"id"`
+	To        string      `json:"to" db:"to"`
+	Subject   string      `json:"subject" db:"subject"`
+	TextBody  string      `json:"-" db:"text_body"`
+	HTMLBody  string      `json:"-" db:"html_body"`
+	Template  string      `json:"template" db:"template"`
+	Status    EmailStatus `json:"status" db:"status"`
+	LastError string      `json:"last_error,omitempty" db:"last_error"`
+
+	// 4-byte aligned fields
+	Attempts    int `json:"attempts" db:"attempts"`
+	MaxAttempts int `json:"max_attempts" db:"max_attempts"`
+}
+
+// Validate performs comprehensive validation of the outbox email
+func (e *OutboxEmail) Validate() error {
+	if _, err := mail.ParseAddress(e.To); err != nil {
+		return NewValidationError("to", "A valid recipient address is required", nil)
+	}
+	if strings.TrimSpace(e.Subject) == "" || utf8.RuneCountInString(e.Subject) > MaxEmailSubjectLen {
+		return NewValidationError("subject", "Subject is required and must be at most 200 characters", nil)
+	}
+	if e.Status == EmailPending && e.TextBody == "" && e.HTMLBody == "" {
+		return NewValidationError("body", "A text or HTML body is required", nil)
+	}
+	if e.MaxAttempts <= 0 {
+		return NewValidationError("max_attempts", "Max attempts must be positive", nil)
+	}
+	switch e.Status {
+	case EmailPending, EmailSent, EmailFailed:
+	default:
+		return NewValidationError("status", "Invalid email status", nil)
+	}
+	return nil
+}
+
+// IsDue reports whether the email is waiting for a delivery attempt at the given time
+func (e *OutboxEmail) IsDue(now time.Time) bool {
+	return e.Status == EmailPending && !e.NextAttemptAt.After(now)
+}
+
+// MarkSent records a successful delivery
+func (e *OutboxEmail) MarkSent(now time.Time) {
+	e.Attempts++
+	e.Status = EmailSent
+	e.SentAt = &now
+	e.LastError = ""
+	e.UpdatedAt = now
+	e.clearBodies()
+}
+
+// MarkAttemptFailed records a failed delivery. The next attempt is scheduled after a delay that
+// starts at retryInterval and doubles with every attempt; after the last attempt the email is given up.
+func (e *OutboxEmail) MarkAttemptFailed(cause error, now time.Time, retryInterval time.Duration) {
+	e.Attempts++
+	e.LastError = truncateEmailError(cause.Error())
+	e.UpdatedAt = now
+
+	if e.Attempts >= e.MaxAttempts {
+		e.Status = EmailFailed
+		e.clearBodies()
+		return
+	}
+
+	shift := min(e.Attempts-1, emailBackoffMaxShift)
+	delay := retryInterval << shift
+	if delay <= 0 || delay > MaxEmailRetryDelay {
+		delay = MaxEmailRetryDelay
+	}
+	e.NextAttemptAt = now.Add(delay)
+}
+
+// clearBodies drops the rendered bodies once they are no longer needed
+func (e *OutboxEmail) clearBodies() {
+	e.TextBody = ""
+	e.HTMLBody = ""
+}
+
+// truncateEmailError keeps delivery errors within the stored length
+func truncateEmailError(message string) string {
+	if utf8.RuneCountInString(message) <= MaxEmailErrorLen {
+		return message
+	}
+	runes := []rune(message)
+	return string(runes[:MaxEmailErrorLen-len(emailErrorEllipsis)]) + emailErrorEllipsis
+}
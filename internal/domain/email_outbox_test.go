@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newPendingEmail(maxAttempts int, now time.Time) *OutboxEmail {
+	return &OutboxEmail{
+		ID:            "email-1",
+		To:            "ada@example.com",
+		Subject:       "Reset your password",
+		TextBody:      "https://example.com/reset-password?token=abc",
+		HTMLBody:      "<p>reset</p>",
+		Status:        EmailPending,
+		MaxAttempts:   maxAttempts,
+		NextAttemptAt: now,
+	}
+}
+
+func TestOutboxEmail_Validate(t *testing.T) {
+	now := time.Now().UTC()
+
+	tests := []struct {
+		modify  func(e *OutboxEmail)
+		name    string
+		field   string
+		wantErr bool
+	}{
+		{name: "valid email", modify: func(_ *OutboxEmail) {}},
+		{name: "invalid recipient", modify: func(e *OutboxEmail) { e.To = "not-an-address" }, wantErr: true, field: "to"},
+		{name: "empty subject", modify: func(e *OutboxEmail) { e.Subject = " " }, wantErr: true, field: "subject"},
+		{
+			name:    "subject too long",
+			modify:  func(e *OutboxEmail) { e.Subject = strings.Repeat("s", MaxEmailSubjectLen+1) },
+			wantErr: true,
+			field:   "subject",
+		},
+		{
+			name:    "pending without body",
+			modify:  func(e *OutboxEmail) { e.TextBody, e.HTMLBody = "", "" },
+			wantErr: true,
+			field:   "body",
+		},
+		{
+			name:   "sent without body",
+			modify: func(e *OutboxEmail) { e.TextBody, e.HTMLBody, e.Status = "", "", EmailSent },
+		},
+		{name: "no attempts", modify: func(e *OutboxEmail) { e.MaxAttempts = 0 }, wantErr: true, field: "max_attempts"},
+		{name: "unknown status", modify: func(e *OutboxEmail) { e.Status = "queued" }, wantErr: true, field: "status"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			email := newPendingEmail(3, now)
+			tt.modify(email)
+
+			err := email.Validate()
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.field)
+		})
+	}
+}
+
+func TestOutboxEmail_MarkAttemptFailed_BacksOffThenGivesUp(t *testing.T) {
+	now := time.Date(2025, 9, 2, 12, 0, 0, 0, time.UTC)
+	email := newPendingEmail(3, now)
+	cause := errors.New("connection refused")
+
+	email.MarkAttemptFailed(cause, now, time.Minute)
+	assert.Equal(t, EmailPending, email.Status)
+	assert.Equal(t, now.Add(time.Minute), email.NextAttemptAt)
+	assert.False(t, email.IsDue(now))
+	assert.True(t, email.IsDue(now.Add(time.Minute)))
+
+	email.MarkAttemptFailed(cause, now, time.Minute)
+	assert.Equal(t, now.Add(2*time.Minute), email.NextAttemptAt, "the delay doubles")
+
+	email.MarkAttemptFailed(cause, now, time.Minute)
+	assert.Equal(t, EmailFailed, email.Status)
+	assert.Equal(t, 3, email.Attempts)
+	assert.Equal(t, "connection refused", email.LastError)
+	assert.Empty(t, email.TextBody)
+	assert.Empty(t, email.HTMLBody)
+	assert.False(t, email.IsDue(now.Add(24*time.Hour)))
+}
+
+func TestOutboxEmail_MarkAttemptFailed_CapsDelayAndError(t *testing.T) {
+	now := time.Now().UTC()
+	email := newPendingEmail(100, now)
+	email.Attempts = 60
+
+	email.MarkAttemptFailed(errors.New(strings.Repeat("e", 2*MaxEmailErrorLen)), now, time.Hour)
+	assert.Equal(t, now.Add(MaxEmailRetryDelay), email.NextAttemptAt)
+	assert.Len(t, email.LastError, MaxEmailErrorLen)
+	assert.True(t, strings.HasSuffix(email.LastError, "..."))
+}
+
+func TestOutboxEmail_MarkSent(t *testing.T) {
+	now := time.Now().UTC()
+	email := newPendingEmail(3, now)
+	email.LastError = "timeout"
+
+	email.MarkSent(now)
+	assert.Equal(t, EmailSent, email.Status)
+	assert.Equal(t, 1, email.Attempts)
+	assert.Equal(t, &now, email.SentAt)
+	assert.Empty(t, email.LastError)
+	assert.Empty(t, email.TextBody, "one-time links are not kept after delivery")
+}
@@ -0,0 +1,25 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+func TestUserMFA_UseRecoveryCode(t *testing.T) {
+	mfa := &domain.UserMFA{}
+	mfa.SetRecoveryCodes([]string{"a1b2c-3d4e5", "f6a7b-8c9d0"})
+
+	if mfa.RecoveryCodes[0] == "a1b2c-3d4e5" {
+		t.Fatal("Expected recovery codes to be stored hashed")
+	}
+	if !mfa.UseRecoveryCode(" A1B2C3D4E5 ") {
+		t.Error("Expected codes to match regardless of case, dashes and spaces")
+	}
+	if mfa.UseRecoveryCode("a1b2c-3d4e5") {
+		t.Error("Expected a recovery code to work only once")
+	}
+	if len(mfa.RecoveryCodes) != 1 {
+		t.Errorf("Expected one code left, got %d", len(mfa.RecoveryCodes))
+	}
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxNotificationMessageLen caps the stored summary of a notification
+const MaxNotificationMessageLen = 500
+
+// NotificationKind identifies why a user was notified. Kinds double as the keys of
+// ProjectSettings.Notifications and, prefixed with "notification_", of UserPreferences.Preferences.
+type NotificationKind string
+
+const (
+	// NotificationAssigned is sent to the new assignee of a task
+	NotificationAssigned NotificationKind = "assigned"
+	// NotificationCommented is sent to the watchers of a task that was commented on
+	NotificationCommented NotificationKind = "commented"
+	// NotificationStatusChanged is sent to the watchers of a task whose status changed
+	NotificationStatusChanged NotificationKind = "status_changed"
+	// NotificationMentioned is sent to users @mentioned in a comment
+	NotificationMentioned NotificationKind = "mentioned"
+)
+
+// notificationPreferencePrefix prefixes a kind to form its UserPreferences key
+const notificationPreferencePrefix = "notification_"
+
+// NotificationsPreference is the UserPreferences key that turns every in-app notification off when "false"
+const NotificationsPreference = "notifications"
+
+// IsValid checks if the NotificationKind is one of the allowed values
+func (k NotificationKind) IsValid() bool {
+	switch k {
+	case NotificationAssigned, NotificationCommented, NotificationStatusChanged, NotificationMentioned:
+		return true
+	default:
+		return false
+	}
+}
+
+// PreferenceKey returns the UserPreferences key that turns this kind off when set to "false"
+func (k NotificationKind) PreferenceKey() string {
+	return notificationPreferencePrefix + string(k)
+}
+
+// EnabledForProject reports whether the project lets its members receive this kind; unset kinds are enabled
+func (k NotificationKind) EnabledForProject(settings ProjectSettings) bool {
+	enabled, ok := settings.Notifications[string(k)]
+	return !ok || enabled
+}
+
+// EnabledForUser reports whether the user's preferences let them receive this kind; unset kinds are enabled
+func (k NotificationKind) EnabledForUser(preferences UserPreferences) bool {
+	for _, key := range []string{NotificationsPreference, k.PreferenceKey()} {
+		if value, ok := preferences.Preferences[key]; ok && strings.EqualFold(strings.TrimSpace(value), "false") {
+			return false
+		}
+	}
+	return true
+}
+
+// Notification is an entry in a user's in-app notification inbox
+type Notification struct {
+	CreatedAt time.Time  `json:"created_at" db:"created"`
+	ReadAt    *time.Time `json:"read_at,omitempty" db:"read_at"`
+
+	ID        string           `json:"id" db:"id"`
+	UserID    string           `json:"user_id" db:"user"`       // the recipient
+	ProjectID string           `json:"project_id" db:"project"` // the project of the task
+	TaskID    string           `json:"task_id" db:"task_id"`
+	ActorID   string           `json:"actor_id" db:"actor"` // who triggered the notification
+	EventID   string           `json:"event_id" db:"event_id"`
+	Kind      NotificationKind `json:"kind" db:"kind"`
+	TaskTitle string           `json:"task_title" db:"task_title"`
+	Message   string           `json:"message" db:"message"`
+}
+
+// Validate ensures the notification has a recipient, a kind and a message
+func (n *Notification) Validate() error {
+	if strings.TrimSpace(n.UserID) == "" {
+		return NewValidationError("user_id", "Notification recipient is required", nil)
+	}
+	if strings.TrimSpace(n.ProjectID) == "" {
+		return NewValidationError("project_id", "Notification project is required", nil)
+	}
+	if !n.Kind.IsValid() {
+		return NewValidationError("kind", "Invalid notification kind", nil)
+	}
+	if strings.TrimSpace(n.Message) == "" {
+		return NewValidationError("message", "Notification message is required", nil)
+	}
+	if utf8.RuneCountInString(n.Message) > MaxNotificationMessageLen {
+		return NewValidationError("message", "Notification message is too long", nil)
+	}
+	return nil
+}
+
+// IsRead reports whether the recipient has read the notification
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+// MarkRead records when the notification was read. It reports false when it was already read.
+func (n *Notification) MarkRead(now time.Time) bool {
+	if n.IsRead() {
+		return false
+	}
+	readAt := now.UTC()
+	n.ReadAt = &readAt
+	return true
+}
@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationKind_EnabledForProject(t *testing.T) {
+	settings := ProjectSettings{Notifications: map[string]bool{
+		string(NotificationCommented): false,
+		string(NotificationAssigned):  true,
+	}}
+
+	assert.True(t, NotificationAssigned.EnabledForProject(settings))
+	assert.False(t, NotificationCommented.EnabledForProject(settings))
+	assert.True(t, NotificationMentioned.EnabledForProject(settings), "unset kinds are enabled")
+	assert.True(t, NotificationMentioned.EnabledForProject(ProjectSettings{}), "no settings enable every kind")
+}
+
+func TestNotificationKind_EnabledForUser(t *testing.T) {
+	tests := []struct {
+		preferences map[string]string
+		name        string
+		kind        NotificationKind
+		want        bool
+	}{
+		{name: "no preferences", kind: NotificationAssigned, want: true},
+		{
+			name:        "kind turned off",
+			preferences: map[string]string{"notification_status_changed": "false"},
+			kind:        NotificationStatusChanged,
+			want:        false,
+		},
+		{
+			name:        "other kind turned off",
+			preferences: map[string]string{"notification_status_changed": "false"},
+			kind:        NotificationMentioned,
+			want:        true,
+		},
+		{
+			name:        "everything turned off",
+			preferences: map[string]string{"notifications": " FALSE "},
+			kind:        NotificationMentioned,
+			want:        false,
+		},
+		{
+			name:        "explicitly on",
+			preferences: map[string]string{"notifications": "true", "notification_assigned": "true"},
+			kind:        NotificationAssigned,
+			want:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.kind.EnabledForUser(UserPreferences{Preferences: tt.preferences})
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNotification_Validate(t *testing.T) {
+	valid := func() *Notification {
+		return &Notification{
+			UserID:    "user-1",
+			ProjectID: "project-1",
+			Kind:      NotificationAssigned,
+			Message:   `Alice assigned you to "Ship it"`,
+		}
+	}
+
+	assert.NoError(t, valid().Validate())
+
+	noRecipient := valid()
+	noRecipient.UserID = " "
+	assert.Error(t, noRecipient.Validate())
+
+	unknownKind := valid()
+	unknownKind.Kind = "poked"
+	assert.Error(t, unknownKind.Validate())
+
+	noMessage := valid()
+	noMessage.Message = ""
+	assert.Error(t, noMessage.Validate())
+}
+
+func TestNotification_MarkRead(t *testing.T) {
+	notification := &Notification{}
+	now := time.Date(2025, 9, 5, 12, 0, 0, 0, time.UTC)
+
+	assert.False(t, notification.IsRead())
+	assert.True(t, notification.MarkRead(now))
+	assert.True(t, notification.IsRead())
+	assert.Equal(t, now, *notification.ReadAt)
+
+	assert.False(t, notification.MarkRead(now.Add(time.Hour)), "reading again changes nothing")
+	assert.Equal(t, now, *notification.ReadAt)
+}
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{name: "no mentions", content: "Looks good to me", want: nil},
+		{name: "single mention", content: "@alice can you review?", want: []string{"alice"}},
+		{name: "trailing punctuation", content: "Thanks @bob.smith.", want: []string{"bob.smith"}},
+		{name: "repeated mention", content: "@carol and @Carol again", want: []string{"carol"}},
+		{name: "several mentions", content: "cc @dave, @erin_1", want: []string{"dave", "erin_1"}},
+		{name: "email address", content: "mail frank@example.com", want: nil},
+		{name: "inside a word", content: "foo@bar and @@baz", want: nil},
+		{name: "too short", content: "@a", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseMentions(tt.content))
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// PersonalAccessTokenPrefix starts every personal access token, which tells them apart from JWTs
+// and makes leaked tokens easy to search for.
+const PersonalAccessTokenPrefix = "set_pat_"
+
+// personalAccessTokenHintLength is how many characters of a token are kept to tell tokens apart
+const personalAccessTokenHintLength = len(PersonalAccessTokenPrefix) + 6
+
+// TokenScope limits what a personal access token can do. Each scope includes the ones below it.
+type TokenScope string
+
+const (
+	// ScopeReadOnly tokens can only read
+	ScopeReadOnly TokenScope = "read"
+	// ScopeTasksWrite tokens can also create and change tasks, comments and attachments
+	ScopeTasksWrite TokenScope = "tasks:write"
+	// ScopeAdmin tokens can do anything the user can
+	ScopeAdmin TokenScope = "admin"
+)
+
+// tokenScopes lists the scopes from narrowest to broadest
+var tokenScopes = []TokenScope{ScopeReadOnly, ScopeTasksWrite, ScopeAdmin}
+
+// IsValid returns true if the scope is a known token scope
+func (s TokenScope) IsValid() bool {
+	return s.rank() >= 0
+}
+
+// Includes returns true if the scope allows everything the other scope does
+func (s TokenScope) Includes(other TokenScope) bool {
+	return s.IsValid() && other.IsValid() && s.rank() >= other.rank()
+}
+
+// rank returns the scope's position in tokenScopes, or -1 for an unknown scope
+func (s TokenScope) rank() int {
+	for i, scope := range tokenScopes {
+		if scope == s {
+			return i
+		}
+	}
+	return -1
+}
+
+// IsPersonalAccessToken returns true if the raw token looks like a personal access token
+func IsPersonalAccessToken(token string) bool {
+	return strings.HasPrefix(token, PersonalAccessTokenPrefix)
+}
+
+// PersonalAccessToken is a long-lived, user-managed API token for scripts and the CLI.
+// Only its hash is stored, so the token itself is only known when it is created.
+type PersonalAccessToken struct {
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"` // nil for tokens that don't expire
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	ID         string     `json:"id"`
+	UserID     string     `json:"user_id"`
+	Name       string     `json:"name"`
+	Hint       string     `json:"hint"`            // the start of the token, to tell tokens apart
+	Token      string     `json:"token,omitempty"` // the raw token, only known when it is created
+	Scope      TokenScope `json:"scope"`
+}
+
+// TokenHint returns the start of a raw token that is kept to tell tokens apart
+func TokenHint(token string) string {
+	if len(token) <= personalAccessTokenHintLength {
+		return token
+	}
+	return token[:personalAccessTokenHintLength]
+}
+
+// IsExpired checks if the token has expired
+func (t *PersonalAccessToken) IsExpired() bool {
+	return t.ExpiresAt != nil && time.Now().UTC().After(*t.ExpiresAt)
+}
+
+// IsActive checks the token hasn't expired or been revoked
+func (t *PersonalAccessToken) IsActive() bool {
+	return t.RevokedAt == nil && !t.IsExpired()
+}
+
+// Revoke marks the token revoked
+func (t *PersonalAccessToken) Revoke() {
+	now := time.Now().UTC()
+	t.RevokedAt = &now
+	t.UpdatedAt = now
+}
+
+// Validate performs domain validation on the token
+func (t *PersonalAccessToken) Validate() error {
+	if t.UserID == "" {
+		return NewValidationError("INVALID_USER_ID", "User ID is required", map[string]interface{}{
+			"field": "user_id",
+		})
+	}
+	if strings.TrimSpace(t.Name) == "" || len(t.Name) > 100 {
+		return NewValidationError("INVALID_TOKEN_NAME", "Name must be between 1 and 100 characters",
+			map[string]interface{}{"field": "name"})
+	}
+	if !t.Scope.IsValid() {
+		return NewValidationError("INVALID_TOKEN_SCOPE", "Scope must be 'read', 'tasks:write' or 'admin'",
+			map[string]interface{}{"field": "scope"})
+	}
+	return nil
+}
+
+// CreatePersonalAccessTokenRequest represents the data needed to create a personal access token
+type CreatePersonalAccessTokenRequest struct {
+	Name          string     `json:"name" binding:"required"`
+	Scope         TokenScope `json:"scope" binding:"required"`
+	ExpiresInDays int        `json:"expires_in_days,omitempty"` // 0 for a token that doesn't expire
+}
+
+// Validate validates the create token request
+func (r *CreatePersonalAccessTokenRequest) Validate() error {
+	r.Name = strings.TrimSpace(r.Name)
+	if r.Name == "" || len(r.Name) > 100 {
+		return NewValidationError("INVALID_TOKEN_NAME", "Name must be between 1 and 100 characters",
+			map[string]interface{}{"field": "name"})
+	}
+	if !r.Scope.IsValid() {
+		return NewValidationError("INVALID_TOKEN_SCOPE", "Scope must be 'read', 'tasks:write' or 'admin'",
+			map[string]interface{}{"field": "scope"})
+	}
+	if r.ExpiresInDays < 0 || r.ExpiresInDays > 366 {
+		return NewValidationError("INVALID_EXPIRY", "Tokens can expire in at most 366 days",
+			map[string]interface{}{"field": "expires_in_days"})
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+func TestTokenScope_Includes(t *testing.T) {
+	tests := []struct {
+		scope, other domain.TokenScope
+		want         bool
+	}{
+		{domain.ScopeAdmin, domain.ScopeTasksWrite, true},
+		{domain.ScopeTasksWrite, domain.ScopeReadOnly, true},
+		{domain.ScopeTasksWrite, domain.ScopeTasksWrite, true},
+		{domain.ScopeReadOnly, domain.ScopeTasksWrite, false},
+		{domain.ScopeTasksWrite, domain.ScopeAdmin, false},
+		{domain.TokenScope("write"), domain.ScopeReadOnly, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.scope.Includes(tt.other); got != tt.want {
+			t.Errorf("%q.Includes(%q) = %v, want %v", tt.scope, tt.other, got, tt.want)
+		}
+	}
+}
+
+func TestCreatePersonalAccessTokenRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     domain.CreatePersonalAccessTokenRequest
+		wantErr bool
+	}{
+		{name: "valid", req: domain.CreatePersonalAccessTokenRequest{Name: "CI", Scope: domain.ScopeReadOnly}},
+		{
+			name:    "blank name",
+			req:     domain.CreatePersonalAccessTokenRequest{Name: " ", Scope: domain.ScopeAdmin},
+			wantErr: true,
+		},
+		{name: "unknown scope", req: domain.CreatePersonalAccessTokenRequest{Name: "CI", Scope: "write"}, wantErr: true},
+		{
+			name:    "negative expiry",
+			req:     domain.CreatePersonalAccessTokenRequest{Name: "CI", Scope: domain.ScopeAdmin, ExpiresInDays: -1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPersonalAccessToken_IsActive(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Hour)
+
+	if !(&domain.PersonalAccessToken{}).IsActive() {
+		t.Error("Expected a token without an expiry to be active")
+	}
+	if !(&domain.PersonalAccessToken{ExpiresAt: &future}).IsActive() {
+		t.Error("Expected an unexpired token to be active")
+	}
+	if (&domain.PersonalAccessToken{ExpiresAt: &past}).IsActive() {
+		t.Error("Expected an expired token to be inactive")
+	}
+
+	token := &domain.PersonalAccessToken{}
+	token.Revoke()
+	if token.IsActive() {
+		t.Error("Expected a revoked token to be inactive")
+	}
+}
@@ -18,11 +18,14 @@ const (
 
 // ProjectSettings represents project-specific settings.
 type ProjectSettings struct {
-	CustomFields   map[string]string `json:"custom_fields"`
-	Notifications  map[string]bool   `json:"notifications"`
-	IsPrivate      bool              `json:"is_private"`
-	AllowGuestView bool              `json:"allow_guest_view"`
-	EnableComments bool              `json:"enable_comments"`
+	CustomFields             map[string]string            `json:"custom_fields"`
+	Notifications            map[string]bool              `json:"notifications"`
+	CrossProjectDependencies CrossProjectDependencyPolicy `json:"cross_project_dependencies,omitempty"` // empty means deny
+	Workflow                 *Workflow                    `json:"workflow,omitempty"`                   // nil means the default
+	IsPrivate                bool                         `json:"is_private"`
+	AllowGuestView           bool                         `json:"allow_guest_view"`
+	EnableComments           bool                         `json:"enable_comments"`
+	RequireMFA               bool                         `json:"require_mfa"` // members need two-factor authentication
 }
 
 // Project represents a project in the system following DDD principles.
@@ -41,6 +44,8 @@ type Project struct {
 	Status      ProjectStatus   `json:"status"`
 	MemberIDs   []string        `json:"member_ids"`
 	Members     []User          `json:"members,omitempty"`
+	// MemberRoles holds each member's role; members without an entry are ProjectMember
+	MemberRoles map[string]ProjectRole `json:"member_roles,omitempty"`
 }
 
 // IsOwner returns true if the given user is the owner of the project.
@@ -63,6 +68,54 @@ func (p *Project) HasAccess(userID string) bool {
 	return p.IsOwner(userID) || p.IsMember(userID) || (!p.Settings.IsPrivate && p.Settings.AllowGuestView)
 }
 
+// RoleOf returns the user's role in the project. The owner is always ProjectOwner, and guests
+// are viewers of public projects that allow guest viewing. ok is false when the user has no role.
+func (p *Project) RoleOf(userID string) (role ProjectRole, ok bool) {
+	switch {
+	case p.IsOwner(userID):
+		return ProjectOwner, true
+	case p.IsMember(userID):
+		if role, ok := p.MemberRoles[userID]; ok && role.IsValid() {
+			return role, true
+		}
+		return ProjectMember, true
+	case !p.Settings.IsPrivate && p.Settings.AllowGuestView:
+		return ProjectViewer, true
+	}
+	return "", false
+}
+
+// Can returns true if the user's role in the project holds the permission.
+func (p *Project) Can(userID string, permission Permission) bool {
+	role, ok := p.RoleOf(userID)
+	return ok && role.Can(permission)
+}
+
+// SetMemberRole changes a member's role; it does nothing for users who aren't members.
+func (p *Project) SetMemberRole(userID string, role ProjectRole) {
+	if !p.IsMember(userID) || p.IsOwner(userID) {
+		return
+	}
+	if p.MemberRoles == nil {
+		p.MemberRoles = make(map[string]ProjectRole)
+	}
+	p.MemberRoles[userID] = role
+	p.UpdatedAt = time.Now()
+}
+
+// AllowsCrossProjectDependencies returns true if the project's tasks may depend on other projects' tasks.
+func (p *Project) AllowsCrossProjectDependencies() bool {
+	return p.Settings.CrossProjectDependencies == CrossProjectDependenciesAllow
+}
+
+// Workflow returns the project's workflow, falling back to the default one
+func (p *Project) Workflow() *Workflow {
+	if p.Settings.Workflow != nil {
+		return p.Settings.Workflow
+	}
+	return DefaultWorkflow()
+}
+
 // AddMember adds a member to the project if not already a member.
 func (p *Project) AddMember(userID string) {
 	if !p.IsMember(userID) && !p.IsOwner(userID) {
@@ -76,6 +129,7 @@ func (p *Project) RemoveMember(userID string) {
 	for i, memberID := range p.MemberIDs {
 		if memberID == userID {
 			p.MemberIDs = append(p.MemberIDs[:i], p.MemberIDs[i+1:]...)
+			delete(p.MemberRoles, userID)
 			p.UpdatedAt = time.Now()
 			break
 		}
@@ -101,6 +155,25 @@ func (p *Project) Validate() error {
 		return err
 	}
 
+	if !p.Settings.CrossProjectDependencies.IsValid() {
+		return NewValidationError("INVALID_DEPENDENCY_POLICY",
+			"Cross-project dependency policy must be 'allow' or 'deny'", map[string]interface{}{
+				"field": "settings.cross_project_dependencies",
+			})
+	}
+
+	if p.Settings.Workflow != nil {
+		if err := p.Settings.Workflow.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, role := range p.MemberRoles {
+		if err := ValidateAssignableRole(role); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,189 @@
+package domain
+
+import (
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// InvitationStatus is the state of a project invitation
+type InvitationStatus string
+
+const (
+	// InvitationPending invitations can still be accepted
+	InvitationPending InvitationStatus = "pending"
+	// InvitationAccepted invitations have been used up
+	InvitationAccepted InvitationStatus = "accepted"
+	// InvitationDeclined invitations were turned down by the invited user
+	InvitationDeclined InvitationStatus = "declined"
+	// InvitationRevoked invitations were withdrawn before they were used up
+	InvitationRevoked InvitationStatus = "revoked"
+)
+
+const (
+	// DefaultInvitationExpiry is how long an invitation lasts when no expiry is given
+	DefaultInvitationExpiry = 7 * 24 * time.Hour
+	// MaxInvitationExpiry is the longest an invitation can last
+	MaxInvitationExpiry = 30 * 24 * time.Hour
+)
+
+// ProjectInvitation invites people to join a project with a role. An invitation either names
+// the email address it was sent to and can be used once, or is a shareable link that can be
+// used up to MaxUses times.
+type ProjectInvitation struct {
+	ExpiresAt time.Time        `json:"expires_at"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
+	ID        string           `json:"id"`
+	ProjectID string           `json:"project_id"`
+	InviterID string           `json:"inviter_id"`
+	Email     string           `json:"email,omitempty"` // empty for link invitations
+	Token     string           `json:"token,omitempty"` // the raw token, only known when the invitation is created
+	Role      ProjectRole      `json:"role"`
+	Status    InvitationStatus `json:"status"`
+	MaxUses   int              `json:"max_uses"`
+	UseCount  int              `json:"use_count"`
+}
+
+// IsEmailInvitation returns true if the invitation was sent to a specific email address
+func (i *ProjectInvitation) IsEmailInvitation() bool {
+	return i.Email != ""
+}
+
+// IsExpired checks if the invitation has expired
+func (i *ProjectInvitation) IsExpired() bool {
+	return time.Now().UTC().After(i.ExpiresAt)
+}
+
+// CanBeAccepted checks the invitation is pending, unexpired and has uses left
+func (i *ProjectInvitation) CanBeAccepted() bool {
+	return i.Status == InvitationPending && !i.IsExpired() && i.UseCount < i.MaxUses
+}
+
+// IsFor checks an email invitation was sent to the email address
+func (i *ProjectInvitation) IsFor(email string) bool {
+	return i.IsEmailInvitation() && strings.EqualFold(i.Email, strings.TrimSpace(email))
+}
+
+// RecordUse counts a use of the invitation, marking it accepted once every use is taken
+func (i *ProjectInvitation) RecordUse() {
+	i.UseCount++
+	if i.UseCount >= i.MaxUses {
+		i.Status = InvitationAccepted
+	}
+	i.UpdatedAt = time.Now().UTC()
+}
+
+// Decline marks the invitation declined
+func (i *ProjectInvitation) Decline() {
+	i.Status = InvitationDeclined
+	i.UpdatedAt = time.Now().UTC()
+}
+
+// Revoke marks the invitation revoked
+func (i *ProjectInvitation) Revoke() {
+	i.Status = InvitationRevoked
+	i.UpdatedAt = time.Now().UTC()
+}
+
+// Validate performs domain validation on the invitation
+func (i *ProjectInvitation) Validate() error {
+	if i.ProjectID == "" {
+		return NewValidationError("INVALID_PROJECT_ID", "Project ID is required", map[string]interface{}{
+			"field": "project_id",
+		})
+	}
+	if i.InviterID == "" {
+		return NewValidationError("INVALID_INVITER_ID", "Inviter ID is required", map[string]interface{}{
+			"field": "inviter_id",
+		})
+	}
+	if err := ValidateAssignableRole(i.Role); err != nil {
+		return err
+	}
+	if i.MaxUses < 1 {
+		return NewValidationError("INVALID_MAX_USES", "An invitation must allow at least one use",
+			map[string]interface{}{"field": "max_uses"})
+	}
+	if i.IsEmailInvitation() && i.MaxUses != 1 {
+		return NewValidationError("INVALID_MAX_USES", "Email invitations can only be used once",
+			map[string]interface{}{"field": "max_uses"})
+	}
+	if i.ExpiresAt.IsZero() {
+		return NewValidationError("INVALID_EXPIRY", "Expiry is required", map[string]interface{}{
+			"field": "expires_at",
+		})
+	}
+	switch i.Status {
+	case InvitationPending, InvitationAccepted, InvitationDeclined, InvitationRevoked:
+	default:
+		return NewValidationError("INVALID_INVITATION_STATUS", "Invalid invitation status",
+			map[string]interface{}{"field": "status"})
+	}
+	return nil
+}
+
+// CreateInvitationRequest represents the data needed to invite people to a project. With an
+// email the invitation is sent to that address; without one it is a shareable link.
+type CreateInvitationRequest struct {
+	Email          string      `json:"email,omitempty"`
+	Role           ProjectRole `json:"role,omitempty"`             // defaults to ProjectMember
+	MaxUses        int         `json:"max_uses,omitempty"`         // link invitations only; defaults to 1
+	ExpiresInHours int         `json:"expires_in_hours,omitempty"` // defaults to DefaultInvitationExpiry
+}
+
+// Validate validates the create invitation request, filling in the defaults
+func (r *CreateInvitationRequest) Validate() error {
+	r.Email = strings.TrimSpace(r.Email)
+	if r.Email != "" {
+		if _, err := mail.ParseAddress(r.Email); err != nil {
+			return NewValidationError("INVALID_EMAIL", "Invalid email address", map[string]interface{}{
+				"field": "email",
+			})
+		}
+		if r.MaxUses > 1 {
+			return NewValidationError("INVALID_MAX_USES", "Email invitations can only be used once",
+				map[string]interface{}{"field": "max_uses"})
+		}
+	}
+	if r.MaxUses < 0 {
+		return NewValidationError("INVALID_MAX_USES", "Max uses cannot be negative", map[string]interface{}{
+			"field": "max_uses",
+		})
+	}
+	if r.MaxUses == 0 {
+		r.MaxUses = 1
+	}
+
+	if r.Role == "" {
+		r.Role = ProjectMember
+	}
+	if err := ValidateAssignableRole(r.Role); err != nil {
+		return err
+	}
+
+	expiresIn := time.Duration(r.ExpiresInHours) * time.Hour
+	if r.ExpiresInHours < 0 || expiresIn > MaxInvitationExpiry {
+		return NewValidationError("INVALID_EXPIRY", "Invitations can last at most 30 days",
+			map[string]interface{}{"field": "expires_in_hours"})
+	}
+	return nil
+}
+
+// ExpiresIn returns how long the requested invitation lasts
+func (r *CreateInvitationRequest) ExpiresIn() time.Duration {
+	if r.ExpiresInHours == 0 {
+		return DefaultInvitationExpiry
+	}
+	return time.Duration(r.ExpiresInHours) * time.Hour
+}
+
+// RegisterWithInvitationRequest creates an account for someone accepting an invitation.
+// Email invitations register the invited address, so Email is only needed for link invitations.
+type RegisterWithInvitationRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Email    string `json:"email,omitempty"`
+	Username string `json:"username" binding:"required,min=3,max=50"`
+	Name     string `json:"name" binding:"required,min=1,max=100"`
+	Password string `json:"password" binding:"required,min=8"`
+}
@@ -0,0 +1,75 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+func TestCreateInvitationRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     domain.CreateInvitationRequest
+		wantErr bool
+	}{
+		{name: "link with defaults", req: domain.CreateInvitationRequest{}},
+		{name: "multi-use link", req: domain.CreateInvitationRequest{MaxUses: 25, Role: domain.ProjectViewer}},
+		{name: "email", req: domain.CreateInvitationRequest{Email: " ada@example.com "}},
+		{name: "invalid email", req: domain.CreateInvitationRequest{Email: "ada"}, wantErr: true},
+		{name: "multi-use email", req: domain.CreateInvitationRequest{Email: "ada@example.com", MaxUses: 2}, wantErr: true},
+		{name: "negative uses", req: domain.CreateInvitationRequest{MaxUses: -1}, wantErr: true},
+		{name: "owner role", req: domain.CreateInvitationRequest{Role: domain.ProjectOwner}, wantErr: true},
+		{name: "too long", req: domain.CreateInvitationRequest{ExpiresInHours: 24*30 + 1}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		req := domain.CreateInvitationRequest{Email: " ada@example.com "}
+		if err := req.Validate(); err != nil {
+			t.Fatal(err)
+		}
+		if req.Email != "ada@example.com" || req.Role != domain.ProjectMember || req.MaxUses != 1 {
+			t.Errorf("Unexpected defaults: %+v", req)
+		}
+		if req.ExpiresIn() != domain.DefaultInvitationExpiry {
+			t.Errorf("Expected the default expiry, got %v", req.ExpiresIn())
+		}
+	})
+}
+
+func TestProjectInvitation_RecordUse(t *testing.T) {
+	invitation := &domain.ProjectInvitation{
+		Status:    domain.InvitationPending,
+		MaxUses:   2,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	invitation.RecordUse()
+	if !invitation.CanBeAccepted() {
+		t.Fatal("Expected a use to be left")
+	}
+
+	invitation.RecordUse()
+	if invitation.CanBeAccepted() || invitation.Status != domain.InvitationAccepted {
+		t.Errorf("Expected the invitation to be used up, got %q", invitation.Status)
+	}
+}
+
+func TestProjectInvitation_IsFor(t *testing.T) {
+	invitation := &domain.ProjectInvitation{Email: "Ada@Example.com"}
+	if !invitation.IsFor("ada@example.com") {
+		t.Error("Expected addresses to match regardless of case")
+	}
+	if (&domain.ProjectInvitation{}).IsFor("") {
+		t.Error("Expected link invitations not to be for anyone")
+	}
+}
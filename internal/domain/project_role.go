@@ -0,0 +1,101 @@
+package domain
+
+// ProjectRole is a user's role within a project. Each role holds the permissions of the roles
+// below it plus its own.
+type ProjectRole string
+
+const (
+	// ProjectViewer can read the project and its tasks.
+	ProjectViewer ProjectRole = "viewer"
+	// ProjectCommenter can also comment on tasks.
+	ProjectCommenter ProjectRole = "commenter"
+	// ProjectMember can also create and edit tasks, and delete the tasks they reported.
+	ProjectMember ProjectRole = "member"
+	// ProjectMaintainer can also delete any task and manage members, integrations and WIP limits.
+	ProjectMaintainer ProjectRole = "maintainer"
+	// ProjectOwner can also change the project's settings and delete it. Only the project's
+	// owner holds this role; it can't be given to members.
+	ProjectOwner ProjectRole = "owner"
+)
+
+// Permission is something a project role allows.
+type Permission string
+
+// Project permissions
+const (
+	PermissionViewProject        Permission = "project.view"
+	PermissionComment            Permission = "tasks.comment"
+	PermissionCreateTasks        Permission = "tasks.create"
+	PermissionEditTasks          Permission = "tasks.edit"
+	PermissionDeleteOwnTasks     Permission = "tasks.delete_own" // tasks the user reported
+	PermissionDeleteTasks        Permission = "tasks.delete"
+	PermissionManageMembers      Permission = "members.manage"
+	PermissionManageIntegrations Permission = "integrations.manage"
+	PermissionManageWIPLimits    Permission = "wip_limits.manage"
+	PermissionManageProject      Permission = "project.manage"
+	PermissionDeleteProject      Permission = "project.delete"
+)
+
+// projectRoles lists the roles from least to most privileged
+var projectRoles = []ProjectRole{ProjectViewer, ProjectCommenter, ProjectMember, ProjectMaintainer, ProjectOwner}
+
+// rolePermissions lists the permissions each role adds to the ones below it
+var rolePermissions = map[ProjectRole][]Permission{
+	ProjectViewer:    {PermissionViewProject},
+	ProjectCommenter: {PermissionComment},
+	ProjectMember:    {PermissionCreateTasks, PermissionEditTasks, PermissionDeleteOwnTasks},
+	ProjectMaintainer: {
+		PermissionDeleteTasks, PermissionManageMembers, PermissionManageIntegrations, PermissionManageWIPLimits,
+	},
+	ProjectOwner: {PermissionManageProject, PermissionDeleteProject},
+}
+
+// IsValid returns true if the role is a known project role
+func (r ProjectRole) IsValid() bool {
+	return r.rank() >= 0
+}
+
+// IsAssignable returns true if the role can be given to a project member
+func (r ProjectRole) IsAssignable() bool {
+	return r.IsValid() && r != ProjectOwner
+}
+
+// Permissions returns every permission the role holds
+func (r ProjectRole) Permissions() []Permission {
+	var permissions []Permission
+	for _, role := range projectRoles[:r.rank()+1] {
+		permissions = append(permissions, rolePermissions[role]...)
+	}
+	return permissions
+}
+
+// Can returns true if the role holds the permission
+func (r ProjectRole) Can(permission Permission) bool {
+	for _, held := range r.Permissions() {
+		if held == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// rank returns the role's position in projectRoles, or -1 for an unknown role
+func (r ProjectRole) rank() int {
+	for i, role := range projectRoles {
+		if role == r {
+			return i
+		}
+	}
+	return -1
+}
+
+// ValidateAssignableRole checks that a role can be given to a project member
+func ValidateAssignableRole(role ProjectRole) error {
+	if !role.IsAssignable() {
+		return NewValidationError("INVALID_PROJECT_ROLE",
+			"Role must be 'viewer', 'commenter', 'member' or 'maintainer'", map[string]interface{}{
+				"field": "role",
+			})
+	}
+	return nil
+}
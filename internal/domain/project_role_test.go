@@ -0,0 +1,102 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+func TestProject_RoleOf(t *testing.T) {
+	project := &domain.Project{
+		OwnerID:     "owner",
+		MemberIDs:   []string{"owner", "alice", "bob"},
+		MemberRoles: map[string]domain.ProjectRole{"alice": domain.ProjectViewer},
+		Settings:    domain.ProjectSettings{IsPrivate: true},
+	}
+
+	tests := []struct {
+		name     string
+		userID   string
+		expected domain.ProjectRole // empty when the user has no role
+	}{
+		{name: "owner", userID: "owner", expected: domain.ProjectOwner},
+		{name: "member with a role", userID: "alice", expected: domain.ProjectViewer},
+		{name: "member without a role", userID: "bob", expected: domain.ProjectMember},
+		{name: "outsider", userID: "carol"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			role, ok := project.RoleOf(tt.userID)
+			if ok != (tt.expected != "") || role != tt.expected {
+				t.Errorf("Expected %q, got %q (ok=%v)", tt.expected, role, ok)
+			}
+		})
+	}
+
+	t.Run("guests view public projects", func(t *testing.T) {
+		public := *project
+		public.Settings = domain.ProjectSettings{AllowGuestView: true}
+		if role, ok := public.RoleOf("carol"); !ok || role != domain.ProjectViewer {
+			t.Errorf("Expected a guest to be a viewer, got %q (ok=%v)", role, ok)
+		}
+	})
+
+	t.Run("removing a member drops their role", func(t *testing.T) {
+		removed := *project
+		removed.MemberIDs = append([]string(nil), project.MemberIDs...)
+		removed.MemberRoles = map[string]domain.ProjectRole{"alice": domain.ProjectViewer}
+		removed.RemoveMember("alice")
+		if _, ok := removed.MemberRoles["alice"]; ok {
+			t.Error("Expected the removed member's role to be gone")
+		}
+	})
+}
+
+func TestProjectRole_Can(t *testing.T) {
+	tests := []struct {
+		role       domain.ProjectRole
+		permission domain.Permission
+		expected   bool
+	}{
+		{domain.ProjectViewer, domain.PermissionViewProject, true},
+		{domain.ProjectViewer, domain.PermissionComment, false},
+		{domain.ProjectCommenter, domain.PermissionComment, true},
+		{domain.ProjectCommenter, domain.PermissionCreateTasks, false},
+		{domain.ProjectMember, domain.PermissionEditTasks, true},
+		{domain.ProjectMember, domain.PermissionDeleteOwnTasks, true},
+		{domain.ProjectMember, domain.PermissionDeleteTasks, false},
+		{domain.ProjectMaintainer, domain.PermissionManageMembers, true},
+		{domain.ProjectMaintainer, domain.PermissionManageWIPLimits, true},
+		{domain.ProjectMaintainer, domain.PermissionDeleteProject, false},
+		{domain.ProjectOwner, domain.PermissionDeleteProject, true},
+		{domain.ProjectRole("admin"), domain.PermissionViewProject, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role)+" "+string(tt.permission), func(t *testing.T) {
+			if got := tt.role.Can(tt.permission); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestProject_ValidateMemberRoles(t *testing.T) {
+	project := &domain.Project{
+		Title:       "Project",
+		Slug:        "project",
+		OwnerID:     "owner",
+		Status:      domain.ActiveProject,
+		MemberIDs:   []string{"alice"},
+		MemberRoles: map[string]domain.ProjectRole{"alice": domain.ProjectMaintainer},
+	}
+	if err := project.Validate(); err != nil {
+		t.Fatalf("Expected a maintainer to be valid, got %v", err)
+	}
+
+	project.MemberRoles["alice"] = domain.ProjectOwner
+	if err := project.Validate(); err == nil {
+		t.Error("Expected the owner role to be rejected for a member")
+	}
+}
@@ -0,0 +1,463 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxRecurrenceInterval bounds the N of "every N days/weeks/months"
+const MaxRecurrenceInterval = 365
+
+// RecurrenceFrequency is the unit a recurring task repeats in
+type RecurrenceFrequency string
+
+// Recurrence frequencies
+const (
+	RecurrenceDaily   RecurrenceFrequency = "daily"
+	RecurrenceWeekly  RecurrenceFrequency = "weekly"
+	RecurrenceMonthly RecurrenceFrequency = "monthly"
+)
+
+// IsValid checks if the RecurrenceFrequency is one of the allowed values
+func (f RecurrenceFrequency) IsValid() bool {
+	switch f {
+	case RecurrenceDaily, RecurrenceWeekly, RecurrenceMonthly:
+		return true
+	default:
+		return false
+	}
+}
+
+// recurrenceWeekdays are the RRULE weekday codes in the order weeks are walked, Monday first
+var recurrenceWeekdays = []string{"MO", "TU", "WE", "TH", "FR", "SA", "SU"}
+
+// RecurrenceRule describes when a recurring task repeats, after RFC 5545 RRULEs: every Interval
+// days, weeks or months, counted from the series' first occurrence. Weekly rules may pick the
+// weekdays, monthly rules the day of the month; both default to those of the first occurrence.
+// Occurrences keep the time of day of the first one.
+type RecurrenceRule struct {
+	Until     *time.Time          `json:"until,omitempty"` // no occurrences after this time
+	Frequency RecurrenceFrequency `json:"frequency"`
+	Weekdays  []string            `json:"weekdays,omitempty"`  // RRULE codes: MO, TU, WE, TH, FR, SA, SU
+	Interval  int                 `json:"interval,omitempty"`  // defaults to 1
+	MonthDay  int                 `json:"month_day,omitempty"` // 1-31; shorter months use their last day
+	Count     int                 `json:"count,omitempty"`     // total occurrences, 0 for no limit
+}
+
+// Validate checks the rule is complete and its parts fit its frequency
+func (r *RecurrenceRule) Validate() error {
+	if !r.Frequency.IsValid() {
+		return newRecurrenceError("Recurrence frequency must be daily, weekly or monthly",
+			map[string]interface{}{"frequency": string(r.Frequency)})
+	}
+	if r.Interval < 0 || r.Interval > MaxRecurrenceInterval {
+		return newRecurrenceError(fmt.Sprintf("Recurrence interval must be between 1 and %d", MaxRecurrenceInterval),
+			map[string]interface{}{"interval": r.Interval})
+	}
+	if r.Count < 0 {
+		return newRecurrenceError("Recurrence count cannot be negative", map[string]interface{}{"count": r.Count})
+	}
+
+	if len(r.Weekdays) > 0 && r.Frequency != RecurrenceWeekly {
+		return newRecurrenceError("Weekdays can only be set on weekly recurrences", nil)
+	}
+	for i, day := range r.Weekdays {
+		if !slices.Contains(recurrenceWeekdays, day) || slices.Contains(r.Weekdays[:i], day) {
+			return newRecurrenceError("Weekdays must be distinct RRULE codes (MO, TU, WE, TH, FR, SA, SU)",
+				map[string]interface{}{"weekday": day})
+		}
+	}
+
+	if r.MonthDay != 0 && r.Frequency != RecurrenceMonthly {
+		return newRecurrenceError("A day of the month can only be set on monthly recurrences", nil)
+	}
+	if r.MonthDay < 0 || r.MonthDay > 31 {
+		return newRecurrenceError("Day of the month must be between 1 and 31",
+			map[string]interface{}{"month_day": r.MonthDay})
+	}
+
+	return nil
+}
+
+// Next returns the first occurrence strictly after the given time, for a series whose first
+// occurrence is start. It reports false when the rule ends before then. Count is left to the
+// series, which knows how many occurrences it has created.
+func (r *RecurrenceRule) Next(start, after time.Time) (time.Time, bool) {
+	interval := max(r.Interval, 1)
+
+	var next time.Time
+	switch r.Frequency {
+	case RecurrenceDaily:
+		next = r.nextDaily(start, after, interval)
+	case RecurrenceWeekly:
+		next = r.nextWeekly(start, after, interval)
+	case RecurrenceMonthly:
+		next = r.nextMonthly(start, after, interval)
+	default:
+		return time.Time{}, false
+	}
+
+	if next.IsZero() || (r.Until != nil && next.After(*r.Until)) {
+		return time.Time{}, false
+	}
+	return next, true
+}
+
+// nextDaily steps whole days so the time of day survives daylight saving changes
+func (r *RecurrenceRule) nextDaily(start, after time.Time, interval int) time.Time {
+	if after.Before(start) {
+		return start
+	}
+
+	periods := int(after.Sub(start)/(24*time.Hour)) / interval
+	next := start.AddDate(0, 0, periods*interval)
+	for !next.After(after) {
+		next = next.AddDate(0, 0, interval)
+	}
+	return next
+}
+
+// nextWeekly walks the weeks of the series from the one holding after
+func (r *RecurrenceRule) nextWeekly(start, after time.Time, interval int) time.Time {
+	weekdays := r.Weekdays
+	if len(weekdays) == 0 {
+		weekdays = []string{weekdayCode(start.Weekday())}
+	}
+
+	firstWeek := startOfWeek(start)
+	weeks := 0
+	if after.After(start) {
+		weeks = int(startOfWeek(after).Sub(firstWeek).Round(24*time.Hour)/(24*time.Hour)) / 7
+	}
+
+	// Every week of the series has an occurrence, so the one after next can't be needed
+	for week := weeks / interval * interval; week <= weeks+2*interval; week += interval {
+		for offset, code := range recurrenceWeekdays {
+			if !slices.Contains(weekdays, code) {
+				continue
+			}
+			day := firstWeek.AddDate(0, 0, week*7+offset)
+			candidate := atTimeOf(day.Year(), day.Month(), day.Day(), start)
+			if !candidate.Before(start) && candidate.After(after) {
+				return candidate
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// nextMonthly walks the months of the series from the one holding after
+func (r *RecurrenceRule) nextMonthly(start, after time.Time, interval int) time.Time {
+	monthDay := r.MonthDay
+	if monthDay == 0 {
+		monthDay = start.Day()
+	}
+
+	months := 0
+	if after.After(start) {
+		months = (after.Year()-start.Year())*12 + int(after.Month()-start.Month())
+	}
+
+	for month := months / interval * interval; month <= months+2*interval; month += interval {
+		first := time.Date(start.Year(), start.Month()+time.Month(month), 1, 0, 0, 0, 0, start.Location())
+		day := min(monthDay, daysIn(first.Year(), first.Month()))
+		candidate := atTimeOf(first.Year(), first.Month(), day, start)
+		if !candidate.Before(start) && candidate.After(after) {
+			return candidate
+		}
+	}
+	return time.Time{}
+}
+
+// String formats the rule as an RRULE value, e.g. "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH"
+func (r *RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + strings.ToUpper(string(r.Frequency))}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.Weekdays) > 0 {
+		parts = append(parts, "BYDAY="+strings.Join(r.Weekdays, ","))
+	}
+	if r.MonthDay > 0 {
+		parts = append(parts, "BYMONTHDAY="+strconv.Itoa(r.MonthDay))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	return strings.Join(parts, ";")
+}
+
+// ParseRecurrenceRule reads an RRULE value such as "FREQ=MONTHLY;BYMONTHDAY=1". The parts
+// RecurrenceRule has no room for, like BYSETPOS or yearly frequencies, are refused.
+func ParseRecurrenceRule(value string) (*RecurrenceRule, error) {
+	value = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(value)), "RRULE:")
+	if value == "" {
+		return nil, newRecurrenceError("Recurrence rule cannot be empty", nil)
+	}
+
+	rule := &RecurrenceRule{}
+	for _, part := range strings.Split(value, ";") {
+		key, val, found := strings.Cut(part, "=")
+		if !found || val == "" {
+			return nil, newRecurrenceError("Recurrence rule parts must look like KEY=VALUE",
+				map[string]interface{}{"part": part})
+		}
+
+		var err error
+		switch key {
+		case "FREQ":
+			rule.Frequency = RecurrenceFrequency(strings.ToLower(val))
+		case "INTERVAL":
+			rule.Interval, err = strconv.Atoi(val)
+		case "BYDAY":
+			rule.Weekdays = strings.Split(val, ",")
+		case "BYMONTHDAY":
+			rule.MonthDay, err = strconv.Atoi(val)
+		case "COUNT":
+			rule.Count, err = strconv.Atoi(val)
+		case "UNTIL":
+			rule.Until, err = parseRecurrenceUntil(val)
+		default:
+			return nil, newRecurrenceError("Unsupported recurrence rule part", map[string]interface{}{"part": key})
+		}
+		if err != nil {
+			return nil, newRecurrenceError("Invalid recurrence rule value", map[string]interface{}{"part": part})
+		}
+	}
+
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// parseRecurrenceUntil reads an RRULE date or UTC date-time
+func parseRecurrenceUntil(value string) (*time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102"} {
+		if until, err := time.Parse(layout, value); err == nil {
+			return &until, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid UNTIL value %q", value)
+}
+
+// SetRecurrenceRequest carries a recurrence rule either as fields or as an RRULE value
+type SetRecurrenceRequest struct {
+	Rule  *RecurrenceRule `json:"rule,omitempty"`
+	RRule string          `json:"rrule,omitempty"` // e.g. "FREQ=WEEKLY;BYDAY=MO,WE,FR"
+}
+
+// ToRule returns the validated rule of the request, which must give it exactly one way
+func (r *SetRecurrenceRequest) ToRule() (*RecurrenceRule, error) {
+	if (r.Rule == nil) == (r.RRule == "") {
+		return nil, newRecurrenceError("Provide the recurrence as either rule or rrule", nil)
+	}
+	if r.RRule != "" {
+		return ParseRecurrenceRule(r.RRule)
+	}
+	if err := r.Rule.Validate(); err != nil {
+		return nil, err
+	}
+	return r.Rule, nil
+}
+
+// RecurrenceScope says which occurrences an edit to a recurring task applies to
+type RecurrenceScope string
+
+// Recurrence scopes
+const (
+	// RecurrenceScopeThis changes only the edited occurrence
+	RecurrenceScopeThis RecurrenceScope = "this"
+	// RecurrenceScopeFuture changes the edited occurrence, the open ones after it and those still to come
+	RecurrenceScopeFuture RecurrenceScope = "future"
+)
+
+// IsValid checks if the RecurrenceScope is one of the allowed values
+func (s RecurrenceScope) IsValid() bool {
+	return s == RecurrenceScopeThis || s == RecurrenceScopeFuture
+}
+
+// RecurrenceTemplate holds what every new occurrence of a series starts from
+type RecurrenceTemplate struct {
+	EffortEstimate *float64 `json:"effort_estimate,omitempty"`
+	AssigneeID     *string  `json:"assignee_id,omitempty"`
+	// StartLead is how long before its due date an occurrence starts; nil when occurrences have no start date
+	StartLead    *time.Duration  `json:"start_lead,omitempty"`
+	Title        string          `json:"title"`
+	Description  string          `json:"description"`
+	Priority     TaskPriority    `json:"priority"`
+	Tags         []string        `json:"tags,omitempty"`
+	CustomFields json.RawMessage `json:"custom_fields,omitempty"`
+}
+
+// NewRecurrenceTemplate takes a template from the task a series starts with
+func NewRecurrenceTemplate(task *Task) RecurrenceTemplate {
+	template := RecurrenceTemplate{
+		EffortEstimate: task.EffortEstimate,
+		AssigneeID:     task.AssigneeID,
+		Title:          task.Title,
+		Description:    task.Description,
+		Priority:       task.Priority,
+		Tags:           slices.Clone(task.Tags),
+		CustomFields:   task.CustomFields,
+	}
+	if task.StartDate != nil && task.DueDate != nil {
+		lead := task.DueDate.Sub(*task.StartDate)
+		template.StartLead = &lead
+	}
+	return template
+}
+
+// Apply takes the fields an update sets into the template; status and dates are the occurrences' own
+func (t *RecurrenceTemplate) Apply(req UpdateTaskRequest) {
+	if req.Title != nil {
+		t.Title = *req.Title
+	}
+	if req.Description != nil {
+		t.Description = *req.Description
+	}
+	if req.AssigneeID != nil {
+		t.AssigneeID = req.AssigneeID
+		if *req.AssigneeID == "" {
+			t.AssigneeID = nil
+		}
+	}
+	if req.Priority != nil {
+		t.Priority = *req.Priority
+	}
+	if req.Tags != nil {
+		t.Tags = slices.Clone(req.Tags)
+	}
+}
+
+// Occurrence returns the task an occurrence due at the given time is copied from
+func (t RecurrenceTemplate) Occurrence(projectID string, due time.Time) *Task {
+	task := &Task{
+		EffortEstimate: t.EffortEstimate,
+		AssigneeID:     t.AssigneeID,
+		DueDate:        &due,
+		Title:          t.Title,
+		Description:    t.Description,
+		Priority:       t.Priority,
+		ProjectID:      projectID,
+		Tags:           slices.Clone(t.Tags),
+		CustomFields:   t.CustomFields,
+	}
+	if t.StartLead != nil {
+		start := due.Add(-*t.StartLead)
+		task.StartDate = &start
+	}
+	return task
+}
+
+// TaskSeries links the occurrences of a recurring task. Only the latest occurrence exists ahead
+// of time; the next one is created when the latest is completed or its due date arrives.
+type TaskSeries struct {
+	CreatedAt time.Time  `json:"created_at" db:"created"`
+	UpdatedAt time.Time  `json:"updated_at" db:"updated"`
+	StartsAt  time.Time  `json:"starts_at" db:"starts_at"` // the date the rule counts occurrences from
+	LatestAt  time.Time  `json:"latest_at" db:"latest_at"` // the scheduled date of the latest occurrence
+	EndedAt   *time.Time `json:"ended_at,omitempty" db:"ended_at"`
+
+	ID           string             `json:"id" db:"id"`
+	ProjectID    string             `json:"project_id" db:"project"`
+	CreatedBy    string             `json:"created_by" db:"created_by"` // reporter of new occurrences
+	LatestTaskID string             `json:"latest_task_id" db:"latest_task"`
+	Rule         RecurrenceRule     `json:"rule" db:"rule"`
+	Template     RecurrenceTemplate `json:"template" db:"template"`
+	Occurrences  int                `json:"occurrences" db:"occurrences"` // created so far, the first included
+}
+
+// Validate ensures the series belongs to a project and has a valid rule
+func (s *TaskSeries) Validate() error {
+	if err := ValidateRequired("project_id", s.ProjectID, "INVALID_PROJECT_ID", "Project ID is required"); err != nil {
+		return err
+	}
+	if err := ValidateRequired("created_by", s.CreatedBy, "INVALID_USER_ID", "Series creator is required"); err != nil {
+		return err
+	}
+	if s.StartsAt.IsZero() {
+		return newRecurrenceError("Recurring tasks need a due date", nil)
+	}
+	return s.Rule.Validate()
+}
+
+// IsActive reports whether the series still creates occurrences
+func (s *TaskSeries) IsActive() bool {
+	return s.EndedAt == nil
+}
+
+// IsDue reports whether the latest occurrence's date has arrived, so the next one is owed
+func (s *TaskSeries) IsDue(now time.Time) bool {
+	return s.IsActive() && !s.LatestAt.After(now)
+}
+
+// NextOccurrence returns the date of the occurrence after the latest one. Dates that passed
+// while no occurrence was created for them, such as during downtime, are skipped.
+func (s *TaskSeries) NextOccurrence(now time.Time) (time.Time, bool) {
+	if !s.IsActive() || (s.Rule.Count > 0 && s.Occurrences >= s.Rule.Count) {
+		return time.Time{}, false
+	}
+
+	next, ok := s.Rule.Next(s.StartsAt, s.LatestAt)
+	if ok && !next.After(now) {
+		next, ok = s.Rule.Next(s.StartsAt, now)
+	}
+	return next, ok
+}
+
+// Upcoming returns up to n dates the series will create occurrences on after the latest one
+func (s *TaskSeries) Upcoming(now time.Time, n int) []time.Time {
+	upcoming := make([]time.Time, 0, n)
+	preview := *s
+	for len(upcoming) < n {
+		next, ok := preview.NextOccurrence(now)
+		if !ok {
+			break
+		}
+		upcoming = append(upcoming, next)
+		preview.LatestAt = next
+		preview.Occurrences++
+	}
+	return upcoming
+}
+
+// End stops the series from creating more occurrences; ending it again is a no-op
+func (s *TaskSeries) End(at time.Time) {
+	if s.EndedAt == nil {
+		s.EndedAt = &at
+	}
+}
+
+// startOfWeek returns midnight of the Monday of the week holding t
+func startOfWeek(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+}
+
+// atTimeOf returns the date at the time of day of ref, in ref's location
+func atTimeOf(year int, month time.Month, day int, ref time.Time) time.Time {
+	return time.Date(year, month, day, ref.Hour(), ref.Minute(), ref.Second(), 0, ref.Location())
+}
+
+// daysIn returns the number of days in a month
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// weekdayCode returns the RRULE code of a weekday
+func weekdayCode(day time.Weekday) string {
+	return recurrenceWeekdays[(int(day)+6)%7]
+}
+
+// newRecurrenceError creates a validation error for an invalid recurrence
+func newRecurrenceError(message string, details map[string]interface{}) *Error {
+	return NewValidationError("INVALID_RECURRENCE", message, details)
+}
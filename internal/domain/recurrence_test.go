@@ -0,0 +1,249 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// date returns 09:30 UTC on the given day
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 9, 30, 0, 0, time.UTC)
+}
+
+func TestRecurrenceRule_Next(t *testing.T) {
+	until := date(2025, time.March, 31)
+
+	tests := []struct {
+		name     string
+		rule     domain.RecurrenceRule
+		start    time.Time
+		after    time.Time
+		expected time.Time // zero when the rule has ended
+	}{
+		{
+			name:     "daily",
+			rule:     domain.RecurrenceRule{Frequency: domain.RecurrenceDaily},
+			start:    date(2025, time.March, 3),
+			after:    date(2025, time.March, 3),
+			expected: date(2025, time.March, 4),
+		},
+		{
+			name:     "every third day counts from the start",
+			rule:     domain.RecurrenceRule{Frequency: domain.RecurrenceDaily, Interval: 3},
+			start:    date(2025, time.March, 3),
+			after:    date(2025, time.March, 7),
+			expected: date(2025, time.March, 9),
+		},
+		{
+			name:     "before the start is the start",
+			rule:     domain.RecurrenceRule{Frequency: domain.RecurrenceDaily},
+			start:    date(2025, time.March, 3),
+			after:    date(2025, time.March, 1),
+			expected: date(2025, time.March, 3),
+		},
+		{
+			name:     "weekly defaults to the start's weekday",
+			rule:     domain.RecurrenceRule{Frequency: domain.RecurrenceWeekly},
+			start:    date(2025, time.March, 5), // Wednesday
+			after:    date(2025, time.March, 5),
+			expected: date(2025, time.March, 12),
+		},
+		{
+			name:     "weekly on given weekdays",
+			rule:     domain.RecurrenceRule{Frequency: domain.RecurrenceWeekly, Weekdays: []string{"MO", "WE", "FR"}},
+			start:    date(2025, time.March, 3), // Monday
+			after:    date(2025, time.March, 7), // Friday
+			expected: date(2025, time.March, 10),
+		},
+		{
+			name: "every other week skips the week between",
+			rule: domain.RecurrenceRule{
+				Frequency: domain.RecurrenceWeekly, Interval: 2, Weekdays: []string{"TU", "TH"},
+			},
+			start:    date(2025, time.March, 4),  // Tuesday
+			after:    date(2025, time.March, 6),  // Thursday
+			expected: date(2025, time.March, 18), // Tuesday two weeks on
+		},
+		{
+			name:     "monthly by day",
+			rule:     domain.RecurrenceRule{Frequency: domain.RecurrenceMonthly, MonthDay: 15},
+			start:    date(2025, time.January, 15),
+			after:    date(2025, time.January, 20),
+			expected: date(2025, time.February, 15),
+		},
+		{
+			name:     "monthly on the 31st uses the last day of short months",
+			rule:     domain.RecurrenceRule{Frequency: domain.RecurrenceMonthly, MonthDay: 31},
+			start:    date(2025, time.January, 31),
+			after:    date(2025, time.January, 31),
+			expected: date(2025, time.February, 28),
+		},
+		{
+			name:     "quarterly",
+			rule:     domain.RecurrenceRule{Frequency: domain.RecurrenceMonthly, Interval: 3},
+			start:    date(2025, time.January, 10),
+			after:    date(2025, time.February, 1),
+			expected: date(2025, time.April, 10),
+		},
+		{
+			name:     "until ends the rule",
+			rule:     domain.RecurrenceRule{Frequency: domain.RecurrenceMonthly, Until: &until},
+			start:    date(2025, time.January, 10),
+			after:    date(2025, time.March, 10),
+			expected: time.Time{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, ok := tt.rule.Next(tt.start, tt.after)
+			if ok != !tt.expected.IsZero() {
+				t.Fatalf("Expected ok=%v, got %v (%v)", !tt.expected.IsZero(), ok, next)
+			}
+			if ok && !next.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, next)
+			}
+		})
+	}
+}
+
+func TestParseRecurrenceRule(t *testing.T) {
+	tests := []struct {
+		name         string
+		value        string
+		expected     string
+		expectedCode string
+	}{
+		{
+			name:     "weekly on weekdays",
+			value:    "RRULE:FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR",
+			expected: "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR",
+		},
+		{
+			name:     "monthly with count",
+			value:    "freq=monthly;interval=2;bymonthday=1;count=6",
+			expected: "FREQ=MONTHLY;INTERVAL=2;BYMONTHDAY=1;COUNT=6",
+		},
+		{
+			name:     "until date",
+			value:    "FREQ=DAILY;UNTIL=20251231",
+			expected: "FREQ=DAILY;UNTIL=20251231T000000Z",
+		},
+		{
+			name:         "yearly is not supported",
+			value:        "FREQ=YEARLY",
+			expectedCode: "INVALID_RECURRENCE",
+		},
+		{
+			name:         "unsupported part",
+			value:        "FREQ=MONTHLY;BYSETPOS=-1",
+			expectedCode: "INVALID_RECURRENCE",
+		},
+		{
+			name:         "weekdays on a monthly rule",
+			value:        "FREQ=MONTHLY;BYDAY=MO",
+			expectedCode: "INVALID_RECURRENCE",
+		},
+		{
+			name:         "unknown weekday",
+			value:        "FREQ=WEEKLY;BYDAY=XX",
+			expectedCode: "INVALID_RECURRENCE",
+		},
+		{
+			name:         "interval too large",
+			value:        "FREQ=DAILY;INTERVAL=1000",
+			expectedCode: "INVALID_RECURRENCE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := domain.ParseRecurrenceRule(tt.value)
+
+			if tt.expectedCode != "" {
+				var domainErr *domain.Error
+				if !errors.As(err, &domainErr) || domainErr.Code != tt.expectedCode {
+					t.Fatalf("Expected error %s, got %v", tt.expectedCode, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if rule.String() != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, rule.String())
+			}
+		})
+	}
+}
+
+func TestTaskSeries_NextOccurrence(t *testing.T) {
+	newSeries := func() domain.TaskSeries {
+		return domain.TaskSeries{
+			Rule:        domain.RecurrenceRule{Frequency: domain.RecurrenceDaily},
+			StartsAt:    date(2025, time.March, 3),
+			LatestAt:    date(2025, time.March, 3),
+			Occurrences: 1,
+		}
+	}
+
+	t.Run("completed early", func(t *testing.T) {
+		series := newSeries()
+		next, ok := series.NextOccurrence(date(2025, time.March, 1))
+		if !ok || !next.Equal(date(2025, time.March, 4)) {
+			t.Errorf("Expected the day after the latest occurrence, got %v (%v)", next, ok)
+		}
+	})
+
+	t.Run("missed dates are skipped", func(t *testing.T) {
+		series := newSeries()
+		next, ok := series.NextOccurrence(date(2025, time.March, 10).Add(time.Hour))
+		if !ok || !next.Equal(date(2025, time.March, 11)) {
+			t.Errorf("Expected the first date after now, got %v (%v)", next, ok)
+		}
+	})
+
+	t.Run("count reached", func(t *testing.T) {
+		series := newSeries()
+		series.Rule.Count = 1
+		if _, ok := series.NextOccurrence(date(2025, time.March, 1)); ok {
+			t.Error("Expected no occurrence once the count is reached")
+		}
+	})
+
+	t.Run("ended", func(t *testing.T) {
+		series := newSeries()
+		series.End(date(2025, time.March, 1))
+		if _, ok := series.NextOccurrence(date(2025, time.March, 1)); ok {
+			t.Error("Expected no occurrence after the series ended")
+		}
+	})
+
+	t.Run("upcoming", func(t *testing.T) {
+		series := newSeries()
+		series.Rule.Count = 3
+		upcoming := series.Upcoming(date(2025, time.March, 1), 5)
+		if len(upcoming) != 2 || !upcoming[1].Equal(date(2025, time.March, 5)) {
+			t.Errorf("Expected the two occurrences left, got %v", upcoming)
+		}
+	})
+}
+
+func TestSetRecurrenceRequest_ToRule(t *testing.T) {
+	rule := &domain.RecurrenceRule{Frequency: domain.RecurrenceDaily}
+
+	if _, err := (&domain.SetRecurrenceRequest{}).ToRule(); err == nil {
+		t.Error("Expected an error without a rule")
+	}
+	if _, err := (&domain.SetRecurrenceRequest{Rule: rule, RRule: "FREQ=DAILY"}).ToRule(); err == nil {
+		t.Error("Expected an error with both a rule and an rrule")
+	}
+	if parsed, err := (&domain.SetRecurrenceRequest{RRule: "FREQ=WEEKLY"}).ToRule(); err != nil ||
+		parsed.Frequency != domain.RecurrenceWeekly {
+		t.Errorf("Expected the rrule to be parsed, got %v, %v", parsed, err)
+	}
+}
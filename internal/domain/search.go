@@ -0,0 +1,174 @@
+package domain
+
+import (
+	"strings"
+	"unicode"
+)
+
+// MaxSearchTerms limits how many terms a single search query may contain
+const MaxSearchTerms = 20
+
+// SearchDocumentType identifies what kind of record a search hit refers to
+type SearchDocumentType string
+
+// Search document types
+const (
+	SearchDocTask    SearchDocumentType = "task"
+	SearchDocComment SearchDocumentType = "comment"
+)
+
+// IsValid checks if the document type is supported
+func (t SearchDocumentType) IsValid() bool {
+	return t == SearchDocTask || t == SearchDocComment
+}
+
+// SearchField restricts a search term to one indexed field
+type SearchField string
+
+// Field scopes understood by the query syntax, e.g. "title:login" or "tag:bug"
+const (
+	SearchAnyField SearchField = ""
+	SearchTitle    SearchField = "title"
+	SearchTag      SearchField = "tag"
+	SearchAssignee SearchField = "assignee"
+)
+
+// SearchTerm is one element of a parsed search query
+type SearchTerm struct {
+	Field  SearchField `json:"field,omitempty"`
+	Value  string      `json:"value"`
+	Prefix bool        `json:"prefix,omitempty"` // trailing * matches words starting with Value
+	Phrase bool        `json:"phrase,omitempty"` // quoted; words must appear together and in order
+}
+
+// SearchQuery is a parsed, project-scoped full-text search
+type SearchQuery struct {
+	ProjectID string               `json:"project_id"`
+	Terms     []SearchTerm         `json:"terms"`
+	Types     []SearchDocumentType `json:"types,omitempty"` // empty searches every type
+	Limit     int                  `json:"limit"`
+	Offset    int                  `json:"offset"`
+}
+
+// Validate performs validation of the search query
+func (q *SearchQuery) Validate() error {
+	if q.ProjectID == "" {
+		return NewValidationError("INVALID_PROJECT_ID", "Project ID cannot be empty", nil)
+	}
+	if len(q.Terms) == 0 {
+		return NewValidationError("EMPTY_SEARCH_QUERY", "Search query cannot be empty", nil)
+	}
+	for _, docType := range q.Types {
+		if !docType.IsValid() {
+			return NewValidationError("INVALID_SEARCH_TYPE", "Search type must be task or comment", nil)
+		}
+	}
+	if q.Limit < 0 || q.Offset < 0 {
+		return NewValidationError("INVALID_PAGINATION", "Limit and offset cannot be negative", nil)
+	}
+	return nil
+}
+
+// SearchResult is a single ranked search hit. Title and Snippet are HTML-escaped,
+// with matched words wrapped in <mark> elements.
+type SearchResult struct {
+	Type      SearchDocumentType `json:"type"`
+	TaskID    string             `json:"task_id"`
+	CommentID string             `json:"comment_id,omitempty"`
+	ProjectID string             `json:"project_id"`
+	Title     string             `json:"title"`
+	Snippet   string             `json:"snippet"`
+	Score     float64            `json:"score"` // higher is more relevant
+}
+
+// searchFieldAliases maps the field names accepted in queries to search fields
+var searchFieldAliases = map[string]SearchField{
+	"title":    SearchTitle,
+	"tag":      SearchTag,
+	"tags":     SearchTag,
+	"assignee": SearchAssignee,
+}
+
+// ParseSearchQuery parses the search syntax into terms. Terms are separated by
+// whitespace and must all match. A term may be a "quoted phrase", end in * for
+// a prefix match, and be scoped to a field with title:, tag: or assignee:.
+// Unknown field names are searched as plain text.
+func ParseSearchQuery(input string) ([]SearchTerm, error) {
+	var terms []SearchTerm
+	runes := []rune(strings.TrimSpace(input))
+
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+
+		term := SearchTerm{}
+
+		// Field scope: a known name directly followed by a colon
+		if colon := indexRuneUntilSpace(runes[i:], ':'); colon > 0 {
+			if field, ok := searchFieldAliases[strings.ToLower(string(runes[i:i+colon]))]; ok {
+				term.Field = field
+				i += colon + 1
+			}
+		}
+
+		var value []rune
+		if i < len(runes) && runes[i] == '"' {
+			term.Phrase = true
+			i++
+			for i < len(runes) && runes[i] != '"' {
+				value = append(value, runes[i])
+				i++
+			}
+			if i >= len(runes) {
+				return nil, NewValidationError("UNTERMINATED_PHRASE", "Search phrase is missing its closing quote", nil)
+			}
+			i++ // closing quote
+			if i < len(runes) && runes[i] == '*' {
+				term.Prefix = true
+				i++
+			}
+		} else {
+			for i < len(runes) && !unicode.IsSpace(runes[i]) {
+				value = append(value, runes[i])
+				i++
+			}
+			if n := len(value); n > 0 && value[n-1] == '*' {
+				term.Prefix = true
+				value = value[:n-1]
+			}
+		}
+
+		term.Value = strings.TrimSpace(string(value))
+		if term.Value == "" {
+			continue
+		}
+
+		terms = append(terms, term)
+		if len(terms) > MaxSearchTerms {
+			return nil, NewValidationError("TOO_MANY_SEARCH_TERMS", "Search query has too many terms", map[string]interface{}{
+				"max": MaxSearchTerms,
+			})
+		}
+	}
+
+	if len(terms) == 0 {
+		return nil, NewValidationError("EMPTY_SEARCH_QUERY", "Search query cannot be empty", nil)
+	}
+
+	return terms, nil
+}
+
+// indexRuneUntilSpace returns the index of r before the first whitespace, or -1
+func indexRuneUntilSpace(runes []rune, r rune) int {
+	for i, c := range runes {
+		if c == r {
+			return i
+		}
+		if unicode.IsSpace(c) || c == '"' {
+			return -1
+		}
+	}
+	return -1
+}
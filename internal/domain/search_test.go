@@ -0,0 +1,113 @@
+package domain_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+func TestParseSearchQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []domain.SearchTerm
+	}{
+		{
+			name:  "plain words",
+			input: "login bug",
+			expected: []domain.SearchTerm{
+				{Value: "login"},
+				{Value: "bug"},
+			},
+		},
+		{
+			name:  "phrase and prefix",
+			input: `"page crashes" deploy*`,
+			expected: []domain.SearchTerm{
+				{Value: "page crashes", Phrase: true},
+				{Value: "deploy", Prefix: true},
+			},
+		},
+		{
+			name:  "field scoped terms",
+			input: `title:login TAG:bug assignee:alice title:"sign in"*`,
+			expected: []domain.SearchTerm{
+				{Field: domain.SearchTitle, Value: "login"},
+				{Field: domain.SearchTag, Value: "bug"},
+				{Field: domain.SearchAssignee, Value: "alice"},
+				{Field: domain.SearchTitle, Value: "sign in", Phrase: true, Prefix: true},
+			},
+		},
+		{
+			name:  "unknown field is plain text",
+			input: "http://example.com",
+			expected: []domain.SearchTerm{
+				{Value: "http://example.com"},
+			},
+		},
+		{
+			name:  "empty terms are skipped",
+			input: `bug "" * title:`,
+			expected: []domain.SearchTerm{
+				{Value: "bug"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			terms, err := domain.ParseSearchQuery(tt.input)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(terms, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, terms)
+			}
+		})
+	}
+}
+
+func TestParseSearchQuery_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		code  string
+	}{
+		{name: "empty", input: "   ", code: "EMPTY_SEARCH_QUERY"},
+		{name: "unterminated phrase", input: `"login bug`, code: "UNTERMINATED_PHRASE"},
+		{name: "too many terms", input: strings.Repeat("a ", domain.MaxSearchTerms+1), code: "TOO_MANY_SEARCH_TERMS"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.ParseSearchQuery(tt.input)
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+			if !strings.Contains(err.Error(), tt.code) {
+				t.Errorf("Expected error code %s, got %v", tt.code, err)
+			}
+		})
+	}
+}
+
+func TestSearchQuery_Validate(t *testing.T) {
+	terms := []domain.SearchTerm{{Value: "bug"}}
+
+	valid := domain.SearchQuery{ProjectID: "p1", Terms: terms, Types: []domain.SearchDocumentType{domain.SearchDocTask}}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Expected valid query, got %v", err)
+	}
+
+	noProject := domain.SearchQuery{Terms: terms}
+	if err := noProject.Validate(); err == nil {
+		t.Error("Expected error for missing project")
+	}
+
+	badType := domain.SearchQuery{ProjectID: "p1", Terms: terms, Types: []domain.SearchDocumentType{"user"}}
+	if err := badType.Validate(); err == nil {
+		t.Error("Expected error for unknown document type")
+	}
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"time"
+)
+
+// maxUserAgentLength is how much of a client's user agent a session keeps
+const maxUserAgentLength = 512
+
+// ClientInfo identifies the device a request came from
+type ClientInfo struct {
+	UserAgent string
+	IPAddress string
+}
+
+// Session is one sign-in on one device. Its refresh tokens form a family: each is replaced when it
+// is used, so a refresh token that comes back after being replaced has been copied, and the whole
+// session is revoked.
+type Session struct {
+	CreatedAt      time.Time  `json:"created_at"`
+	LastSeenAt     time.Time  `json:"last_seen_at"`
+	ExpiresAt      time.Time  `json:"expires_at"` // when the current refresh token expires
+	RevokedAt      *time.Time `json:"revoked_at,omitempty"`
+	ID             string     `json:"id"`
+	UserID         string     `json:"user_id"`
+	UserAgent      string     `json:"user_agent"`
+	IPAddress      string     `json:"ip_address"`
+	RefreshTokenID string     `json:"-"` // ID of the only refresh token that may be used next
+	TokenVersion   int        `json:"-"` // the user's token version when the session started
+}
+
+// IsExpired checks if the session's refresh token has expired
+func (s *Session) IsExpired() bool {
+	return time.Now().UTC().After(s.ExpiresAt)
+}
+
+// IsActive checks the session hasn't expired or been revoked
+func (s *Session) IsActive() bool {
+	return s.RevokedAt == nil && !s.IsExpired()
+}
+
+// Revoke signs the session out
+func (s *Session) Revoke() {
+	now := time.Now().UTC()
+	s.RevokedAt = &now
+}
+
+// Seen records a request from the session's device
+func (s *Session) Seen(client ClientInfo) {
+	s.LastSeenAt = time.Now().UTC()
+	if client.UserAgent != "" {
+		s.UserAgent = truncateUserAgent(client.UserAgent)
+	}
+	if client.IPAddress != "" {
+		s.IPAddress = client.IPAddress
+	}
+}
+
+// Validate performs domain validation on the session
+func (s *Session) Validate() error {
+	if s.UserID == "" {
+		return NewValidationError("INVALID_USER_ID", "User ID is required", map[string]interface{}{
+			"field": "user_id",
+		})
+	}
+	if s.RefreshTokenID == "" {
+		return NewValidationError("INVALID_REFRESH_TOKEN_ID", "Refresh token ID is required", nil)
+	}
+	return nil
+}
+
+// truncateUserAgent keeps a user agent to a length worth storing
+func truncateUserAgent(userAgent string) string {
+	if len(userAgent) <= maxUserAgentLength {
+		return userAgent
+	}
+	return userAgent[:maxUserAgentLength]
+}
@@ -0,0 +1,45 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+func TestSession_Seen(t *testing.T) {
+	session := &domain.Session{UserAgent: "Firefox", IPAddress: "192.0.2.1"}
+
+	// Requests that don't say where they came from keep the last known device
+	session.Seen(domain.ClientInfo{})
+	if session.UserAgent != "Firefox" || session.IPAddress != "192.0.2.1" {
+		t.Errorf("Expected the device to be kept, got %q from %q", session.UserAgent, session.IPAddress)
+	}
+	if session.LastSeenAt.IsZero() {
+		t.Error("Expected the session to be seen")
+	}
+
+	session.Seen(domain.ClientInfo{UserAgent: strings.Repeat("a", 1000), IPAddress: "198.51.100.7"})
+	if len(session.UserAgent) != 512 || session.IPAddress != "198.51.100.7" {
+		t.Errorf("Expected a truncated user agent from the new address, got %d characters from %q",
+			len(session.UserAgent), session.IPAddress)
+	}
+}
+
+func TestSession_IsActive(t *testing.T) {
+	session := &domain.Session{ExpiresAt: time.Now().Add(time.Hour)}
+	if !session.IsActive() {
+		t.Error("Expected a new session to be active")
+	}
+
+	session.Revoke()
+	if session.IsActive() {
+		t.Error("Expected a revoked session to be inactive")
+	}
+
+	expired := &domain.Session{ExpiresAt: time.Now().Add(-time.Minute)}
+	if expired.IsActive() {
+		t.Error("Expected an expired session to be inactive")
+	}
+}
@@ -3,8 +3,10 @@ package domain
 import (
 	"encoding/json"
 	"fmt"
+	"html/template"
 	"log/slog"
 	"math"
+	"regexp"
 	"strings"
 	"time"
 )
@@ -21,14 +23,16 @@ const (
 	StatusComplete   TaskStatus = "complete"   // StatusComplete indicates task is finished
 )
 
-// IsValid checks if the TaskStatus is one of the allowed values
+// MaxStatusLen defines the maximum length of a status identifier
+const MaxStatusLen = 50
+
+// statusPattern matches well-formed status identifiers such as "in_review" or "qa-2"
+var statusPattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// IsValid checks if the TaskStatus is a well-formed status identifier.
+// Which statuses a project actually uses is defined by its Workflow.
 func (s TaskStatus) IsValid() bool {
-	switch s {
-	case StatusBacklog, StatusTodo, StatusDeveloping, StatusReview, StatusComplete:
-		return true
-	default:
-		return false
-	}
+	return len(s) <= MaxStatusLen && statusPattern.MatchString(string(s))
 }
 
 // TaskPriority represents the importance level of a task
@@ -64,31 +68,37 @@ const (
 
 // Task represents a work item in the project management system
 type Task struct {
-	UpdatedAt      time.Time       `json:"updated_at" db:"updated"`
-	CreatedAt      time.Time       `json:"created_at" db:"created"`
-	EffortEstimate *float64        `json:"effort_estimate,omitempty" db:"effort_estimate"`
-	AssigneeID     *string         `json:"assignee_id,omitempty" db:"assignee"`
-	ParentTaskID   *string         `json:"parent_task_id,omitempty" db:"parent_task"`
-	DueDate        *time.Time      `json:"due_date,omitempty" db:"due_date"`
-	StartDate      *time.Time      `json:"start_date,omitempty" db:"start_date"`
-	ArchivedAt     *time.Time      `json:"archived_at,omitempty" db:"archived_at"`
-	Description    string          `json:"description" db:"description"`
-	Priority       TaskPriority    `json:"priority" db:"priority"`
-	Title          string          `json:"title" db:"title"`
-	ID             string          `json:"id" db:"id"`
-	ProjectID      string          `json:"project_id" db:"project"`
-	ReporterID     string          `json:"reporter_id" db:"reporter"`
-	Status         TaskStatus      `json:"status" db:"status"`
-	Dependencies   []string        `json:"dependencies,omitempty" db:"-"`
-	Tags           []string        `json:"tags,omitempty" db:"-"`
-	Attachments    []string        `json:"attachments,omitempty" db:"-"`
-	ColumnPosition json.RawMessage `json:"column_position,omitempty" db:"column_position"`
-	GithubData     json.RawMessage `json:"github_data,omitempty" db:"github_data"`
-	CustomFields   json.RawMessage `json:"custom_fields,omitempty" db:"custom_fields"`
-	TimeSpent      float64         `json:"time_spent" db:"time_spent"`
-	Progress       int             `json:"progress" db:"progress"`
-	Position       int             `json:"position" db:"position"`
-	Archived       bool            `json:"archived" db:"archived"`
+	UpdatedAt      time.Time  `json:"updated_at" db:"updated"`
+	CreatedAt      time.Time  `json:"created_at" db:"created"`
+	EffortEstimate *float64   `json:"effort_estimate,omitempty" db:"effort_estimate"`
+	AssigneeID     *string    `json:"assignee_id,omitempty" db:"assignee"`
+	ParentTaskID   *string    `json:"parent_task_id,omitempty" db:"parent_task"`
+	SeriesID       *string    `json:"series_id,omitempty" db:"series"` // the recurring series the task is an occurrence of
+	DueDate        *time.Time `json:"due_date,omitempty" db:"due_date"`
+	StartDate      *time.Time `json:"start_date,omitempty" db:"start_date"`
+	ArchivedAt     *time.Time `json:"archived_at,omitempty" db:"archived_at"`
+	Description    string     `json:"description" db:"description"`
+	// DescriptionHTML is the sanitized rendering of the markdown Description, cached when the task is saved
+	DescriptionHTML string          `json:"description_html" db:"description_html"`
+	Priority        TaskPriority    `json:"priority" db:"priority"`
+	Title           string          `json:"title" db:"title"`
+	ID              string          `json:"id" db:"id"`
+	ProjectID       string          `json:"project_id" db:"project"`
+	ReporterID      string          `json:"reporter_id" db:"reporter"`
+	Status          TaskStatus      `json:"status" db:"status"`
+	Dependencies    []string        `json:"dependencies,omitempty" db:"-"`
+	BlockedBy       []string        `json:"blocked_by,omitempty" db:"-"` // computed: dependencies not yet complete
+	Tags            []string        `json:"tags,omitempty" db:"-"`
+	Attachments     []string        `json:"attachments,omitempty" db:"-"`
+	Watchers        []string        `json:"watchers,omitempty" db:"watchers"` // users following the task
+	ColumnPosition  json.RawMessage `json:"column_position,omitempty" db:"column_position"`
+	GithubData      json.RawMessage `json:"github_data,omitempty" db:"github_data"`
+	CustomFields    json.RawMessage `json:"custom_fields,omitempty" db:"custom_fields"`
+	TimeSpent       float64         `json:"time_spent" db:"time_spent"`
+	Progress        int             `json:"progress" db:"progress"`
+	Position        int             `json:"position" db:"position"`
+	Archived        bool            `json:"archived" db:"archived"`
+	Blocked         bool            `json:"blocked" db:"-"` // computed: true while BlockedBy is non-empty
 }
 
 // NewTask creates a new task with default values
@@ -185,69 +195,32 @@ func (t *Task) validateNumericFields() error {
 	return nil
 }
 
-// CanTransitionTo checks if the task can transition to the specified status
+// CanTransitionTo checks if the task can transition to the specified status in the default workflow
 func (t *Task) CanTransitionTo(newStatus TaskStatus) bool {
-	if !newStatus.IsValid() {
-		return false
-	}
-
-	transitions := map[TaskStatus][]TaskStatus{
-		StatusBacklog:    {StatusTodo, StatusDeveloping},
-		StatusTodo:       {StatusBacklog, StatusDeveloping},
-		StatusDeveloping: {StatusTodo, StatusReview, StatusBacklog},
-		StatusReview:     {StatusDeveloping, StatusComplete, StatusTodo},
-		StatusComplete:   {StatusReview, StatusTodo},
-	}
-
-	allowedStatuses, exists := transitions[t.Status]
-	if !exists {
-		return false
-	}
-
-	for _, status := range allowedStatuses {
-		if status == newStatus {
-			return true
-		}
-	}
-
-	return false
+	return DefaultWorkflow().CanTransition(t.Status, newStatus)
 }
 
-// UpdateStatus transitions the task to a new status if allowed
+// UpdateStatus transitions the task to a new status if the default workflow allows it
 func (t *Task) UpdateStatus(newStatus TaskStatus) error {
-	if !newStatus.IsValid() {
+	return t.UpdateStatusIn(DefaultWorkflow(), newStatus)
+}
+
+// UpdateStatusIn transitions the task to a new status if the given workflow allows it
+func (t *Task) UpdateStatusIn(workflow *Workflow, newStatus TaskStatus) error {
+	if !workflow.HasStatus(newStatus) {
 		return NewValidationError("status", "Invalid status provided", map[string]interface{}{
 			"provided_status": string(newStatus),
-			"valid_statuses": []string{
-				string(StatusBacklog), string(StatusTodo), string(StatusDeveloping),
-				string(StatusReview), string(StatusComplete),
-			},
+			"valid_statuses":  statusStrings(workflow.StatusIDs()),
 		})
 	}
 
-	if !t.CanTransitionTo(newStatus) {
-		transitions := map[TaskStatus][]TaskStatus{
-			StatusBacklog:    {StatusTodo, StatusDeveloping},
-			StatusTodo:       {StatusBacklog, StatusDeveloping},
-			StatusDeveloping: {StatusTodo, StatusReview, StatusBacklog},
-			StatusReview:     {StatusDeveloping, StatusComplete, StatusTodo},
-			StatusComplete:   {StatusReview, StatusTodo},
-		}
-
-		allowedStatuses, exists := transitions[t.Status]
-		var allowedStatusStrings []string
-		if exists {
-			for _, status := range allowedStatuses {
-				allowedStatusStrings = append(allowedStatusStrings, string(status))
-			}
-		}
-
+	if !workflow.CanTransition(t.Status, newStatus) {
 		conflictErr := NewConflictError("invalid_transition",
 			fmt.Sprintf("Cannot transition from %s to %s", t.Status, newStatus))
 		conflictErr.Details = map[string]interface{}{
 			"current_status":      string(t.Status),
 			"requested_status":    string(newStatus),
-			"allowed_transitions": allowedStatusStrings,
+			"allowed_transitions": statusStrings(workflow.AllowedTransitions(t.Status)),
 		}
 		return conflictErr
 	}
@@ -336,6 +309,20 @@ func (t *Task) UpdateProgress(progress int) error {
 	return nil
 }
 
+// ApplyChecklist derives progress from the checklist in the description, given how many of its
+// items are done. Tasks without checklist items keep the progress they have.
+func (t *Task) ApplyChecklist(done, total int) {
+	if total == 0 {
+		return
+	}
+	t.Progress = done * ProgressMax / total
+}
+
+// DescriptionMarkup returns the rendered description for use in HTML templates
+func (t *Task) DescriptionMarkup() template.HTML {
+	return template.HTML(t.DescriptionHTML) //nolint:gosec // sanitized when the description is rendered
+}
+
 // AddTimeSpent adds hours to the time spent on the task
 func (t *Task) AddTimeSpent(hours float64) error {
 	if hours < MinTimeSpent {
@@ -349,17 +336,15 @@ func (t *Task) AddTimeSpent(hours float64) error {
 	return nil
 }
 
-// SetParentTask sets the parent task for subtask relationships
-// Note: This only validates immediate self-parent cycles. For broader cycle detection
-// and cross-project validation, additional checks should be performed at the service layer
-// with repository access to traverse the full parent chain via DFS.
+// SetParentTask sets the parent task for subtask relationships.
+// Subtasks are only ever created under an existing parent of the same project
+// (see taskService.CreateSubtask), so a parent chain cannot loop back beyond the
+// self-parent case checked here. Dependency cycles are checked by FindDependencyCycle.
 func (t *Task) SetParentTask(parentID string) error {
 	if parentID == t.ID {
 		return NewConflictError("circular_dependency",
 			"Task cannot be its own parent")
 	}
-	// TODO: Implement broader cycle detection and cross-project validation
-	// at the service layer with repository access
 	t.ParentTaskID = &parentID
 	t.UpdatedAt = time.Now().UTC()
 	return nil
@@ -393,6 +378,49 @@ func (t *Task) IsArchived() bool {
 	return t.Archived
 }
 
+// IsWatchedBy reports whether the user follows the task
+func (t *Task) IsWatchedBy(userID string) bool {
+	for _, watcher := range t.Watchers {
+		if watcher == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// AddWatcher makes the user follow the task. It reports false when the user already did.
+func (t *Task) AddWatcher(userID string) bool {
+	if userID == "" || t.IsWatchedBy(userID) {
+		return false
+	}
+	// Never append in place, so copies of the task keep their own watchers
+	t.Watchers = append(t.Watchers[:len(t.Watchers):len(t.Watchers)], userID)
+	return true
+}
+
+// RemoveWatcher stops the user following the task. It reports false when the user did not follow it.
+func (t *Task) RemoveWatcher(userID string) bool {
+	if !t.IsWatchedBy(userID) {
+		return false
+	}
+	watchers := make([]string, 0, len(t.Watchers)-1)
+	for _, watcher := range t.Watchers {
+		if watcher != userID {
+			watchers = append(watchers, watcher)
+		}
+	}
+	t.Watchers = watchers
+	return true
+}
+
+// WatchParticipants makes the reporter and the assignee follow the task
+func (t *Task) WatchParticipants() {
+	t.AddWatcher(t.ReporterID)
+	if t.AssigneeID != nil {
+		t.AddWatcher(*t.AssigneeID)
+	}
+}
+
 // GetColumnPositionMap retrieves the column positions as a map
 func (t *Task) GetColumnPositionMap() (map[string]int, error) {
 	if len(t.ColumnPosition) == 0 {
@@ -482,4 +510,6 @@ type UpdateTaskRequest struct {
 	DueDate     *time.Time             `json:"due_date,omitempty"`
 	Metadata    map[string]interface{} `json:"metadata,omitempty"`
 	Tags        []string               `json:"tags,omitempty"`
+	// OverrideBlocked allows moving a task forward while its dependencies are still open
+	OverrideBlocked bool `json:"override_blocked,omitempty"`
 }
@@ -17,18 +17,34 @@ const (
 	TaskAssigned  TaskEventType = "task.assigned"  // TaskAssigned indicates a task was assigned to someone
 	TaskDeleted   TaskEventType = "task.deleted"   // TaskDeleted indicates a task was removed
 	TaskCommented TaskEventType = "task.commented" // TaskCommented indicates a comment was added to a task
+	TaskUnblocked TaskEventType = "task.unblocked" // TaskUnblocked indicates a task's last open dependency was completed
+	TaskMentioned TaskEventType = "task.mentioned" // TaskMentioned indicates a user was @mentioned in a comment on a task
+
+	// TaskCommentReacted indicates a reaction was added to or removed from a comment on a task
+	TaskCommentReacted TaskEventType = "task.comment_reacted"
+	// TaskCommentResolved indicates a comment thread on a task was resolved or reopened
+	TaskCommentResolved TaskEventType = "task.comment_resolved"
+
+	// TasksBulkUpdated summarises one bulk operation; it carries no single task ID
+	TasksBulkUpdated TaskEventType = "tasks.bulk_updated"
 )
 
 // IsValid checks if the TaskEventType is one of the allowed values
 func (t TaskEventType) IsValid() bool {
 	switch t {
-	case TaskCreated, TaskUpdated, TaskMoved, TaskAssigned, TaskDeleted, TaskCommented:
+	case TaskCreated, TaskUpdated, TaskMoved, TaskAssigned, TaskDeleted, TaskCommented, TaskUnblocked,
+		TaskMentioned, TaskCommentReacted, TaskCommentResolved, TasksBulkUpdated:
 		return true
 	default:
 		return false
 	}
 }
 
+// RequiresTaskID reports whether events of this type must reference a single task
+func (t TaskEventType) RequiresTaskID() bool {
+	return t != TasksBulkUpdated
+}
+
 // String returns the string representation of the event type
 func (t TaskEventType) String() string {
 	return string(t)
@@ -37,13 +53,17 @@ func (t TaskEventType) String() string {
 // TaskEvent represents a real-time event related to task operations
 // This structure is used for broadcasting changes to all connected clients
 type TaskEvent struct {
-	Type      TaskEventType   `json:"type"`       // Type specifies what kind of event occurred
-	TaskID    string          `json:"task_id"`    // TaskID identifies the task that was affected
-	ProjectID string          `json:"project_id"` // ProjectID identifies the project containing the task
-	UserID    string          `json:"user_id"`    // UserID identifies who triggered the event
-	Data      json.RawMessage `json:"data"`       // Data contains event-specific payload
-	Timestamp time.Time       `json:"timestamp"`  // Timestamp when the event occurred
-	EventID   string          `json:"event_id"`   // EventID provides unique identifier for the event
+	Type      TaskEventType   `json:"type"`               // Type specifies what kind of event occurred
+	TaskID    string          `json:"task_id"`            // TaskID identifies the task that was affected
+	ProjectID string          `json:"project_id"`         // ProjectID identifies the project containing the task
+	UserID    string          `json:"user_id"`            // UserID identifies who triggered the event
+	Data      json.RawMessage `json:"data"`               // Data contains event-specific payload
+	Timestamp time.Time       `json:"timestamp"`          // Timestamp when the event occurred
+	EventID   string          `json:"event_id"`           // EventID provides unique identifier for the event
+	Sequence  int64           `json:"sequence,omitempty"` // Sequence orders the event in the event log, 0 until logged
+	Watchers  []string        `json:"watchers,omitempty"` // Watchers lists the users following the task
+	// Recipients limits delivery to these users; empty means everyone subscribed to the project
+	Recipients []string `json:"recipients,omitempty"`
 }
 
 // NewTaskEvent creates a new TaskEvent with the specified details
@@ -52,7 +72,7 @@ func NewTaskEvent(eventType TaskEventType, taskID, projectID, userID string, dat
 		return nil, NewValidationError("INVALID_EVENT_TYPE", "Invalid task event type", nil)
 	}
 
-	if taskID == "" {
+	if taskID == "" && eventType.RequiresTaskID() {
 		return nil, NewValidationError("INVALID_TASK_ID", "Task ID cannot be empty", nil)
 	}
 
@@ -74,7 +94,7 @@ func NewTaskEvent(eventType TaskEventType, taskID, projectID, userID string, dat
 		jsonData = dataBytes
 	}
 
-	return &TaskEvent{
+	event := &TaskEvent{
 		Type:      eventType,
 		TaskID:    taskID,
 		ProjectID: projectID,
@@ -82,7 +102,67 @@ func NewTaskEvent(eventType TaskEventType, taskID, projectID, userID string, dat
 		Data:      jsonData,
 		Timestamp: time.Now().UTC(),
 		EventID:   generateEventID(),
-	}, nil
+		Watchers:  eventWatchers(data),
+	}
+	if mentioned, ok := data.(*TaskMentionedData); ok {
+		event.Recipients = []string{mentioned.MentionedUserID}
+	}
+
+	return event, nil
+}
+
+// eventWatchers returns the watchers of the task carried by the event data
+func eventWatchers(data interface{}) []string {
+	var task *Task
+	switch d := data.(type) {
+	case *TaskCreatedData:
+		task = d.Task
+	case *TaskUpdatedData:
+		task = d.Task
+	case *TaskMovedData:
+		task = d.Task
+	case *TaskAssignedData:
+		task = d.Task
+	case *TaskCommentedData:
+		task = d.Task
+	case *TaskMentionedData:
+		task = d.Task
+	case *TaskCommentReactedData:
+		task = d.Task
+	case *TaskCommentResolvedData:
+		task = d.Task
+	case *TaskUnblockedData:
+		task = d.Task
+	case *TaskDeletedData:
+		return d.Watchers
+	}
+	if task == nil {
+		return nil
+	}
+	return task.Watchers
+}
+
+// IsFor reports whether the event may be delivered to the user, which is everyone unless it has recipients
+func (e *TaskEvent) IsFor(userID string) bool {
+	if len(e.Recipients) == 0 {
+		return true
+	}
+	for _, recipient := range e.Recipients {
+		if recipient == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// IsWatchedBy reports whether the user follows the task the event is about
+func (e *TaskEvent) IsWatchedBy(userID string) bool {
+	for _, watcher := range e.Watchers {
+		if watcher == userID {
+			return true
+		}
+	}
+	return false
 }
 
 // Validate ensures the TaskEvent has all required fields
@@ -91,7 +171,7 @@ func (e *TaskEvent) Validate() error {
 		return NewValidationError("INVALID_EVENT_TYPE", "Invalid task event type", nil)
 	}
 
-	if e.TaskID == "" {
+	if e.TaskID == "" && e.Type.RequiresTaskID() {
 		return NewValidationError("INVALID_TASK_ID", "Task ID cannot be empty", nil)
 	}
 
@@ -169,17 +249,61 @@ type TaskAssignedData struct {
 
 // TaskDeletedData contains data for task deletion events
 type TaskDeletedData struct {
-	TaskID    string `json:"task_id"`
-	TaskTitle string `json:"task_title"`
-	DeletedBy string `json:"deleted_by"`
+	TaskID    string   `json:"task_id"`
+	TaskTitle string   `json:"task_title"`
+	DeletedBy string   `json:"deleted_by"`
+	Watchers  []string `json:"watchers,omitempty"` // who followed the task before it was deleted
 }
 
 // TaskCommentedData contains data for task comment events
 type TaskCommentedData struct {
-	Task      *Task  `json:"task"`
-	CommentID string `json:"comment_id"`
-	Comment   string `json:"comment"`
-	Author    string `json:"author"`
+	Task      *Task    `json:"task"`
+	CommentID string   `json:"comment_id"`
+	Comment   string   `json:"comment"`
+	Author    string   `json:"author"`
+	Mentions  []string `json:"mentions,omitempty"` // IDs of the users @mentioned in the comment
+}
+
+// TaskMentionedData contains data for the event sent to a user @mentioned in a comment
+type TaskMentionedData struct {
+	Task            *Task  `json:"task"`
+	CommentID       string `json:"comment_id"`
+	Comment         string `json:"comment"`
+	Author          string `json:"author"`
+	MentionedUserID string `json:"mentioned_user_id"`
+}
+
+// TaskCommentReactedData contains data for events sent when a reaction to a comment changes
+type TaskCommentReactedData struct {
+	Task      *Task            `json:"task"`
+	CommentID string           `json:"comment_id"`
+	UserID    string           `json:"user_id"`
+	Emoji     string           `json:"emoji"`
+	Reactions []*ReactionGroup `json:"reactions"` // the comment's reactions after the change
+	Added     bool             `json:"added"`     // false when the reaction was removed
+}
+
+// TaskCommentResolvedData contains data for events sent when a comment thread is resolved or reopened
+type TaskCommentResolvedData struct {
+	Task       *Task      `json:"task"`
+	CommentID  string     `json:"comment_id"`
+	ResolvedBy string     `json:"resolved_by,omitempty"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	Resolved   bool       `json:"resolved"` // false when the thread was reopened
+}
+
+// TaskUnblockedData contains data for events sent when completing a dependency unblocks a task
+type TaskUnblockedData struct {
+	Task                  *Task  `json:"task"`
+	CompletedDependencyID string `json:"completed_dependency_id"`
+}
+
+// TasksBulkUpdatedData contains data for bulk operation events
+type TasksBulkUpdatedData struct {
+	Operation  string   `json:"operation"`
+	TaskIDs    []string `json:"task_ids"`
+	Successful int      `json:"successful"`
+	Failed     int      `json:"failed"`
 }
 
 // generateEventID creates a unique identifier for events
@@ -188,6 +312,9 @@ func generateEventID() string {
 	return fmt.Sprintf("evt_%d", time.Now().UnixNano())
 }
 
+// WatchingFilter is the EventSubscription filter that, set to "true", limits events to tasks the subscriber watches
+const WatchingFilter = "watching"
+
 // EventSubscription represents a client subscription to task events
 type EventSubscription struct {
 	ID           string            `json:"id"`            // Unique subscription identifier
@@ -260,6 +387,11 @@ func (s *EventSubscription) MatchesEvent(event *TaskEvent) bool {
 		return false
 	}
 
+	// Events addressed to particular users only reach them
+	if !event.IsFor(s.UserID) {
+		return false
+	}
+
 	// Apply additional filters
 	for key, value := range s.Filters {
 		if !s.matchesFilter(key, value, event) {
@@ -277,6 +409,8 @@ func (s *EventSubscription) matchesFilter(key, value string, event *TaskEvent) b
 		return event.UserID == value
 	case "task_id":
 		return event.TaskID == value
+	case WatchingFilter:
+		return value != "true" || event.IsWatchedBy(s.UserID)
 	// Add more filters as needed
 	default:
 		return true // Unknown filters are ignored
@@ -15,6 +15,8 @@ func TestTaskEventType(t *testing.T) {
 			TaskAssigned,
 			TaskDeleted,
 			TaskCommented,
+			TaskUnblocked,
+			TasksBulkUpdated,
 		}
 
 		for _, eventType := range validTypes {
@@ -40,6 +42,24 @@ func TestTaskEventType(t *testing.T) {
 }
 
 func TestNewTaskEvent(t *testing.T) {
+	t.Run("BulkEventWithoutTaskID", func(t *testing.T) {
+		event, err := NewTaskEvent(TasksBulkUpdated, "", "project1", "user1", &TasksBulkUpdatedData{
+			Operation:  "status",
+			TaskIDs:    []string{"task1", "task2"},
+			Successful: 2,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create bulk event: %v", err)
+		}
+		if err := event.Validate(); err != nil {
+			t.Errorf("Expected bulk event without task ID to be valid, got %v", err)
+		}
+
+		if _, err := NewTaskEvent(TaskUpdated, "", "project1", "user1", nil); err == nil {
+			t.Error("Expected single-task events to still require a task ID")
+		}
+	})
+
 	t.Run("ValidEvent", func(t *testing.T) {
 		eventData := &TaskCreatedData{
 			Task: &Task{
@@ -511,6 +531,52 @@ func TestEventSubscriptionMatching(t *testing.T) {
 		}
 	})
 
+	t.Run("WatchingFilter", func(t *testing.T) {
+		subscription := NewEventSubscription(
+			"user1",
+			nil,
+			[]TaskEventType{TaskUpdated, TaskDeleted},
+		)
+		subscription.Filters[WatchingFilter] = "true"
+
+		watched := &Task{ID: "task1", Watchers: []string{"user2", "user1"}}
+		event1, _ := NewTaskEvent(TaskUpdated, "task1", "project1", "user2", &TaskUpdatedData{Task: watched})
+		if !subscription.MatchesEvent(event1) {
+			t.Error("Expected subscription to match an event about a watched task")
+		}
+
+		unwatched := &Task{ID: "task2", Watchers: []string{"user2"}}
+		event2, _ := NewTaskEvent(TaskUpdated, "task2", "project1", "user2", &TaskUpdatedData{Task: unwatched})
+		if subscription.MatchesEvent(event2) {
+			t.Error("Expected subscription not to match an event about a task the user doesn't watch")
+		}
+
+		deleted := &TaskDeletedData{TaskID: "task1", Watchers: watched.Watchers}
+		event3, _ := NewTaskEvent(TaskDeleted, "task1", "project1", "user2", deleted)
+		if !subscription.MatchesEvent(event3) {
+			t.Error("Expected subscription to match the deletion of a watched task")
+		}
+	})
+
+	t.Run("MentionRecipients", func(t *testing.T) {
+		mentioned := NewEventSubscription("user1", nil, []TaskEventType{TaskMentioned})
+		bystander := NewEventSubscription("user3", nil, []TaskEventType{TaskMentioned})
+
+		event, err := NewTaskEvent(TaskMentioned, "task1", "project1", "user2", &TaskMentionedData{
+			Task: &Task{ID: "task1"}, CommentID: "comment1", Author: "user2", MentionedUserID: "user1",
+		})
+		if err != nil {
+			t.Fatalf("Failed to create mention event: %v", err)
+		}
+
+		if !mentioned.MatchesEvent(event) {
+			t.Error("Expected the mentioned user's subscription to match")
+		}
+		if bystander.MatchesEvent(event) {
+			t.Error("Expected other users' subscriptions not to match a mention")
+		}
+	})
+
 	t.Run("UpdateActivity", func(t *testing.T) {
 		subscription := NewEventSubscription(
 			"user1",
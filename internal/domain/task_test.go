@@ -242,6 +242,30 @@ func TestTask_UpdateProgress(t *testing.T) {
 	}
 }
 
+func TestTask_ApplyChecklist(t *testing.T) {
+	task := domain.NewTask("Test Task", "description", "proj-123", "user-456")
+	task.Status = domain.StatusReview
+	task.Progress = 10
+
+	task.ApplyChecklist(0, 0)
+	if task.Progress != 10 {
+		t.Errorf("Expected progress to be left alone without a checklist, got %d", task.Progress)
+	}
+
+	task.ApplyChecklist(1, 3)
+	if task.Progress != 33 {
+		t.Errorf("Expected progress 33, got %d", task.Progress)
+	}
+
+	task.ApplyChecklist(3, 3)
+	if task.Progress != 100 {
+		t.Errorf("Expected progress 100, got %d", task.Progress)
+	}
+	if task.Status != domain.StatusReview {
+		t.Errorf("Expected status to stay %s, got %s", domain.StatusReview, task.Status)
+	}
+}
+
 func TestTask_AddTimeSpent(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -607,3 +631,39 @@ func TestTask_IsOverdue(t *testing.T) {
 		})
 	}
 }
+
+func TestTask_Watchers(t *testing.T) {
+	task := domain.NewTask("Test Task", "description", "proj-123", "user-456")
+	task.AssignTo("user-789")
+	task.WatchParticipants()
+
+	if !task.IsWatchedBy("user-456") || !task.IsWatchedBy("user-789") {
+		t.Fatalf("Expected reporter and assignee to watch the task, got %v", task.Watchers)
+	}
+
+	if task.AddWatcher("user-456") {
+		t.Error("Expected adding an existing watcher to report false")
+	}
+
+	snapshot := *task
+	if !task.AddWatcher("user-111") {
+		t.Error("Expected adding a new watcher to report true")
+	}
+	if snapshot.IsWatchedBy("user-111") || len(snapshot.Watchers) != 2 {
+		t.Errorf("Expected copies of the task to keep their watchers, got %v", snapshot.Watchers)
+	}
+
+	if !task.RemoveWatcher("user-456") {
+		t.Error("Expected removing a watcher to report true")
+	}
+	if task.RemoveWatcher("user-456") {
+		t.Error("Expected removing a non-watcher to report false")
+	}
+	if !snapshot.IsWatchedBy("user-456") {
+		t.Error("Expected copies of the task to keep watchers removed later")
+	}
+
+	if len(task.Watchers) != 2 || task.IsWatchedBy("user-456") {
+		t.Errorf("Expected user-789 and user-111 to watch the task, got %v", task.Watchers)
+	}
+}
@@ -0,0 +1,287 @@
+package domain
+
+import (
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Webhook constraints
+const (
+	MaxWebhookURLLen         = 2000          // MaxWebhookURLLen defines the maximum length for endpoint URLs
+	MaxWebhookDescriptionLen = 200           // MaxWebhookDescriptionLen defines the maximum length for descriptions
+	MinWebhookSecretLen      = 16            // MinWebhookSecretLen defines the minimum length for signing secrets
+	MaxWebhookSecretLen      = 200           // MaxWebhookSecretLen defines the maximum length for signing secrets
+	MaxWebhookRetryDelay     = 6 * time.Hour // MaxWebhookRetryDelay caps the backoff between delivery attempts
+	MaxWebhookErrorLen       = 500           // MaxWebhookErrorLen defines the maximum stored length of a delivery error
+	// WebhookDisableThreshold is how many consecutive failed attempts disable a webhook
+	WebhookDisableThreshold = 15
+	webhookBackoffMaxShift  = 20
+)
+
+// Webhook is an outbound endpoint that receives a project's task events.
+// The secret signs every payload so the receiver can verify it came from us.
+type Webhook struct {
+	// 8-byte aligned fields first
+	CreatedAt  time.Time  `json:"created_at" db:"created"`
+	UpdatedAt  time.Time  `json:"updated_at" db:"updated"`
+	DisabledAt *time.Time `json:"disabled_at,omitempty" db:"disabled_at"`
+
+	// String fields
+	ID             string `json:"id" db:"id"`
+	ProjectID      string `json:"project_id" db:"project"`
+	URL            string `json:"url" db:"url"`
+	Description    string `json:"description,omitempty" db:"description"`
+	Secret         string `json:"-" db:"secret"`
+	CreatedBy      string `json:"created_by" db:"created_by"`
+	DisabledReason string `json:"disabled_reason,omitempty" db:"disabled_reason"`
+
+	// Slice fields
+	EventTypes []TaskEventType `json:"event_types" db:"event_types"` // empty receives every event type
+
+	// 4-byte aligned fields
+	ConsecutiveFailures int `json:"consecutive_failures" db:"consecutive_failures"`
+
+	// 1-byte aligned fields
+	Active bool `json:"active" db:"active"`
+}
+
+// Validate performs comprehensive validation of the webhook
+func (w *Webhook) Validate() error {
+	if w.ProjectID == "" {
+		return NewValidationError("project_id", "Project ID is required", nil)
+	}
+	if err := ValidateWebhookURL(w.URL); err != nil {
+		return err
+	}
+	if utf8.RuneCountInString(w.Description) > MaxWebhookDescriptionLen {
+		return NewValidationError("description", "Description must be at most 200 characters", nil)
+	}
+	if len(w.Secret) < MinWebhookSecretLen || len(w.Secret) > MaxWebhookSecretLen {
+		return NewValidationError("secret", "Secret must be between 16 and 200 characters", nil)
+	}
+	seen := make(map[TaskEventType]bool, len(w.EventTypes))
+	for _, eventType := range w.EventTypes {
+		if !eventType.IsValid() {
+			return NewValidationError("event_types", "Invalid event type: "+string(eventType), nil)
+		}
+		if seen[eventType] {
+			return NewValidationError("event_types", "Duplicate event type: "+string(eventType), nil)
+		}
+		seen[eventType] = true
+	}
+	return nil
+}
+
+// ValidateWebhookURL checks that an endpoint URL is an absolute http(s) URL
+func ValidateWebhookURL(rawURL string) error {
+	if rawURL == "" || len(rawURL) > MaxWebhookURLLen {
+		return NewValidationError("url", "URL is required and must be at most 2000 characters", nil)
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "https" && parsed.Scheme != "http") {
+		return NewValidationError("url", "URL must be an absolute http or https URL", nil)
+	}
+	if parsed.User != nil {
+		return NewValidationError("url", "URL must not contain credentials", nil)
+	}
+	return nil
+}
+
+// sharedAddressSpace is the carrier-grade NAT range, which is no more public than private networks
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// IsPublicWebhookAddress reports whether an endpoint address is on the public internet. Loopback,
+// link-local, private and other addresses inside the server's network are not.
+func IsPublicWebhookAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsUnspecified() || addr.IsLoopback() || addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() || addr.IsMulticast() {
+		return false
+	}
+	return !sharedAddressSpace.Contains(addr)
+}
+
+// Matches reports whether the webhook should receive the event
+func (w *Webhook) Matches(event *TaskEvent) bool {
+	if !w.Active || event.ProjectID != w.ProjectID {
+		return false
+	}
+	if len(w.EventTypes) == 0 {
+		return true
+	}
+	for _, eventType := range w.EventTypes {
+		if eventType == event.Type {
+			return true
+		}
+	}
+	return false
+}
+
+// RecordSuccess resets the failure count after a successful delivery
+func (w *Webhook) RecordSuccess(now time.Time) {
+	w.ConsecutiveFailures = 0
+	w.UpdatedAt = now
+}
+
+// RecordFailure counts a failed delivery attempt and disables the webhook once
+// WebhookDisableThreshold attempts in a row have failed. It reports whether it disabled the webhook.
+func (w *Webhook) RecordFailure(now time.Time) bool {
+	w.ConsecutiveFailures++
+	w.UpdatedAt = now
+
+	if !w.Active || w.ConsecutiveFailures < WebhookDisableThreshold {
+		return false
+	}
+
+	w.Active = false
+	w.DisabledAt = &now
+	w.DisabledReason = "Disabled after repeated delivery failures"
+	return true
+}
+
+// Enable reactivates the webhook and clears its failure history
+func (w *Webhook) Enable(now time.Time) {
+	w.Active = true
+	w.ConsecutiveFailures = 0
+	w.DisabledAt = nil
+	w.DisabledReason = ""
+	w.UpdatedAt = now
+}
+
+// Disable deactivates the webhook
+func (w *Webhook) Disable(now time.Time, reason string) {
+	w.Active = false
+	w.DisabledAt = &now
+	w.DisabledReason = reason
+	w.UpdatedAt = now
+}
+
+// WebhookDeliveryStatus represents the state of a webhook delivery
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending means the delivery is waiting for its next attempt
+	WebhookDeliveryPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliverySucceeded means the endpoint answered with a 2xx status
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryFailed means every attempt failed, or the webhook was disabled, and the delivery was given up
+	WebhookDeliveryFailed WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery is one event queued for, or delivered to, a webhook.
+// Deliveries double as the webhook's delivery log.
+type WebhookDelivery struct {
+	// 8-byte aligned fields first
+	NextAttemptAt time.Time  `json:"next_attempt_at" db:"next_attempt_at"`
+	CreatedAt     time.Time  `json:"created_at" db:"created"`
+	UpdatedAt     time.Time  `json:"updated_at" db:"updated"`
+	DeliveredAt   *time.Time `json:"delivered_at,omitempty" db:"delivered_at"`
+	DurationMs    int64      `json:"duration_ms" db:"duration_ms"` // duration of the last attempt
+
+	// String fields
+	ID           string                `json:"id" db:"id"`
+	WebhookID    string                `json:"webhook_id" db:"webhook"`
+	ProjectID    string                `json:"project_id" db:"project"`
+	EventID      string                `json:"event_id" db:"event_id"`
+	EventType    TaskEventType         `json:"event_type" db:"event_type"`
+	Status       WebhookDeliveryStatus `json:"status" db:"status"`
+	LastError    string                `json:"last_error,omitempty" db:"last_error"`
+	RedeliveryOf string                `json:"redelivery_of,omitempty" db:"redelivery_of"`
+
+	// Slice fields
+	Payload []byte `json:"-" db:"payload"` // the exact body that is signed and sent
+
+	// 4-byte aligned fields
+	Attempts       int `json:"attempts" db:"attempts"`
+	MaxAttempts    int `json:"max_attempts" db:"max_attempts"`
+	ResponseStatus int `json:"response_status,omitempty" db:"response_status"`
+}
+
+// Validate performs comprehensive validation of the webhook delivery
+func (d *WebhookDelivery) Validate() error {
+	if d.WebhookID == "" {
+		return NewValidationError("webhook_id", "Webhook ID is required", nil)
+	}
+	if d.ProjectID == "" {
+		return NewValidationError("project_id", "Project ID is required", nil)
+	}
+	if d.EventID == "" {
+		return NewValidationError("event_id", "Event ID is required", nil)
+	}
+	if !d.EventType.IsValid() {
+		return NewValidationError("event_type", "Invalid event type", nil)
+	}
+	if len(d.Payload) == 0 {
+		return NewValidationError("payload", "Payload is required", nil)
+	}
+	if d.MaxAttempts <= 0 {
+		return NewValidationError("max_attempts", "Max attempts must be positive", nil)
+	}
+	switch d.Status {
+	case WebhookDeliveryPending, WebhookDeliverySucceeded, WebhookDeliveryFailed:
+	default:
+		return NewValidationError("status", "Invalid delivery status", nil)
+	}
+	return nil
+}
+
+// IsDue reports whether the delivery is waiting for an attempt at the given time
+func (d *WebhookDelivery) IsDue(now time.Time) bool {
+	return d.Status == WebhookDeliveryPending && !d.NextAttemptAt.After(now)
+}
+
+// MarkSucceeded records a successful attempt
+func (d *WebhookDelivery) MarkSucceeded(responseStatus int, duration time.Duration, now time.Time) {
+	d.Attempts++
+	d.Status = WebhookDeliverySucceeded
+	d.ResponseStatus = responseStatus
+	d.DurationMs = duration.Milliseconds()
+	d.DeliveredAt = &now
+	d.LastError = ""
+	d.UpdatedAt = now
+}
+
+// MarkAttemptFailed records a failed attempt. responseStatus is 0 when no response was received.
+// The next attempt is scheduled after a delay that starts at retryInterval and doubles with
+// every attempt; after the last attempt the delivery is given up.
+func (d *WebhookDelivery) MarkAttemptFailed(
+	responseStatus int, cause string, duration time.Duration, now time.Time, retryInterval time.Duration,
+) {
+	d.Attempts++
+	d.ResponseStatus = responseStatus
+	d.DurationMs = duration.Milliseconds()
+	d.LastError = truncateWebhookError(cause)
+	d.UpdatedAt = now
+
+	if d.Attempts >= d.MaxAttempts {
+		d.Status = WebhookDeliveryFailed
+		return
+	}
+
+	shift := min(d.Attempts-1, webhookBackoffMaxShift)
+	delay := retryInterval << shift
+	if delay <= 0 || delay > MaxWebhookRetryDelay {
+		delay = MaxWebhookRetryDelay
+	}
+	d.NextAttemptAt = now.Add(delay)
+}
+
+// GiveUp abandons a pending delivery without another attempt
+func (d *WebhookDelivery) GiveUp(reason string, now time.Time) {
+	d.Status = WebhookDeliveryFailed
+	d.LastError = truncateWebhookError(reason)
+	d.UpdatedAt = now
+}
+
+// truncateWebhookError keeps delivery errors within the stored length
+func truncateWebhookError(message string) string {
+	message = strings.TrimSpace(message)
+	if utf8.RuneCountInString(message) <= MaxWebhookErrorLen {
+		return message
+	}
+	runes := []rune(message)
+	return string(runes[:MaxWebhookErrorLen-len(emailErrorEllipsis)]) + emailErrorEllipsis
+}
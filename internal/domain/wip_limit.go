@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"time"
+)
+
+// WIPLimit is the stored work-in-progress limit for one status column of a project board
+type WIPLimit struct {
+	// 8-byte aligned fields first
+	CreatedAt time.Time `json:"created_at" db:"created"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated"`
+
+	// String fields
+	ID        string     `json:"id" db:"id"`
+	ProjectID string     `json:"project_id" db:"project"`
+	Status    TaskStatus `json:"status" db:"status"`
+
+	// 4-byte aligned fields
+	SoftLimit int `json:"soft_limit" db:"soft_limit"`
+	HardLimit int `json:"hard_limit" db:"hard_limit"`
+
+	// Single byte fields at the end
+	Enabled bool `json:"enabled" db:"enabled"`
+}
+
+// Validate performs comprehensive validation of the WIP limit
+func (l *WIPLimit) Validate() error {
+	if l.ProjectID == "" {
+		return NewValidationError("project_id", "Project ID is required", nil)
+	}
+	if !l.Status.IsValid() {
+		return NewValidationError("status", "Invalid task status", nil)
+	}
+	if l.SoftLimit < 0 || l.HardLimit < 0 {
+		return NewValidationError("limits", "WIP limits cannot be negative", nil)
+	}
+	if l.HardLimit > 0 && l.SoftLimit > l.HardLimit {
+		return NewValidationError("limits", "Soft limit cannot exceed hard limit", nil)
+	}
+	return nil
+}
+
+// WIPLimitOverride records a move that was allowed past a hard WIP limit
+type WIPLimitOverride struct {
+	// 8-byte aligned fields first
+	CreatedAt time.Time `json:"created_at" db:"created"`
+
+	// String fields
+	ID        string     `json:"id" db:"id"`
+	ProjectID string     `json:"project_id" db:"project"`
+	TaskID    string     `json:"task_id" db:"task"`
+	UserID    string     `json:"user_id" db:"user"`
+	Status    TaskStatus `json:"status" db:"status"`
+	Reason    string     `json:"reason" db:"reason"`
+	Comment   string     `json:"comment" db:"comment"`
+
+	// 4-byte aligned fields
+	HardLimit   int `json:"hard_limit" db:"hard_limit"`
+	ColumnCount int `json:"column_count" db:"column_count"`
+}
+
+// Validate performs comprehensive validation of the override record
+func (o *WIPLimitOverride) Validate() error {
+	if o.ProjectID == "" {
+		return NewValidationError("project_id", "Project ID is required", nil)
+	}
+	if o.TaskID == "" {
+		return NewValidationError("task_id", "Task ID is required", nil)
+	}
+	if o.UserID == "" {
+		return NewValidationError("user_id", "User ID is required", nil)
+	}
+	if !o.Status.IsValid() {
+		return NewValidationError("status", "Invalid task status", nil)
+	}
+	if o.Reason == "" {
+		return NewValidationError("reason", "Override reason is required", nil)
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Workflow validation constraints
+const (
+	MaxWorkflowStatuses      = 20 // MaxWorkflowStatuses bounds how many columns a board may have
+	MaxWorkflowStatusNameLen = 50 // MaxWorkflowStatusNameLen defines the maximum length for column names
+)
+
+// WorkflowStatus is one column of a project workflow
+type WorkflowStatus struct {
+	ID   TaskStatus `json:"id"`
+	Name string     `json:"name"`
+	Done bool       `json:"done,omitempty"` // tasks in a done status count as finished
+}
+
+// Workflow defines the statuses a project's tasks move through and the moves allowed between them.
+// Statuses are listed in board order. A nil Transitions map allows any move; otherwise a status
+// only moves to the statuses listed for it.
+type Workflow struct {
+	Transitions   map[TaskStatus][]TaskStatus `json:"transitions,omitempty"`
+	InitialStatus TaskStatus                  `json:"initial_status,omitempty"` // defaults to the first status
+	Statuses      []WorkflowStatus            `json:"statuses"`
+}
+
+// DefaultWorkflow returns the workflow used by projects that have not configured their own
+func DefaultWorkflow() *Workflow {
+	return &Workflow{
+		Statuses: []WorkflowStatus{
+			{ID: StatusBacklog, Name: "Backlog"},
+			{ID: StatusTodo, Name: "To Do"},
+			{ID: StatusDeveloping, Name: "In Progress"},
+			{ID: StatusReview, Name: "Review"},
+			{ID: StatusComplete, Name: "Complete", Done: true},
+		},
+		Transitions: map[TaskStatus][]TaskStatus{
+			StatusBacklog:    {StatusTodo, StatusDeveloping},
+			StatusTodo:       {StatusBacklog, StatusDeveloping},
+			StatusDeveloping: {StatusTodo, StatusReview, StatusBacklog},
+			StatusReview:     {StatusDeveloping, StatusComplete, StatusTodo},
+			StatusComplete:   {StatusReview, StatusTodo},
+		},
+		InitialStatus: StatusTodo,
+	}
+}
+
+// Validate validates the workflow definition
+func (w *Workflow) Validate() error {
+	if len(w.Statuses) == 0 {
+		return newWorkflowError("Workflow must define at least one status", nil)
+	}
+	if len(w.Statuses) > MaxWorkflowStatuses {
+		return newWorkflowError(fmt.Sprintf("Workflow cannot define more than %d statuses", MaxWorkflowStatuses), nil)
+	}
+
+	seen := make(map[TaskStatus]bool, len(w.Statuses))
+	hasDone := false
+	for _, status := range w.Statuses {
+		if !status.ID.IsValid() {
+			return newWorkflowError("Status IDs must be lowercase letters, digits, '-' or '_'",
+				map[string]interface{}{"status": string(status.ID)})
+		}
+		if seen[status.ID] {
+			return newWorkflowError("Status IDs must be unique", map[string]interface{}{"status": string(status.ID)})
+		}
+		seen[status.ID] = true
+
+		name := strings.TrimSpace(status.Name)
+		if name == "" || utf8.RuneCountInString(name) > MaxWorkflowStatusNameLen {
+			return newWorkflowError(
+				fmt.Sprintf("Status names must be between 1 and %d characters", MaxWorkflowStatusNameLen),
+				map[string]interface{}{"status": string(status.ID)})
+		}
+		hasDone = hasDone || status.Done
+	}
+
+	if !hasDone {
+		return newWorkflowError("Workflow must mark at least one status as done", nil)
+	}
+	if w.InitialStatus != "" && !seen[w.InitialStatus] {
+		return newWorkflowError("Initial status must be one of the workflow statuses",
+			map[string]interface{}{"status": string(w.InitialStatus)})
+	}
+
+	for from, targets := range w.Transitions {
+		if !seen[from] {
+			return newWorkflowError("Transitions must start from a workflow status",
+				map[string]interface{}{"status": string(from)})
+		}
+		for _, to := range targets {
+			if !seen[to] || to == from {
+				return newWorkflowError("Transitions must lead to another workflow status",
+					map[string]interface{}{"from": string(from), "to": string(to)})
+			}
+		}
+	}
+
+	return nil
+}
+
+// newWorkflowError creates the validation error for an invalid workflow definition
+func newWorkflowError(message string, details map[string]interface{}) *Error {
+	return NewValidationError("INVALID_WORKFLOW", message, details)
+}
+
+// HasStatus reports whether the status is part of the workflow
+func (w *Workflow) HasStatus(status TaskStatus) bool {
+	return w.position(status) >= 0
+}
+
+// StatusIDs returns the workflow's statuses in board order
+func (w *Workflow) StatusIDs() []TaskStatus {
+	ids := make([]TaskStatus, len(w.Statuses))
+	for i, status := range w.Statuses {
+		ids[i] = status.ID
+	}
+	return ids
+}
+
+// StatusName returns the display name of a status, or the status itself when it isn't part of the workflow
+func (w *Workflow) StatusName(status TaskStatus) string {
+	if i := w.position(status); i >= 0 {
+		return w.Statuses[i].Name
+	}
+	return string(status)
+}
+
+// IsDone reports whether tasks in the status count as finished
+func (w *Workflow) IsDone(status TaskStatus) bool {
+	i := w.position(status)
+	return i >= 0 && w.Statuses[i].Done
+}
+
+// Initial returns the status new tasks start in
+func (w *Workflow) Initial() TaskStatus {
+	if w.InitialStatus != "" {
+		return w.InitialStatus
+	}
+	return w.Statuses[0].ID
+}
+
+// DoneStatus returns the first done status in board order, which closing a task moves it to
+func (w *Workflow) DoneStatus() TaskStatus {
+	for _, status := range w.Statuses {
+		if status.Done {
+			return status.ID
+		}
+	}
+	return ""
+}
+
+// CanTransition reports whether a task may move from one status to another
+func (w *Workflow) CanTransition(from, to TaskStatus) bool {
+	if from == to || !w.HasStatus(from) || !w.HasStatus(to) {
+		return false
+	}
+	if w.Transitions == nil {
+		return true
+	}
+	for _, allowed := range w.Transitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowedTransitions returns the statuses a task may move to from the given status
+func (w *Workflow) AllowedTransitions(from TaskStatus) []TaskStatus {
+	var allowed []TaskStatus
+	for _, status := range w.Statuses {
+		if w.CanTransition(from, status.ID) {
+			allowed = append(allowed, status.ID)
+		}
+	}
+	return allowed
+}
+
+// IsForwardMove reports whether moving between statuses starts or advances work: the target is
+// further right on the board than both the current status and the initial status. Statuses up
+// to the initial one are for planning, so moving between them, or backwards, is never forward.
+func (w *Workflow) IsForwardMove(from, to TaskStatus) bool {
+	target := w.position(to)
+	return target > w.position(from) && target > w.position(w.Initial())
+}
+
+// ValidateStatus returns a validation error when the status is not part of the workflow
+func (w *Workflow) ValidateStatus(status TaskStatus) error {
+	if w.HasStatus(status) {
+		return nil
+	}
+	return NewValidationError("INVALID_STATUS", "Invalid task status for this project's workflow",
+		map[string]interface{}{
+			"status":         string(status),
+			"valid_statuses": statusStrings(w.StatusIDs()),
+		})
+}
+
+// position returns the board index of a status, or -1 when it isn't part of the workflow
+func (w *Workflow) position(status TaskStatus) int {
+	for i, candidate := range w.Statuses {
+		if candidate.ID == status {
+			return i
+		}
+	}
+	return -1
+}
+
+// statusStrings converts statuses for error details
+func statusStrings(statuses []TaskStatus) []string {
+	values := make([]string, len(statuses))
+	for i, status := range statuses {
+		values[i] = string(status)
+	}
+	return values
+}
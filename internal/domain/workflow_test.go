@@ -0,0 +1,181 @@
+package domain_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// qaWorkflow is a custom workflow with a QA column and two done statuses
+func qaWorkflow() *domain.Workflow {
+	return &domain.Workflow{
+		Statuses: []domain.WorkflowStatus{
+			{ID: "ideas", Name: "Ideas"},
+			{ID: "ready", Name: "Ready"},
+			{ID: "building", Name: "Building"},
+			{ID: "qa", Name: "QA"},
+			{ID: "deployed", Name: "Deployed", Done: true},
+			{ID: "wont_do", Name: "Won't Do", Done: true},
+		},
+		Transitions: map[domain.TaskStatus][]domain.TaskStatus{
+			"ideas":    {"ready", "wont_do"},
+			"ready":    {"building", "ideas"},
+			"building": {"qa", "ready"},
+			"qa":       {"deployed", "building"},
+		},
+		InitialStatus: "ready",
+	}
+}
+
+func TestDefaultWorkflow_MatchesBuiltInStatuses(t *testing.T) {
+	workflow := domain.DefaultWorkflow()
+	if err := workflow.Validate(); err != nil {
+		t.Fatalf("Expected default workflow to be valid, got %v", err)
+	}
+
+	expected := []domain.TaskStatus{
+		domain.StatusBacklog, domain.StatusTodo, domain.StatusDeveloping, domain.StatusReview, domain.StatusComplete,
+	}
+	if !reflect.DeepEqual(workflow.StatusIDs(), expected) {
+		t.Errorf("Expected default statuses %v, got %v", expected, workflow.StatusIDs())
+	}
+	if workflow.Initial() != domain.StatusTodo || workflow.DoneStatus() != domain.StatusComplete {
+		t.Errorf("Expected todo/complete, got %s/%s", workflow.Initial(), workflow.DoneStatus())
+	}
+}
+
+func TestWorkflow_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(w *domain.Workflow)
+	}{
+		{"NoStatuses", func(w *domain.Workflow) { w.Statuses = nil }},
+		{"MalformedID", func(w *domain.Workflow) { w.Statuses[0].ID = "In Review" }},
+		{"DuplicateID", func(w *domain.Workflow) { w.Statuses[1].ID = "ideas" }},
+		{"EmptyName", func(w *domain.Workflow) { w.Statuses[0].Name = "  " }},
+		{"NoDoneStatus", func(w *domain.Workflow) {
+			w.Statuses[4].Done = false
+			w.Statuses[5].Done = false
+		}},
+		{"UnknownInitialStatus", func(w *domain.Workflow) { w.InitialStatus = "missing" }},
+		{"TransitionFromUnknownStatus", func(w *domain.Workflow) { w.Transitions["missing"] = []domain.TaskStatus{"qa"} }},
+		{"TransitionToUnknownStatus", func(w *domain.Workflow) { w.Transitions["qa"] = []domain.TaskStatus{"missing"} }},
+		{"SelfTransition", func(w *domain.Workflow) { w.Transitions["qa"] = []domain.TaskStatus{"qa"} }},
+	}
+
+	if err := qaWorkflow().Validate(); err != nil {
+		t.Fatalf("Expected custom workflow to be valid, got %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workflow := qaWorkflow()
+			tt.modify(workflow)
+
+			var domainErr *domain.Error
+			if err := workflow.Validate(); !errors.As(err, &domainErr) || domainErr.Code != "INVALID_WORKFLOW" {
+				t.Errorf("Expected INVALID_WORKFLOW, got %v", err)
+			}
+		})
+	}
+}
+
+func TestWorkflow_Transitions(t *testing.T) {
+	workflow := qaWorkflow()
+
+	if !workflow.CanTransition("building", "qa") || workflow.CanTransition("building", "deployed") {
+		t.Error("Expected only listed transitions to be allowed")
+	}
+	if workflow.CanTransition("deployed", "qa") {
+		t.Error("Expected a status without transitions to be final")
+	}
+	if workflow.CanTransition("qa", domain.StatusComplete) {
+		t.Error("Expected statuses outside the workflow to be refused")
+	}
+	if got := workflow.AllowedTransitions("qa"); !reflect.DeepEqual(got, []domain.TaskStatus{"building", "deployed"}) {
+		t.Errorf("Expected transitions in board order, got %v", got)
+	}
+
+	workflow.Transitions = nil
+	if !workflow.CanTransition("deployed", "ideas") {
+		t.Error("Expected a workflow without transitions to allow any move")
+	}
+}
+
+func TestWorkflow_IsDone(t *testing.T) {
+	workflow := qaWorkflow()
+
+	for status, expected := range map[domain.TaskStatus]bool{
+		"deployed": true, "wont_do": true, "qa": false, domain.StatusComplete: false,
+	} {
+		if workflow.IsDone(status) != expected {
+			t.Errorf("IsDone(%s) = %v, expected %v", status, !expected, expected)
+		}
+	}
+}
+
+func TestWorkflow_IsForwardMove(t *testing.T) {
+	tests := []struct {
+		workflow *domain.Workflow
+		from, to domain.TaskStatus
+		expected bool
+	}{
+		{domain.DefaultWorkflow(), domain.StatusTodo, domain.StatusDeveloping, true},
+		{domain.DefaultWorkflow(), domain.StatusBacklog, domain.StatusComplete, true},
+		{domain.DefaultWorkflow(), domain.StatusDeveloping, domain.StatusReview, true},
+		{domain.DefaultWorkflow(), domain.StatusBacklog, domain.StatusTodo, false},
+		{domain.DefaultWorkflow(), domain.StatusReview, domain.StatusDeveloping, false},
+		{domain.DefaultWorkflow(), domain.StatusDeveloping, domain.StatusDeveloping, false},
+		{qaWorkflow(), "ideas", "ready", false},
+		{qaWorkflow(), "ready", "building", true},
+		{qaWorkflow(), "qa", "deployed", true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.workflow.IsForwardMove(tt.from, tt.to); got != tt.expected {
+			t.Errorf("IsForwardMove(%s, %s) = %v, expected %v", tt.from, tt.to, got, tt.expected)
+		}
+	}
+}
+
+func TestTask_UpdateStatusIn(t *testing.T) {
+	task := domain.NewTask("Ship it", "", "project-1", "user-1")
+	task.Status = "qa"
+
+	if err := task.UpdateStatusIn(qaWorkflow(), "deployed"); err != nil {
+		t.Fatalf("Expected qa → deployed to be allowed, got %v", err)
+	}
+	if task.Status != "deployed" {
+		t.Errorf("Expected deployed, got %s", task.Status)
+	}
+
+	var domainErr *domain.Error
+	err := task.UpdateStatusIn(qaWorkflow(), domain.StatusReview)
+	if !errors.As(err, &domainErr) || domainErr.Type != domain.ValidationError {
+		t.Errorf("Expected a validation error for a status outside the workflow, got %v", err)
+	}
+
+	err = task.UpdateStatusIn(qaWorkflow(), "qa")
+	if !errors.As(err, &domainErr) || domainErr.Code != "invalid_transition" {
+		t.Errorf("Expected invalid_transition out of a final status, got %v", err)
+	}
+}
+
+func TestProject_Workflow(t *testing.T) {
+	project := &domain.Project{Title: "P", Slug: "p", OwnerID: "owner", Status: domain.ActiveProject}
+	if !reflect.DeepEqual(project.Workflow(), domain.DefaultWorkflow()) {
+		t.Error("Expected projects without a workflow to use the default")
+	}
+
+	project.Settings.Workflow = qaWorkflow()
+	if project.Workflow().Initial() != "ready" {
+		t.Errorf("Expected the project's own workflow, got initial status %s", project.Workflow().Initial())
+	}
+
+	project.Settings.Workflow.Statuses = nil
+	if err := project.Validate(); err == nil {
+		t.Error("Expected an invalid workflow to fail project validation")
+	}
+}
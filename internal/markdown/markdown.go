@@ -0,0 +1,144 @@
+// Package markdown renders the markdown users write in task descriptions and comments to HTML.
+//
+// Parsing is goldmark's CommonMark implementation with the GitHub extensions for tables, task
+// lists, strikethrough and bare URLs. Raw HTML in the source is never passed through: goldmark
+// omits it, and the rendered output goes through Sanitize before it is returned.
+package markdown
+
+import (
+	"bytes"
+	"html"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	extast "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// maxSourceLength bounds the source that is parsed as markdown. Some inputs take the parser time
+// quadratic in their length, so longer sources are shown as preformatted text instead.
+const maxSourceLength = 16 << 10
+
+// maxNesting bounds how deeply block quotes and lists nest; deeper ones are merged into the
+// block around them
+const maxNesting = 32
+
+// renderer is the goldmark instance every rendering shares; it is safe for concurrent use
+var renderer = goldmark.New(
+	goldmark.WithExtensions(
+		extension.NewTable(extension.WithTableCellAlignMethod(extension.TableCellAlignAttribute)),
+		extension.Strikethrough,
+		extension.Linkify,
+		extension.TaskList,
+	),
+	goldmark.WithParserOptions(
+		parser.WithASTTransformers(util.Prioritized(nestingLimit{}, 0)),
+	),
+)
+
+// Render converts markdown source to sanitized HTML
+func Render(source string) string {
+	if strings.TrimSpace(source) == "" {
+		return ""
+	}
+	if len(source) > maxSourceLength {
+		return preformatted(source)
+	}
+
+	var b bytes.Buffer
+	if err := renderer.Convert([]byte(source), &b); err != nil {
+		return preformatted(source)
+	}
+	return Sanitize(b.String())
+}
+
+// Checklist counts the task list items ("- [ ]" and "- [x]") in the source and how many of them
+// are checked. Items inside code blocks are not counted, nor are any in sources too long to render.
+func Checklist(source string) (done, total int) {
+	if len(source) > maxSourceLength {
+		return 0, 0
+	}
+
+	document := renderer.Parser().Parse(text.NewReader([]byte(source)))
+	_ = ast.Walk(document, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		checkBox, ok := n.(*extast.TaskCheckBox)
+		if !entering || !ok {
+			return ast.WalkContinue, nil
+		}
+		total++
+		if checkBox.IsChecked {
+			done++
+		}
+		return ast.WalkContinue, nil
+	})
+	return done, total
+}
+
+// preformatted shows source as it was written
+func preformatted(source string) string {
+	return "<pre>" + html.EscapeString(source) + "</pre>\n"
+}
+
+// nestingLimit is an AST transformer that merges block quotes and lists nested deeper than
+// maxNesting into the block around them
+type nestingLimit struct{}
+
+// Transform flattens the document's deeply nested blocks
+func (nestingLimit) Transform(document *ast.Document, _ text.Reader, _ parser.Context) {
+	limitNesting(document, 0)
+}
+
+// limitNesting flattens the block quotes and lists among a node's children once depth of
+// them enclose it
+func limitNesting(parent ast.Node, depth int) {
+	for child := parent.FirstChild(); child != nil; {
+		kind := child.Kind()
+		if kind != ast.KindBlockquote && kind != ast.KindList {
+			limitNesting(child, depth)
+			child = child.NextSibling()
+			continue
+		}
+		if depth < maxNesting {
+			limitNesting(child, depth+1)
+			child = child.NextSibling()
+			continue
+		}
+
+		// The merged blocks take the quote's or list's place and are looked at in turn
+		next := unwrap(parent, child)
+		if next == nil {
+			next = child.NextSibling()
+		}
+		parent.RemoveChild(parent, child)
+		child = next
+	}
+}
+
+// unwrap moves the blocks of a quote, or of a list's items, in front of it and returns the
+// first one moved
+func unwrap(parent, n ast.Node) ast.Node {
+	containers := []ast.Node{n}
+	if n.Kind() == ast.KindList {
+		containers = containers[:0]
+		for item := n.FirstChild(); item != nil; item = item.NextSibling() {
+			containers = append(containers, item)
+		}
+	}
+
+	var first ast.Node
+	for _, container := range containers {
+		for block := container.FirstChild(); block != nil; {
+			next := block.NextSibling()
+			parent.InsertBefore(parent, n, block)
+			if first == nil {
+				first = block
+			}
+			block = next
+		}
+	}
+	return first
+}
@@ -0,0 +1,166 @@
+package markdown_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/markdown"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		expected string
+	}{
+		{
+			name:     "empty",
+			source:   "  \n",
+			expected: "",
+		},
+		{
+			name:     "inline formatting",
+			source:   "Some *em*, **strong**, ~~gone~~ and `a < b` text",
+			expected: "<p>Some <em>em</em>, <strong>strong</strong>, <del>gone</del> and <code>a &lt; b</code> text</p>\n",
+		},
+		{
+			name:     "intraword underscores",
+			source:   "snake_case_name and _emphasis_",
+			expected: "<p>snake_case_name and <em>emphasis</em></p>\n",
+		},
+		{
+			name:     "headings",
+			source:   "# Title\n\nSetext\n------",
+			expected: "<h1>Title</h1>\n<h2>Setext</h2>\n",
+		},
+		{
+			name:     "hard break",
+			source:   "first  \nsecond\nthird",
+			expected: "<p>first<br>\nsecond\nthird</p>\n",
+		},
+		{
+			name:   "task list",
+			source: "- [ ] write tests\n- [x] ship it\n  - nested",
+			expected: "<ul>\n" +
+				`<li><input disabled="" type="checkbox"> write tests</li>` + "\n" +
+				`<li><input checked="" disabled="" type="checkbox"> ship it` + "\n" +
+				"<ul>\n<li>nested</li>\n</ul>\n</li>\n</ul>\n",
+		},
+		{
+			name:     "loose ordered list",
+			source:   "3. one\n\n4. two",
+			expected: "<ol start=\"3\">\n<li>\n<p>one</p>\n</li>\n<li>\n<p>two</p>\n</li>\n</ol>\n",
+		},
+		{
+			name:   "table",
+			source: "| Name | Count |\n|:-----|------:|\n| `a\\|b` | 2 |",
+			expected: "<table>\n<thead>\n<tr>\n<th align=\"left\">Name</th>\n<th align=\"right\">Count</th>\n</tr>\n" +
+				"</thead>\n<tbody>\n<tr>\n<td align=\"left\"><code>a|b</code></td>\n<td align=\"right\">2</td>\n" +
+				"</tr>\n</tbody>\n</table>\n",
+		},
+		{
+			name:     "code fence",
+			source:   "```go\nif a < b {\n}\n```",
+			expected: "<pre><code class=\"language-go\">if a &lt; b {\n}\n</code></pre>\n",
+		},
+		{
+			name:     "block quote",
+			source:   "> quoted\nlazy line\n\n***",
+			expected: "<blockquote>\n<p>quoted\nlazy line</p>\n</blockquote>\n<hr>\n",
+		},
+		{
+			name:   "links",
+			source: `[docs](https://example.com/docs "Docs") and https://example.com/a_(b). and <me@example.com>`,
+			expected: `<p><a href="https://example.com/docs" title="Docs" rel="nofollow noopener noreferrer">docs</a>` +
+				` and <a href="https://example.com/a_(b)" rel="nofollow noopener noreferrer">https://example.com/a_(b)</a>.` +
+				` and <a href="mailto:me@example.com" rel="nofollow noopener noreferrer">me@example.com</a></p>` + "\n",
+		},
+		{
+			name:     "escapes and entities",
+			source:   `\*not em\* &copy; &nope;`,
+			expected: "<p>*not em* © &amp;nope;</p>\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := markdown.Render(tt.source); got != tt.expected {
+				t.Errorf("Render(%q)\n got: %q\nwant: %q", tt.source, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRender_UnsafeInput(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+	}{
+		{name: "raw script", source: "<script>alert(1)</script>"},
+		{name: "event handler", source: `<img src="x" onerror="alert(1)">`},
+		{name: "javascript link", source: "[click](javascript:alert(1))"},
+		{name: "obfuscated scheme", source: "[click](JaVaScRiPt:alert(1))"},
+		{name: "data image", source: "![x](data:text/html;base64,PHNjcmlwdD4=)"},
+		{name: "autolink", source: "<javascript:alert(1)>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := strings.ToLower(markdown.Render(tt.source))
+			for _, unsafe := range []string{"<script", "<img src=\"x\"", `onerror="`, `href="javascript`, `src="data`} {
+				if strings.Contains(got, unsafe) {
+					t.Errorf("Render(%q) = %q, contains %q", tt.source, got, unsafe)
+				}
+			}
+		})
+	}
+}
+
+func TestRender_PathologicalInput(t *testing.T) {
+	// Each of these would take seconds with quadratic matching or unbounded nesting
+	inputs := []string{
+		strings.Repeat("_a_ ", 4000),
+		strings.Repeat("[a](", 4000),
+		strings.Repeat(">", 16000) + " deep",
+		strings.Repeat("- ", 8000) + "deep",
+		strings.Repeat("`a``", 4000),
+	}
+
+	for _, input := range inputs {
+		if got := markdown.Render(input); got == "" {
+			t.Errorf("Expected output for %q...", input[:8])
+		}
+	}
+}
+
+func TestRender_DeepNestingIsFlattened(t *testing.T) {
+	got := markdown.Render(strings.Repeat("> ", 40) + "deep")
+	if depth := strings.Count(got, "<blockquote>"); depth != 32 {
+		t.Errorf("Expected quotes nested 32 deep, got %d", depth)
+	}
+	if !strings.Contains(got, "<p>deep</p>") {
+		t.Errorf("Expected the innermost text to be kept, got %q", got)
+	}
+}
+
+func TestRender_LongSourceIsPreformatted(t *testing.T) {
+	source := "# Log\n" + strings.Repeat("<b>x</b>\n", 3000)
+
+	got := markdown.Render(source)
+	if !strings.HasPrefix(got, "<pre># Log\n&lt;b&gt;x&lt;/b&gt;") {
+		t.Errorf("Expected the source as preformatted text, got %q...", got[:40])
+	}
+}
+
+func TestChecklist(t *testing.T) {
+	source := "Steps:\n\n- [x] design\n- [ ] build\n  - [X] spike\n1. [ ] release\n\n```\n- [ ] not a task\n```\n"
+
+	done, total := markdown.Checklist(source)
+	if done != 2 || total != 4 {
+		t.Errorf("Expected 2 of 4 items done, got %d of %d", done, total)
+	}
+
+	if done, total := markdown.Checklist("No checklist here\n- plain item"); done != 0 || total != 0 {
+		t.Errorf("Expected no checklist, got %d of %d", done, total)
+	}
+}
@@ -0,0 +1,174 @@
+package markdown
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// allowedElements maps the elements Sanitize keeps to the attributes they may carry. Other
+// elements are dropped but their text is kept.
+var allowedElements = map[string][]string{
+	"a": {"href", "title"}, "blockquote": nil, "br": nil, "code": {"class"}, "del": nil, "em": nil,
+	"h1": nil, "h2": nil, "h3": nil, "h4": nil, "h5": nil, "h6": nil, "hr": nil,
+	"img": {"src", "alt", "title"}, "input": {"type", "checked", "disabled"}, "li": nil,
+	"ol": {"start"}, "p": nil, "pre": nil, "strong": nil, "table": nil, "tbody": nil,
+	"td": {"align"}, "th": {"align"}, "thead": nil, "tr": nil, "ul": nil,
+}
+
+// droppedElements are removed together with everything inside them
+var droppedElements = map[string]bool{
+	"script": true, "style": true, "iframe": true, "object": true, "embed": true, "template": true,
+	"noscript": true, "textarea": true, "select": true, "svg": true, "math": true, "title": true,
+}
+
+// voidElements have no closing tag
+var voidElements = map[string]bool{"br": true, "hr": true, "img": true, "input": true}
+
+// allowedSchemes are the URL schemes links and images may use; relative URLs are always allowed
+var allowedSchemes = map[string]bool{"http": true, "https": true, "mailto": true}
+
+// linkRel keeps linked pages from gaining a handle on ours or credit from spam
+const linkRel = "nofollow noopener noreferrer"
+
+var (
+	languageClassPattern = regexp.MustCompile(`^language-[A-Za-z0-9_+#.-]+$`)
+	digitsPattern        = regexp.MustCompile(`^[0-9]{1,9}$`)
+)
+
+// Sanitize reduces an HTML fragment to the small set of elements markdown produces. Attributes
+// outside the allow list, event handlers, styles and unsafe URLs are removed, and every link is
+// given rel="nofollow noopener noreferrer".
+func Sanitize(fragment string) string {
+	context := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := html.ParseFragment(strings.NewReader(fragment), context)
+	if err != nil {
+		return html.EscapeString(fragment)
+	}
+
+	var b strings.Builder
+	for _, n := range nodes {
+		sanitizeNode(&b, n)
+	}
+	return b.String()
+}
+
+// sanitizeNode writes the allowed parts of a node and its children
+func sanitizeNode(b *strings.Builder, n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		b.WriteString(html.EscapeString(n.Data))
+		return
+	case html.ElementNode:
+	default:
+		// Comments and doctypes are dropped
+		return
+	}
+
+	if droppedElements[n.Data] {
+		return
+	}
+
+	attrs, ok := sanitizeAttributes(n)
+	if !ok {
+		sanitizeChildren(b, n)
+		return
+	}
+
+	b.WriteString("<" + n.Data)
+	for _, attr := range attrs {
+		b.WriteString(" " + attr.Key + `="` + html.EscapeString(attr.Val) + `"`)
+	}
+	b.WriteString(">")
+
+	if voidElements[n.Data] {
+		return
+	}
+	sanitizeChildren(b, n)
+	b.WriteString("</" + n.Data + ">")
+}
+
+func sanitizeChildren(b *strings.Builder, n *html.Node) {
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		sanitizeNode(b, child)
+	}
+}
+
+// sanitizeAttributes returns the attributes an element keeps, or false when the element itself
+// is not allowed
+func sanitizeAttributes(n *html.Node) ([]html.Attribute, bool) {
+	allowed, ok := allowedElements[n.Data]
+	if !ok {
+		return nil, false
+	}
+
+	var attrs []html.Attribute
+	for _, attr := range n.Attr {
+		if attr.Namespace == "" && contains(allowed, attr.Key) && allowedValue(n.Data, attr) {
+			attrs = append(attrs, html.Attribute{Key: attr.Key, Val: attr.Val})
+		}
+	}
+
+	switch n.Data {
+	case "a":
+		attrs = append(attrs, html.Attribute{Key: "rel", Val: linkRel})
+	case "img":
+		if !hasAttribute(attrs, "src") {
+			return nil, false
+		}
+	case "input":
+		// Only the checkboxes of task lists, which cannot be ticked in place
+		if !hasAttribute(attrs, "type") || !hasAttribute(attrs, "disabled") {
+			return nil, false
+		}
+	}
+	return attrs, true
+}
+
+// allowedValue checks the values of attributes that could carry something harmful
+func allowedValue(element string, attr html.Attribute) bool {
+	switch attr.Key {
+	case "href", "src":
+		return safeURL(attr.Val) != ""
+	case "class":
+		return element == "code" && languageClassPattern.MatchString(attr.Val)
+	case "type":
+		return attr.Val == "checkbox"
+	case "start":
+		return digitsPattern.MatchString(attr.Val)
+	case "align":
+		return attr.Val == "left" || attr.Val == "center" || attr.Val == "right"
+	}
+	return true
+}
+
+// safeURL returns the URL if it is relative or uses an allowed scheme, and "" otherwise
+func safeURL(raw string) string {
+	url := strings.TrimSpace(raw)
+	if i := strings.IndexAny(url, ":/?#"); i >= 0 && url[i] == ':' {
+		if !allowedSchemes[strings.ToLower(url[:i])] {
+			return ""
+		}
+	}
+	return url
+}
+
+func hasAttribute(attrs []html.Attribute, key string) bool {
+	for _, attr := range attrs {
+		if attr.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package markdown_test
+
+import (
+	"testing"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/markdown"
+)
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "allowed markup is kept",
+			input:    `<p><strong>bold</strong> <code class="language-go">x</code></p>`,
+			expected: `<p><strong>bold</strong> <code class="language-go">x</code></p>`,
+		},
+		{
+			name:     "scripts are dropped with their contents",
+			input:    `<p>hi<script>alert(1)</script><style>p{}</style></p>`,
+			expected: `<p>hi</p>`,
+		},
+		{
+			name:     "unknown elements keep their text",
+			input:    `<div onclick="x()"><span style="color:red">text</span></div>`,
+			expected: `text`,
+		},
+		{
+			name:     "event handlers and styles are stripped",
+			input:    `<p onclick="alert(1)" style="x">a</p>`,
+			expected: `<p>a</p>`,
+		},
+		{
+			name:  "links get rel and lose unsafe hrefs",
+			input: `<a href="https://example.com">ok</a><a href=" javascript:alert(1)">bad</a>`,
+			expected: `<a href="https://example.com" rel="nofollow noopener noreferrer">ok</a>` +
+				`<a rel="nofollow noopener noreferrer">bad</a>`,
+		},
+		{
+			name:     "images without a safe source are dropped",
+			input:    `<img src="javascript:alert(1)" alt="x"><img src="/logo.png" onerror="x">`,
+			expected: `<img src="/logo.png">`,
+		},
+		{
+			name:     "only disabled checkboxes survive",
+			input:    `<input type="text" disabled=""><input type="checkbox"><input type="checkbox" disabled="">`,
+			expected: `<input type="checkbox" disabled="">`,
+		},
+		{
+			name:     "comments are dropped",
+			input:    `a<!-- hidden -->b`,
+			expected: `ab`,
+		},
+		{
+			name:     "text is escaped",
+			input:    `<p>&lt;script&gt; &amp; "quotes"</p>`,
+			expected: `<p>&lt;script&gt; &amp; &#34;quotes&#34;</p>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := markdown.Sanitize(tt.input); got != tt.expected {
+				t.Errorf("Sanitize(%q)\n got: %q\nwant: %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
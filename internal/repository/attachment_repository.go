@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// AttachmentRepository defines the interface for storing the files attached to tasks and comments.
+type AttachmentRepository interface {
+	// Add stores a file on a task or comment and returns the name it was stored under
+	Add(ctx context.Context, owner domain.AttachmentOwner, upload *domain.AttachmentUpload) (string, error)
+
+	// Open opens an attachment. With a thumb size it opens the image's thumbnail instead,
+	// generating it on first use and falling back to the original when that fails.
+	Open(ctx context.Context, owner domain.AttachmentOwner, name, thumbSize string) (*domain.AttachmentContent, error)
+
+	// Remove deletes an attachment and its thumbnails
+	Remove(ctx context.Context, owner domain.AttachmentOwner, name string) error
+
+	// RemoveTaskFiles deletes the files attached to a task and to its comments
+	RemoveTaskFiles(ctx context.Context, taskID string) error
+}
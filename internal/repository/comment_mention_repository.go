@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// CommentMentionRepository defines the interface for data access to the users @mentioned in comments.
+type CommentMentionRepository interface {
+	// ReplaceForComment replaces the mentions recorded for a comment
+	ReplaceForComment(ctx context.Context, commentID string, mentions []*domain.CommentMention) error
+
+	// ListByComments retrieves the mentions of several comments, keyed by comment ID
+	ListByComments(ctx context.Context, commentIDs []string) (map[string][]*domain.CommentMention, error)
+
+	// ListByUser retrieves a page of the mentions of a user, newest first
+	ListByUser(ctx context.Context, userID string, offset, limit int) ([]*domain.CommentMention, error)
+
+	// CountByUser counts the mentions of a user
+	CountByUser(ctx context.Context, userID string) (int, error)
+}
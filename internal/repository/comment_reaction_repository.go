@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// CommentReactionRepository defines the interface for data access to emoji reactions on comments.
+type CommentReactionRepository interface {
+	// Add stores a reaction, reporting false when the user already reacted with that emoji
+	Add(ctx context.Context, reaction *domain.CommentReaction) (bool, error)
+
+	// Remove deletes a user's reaction, reporting false when there was none
+	Remove(ctx context.Context, commentID, userID, emoji string) (bool, error)
+
+	// ListByComments retrieves the reactions to several comments in the order they were made, keyed by comment ID
+	ListByComments(ctx context.Context, commentIDs []string) (map[string][]*domain.CommentReaction, error)
+}
@@ -20,6 +20,9 @@ type CommentQueryRepository interface {
 	// ListByTask retrieves comments for a specific task
 	ListByTask(ctx context.Context, taskID string, offset, limit int) ([]*domain.Comment, error)
 
+	// ListOpenByTask retrieves comments for a specific task, leaving out resolved threads
+	ListOpenByTask(ctx context.Context, taskID string, offset, limit int) ([]*domain.Comment, error)
+
 	// ListByAuthor retrieves comments by a specific author
 	ListByAuthor(ctx context.Context, authorID string, offset, limit int) ([]*domain.Comment, error)
 
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// EmailOutboxRepository defines the interface for email outbox data access operations.
+type EmailOutboxRepository interface {
+	// Create stores a new email in the outbox
+	Create(ctx context.Context, email *domain.OutboxEmail) error
+
+	// Update persists the delivery state of an email
+	Update(ctx context.Context, email *domain.OutboxEmail) error
+
+	// ListDue retrieves pending emails whose next attempt is due, oldest first
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.OutboxEmail, error)
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// EventLogRepository defines the interface for the persisted task event log.
+// Events are keyed by a monotonic sequence so clients can resume after a disconnect.
+type EventLogRepository interface {
+	// Append stores an event and sets its Sequence to the next value in the log
+	Append(ctx context.Context, event *domain.TaskEvent) error
+
+	// ListAfter retrieves up to limit events with a sequence greater than afterSequence, in order
+	ListAfter(ctx context.Context, afterSequence int64, limit int) ([]*domain.TaskEvent, error)
+
+	// Bounds returns the oldest and latest sequence in the log, both zero when it is empty
+	Bounds(ctx context.Context) (oldest, latest int64, err error)
+
+	// DeleteUpTo removes every event with a sequence at or below the given one
+	DeleteUpTo(ctx context.Context, sequence int64) error
+}
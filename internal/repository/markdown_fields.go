@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/markdown"
+)
+
+// renderedMarkdown returns the HTML to store next to a record's markdown field. The cached
+// rendering is reused while the source is unchanged and rendered again when it changes.
+func renderedMarkdown(record *core.Record, field, source string) string {
+	cached := record.GetString(field + "_html")
+	if record.GetString(field) == source && (cached != "" || source == "") {
+		return cached
+	}
+	return markdown.Render(source)
+}
+
+// storedMarkdown returns the HTML stored next to a record's markdown field. Records saved before
+// renderings were stored have none, so theirs is rendered when they are read.
+func storedMarkdown(record *core.Record, field string) string {
+	if cached := record.GetString(field + "_html"); cached != "" {
+		return cached
+	}
+	return markdown.Render(record.GetString(field))
+}
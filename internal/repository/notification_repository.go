@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// NotificationRepository defines the interface for notification inbox data access operations.
+type NotificationRepository interface {
+	// Create stores a new notification
+	Create(ctx context.Context, notification *domain.Notification) error
+
+	// GetByID retrieves a notification by its ID
+	GetByID(ctx context.Context, id string) (*domain.Notification, error)
+
+	// Update persists the read state of a notification
+	Update(ctx context.Context, notification *domain.Notification) error
+
+	// ListByUser retrieves a page of a user's notifications, newest first
+	ListByUser(ctx context.Context, userID string, unreadOnly bool, offset, limit int) ([]*domain.Notification, error)
+
+	// CountByUser counts a user's notifications, or only the unread ones
+	CountByUser(ctx context.Context, userID string, unreadOnly bool) (int, error)
+
+	// MarkAllRead marks every unread notification of a user read and returns how many changed
+	MarkAllRead(ctx context.Context, userID string, readAt time.Time) (int, error)
+}
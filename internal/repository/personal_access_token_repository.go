@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// PersonalAccessTokenRepository defines the interface for personal access token data access operations.
+// Tokens are stored hashed; GetByToken takes the raw token.
+type PersonalAccessTokenRepository interface {
+	// Create stores a new token
+	Create(ctx context.Context, token *domain.PersonalAccessToken) error
+
+	// GetByID retrieves a token by its ID
+	GetByID(ctx context.Context, id string) (*domain.PersonalAccessToken, error)
+
+	// GetByToken retrieves a token by its raw value
+	GetByToken(ctx context.Context, token string) (*domain.PersonalAccessToken, error)
+
+	// Update persists when a token was last used and revoked
+	Update(ctx context.Context, token *domain.PersonalAccessToken) error
+
+	// ListByUser retrieves a user's tokens, newest first
+	ListByUser(ctx context.Context, userID string) ([]*domain.PersonalAccessToken, error)
+}
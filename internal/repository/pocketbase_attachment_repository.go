@@ -0,0 +1,225 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/filesystem"
+	"github.com/pocketbase/pocketbase/tools/filesystem/blob"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// attachmentsField is the file field tasks and comments keep their attachments in
+const attachmentsField = "attachments"
+
+type pocketbaseAttachmentRepository struct {
+	app core.App
+}
+
+// NewPocketBaseAttachmentRepository creates a new PocketBase attachment repository.
+// Files go to the app's file storage, under the directory of the task or comment record.
+func NewPocketBaseAttachmentRepository(app core.App) AttachmentRepository {
+	return &pocketbaseAttachmentRepository{app: app}
+}
+
+// Add stores a file on a task or comment and returns the name it was stored under.
+func (r *pocketbaseAttachmentRepository) Add(
+	_ context.Context, owner domain.AttachmentOwner, upload *domain.AttachmentUpload,
+) (string, error) {
+	file, err := filesystem.NewFileFromBytes(upload.Content, upload.Name)
+	if err != nil {
+		return "", fmt.Errorf("failed to prepare attachment: %w", err)
+	}
+
+	var record *core.Record
+	err = r.app.RunInTransaction(func(txApp core.App) error {
+		record, err = findAttachmentOwner(txApp, owner)
+		if err != nil {
+			return err
+		}
+
+		record.Set(attachmentsField+"+", file)
+		return txApp.Save(record)
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to save attachment: %w", err)
+	}
+
+	// Generate the thumbnail up front; Open retries if this fails
+	if domain.IsImageAttachment(file.Name) {
+		if thumbErr := r.createThumb(record, file.Name, domain.AttachmentThumbSize); thumbErr != nil {
+			r.app.Logger().Warn("Failed to create attachment thumbnail", "file", file.Name, "error", thumbErr)
+		}
+	}
+
+	return file.Name, nil
+}
+
+// Open opens an attachment, or the thumbnail of an image when a thumb size is given.
+func (r *pocketbaseAttachmentRepository) Open(
+	ctx context.Context, owner domain.AttachmentOwner, name, thumbSize string,
+) (*domain.AttachmentContent, error) {
+	record, err := findAttachmentOwner(r.app, owner)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(record.GetStringSlice(attachmentsField), name) {
+		return nil, fmt.Errorf("attachment %s not found on %s %s", name, owner.Type, owner.ID)
+	}
+
+	key, servedName := record.BaseFilesPath()+"/"+name, name
+	if thumbSize != "" && domain.IsImageAttachment(name) {
+		thumbErr := r.createThumb(record, name, thumbSize)
+		if thumbErr == nil {
+			key, servedName = thumbKey(record, name, thumbSize), thumbSize+"_"+name
+		} else {
+			r.app.Logger().Warn("Serving original instead of thumbnail", "file", name, "error", thumbErr)
+		}
+	}
+
+	fsys, err := r.app.NewFilesystem()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file storage: %w", err)
+	}
+	fsys.SetContext(ctx)
+
+	reader, err := fsys.GetReader(key)
+	if err != nil {
+		_ = fsys.Close()
+		return nil, fmt.Errorf("failed to open attachment %s: %w", name, err)
+	}
+
+	return &domain.AttachmentContent{
+		ReadSeekCloser: &storedFile{Reader: reader, fsys: fsys},
+		ModTime:        reader.ModTime(),
+		Name:           servedName,
+		ContentType:    reader.ContentType(),
+	}, nil
+}
+
+// Remove deletes an attachment; the file and its thumbnails are removed from storage when the record saves.
+func (r *pocketbaseAttachmentRepository) Remove(
+	_ context.Context, owner domain.AttachmentOwner, name string,
+) error {
+	err := r.app.RunInTransaction(func(txApp core.App) error {
+		record, err := findAttachmentOwner(txApp, owner)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(record.GetStringSlice(attachmentsField), name) {
+			return fmt.Errorf("attachment %s not found on %s %s", name, owner.Type, owner.ID)
+		}
+
+		record.Set(attachmentsField+"-", name)
+		return txApp.Save(record)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove attachment: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveTaskFiles deletes the files attached to a task and to its comments.
+// It is meant for tasks that are already deleted, so it works from the storage paths
+// rather than from the task record.
+func (r *pocketbaseAttachmentRepository) RemoveTaskFiles(ctx context.Context, taskID string) error {
+	if taskID == "" {
+		return fmt.Errorf("task ID cannot be empty")
+	}
+
+	tasks, err := r.app.FindCollectionByNameOrId(string(domain.AttachmentOwnerTask))
+	if err != nil {
+		return fmt.Errorf("failed to find tasks collection: %w", err)
+	}
+
+	// Comments normally go with their task, but any left behind lose their files too
+	comments, err := r.app.FindAllRecords(string(domain.AttachmentOwnerComment), dbx.HashExp{"task": taskID})
+	if err != nil {
+		return fmt.Errorf("failed to find comments of task %s: %w", taskID, err)
+	}
+
+	prefixes := []string{tasks.BaseFilesPath() + "/" + taskID + "/"}
+	for _, comment := range comments {
+		prefixes = append(prefixes, comment.BaseFilesPath()+"/")
+	}
+
+	fsys, err := r.app.NewFilesystem()
+	if err != nil {
+		return fmt.Errorf("failed to open file storage: %w", err)
+	}
+	defer func() { _ = fsys.Close() }()
+	fsys.SetContext(ctx)
+
+	var failed []error
+	for _, prefix := range prefixes {
+		failed = append(failed, fsys.DeletePrefix(prefix)...)
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to delete files of task %s: %w", taskID, errors.Join(failed...))
+	}
+
+	if len(comments) > 0 {
+		_, err = r.app.DB().Update(string(domain.AttachmentOwnerComment),
+			dbx.Params{attachmentsField: "[]"},
+			dbx.HashExp{"task": taskID},
+		).Execute()
+		if err != nil {
+			return fmt.Errorf("failed to clear comment attachments of task %s: %w", taskID, err)
+		}
+	}
+
+	return nil
+}
+
+// createThumb generates an image thumbnail unless it already exists
+func (r *pocketbaseAttachmentRepository) createThumb(record *core.Record, name, size string) error {
+	fsys, err := r.app.NewFilesystem()
+	if err != nil {
+		return fmt.Errorf("failed to open file storage: %w", err)
+	}
+	defer func() { _ = fsys.Close() }()
+
+	key := thumbKey(record, name, size)
+	if exists, _ := fsys.Exists(key); exists {
+		return nil
+	}
+
+	return fsys.CreateThumb(record.BaseFilesPath()+"/"+name, key, size)
+}
+
+// thumbKey is where a thumbnail is stored; it matches PocketBase's own layout so that
+// removing the attachment removes its thumbnails
+func thumbKey(record *core.Record, name, size string) string {
+	return record.BaseFilesPath() + "/thumbs_" + name + "/" + size + "_" + name
+}
+
+// findAttachmentOwner loads the task or comment record files are attached to
+func findAttachmentOwner(app core.App, owner domain.AttachmentOwner) (*core.Record, error) {
+	if !owner.Type.IsValid() || owner.ID == "" {
+		return nil, fmt.Errorf("invalid attachment owner %s %q", owner.Type, owner.ID)
+	}
+
+	record, err := app.FindRecordById(string(owner.Type), owner.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find %s %s: %w", owner.Type, owner.ID, err)
+	}
+
+	return record, nil
+}
+
+// storedFile is an open file that releases its storage connection when closed
+type storedFile struct {
+	*blob.Reader
+	fsys *filesystem.System
+}
+
+// Close closes the file and the storage it was read from
+func (f *storedFile) Close() error {
+	return errors.Join(f.Reader.Close(), f.fsys.Close())
+}
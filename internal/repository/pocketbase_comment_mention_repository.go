@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+const commentMentionsCollection = "comment_mentions"
+
+type pocketbaseCommentMentionRepository struct {
+	app core.App
+}
+
+// NewPocketBaseCommentMentionRepository creates a new PocketBase comment mention repository.
+func NewPocketBaseCommentMentionRepository(app core.App) CommentMentionRepository {
+	return &pocketbaseCommentMentionRepository{app: app}
+}
+
+// ReplaceForComment replaces the mentions recorded for a comment in one transaction.
+func (r *pocketbaseCommentMentionRepository) ReplaceForComment(
+	ctx context.Context, commentID string, mentions []*domain.CommentMention,
+) error {
+	if commentID == "" {
+		return fmt.Errorf("comment ID cannot be empty")
+	}
+
+	return r.app.RunInTransaction(func(txApp core.App) error {
+		collection, err := txApp.FindCollectionByNameOrId(commentMentionsCollection)
+		if err != nil {
+			return fmt.Errorf("failed to find comment_mentions collection: %w", err)
+		}
+
+		_, err = txApp.DB().Delete(commentMentionsCollection, dbx.HashExp{"comment": commentID}).
+			WithContext(ctx).Execute()
+		if err != nil {
+			return fmt.Errorf("failed to clear mentions of comment %s: %w", commentID, err)
+		}
+
+		for _, mention := range mentions {
+			record := core.NewRecord(collection)
+			record.Set("comment", commentID)
+			record.Set("task", mention.TaskID)
+			record.Set("user", mention.UserID)
+			record.Set("username", mention.Username)
+
+			if err := txApp.Save(record); err != nil {
+				return fmt.Errorf("failed to save mention of user %s: %w", mention.UserID, err)
+			}
+
+			mention.ID = record.Id
+			mention.CommentID = commentID
+			mention.CreatedAt = record.GetDateTime("created").Time()
+		}
+
+		return nil
+	})
+}
+
+// ListByComments retrieves the mentions of several comments, keyed by comment ID.
+func (r *pocketbaseCommentMentionRepository) ListByComments(
+	_ context.Context, commentIDs []string,
+) (map[string][]*domain.CommentMention, error) {
+	mentions := make(map[string][]*domain.CommentMention)
+	if len(commentIDs) == 0 {
+		return mentions, nil
+	}
+
+	ids := make([]interface{}, len(commentIDs))
+	for i, id := range commentIDs {
+		ids[i] = id
+	}
+
+	records, err := r.app.FindAllRecords(commentMentionsCollection, dbx.In("comment", ids...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comment mentions: %w", err)
+	}
+
+	for _, record := range records {
+		mention := r.recordToMention(record)
+		mentions[mention.CommentID] = append(mentions[mention.CommentID], mention)
+	}
+
+	return mentions, nil
+}
+
+// ListByUser retrieves a page of the mentions of a user, newest first.
+func (r *pocketbaseCommentMentionRepository) ListByUser(
+	_ context.Context, userID string, offset, limit int,
+) ([]*domain.CommentMention, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user ID cannot be empty")
+	}
+
+	records, err := r.app.FindRecordsByFilter(
+		commentMentionsCollection, "user = {:userID}", "-created", limit, offset, dbx.Params{"userID": userID},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list mentions of user %s: %w", userID, err)
+	}
+
+	mentions := make([]*domain.CommentMention, len(records))
+	for i, record := range records {
+		mentions[i] = r.recordToMention(record)
+	}
+
+	return mentions, nil
+}
+
+// CountByUser counts the mentions of a user.
+func (r *pocketbaseCommentMentionRepository) CountByUser(_ context.Context, userID string) (int, error) {
+	if userID == "" {
+		return 0, fmt.Errorf("user ID cannot be empty")
+	}
+
+	total, err := r.app.CountRecords(commentMentionsCollection, dbx.HashExp{"user": userID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count mentions of user %s: %w", userID, err)
+	}
+
+	return int(total), nil
+}
+
+// recordToMention converts a PocketBase record to a domain.CommentMention.
+func (r *pocketbaseCommentMentionRepository) recordToMention(record *core.Record) *domain.CommentMention {
+	return &domain.CommentMention{
+		ID:        record.Id,
+		CommentID: record.GetString("comment"),
+		TaskID:    record.GetString("task"),
+		UserID:    record.GetString("user"),
+		Username:  record.GetString("username"),
+		CreatedAt: record.GetDateTime("created").Time(),
+	}
+}
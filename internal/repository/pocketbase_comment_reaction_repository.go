@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+const (
+	commentReactionsCollection = "comment_reactions"
+	reactionFilterQuery        = "comment = {:commentID} && user = {:userID} && emoji = {:emoji}"
+)
+
+type pocketbaseCommentReactionRepository struct {
+	app core.App
+}
+
+// NewPocketBaseCommentReactionRepository creates a new PocketBase comment reaction repository.
+func NewPocketBaseCommentReactionRepository(app core.App) CommentReactionRepository {
+	return &pocketbaseCommentReactionRepository{app: app}
+}
+
+// Add stores a reaction, reporting false when the user already reacted with that emoji.
+func (r *pocketbaseCommentReactionRepository) Add(_ context.Context, reaction *domain.CommentReaction) (bool, error) {
+	if err := domain.ValidateReactionEmoji(reaction.Emoji); err != nil {
+		return false, fmt.Errorf("validation failed: %w", err)
+	}
+
+	existing, err := r.find(reaction.CommentID, reaction.UserID, reaction.Emoji)
+	if err != nil {
+		return false, err
+	}
+	if existing != nil {
+		*reaction = *r.recordToReaction(existing)
+		return false, nil
+	}
+
+	collection, err := r.app.FindCollectionByNameOrId(commentReactionsCollection)
+	if err != nil {
+		return false, fmt.Errorf("failed to find comment_reactions collection: %w", err)
+	}
+
+	record := core.NewRecord(collection)
+	record.Set("comment", reaction.CommentID)
+	record.Set("user", reaction.UserID)
+	record.Set("emoji", reaction.Emoji)
+
+	if err := r.app.Save(record); err != nil {
+		return false, fmt.Errorf("failed to save comment reaction: %w", err)
+	}
+
+	reaction.ID = record.Id
+	reaction.CreatedAt = record.GetDateTime("created").Time()
+
+	return true, nil
+}
+
+// Remove deletes a user's reaction, reporting false when there was none.
+func (r *pocketbaseCommentReactionRepository) Remove(_ context.Context, commentID, userID, emoji string) (bool, error) {
+	record, err := r.find(commentID, userID, emoji)
+	if err != nil || record == nil {
+		return false, err
+	}
+
+	if err := r.app.Delete(record); err != nil {
+		return false, fmt.Errorf("failed to delete comment reaction: %w", err)
+	}
+
+	return true, nil
+}
+
+// ListByComments retrieves the reactions to several comments in the order they were made, keyed by comment ID.
+func (r *pocketbaseCommentReactionRepository) ListByComments(
+	_ context.Context, commentIDs []string,
+) (map[string][]*domain.CommentReaction, error) {
+	reactions := make(map[string][]*domain.CommentReaction)
+	if len(commentIDs) == 0 {
+		return reactions, nil
+	}
+
+	ids := make([]interface{}, len(commentIDs))
+	for i, id := range commentIDs {
+		ids[i] = id
+	}
+
+	var records []*core.Record
+	err := r.app.RecordQuery(commentReactionsCollection).
+		AndWhere(dbx.In("comment", ids...)).
+		OrderBy("created ASC", "id ASC").
+		All(&records)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comment reactions: %w", err)
+	}
+
+	for _, record := range records {
+		reaction := r.recordToReaction(record)
+		reactions[reaction.CommentID] = append(reactions[reaction.CommentID], reaction)
+	}
+
+	return reactions, nil
+}
+
+// find looks up a user's reaction to a comment, returning nil when there is none.
+func (r *pocketbaseCommentReactionRepository) find(commentID, userID, emoji string) (*core.Record, error) {
+	record, err := r.app.FindFirstRecordByFilter(commentReactionsCollection, reactionFilterQuery, dbx.Params{
+		"commentID": commentID,
+		"userID":    userID,
+		"emoji":     emoji,
+	})
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find comment reaction: %w", err)
+	}
+
+	return record, nil
+}
+
+// recordToReaction converts a PocketBase record to a domain.CommentReaction.
+func (r *pocketbaseCommentReactionRepository) recordToReaction(record *core.Record) *domain.CommentReaction {
+	return &domain.CommentReaction{
+		ID:        record.Id,
+		CommentID: record.GetString("comment"),
+		UserID:    record.GetString("user"),
+		Emoji:     record.GetString("emoji"),
+		CreatedAt: record.GetDateTime("created").Time(),
+	}
+}
@@ -14,6 +14,8 @@ import (
 
 const (
 	taskFilterQuery = "task = {:taskID}"
+	// openFilterQuery excludes resolved root comments and the replies to them
+	openFilterQuery = "resolved_at = '' && (parent_comment = '' || parent_comment.resolved_at = '')"
 )
 
 type pocketbaseCommentRepository struct {
@@ -37,12 +39,14 @@ func (r *pocketbaseCommentRepository) Create(_ context.Context, comment *domain.
 	}
 
 	record := core.NewRecord(collection)
+	comment.ContentHTML = renderedMarkdown(record, "content", comment.Content)
 	record.Set("content", comment.Content)
+	record.Set("content_html", comment.ContentHTML)
 	record.Set("task", comment.TaskID)
 	record.Set("author", comment.AuthorID)
 	record.Set("type", string(comment.Type))
 	record.Set("is_edited", comment.IsEdited)
-	record.Set("attachments", comment.Attachments)
+	r.setResolution(record, comment)
 
 	// Set optional parent comment
 	if comment.ParentCommentID != nil && *comment.ParentCommentID != "" {
@@ -106,11 +110,13 @@ func (r *pocketbaseCommentRepository) Update(_ context.Context, comment *domain.
 		return fmt.Errorf("failed to find comment for update: %w", err)
 	}
 
+	comment.ContentHTML = renderedMarkdown(record, "content", comment.Content)
 	record.Set("content", comment.Content)
+	record.Set("content_html", comment.ContentHTML)
 	record.Set("type", string(comment.Type))
 	record.Set("is_edited", comment.IsEdited)
-	record.Set("attachments", comment.Attachments)
 	record.Set("updated", time.Now().UTC())
+	r.setResolution(record, comment)
 
 	// Update optional parent comment
 	if comment.ParentCommentID != nil && *comment.ParentCommentID != "" {
@@ -171,6 +177,24 @@ func (r *pocketbaseCommentRepository) ListByTask(
 	return r.recordsToComments(records)
 }
 
+// ListOpenByTask retrieves comments for a specific task, leaving out resolved threads.
+func (r *pocketbaseCommentRepository) ListOpenByTask(
+	_ context.Context, taskID string, offset, limit int,
+) ([]*domain.Comment, error) {
+	if taskID == "" {
+		return nil, fmt.Errorf("task ID cannot be empty")
+	}
+
+	records, err := r.app.FindRecordsByFilter(
+		"comments", taskFilterQuery+" && "+openFilterQuery, "created", limit, offset, dbx.Params{"taskID": taskID},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list open comments by task %s: %w", taskID, err)
+	}
+
+	return r.recordsToComments(records)
+}
+
 // ListByAuthor retrieves comments by a specific author.
 func (r *pocketbaseCommentRepository) ListByAuthor(
 	_ context.Context, authorID string, offset, limit int,
@@ -385,14 +409,15 @@ func (r *pocketbaseCommentRepository) Restore(_ context.Context, id string) erro
 // recordToComment converts a PocketBase record to a domain.Comment.
 func (r *pocketbaseCommentRepository) recordToComment(record *core.Record) (*domain.Comment, error) {
 	comment := &domain.Comment{
-		ID:        record.Id,
-		Content:   record.GetString("content"),
-		TaskID:    record.GetString("task"),
-		AuthorID:  record.GetString("author"),
-		Type:      domain.CommentType(record.GetString("type")),
-		IsEdited:  record.GetBool("is_edited"),
-		CreatedAt: record.GetDateTime("created").Time(),
-		UpdatedAt: record.GetDateTime("updated").Time(),
+		ID:          record.Id,
+		Content:     record.GetString("content"),
+		ContentHTML: storedMarkdown(record, "content"),
+		TaskID:      record.GetString("task"),
+		AuthorID:    record.GetString("author"),
+		Type:        domain.CommentType(record.GetString("type")),
+		IsEdited:    record.GetBool("is_edited"),
+		CreatedAt:   record.GetDateTime("created").Time(),
+		UpdatedAt:   record.GetDateTime("updated").Time(),
 	}
 
 	// Handle optional parent comment
@@ -400,15 +425,37 @@ func (r *pocketbaseCommentRepository) recordToComment(record *core.Record) (*dom
 		comment.ParentCommentID = &parentComment
 	}
 
-	// Handle attachments array
-	var attachments []string
-	if err := record.UnmarshalJSONField("attachments", &attachments); err == nil && len(attachments) > 0 {
+	// Handle thread resolution
+	if resolvedAt := record.GetDateTime("resolved_at"); !resolvedAt.IsZero() {
+		resolved := resolvedAt.Time()
+		resolvedBy := record.GetString("resolved_by")
+		comment.ResolvedAt = &resolved
+		comment.ResolvedBy = &resolvedBy
+	}
+
+	// Attachments are stored in a file field, which holds the stored file names
+	if attachments := record.GetStringSlice(attachmentsField); len(attachments) > 0 {
 		comment.Attachments = attachments
 	}
 
 	return comment, nil
 }
 
+// setResolution copies the resolved state of a comment onto a record.
+func (r *pocketbaseCommentRepository) setResolution(record *core.Record, comment *domain.Comment) {
+	if comment.ResolvedAt != nil {
+		record.Set("resolved_at", comment.ResolvedAt.UTC())
+		resolvedBy := ""
+		if comment.ResolvedBy != nil {
+			resolvedBy = *comment.ResolvedBy
+		}
+		record.Set("resolved_by", resolvedBy)
+	} else {
+		record.Set("resolved_at", "")
+		record.Set("resolved_by", "")
+	}
+}
+
 // recordsToComments converts PocketBase records to domain.Comment slice.
 func (r *pocketbaseCommentRepository) recordsToComments(records []*core.Record) ([]*domain.Comment, error) {
 	comments := make([]*domain.Comment, len(records))
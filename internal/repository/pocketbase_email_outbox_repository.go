@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/types"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+const emailOutboxCollection = "email_outbox"
+
+type pocketbaseEmailOutboxRepository struct {
+	app core.App
+}
+
+// NewPocketBaseEmailOutboxRepository creates a new PocketBase email outbox repository.
+func NewPocketBaseEmailOutboxRepository(app core.App) EmailOutboxRepository {
+	return &pocketbaseEmailOutboxRepository{app: app}
+}
+
+// Create stores a new email in the outbox.
+func (r *pocketbaseEmailOutboxRepository) Create(_ context.Context, email *domain.OutboxEmail) error {
+	if err := email.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	collection, err := r.app.FindCollectionByNameOrId(emailOutboxCollection)
+	if err != nil {
+		return fmt.Errorf("failed to find email_outbox collection: %w", err)
+	}
+
+	record := core.NewRecord(collection)
+	record.Set("template", email.Template)
+	r.setDeliveryFields(record, email)
+
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to save email record: %w", err)
+	}
+
+	email.ID = record.Id
+	email.CreatedAt = record.GetDateTime("created").Time()
+	email.UpdatedAt = record.GetDateTime("updated").Time()
+
+	return nil
+}
+
+// Update persists the delivery state of an email.
+func (r *pocketbaseEmailOutboxRepository) Update(_ context.Context, email *domain.OutboxEmail) error {
+	if err := email.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	record, err := r.app.FindRecordById(emailOutboxCollection, email.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find email %s: %w", email.ID, err)
+	}
+
+	r.setDeliveryFields(record, email)
+
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to update email record: %w", err)
+	}
+
+	email.UpdatedAt = record.GetDateTime("updated").Time()
+
+	return nil
+}
+
+// ListDue retrieves pending emails whose next attempt is due, oldest first.
+func (r *pocketbaseEmailOutboxRepository) ListDue(
+	_ context.Context, now time.Time, limit int,
+) ([]*domain.OutboxEmail, error) {
+	records, err := r.app.FindRecordsByFilter(
+		emailOutboxCollection,
+		"status = {:status} && next_attempt_at <= {:now}",
+		"next_attempt_at",
+		limit, 0,
+		dbx.Params{"status": string(domain.EmailPending), "now": now.UTC().Format(types.DefaultDateLayout)},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due emails: %w", err)
+	}
+
+	emails := make([]*domain.OutboxEmail, len(records))
+	for i, record := range records {
+		emails[i] = r.recordToEmail(record)
+	}
+
+	return emails, nil
+}
+
+// setDeliveryFields copies the fields that change while an email is delivered onto a record.
+func (r *pocketbaseEmailOutboxRepository) setDeliveryFields(record *core.Record, email *domain.OutboxEmail) {
+	record.Set("to", email.To)
+	record.Set("subject", email.Subject)
+	record.Set("text_body", email.TextBody)
+	record.Set("html_body", email.HTMLBody)
+	record.Set("status", string(email.Status))
+	record.Set("attempts", email.Attempts)
+	record.Set("max_attempts", email.MaxAttempts)
+	record.Set("last_error", email.LastError)
+	record.Set("next_attempt_at", email.NextAttemptAt.UTC())
+	if email.SentAt != nil {
+		record.Set("sent_at", email.SentAt.UTC())
+	}
+}
+
+// recordToEmail converts a PocketBase record to a domain.OutboxEmail.
+func (r *pocketbaseEmailOutboxRepository) recordToEmail(record *core.Record) *domain.OutboxEmail {
+	email := &domain.OutboxEmail{
+		ID:            record.Id,
+		To:            record.GetString("to"),
+		Subject:       record.GetString("subject"),
+		TextBody:      record.GetString("text_body"),
+		HTMLBody:      record.GetString("html_body"),
+		Template:      record.GetString("template"),
+		Status:        domain.EmailStatus(record.GetString("status")),
+		LastError:     record.GetString("last_error"),
+		Attempts:      record.GetInt("attempts"),
+		MaxAttempts:   record.GetInt("max_attempts"),
+		NextAttemptAt: record.GetDateTime("next_attempt_at").Time(),
+		CreatedAt:     record.GetDateTime("created").Time(),
+		UpdatedAt:     record.GetDateTime("updated").Time(),
+	}
+	if sentAt := record.GetDateTime("sent_at"); !sentAt.IsZero() {
+		sent := sentAt.Time()
+		email.SentAt = &sent
+	}
+	return email
+}
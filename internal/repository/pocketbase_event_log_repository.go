@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/types"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+const eventLogCollection = "event_log"
+
+type pocketbaseEventLogRepository struct {
+	app core.App
+}
+
+// NewPocketBaseEventLogRepository creates a new PocketBase event log repository.
+func NewPocketBaseEventLogRepository(app core.App) EventLogRepository {
+	return &pocketbaseEventLogRepository{app: app}
+}
+
+// Append stores an event and sets its Sequence to the next value in the log.
+func (r *pocketbaseEventLogRepository) Append(ctx context.Context, event *domain.TaskEvent) error {
+	if err := event.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	// Reading the latest sequence and inserting share one transaction; SQLite serializes
+	// write transactions, so concurrent appends can't claim the same sequence.
+	return r.app.RunInTransaction(func(txApp core.App) error {
+		collection, err := txApp.FindCollectionByNameOrId(eventLogCollection)
+		if err != nil {
+			return fmt.Errorf("failed to find event_log collection: %w", err)
+		}
+
+		var latest int64
+		err = txApp.DB().NewQuery("SELECT COALESCE(MAX(sequence), 0) FROM " + eventLogCollection).
+			WithContext(ctx).Row(&latest)
+		if err != nil {
+			return fmt.Errorf("failed to read latest event sequence: %w", err)
+		}
+
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to marshal event: %w", err)
+		}
+
+		record := core.NewRecord(collection)
+		record.Set("sequence", latest+1)
+		record.Set("event_id", event.EventID)
+		record.Set("type", string(event.Type))
+		record.Set("project_id", event.ProjectID)
+		record.Set("payload", types.JSONRaw(payload))
+
+		if err := txApp.Save(record); err != nil {
+			return fmt.Errorf("failed to save event record: %w", err)
+		}
+
+		event.Sequence = latest + 1
+		return nil
+	})
+}
+
+// ListAfter retrieves up to limit events with a sequence greater than afterSequence, in order.
+func (r *pocketbaseEventLogRepository) ListAfter(
+	_ context.Context, afterSequence int64, limit int,
+) ([]*domain.TaskEvent, error) {
+	records, err := r.app.FindRecordsByFilter(
+		eventLogCollection,
+		"sequence > {:after}",
+		"sequence",
+		limit,
+		0,
+		dbx.Params{"after": afterSequence},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	events := make([]*domain.TaskEvent, 0, len(records))
+	for _, record := range records {
+		var event domain.TaskEvent
+		if err := json.Unmarshal([]byte(record.GetString("payload")), &event); err != nil {
+			return nil, fmt.Errorf("failed to decode event %d: %w", record.GetInt("sequence"), err)
+		}
+		event.Sequence = int64(record.GetInt("sequence"))
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
+
+// Bounds returns the oldest and latest sequence in the log, both zero when it is empty.
+func (r *pocketbaseEventLogRepository) Bounds(ctx context.Context) (int64, int64, error) {
+	var bounds struct {
+		Oldest int64 `db:"oldest"`
+		Latest int64 `db:"latest"`
+	}
+	err := r.app.DB().
+		NewQuery("SELECT COALESCE(MIN(sequence), 0) AS oldest, COALESCE(MAX(sequence), 0) AS latest FROM " +
+			eventLogCollection).
+		WithContext(ctx).
+		One(&bounds)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to read event log bounds: %w", err)
+	}
+
+	return bounds.Oldest, bounds.Latest, nil
+}
+
+// DeleteUpTo removes every event with a sequence at or below the given one.
+func (r *pocketbaseEventLogRepository) DeleteUpTo(ctx context.Context, sequence int64) error {
+	_, err := r.app.DB().Delete(eventLogCollection, dbx.NewExp("sequence <= {:sequence}", dbx.Params{
+		"sequence": sequence,
+	})).WithContext(ctx).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to trim event log: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/types"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+const notificationsCollection = "notifications"
+
+type pocketbaseNotificationRepository struct {
+	app core.App
+}
+
+// NewPocketBaseNotificationRepository creates a new PocketBase notification repository.
+func NewPocketBaseNotificationRepository(app core.App) NotificationRepository {
+	return &pocketbaseNotificationRepository{app: app}
+}
+
+// Create stores a new notification.
+func (r *pocketbaseNotificationRepository) Create(_ context.Context, notification *domain.Notification) error {
+	if err := notification.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	collection, err := r.app.FindCollectionByNameOrId(notificationsCollection)
+	if err != nil {
+		return fmt.Errorf("failed to find notifications collection: %w", err)
+	}
+
+	record := core.NewRecord(collection)
+	record.Set("user", notification.UserID)
+	record.Set("project", notification.ProjectID)
+	record.Set("task_id", notification.TaskID)
+	record.Set("actor", notification.ActorID)
+	record.Set("event_id", notification.EventID)
+	record.Set("kind", string(notification.Kind))
+	record.Set("task_title", notification.TaskTitle)
+	record.Set("message", notification.Message)
+	r.setReadAt(record, notification)
+
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to save notification record: %w", err)
+	}
+
+	notification.ID = record.Id
+	notification.CreatedAt = record.GetDateTime("created").Time()
+
+	return nil
+}
+
+// GetByID retrieves a notification by its ID.
+func (r *pocketbaseNotificationRepository) GetByID(_ context.Context, id string) (*domain.Notification, error) {
+	if id == "" {
+		return nil, fmt.Errorf("notification ID cannot be empty")
+	}
+
+	record, err := r.app.FindRecordById(notificationsCollection, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find notification by ID %s: %w", id, err)
+	}
+
+	return r.recordToNotification(record), nil
+}
+
+// Update persists the read state of a notification.
+func (r *pocketbaseNotificationRepository) Update(_ context.Context, notification *domain.Notification) error {
+	record, err := r.app.FindRecordById(notificationsCollection, notification.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find notification %s: %w", notification.ID, err)
+	}
+
+	r.setReadAt(record, notification)
+
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to update notification record: %w", err)
+	}
+
+	return nil
+}
+
+// ListByUser retrieves a page of a user's notifications, newest first.
+func (r *pocketbaseNotificationRepository) ListByUser(
+	_ context.Context, userID string, unreadOnly bool, offset, limit int,
+) ([]*domain.Notification, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user ID cannot be empty")
+	}
+
+	filter := "user = {:userID}"
+	if unreadOnly {
+		filter += " && read_at = ''"
+	}
+
+	records, err := r.app.FindRecordsByFilter(
+		notificationsCollection, filter, "-created", limit, offset, dbx.Params{"userID": userID},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications for user %s: %w", userID, err)
+	}
+
+	notifications := make([]*domain.Notification, len(records))
+	for i, record := range records {
+		notifications[i] = r.recordToNotification(record)
+	}
+
+	return notifications, nil
+}
+
+// CountByUser counts a user's notifications, or only the unread ones.
+func (r *pocketbaseNotificationRepository) CountByUser(_ context.Context, userID string, unreadOnly bool) (int, error) {
+	if userID == "" {
+		return 0, fmt.Errorf("user ID cannot be empty")
+	}
+
+	exprs := []dbx.Expression{dbx.HashExp{"user": userID}}
+	if unreadOnly {
+		exprs = append(exprs, dbx.HashExp{"read_at": ""})
+	}
+
+	total, err := r.app.CountRecords(notificationsCollection, exprs...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count notifications for user %s: %w", userID, err)
+	}
+
+	return int(total), nil
+}
+
+// MarkAllRead marks every unread notification of a user read.
+func (r *pocketbaseNotificationRepository) MarkAllRead(
+	ctx context.Context, userID string, readAt time.Time,
+) (int, error) {
+	if userID == "" {
+		return 0, fmt.Errorf("user ID cannot be empty")
+	}
+
+	result, err := r.app.DB().Update(
+		notificationsCollection,
+		dbx.Params{"read_at": readAt.UTC().Format(types.DefaultDateLayout)},
+		dbx.HashExp{"user": userID, "read_at": ""},
+	).WithContext(ctx).Execute()
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark notifications read for user %s: %w", userID, err)
+	}
+
+	changed, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count notifications marked read: %w", err)
+	}
+
+	return int(changed), nil
+}
+
+// setReadAt copies the read state of a notification onto a record.
+func (r *pocketbaseNotificationRepository) setReadAt(record *core.Record, notification *domain.Notification) {
+	if notification.ReadAt != nil {
+		record.Set("read_at", notification.ReadAt.UTC())
+	} else {
+		record.Set("read_at", "")
+	}
+}
+
+// recordToNotification converts a PocketBase record to a domain.Notification.
+func (r *pocketbaseNotificationRepository) recordToNotification(record *core.Record) *domain.Notification {
+	notification := &domain.Notification{
+		ID:        record.Id,
+		UserID:    record.GetString("user"),
+		ProjectID: record.GetString("project"),
+		TaskID:    record.GetString("task_id"),
+		ActorID:   record.GetString("actor"),
+		EventID:   record.GetString("event_id"),
+		Kind:      domain.NotificationKind(record.GetString("kind")),
+		TaskTitle: record.GetString("task_title"),
+		Message:   record.GetString("message"),
+		CreatedAt: record.GetDateTime("created").Time(),
+	}
+	if readAt := record.GetDateTime("read_at"); !readAt.IsZero() {
+		read := readAt.Time()
+		notification.ReadAt = &read
+	}
+	return notification
+}
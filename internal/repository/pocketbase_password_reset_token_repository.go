@@ -28,9 +28,10 @@ func NewPocketBasePasswordResetTokenRepository(app core.App, secret string) doma
 	}
 }
 
-// hashResetToken computes HMAC-SHA256 hash of the raw token using the repository's secret.
-func (r *pocketbasePasswordResetTokenRepository) hashResetToken(rawToken string) string {
-	h := hmac.New(sha256.New, []byte(r.secret))
+// hashToken computes the HMAC-SHA256 hash of a raw token using secret. Tokens that are handed
+// out to users, such as password reset and invitation tokens, are only ever stored hashed.
+func hashToken(secret, rawToken string) string {
+	h := hmac.New(sha256.New, []byte(secret))
 	h.Write([]byte(rawToken))
 	return hex.EncodeToString(h.Sum(nil))
 }
@@ -48,7 +49,7 @@ func (r *pocketbasePasswordResetTokenRepository) Create(_ context.Context, token
 	}
 
 	record.Set("id", token.ID)
-	record.Set("token", r.hashResetToken(token.Token))
+	record.Set("token", hashToken(r.secret, token.Token))
 	record.Set("user_id", token.UserID)
 	record.Set("expires_at", token.ExpiresAt)
 	record.Set("used", token.Used)
@@ -75,7 +76,7 @@ func (r *pocketbasePasswordResetTokenRepository) GetByToken(
 	record, err := r.app.FindFirstRecordByFilter(
 		"password_reset_tokens",
 		"token = {:token}",
-		dbx.Params{"token": r.hashResetToken(tokenValue)},
+		dbx.Params{"token": hashToken(r.secret, tokenValue)},
 	)
 	if err != nil {
 		if IsNotFound(err) {
@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+const personalAccessTokensCollection = "personal_access_tokens"
+
+type pocketbasePersonalAccessTokenRepository struct {
+	app    core.App
+	secret string
+}
+
+// NewPocketBasePersonalAccessTokenRepository creates a new PocketBase personal access token repository.
+// Tokens are hashed with secret, the same way password reset tokens are.
+func NewPocketBasePersonalAccessTokenRepository(app core.App, secret string) PersonalAccessTokenRepository {
+	return &pocketbasePersonalAccessTokenRepository{
+		app:    app,
+		secret: secret,
+	}
+}
+
+// Create stores a new token.
+func (r *pocketbasePersonalAccessTokenRepository) Create(_ context.Context, token *domain.PersonalAccessToken) error {
+	if err := token.Validate(); err != nil {
+		return err
+	}
+
+	collection, err := r.app.FindCollectionByNameOrId(personalAccessTokensCollection)
+	if err != nil {
+		return domain.NewInternalError("COLLECTION_NOT_FOUND", "Personal access tokens collection not found", err)
+	}
+
+	record := core.NewRecord(collection)
+	record.Set("user", token.UserID)
+	record.Set("name", token.Name)
+	record.Set("hint", token.Hint)
+	record.Set("token", hashToken(r.secret, token.Token))
+	record.Set("scope", string(token.Scope))
+	if token.ExpiresAt != nil {
+		record.Set("expires_at", token.ExpiresAt.UTC())
+	}
+	r.setUsageFields(record, token)
+
+	if err := r.app.Save(record); err != nil {
+		return domain.NewInternalError("TOKEN_SAVE_FAILED", "Failed to save personal access token", err)
+	}
+
+	token.ID = record.Id
+	token.CreatedAt = record.GetDateTime("created").Time()
+	token.UpdatedAt = record.GetDateTime("updated").Time()
+
+	return nil
+}
+
+// GetByID retrieves a token by its ID.
+func (r *pocketbasePersonalAccessTokenRepository) GetByID(
+	_ context.Context, id string,
+) (*domain.PersonalAccessToken, error) {
+	record, err := r.app.FindRecordById(personalAccessTokensCollection, id)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, domain.NewNotFoundError("TOKEN_NOT_FOUND", "Personal access token not found")
+		}
+		return nil, domain.NewInternalError("TOKEN_QUERY_FAILED", "Failed to query personal access token", err)
+	}
+
+	return r.recordToToken(record), nil
+}
+
+// GetByToken retrieves a token by its raw value.
+func (r *pocketbasePersonalAccessTokenRepository) GetByToken(
+	_ context.Context, token string,
+) (*domain.PersonalAccessToken, error) {
+	record, err := r.app.FindFirstRecordByFilter(
+		personalAccessTokensCollection,
+		"token = {:token}",
+		dbx.Params{"token": hashToken(r.secret, token)},
+	)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, domain.NewNotFoundError("TOKEN_NOT_FOUND", "Personal access token not found")
+		}
+		return nil, domain.NewInternalError("TOKEN_QUERY_FAILED", "Failed to query personal access token", err)
+	}
+
+	return r.recordToToken(record), nil
+}
+
+// Update persists when a token was last used and revoked.
+func (r *pocketbasePersonalAccessTokenRepository) Update(_ context.Context, token *domain.PersonalAccessToken) error {
+	record, err := r.app.FindRecordById(personalAccessTokensCollection, token.ID)
+	if err != nil {
+		return domain.NewNotFoundError("TOKEN_NOT_FOUND", "Personal access token not found")
+	}
+
+	// A token's name, scope and expiry are fixed when it is created
+	r.setUsageFields(record, token)
+
+	if err := r.app.Save(record); err != nil {
+		return domain.NewInternalError("TOKEN_UPDATE_FAILED", "Failed to update personal access token", err)
+	}
+
+	token.UpdatedAt = record.GetDateTime("updated").Time()
+
+	return nil
+}
+
+// ListByUser retrieves a user's tokens, newest first.
+func (r *pocketbasePersonalAccessTokenRepository) ListByUser(
+	_ context.Context, userID string,
+) ([]*domain.PersonalAccessToken, error) {
+	records, err := r.app.FindRecordsByFilter(
+		personalAccessTokensCollection,
+		"user = {:user}",
+		"-created",
+		0, 0,
+		dbx.Params{"user": userID},
+	)
+	if err != nil {
+		return nil, domain.NewInternalError("TOKEN_QUERY_FAILED", "Failed to list personal access tokens", err)
+	}
+
+	tokens := make([]*domain.PersonalAccessToken, len(records))
+	for i, record := range records {
+		tokens[i] = r.recordToToken(record)
+	}
+
+	return tokens, nil
+}
+
+// setUsageFields copies the fields that change as a token is used onto a record.
+func (r *pocketbasePersonalAccessTokenRepository) setUsageFields(
+	record *core.Record, token *domain.PersonalAccessToken,
+) {
+	if token.LastUsedAt != nil {
+		record.Set("last_used_at", token.LastUsedAt.UTC())
+	}
+	if token.RevokedAt != nil {
+		record.Set("revoked_at", token.RevokedAt.UTC())
+	}
+}
+
+// recordToToken converts a PocketBase record to a domain.PersonalAccessToken.
+// The raw token isn't stored, so it is left empty.
+func (r *pocketbasePersonalAccessTokenRepository) recordToToken(record *core.Record) *domain.PersonalAccessToken {
+	return &domain.PersonalAccessToken{
+		CreatedAt:  record.GetDateTime("created").Time(),
+		UpdatedAt:  record.GetDateTime("updated").Time(),
+		ExpiresAt:  optionalTime(record, "expires_at"),
+		LastUsedAt: optionalTime(record, "last_used_at"),
+		RevokedAt:  optionalTime(record, "revoked_at"),
+		ID:         record.Id,
+		UserID:     record.GetString("user"),
+		Name:       record.GetString("name"),
+		Hint:       record.GetString("hint"),
+		Scope:      domain.TokenScope(record.GetString("scope")),
+	}
+}
+
+// optionalTime returns a record's date field, or nil if it isn't set.
+func optionalTime(record *core.Record, field string) *time.Time {
+	value := record.GetDateTime(field)
+	if value.IsZero() {
+		return nil
+	}
+	t := value.Time()
+	return &t
+}
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+const projectInvitationsCollection = "project_invitations"
+
+type pocketbaseProjectInvitationRepository struct {
+	app    core.App
+	secret string
+}
+
+// NewPocketBaseProjectInvitationRepository creates a new PocketBase project invitation repository.
+// Tokens are hashed with secret, the same way password reset tokens are.
+func NewPocketBaseProjectInvitationRepository(app core.App, secret string) ProjectInvitationRepository {
+	return &pocketbaseProjectInvitationRepository{
+		app:    app,
+		secret: secret,
+	}
+}
+
+// Create stores a new invitation.
+func (r *pocketbaseProjectInvitationRepository) Create(_ context.Context, invitation *domain.ProjectInvitation) error {
+	if err := invitation.Validate(); err != nil {
+		return err
+	}
+
+	collection, err := r.app.FindCollectionByNameOrId(projectInvitationsCollection)
+	if err != nil {
+		return domain.NewInternalError("COLLECTION_NOT_FOUND", "Project invitations collection not found", err)
+	}
+
+	record := core.NewRecord(collection)
+	record.Set("project", invitation.ProjectID)
+	record.Set("inviter", invitation.InviterID)
+	record.Set("email", invitation.Email)
+	record.Set("token", hashToken(r.secret, invitation.Token))
+	record.Set("role", string(invitation.Role))
+	record.Set("max_uses", invitation.MaxUses)
+	record.Set("expires_at", invitation.ExpiresAt.UTC())
+	r.setUsageFields(record, invitation)
+
+	if err := r.app.Save(record); err != nil {
+		return domain.NewInternalError("INVITATION_SAVE_FAILED", "Failed to save project invitation", err)
+	}
+
+	invitation.ID = record.Id
+	invitation.CreatedAt = record.GetDateTime("created").Time()
+	invitation.UpdatedAt = record.GetDateTime("updated").Time()
+
+	return nil
+}
+
+// GetByID retrieves an invitation by its ID.
+func (r *pocketbaseProjectInvitationRepository) GetByID(
+	_ context.Context, id string,
+) (*domain.ProjectInvitation, error) {
+	record, err := r.app.FindRecordById(projectInvitationsCollection, id)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, domain.NewNotFoundError("INVITATION_NOT_FOUND", "Invitation not found")
+		}
+		return nil, domain.NewInternalError("INVITATION_QUERY_FAILED", "Failed to query project invitation", err)
+	}
+
+	return r.recordToInvitation(record), nil
+}
+
+// GetByToken retrieves an invitation by its raw token.
+func (r *pocketbaseProjectInvitationRepository) GetByToken(
+	_ context.Context, token string,
+) (*domain.ProjectInvitation, error) {
+	record, err := r.app.FindFirstRecordByFilter(
+		projectInvitationsCollection,
+		"token = {:token}",
+		dbx.Params{"token": hashToken(r.secret, token)},
+	)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, domain.NewNotFoundError("INVITATION_NOT_FOUND", "Invitation not found")
+		}
+		return nil, domain.NewInternalError("INVITATION_QUERY_FAILED", "Failed to query project invitation", err)
+	}
+
+	return r.recordToInvitation(record), nil
+}
+
+// Update persists changes to an invitation's status and use count.
+func (r *pocketbaseProjectInvitationRepository) Update(_ context.Context, invitation *domain.ProjectInvitation) error {
+	if err := invitation.Validate(); err != nil {
+		return err
+	}
+
+	record, err := r.app.FindRecordById(projectInvitationsCollection, invitation.ID)
+	if err != nil {
+		return domain.NewNotFoundError("INVITATION_NOT_FOUND", "Invitation not found")
+	}
+
+	// Who was invited, and how, never changes once the invitation exists
+	r.setUsageFields(record, invitation)
+
+	if err := r.app.Save(record); err != nil {
+		return domain.NewInternalError("INVITATION_UPDATE_FAILED", "Failed to update project invitation", err)
+	}
+
+	invitation.UpdatedAt = record.GetDateTime("updated").Time()
+
+	return nil
+}
+
+// ListPendingByProject retrieves a project's pending invitations, newest first.
+func (r *pocketbaseProjectInvitationRepository) ListPendingByProject(
+	_ context.Context, projectID string,
+) ([]*domain.ProjectInvitation, error) {
+	records, err := r.app.FindRecordsByFilter(
+		projectInvitationsCollection,
+		"project = {:project} && status = {:status}",
+		"-created",
+		0, 0,
+		dbx.Params{"project": projectID, "status": string(domain.InvitationPending)},
+	)
+	if err != nil {
+		return nil, domain.NewInternalError("INVITATION_QUERY_FAILED", "Failed to list project invitations", err)
+	}
+
+	invitations := make([]*domain.ProjectInvitation, len(records))
+	for i, record := range records {
+		invitations[i] = r.recordToInvitation(record)
+	}
+
+	return invitations, nil
+}
+
+// setUsageFields copies the fields that change as an invitation is used onto a record.
+func (r *pocketbaseProjectInvitationRepository) setUsageFields(
+	record *core.Record, invitation *domain.ProjectInvitation,
+) {
+	record.Set("status", string(invitation.Status))
+	record.Set("use_count", invitation.UseCount)
+}
+
+// recordToInvitation converts a PocketBase record to a domain.ProjectInvitation.
+// The raw token isn't stored, so it is left empty.
+func (r *pocketbaseProjectInvitationRepository) recordToInvitation(record *core.Record) *domain.ProjectInvitation {
+	return &domain.ProjectInvitation{
+		ExpiresAt: record.GetDateTime("expires_at").Time(),
+		CreatedAt: record.GetDateTime("created").Time(),
+		UpdatedAt: record.GetDateTime("updated").Time(),
+		ID:        record.Id,
+		ProjectID: record.GetString("project"),
+		InviterID: record.GetString("inviter"),
+		Email:     record.GetString("email"),
+		Role:      domain.ProjectRole(record.GetString("role")),
+		Status:    domain.InvitationStatus(record.GetString("status")),
+		MaxUses:   record.GetInt("max_uses"),
+		UseCount:  record.GetInt("use_count"),
+	}
+}
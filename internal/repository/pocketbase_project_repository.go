@@ -126,6 +126,7 @@ func (r *pocketbaseProjectRepository) Update(_ context.Context, project *domain.
 	record.Set("status", string(project.Status))
 	record.Set("settings", project.Settings)
 	record.Set("members", project.MemberIDs)
+	record.Set("member_roles", project.MemberRoles)
 	record.Set("updated", time.Now())
 
 	if err := r.app.Save(record); err != nil {
@@ -263,6 +264,11 @@ func (r *pocketbaseProjectRepository) recordToProject(record *core.Record) (*dom
 		memberIDs = []string{}
 	}
 
+	var memberRoles map[string]domain.ProjectRole
+	if err := record.UnmarshalJSONField("member_roles", &memberRoles); err != nil {
+		memberRoles = nil
+	}
+
 	project := &domain.Project{
 		ID:          record.Id,
 		Title:       record.GetString("title"),
@@ -274,6 +280,7 @@ func (r *pocketbaseProjectRepository) recordToProject(record *core.Record) (*dom
 		Status:      domain.ProjectStatus(record.GetString("status")),
 		Settings:    settings,
 		MemberIDs:   memberIDs,
+		MemberRoles: memberRoles,
 		CreatedAt:   record.GetDateTime("created").Time(),
 		UpdatedAt:   record.GetDateTime("updated").Time(),
 	}
@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+const (
+	// searchIndexTable is the FTS5 table maintained by the search index migration
+	searchIndexTable = "search_index"
+
+	// Highlight markers emitted by FTS5. Text is escaped before the markers are
+	// swapped for <mark>, so stray markers in user text can never inject HTML.
+	highlightOpen  = "\x02"
+	highlightClose = "\x03"
+
+	// searchSnippetTokens is the approximate snippet length in words
+	searchSnippetTokens = 16
+
+	// defaultSearchLimit applies when the query does not set a limit
+	defaultSearchLimit = 20
+
+	// searchRank weights the indexed columns for bm25; unindexed columns come first.
+	// Titles count most, then tags, then assignee, body and custom fields.
+	searchRank = "bm25(s.search_index, 0, 0, 0, 0, 10.0, 1.0, 5.0, 2.0, 1.0)"
+)
+
+// searchColumns maps field scopes to FTS5 column filters
+var searchColumns = map[domain.SearchField]string{
+	domain.SearchAnyField: "{title body tags custom_fields}",
+	domain.SearchTitle:    "title",
+	domain.SearchTag:      "tags",
+	domain.SearchAssignee: "assignee",
+}
+
+type pocketbaseSearchRepository struct {
+	app core.App
+}
+
+// NewPocketBaseSearchRepository creates a new PocketBase full-text search repository.
+func NewPocketBaseSearchRepository(app core.App) SearchRepository {
+	return &pocketbaseSearchRepository{app: app}
+}
+
+// searchRow is a raw FTS hit as selected by Search
+type searchRow struct {
+	DocType   string  `db:"doc_type"`
+	DocID     string  `db:"doc_id"`
+	TaskID    string  `db:"task_id"`
+	ProjectID string  `db:"project_id"`
+	Title     string  `db:"title"`
+	Snippet   string  `db:"snippet"`
+	Rank      float64 `db:"rank"`
+}
+
+// Search returns ranked hits for the query, best match first.
+func (r *pocketbaseSearchRepository) Search(
+	_ context.Context, query domain.SearchQuery,
+) ([]*domain.SearchResult, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	where, params := buildSearchWhere(query)
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	params["limit"] = limit
+	params["offset"] = query.Offset
+
+	// Comment hits take the title of the task they belong to
+	sql := fmt.Sprintf(`
+		SELECT s.doc_type, s.doc_id, s.task_id, s.project_id,
+			CASE WHEN s.doc_type = 'task'
+				THEN highlight(s.search_index, 4, char(2), char(3))
+				ELSE COALESCE(t.title, '') END AS title,
+			snippet(s.search_index, -1, char(2), char(3), '…', %d) AS snippet,
+			%s AS rank
+		FROM %s s
+		LEFT JOIN tasks t ON t.id = s.task_id
+		WHERE %s
+		ORDER BY rank
+		LIMIT {:limit} OFFSET {:offset}`,
+		searchSnippetTokens, searchRank, searchIndexTable, where)
+
+	var rows []searchRow
+	if err := r.app.DB().NewQuery(sql).Bind(params).All(&rows); err != nil {
+		return nil, fmt.Errorf("failed to search project %s: %w", query.ProjectID, err)
+	}
+
+	results := make([]*domain.SearchResult, len(rows))
+	for i, row := range rows {
+		result := &domain.SearchResult{
+			Type:      domain.SearchDocumentType(row.DocType),
+			TaskID:    row.TaskID,
+			ProjectID: row.ProjectID,
+			Title:     highlightToHTML(row.Title),
+			Snippet:   highlightToHTML(row.Snippet),
+			Score:     -row.Rank, // bm25 is lower-is-better
+		}
+		if result.Type == domain.SearchDocComment {
+			result.CommentID = row.DocID
+		}
+		results[i] = result
+	}
+
+	return results, nil
+}
+
+// Count returns the total number of hits for the query, ignoring pagination.
+func (r *pocketbaseSearchRepository) Count(_ context.Context, query domain.SearchQuery) (int, error) {
+	if err := query.Validate(); err != nil {
+		return 0, err
+	}
+
+	where, params := buildSearchWhere(query)
+
+	var total int
+	sql := fmt.Sprintf("SELECT COUNT(*) FROM %s s WHERE %s", searchIndexTable, where)
+	if err := r.app.DB().NewQuery(sql).Bind(params).Row(&total); err != nil {
+		return 0, fmt.Errorf("failed to count search results for project %s: %w", query.ProjectID, err)
+	}
+
+	return total, nil
+}
+
+// buildSearchWhere creates the WHERE clause shared by Search and Count
+func buildSearchWhere(query domain.SearchQuery) (string, dbx.Params) {
+	clauses := []string{"s.search_index MATCH {:match}", "s.project_id = {:projectID}"}
+	params := dbx.Params{
+		"match":     buildMatchExpression(query.Terms),
+		"projectID": query.ProjectID,
+	}
+
+	if len(query.Types) > 0 {
+		placeholders := make([]string, len(query.Types))
+		for i, docType := range query.Types {
+			key := fmt.Sprintf("type%d", i)
+			placeholders[i] = "{:" + key + "}"
+			params[key] = string(docType)
+		}
+		clauses = append(clauses, "s.doc_type IN ("+strings.Join(placeholders, ", ")+")")
+	}
+
+	return strings.Join(clauses, " AND "), params
+}
+
+// buildMatchExpression converts parsed terms into an FTS5 MATCH expression.
+// Every value is quoted so user input can never inject FTS5 operators.
+func buildMatchExpression(terms []domain.SearchTerm) string {
+	parts := make([]string, 0, len(terms))
+	for _, term := range terms {
+		value := `"` + strings.ReplaceAll(term.Value, `"`, `""`) + `"`
+		if term.Prefix {
+			value += "*"
+		}
+
+		column, ok := searchColumns[term.Field]
+		if !ok {
+			column = searchColumns[domain.SearchAnyField]
+		}
+		parts = append(parts, column+" : "+value)
+	}
+
+	return strings.Join(parts, " AND ")
+}
+
+// highlightToHTML escapes FTS output and turns highlight markers into <mark> elements
+func highlightToHTML(text string) string {
+	escaped := html.EscapeString(text)
+	escaped = strings.ReplaceAll(escaped, highlightOpen, "<mark>")
+	return strings.ReplaceAll(escaped, highlightClose, "</mark>")
+}
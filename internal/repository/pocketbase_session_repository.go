@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/types"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+const sessionsCollection = "sessions"
+
+type pocketbaseSessionRepository struct {
+	app core.App
+}
+
+// NewPocketBaseSessionRepository creates a new PocketBase session repository.
+func NewPocketBaseSessionRepository(app core.App) SessionRepository {
+	return &pocketbaseSessionRepository{app: app}
+}
+
+// Create stores a new session.
+func (r *pocketbaseSessionRepository) Create(_ context.Context, session *domain.Session) error {
+	if err := session.Validate(); err != nil {
+		return err
+	}
+
+	collection, err := r.app.FindCollectionByNameOrId(sessionsCollection)
+	if err != nil {
+		return domain.NewInternalError("COLLECTION_NOT_FOUND", "Sessions collection not found", err)
+	}
+
+	record := core.NewRecord(collection)
+	record.Set("user", session.UserID)
+	record.Set("token_version", session.TokenVersion)
+	r.setMutableFields(record, session)
+
+	if err := r.app.Save(record); err != nil {
+		return domain.NewInternalError("SESSION_SAVE_FAILED", "Failed to save session", err)
+	}
+
+	session.ID = record.Id
+	session.CreatedAt = record.GetDateTime("created").Time()
+
+	return nil
+}
+
+// GetByID retrieves a session by its ID.
+func (r *pocketbaseSessionRepository) GetByID(_ context.Context, id string) (*domain.Session, error) {
+	record, err := r.app.FindRecordById(sessionsCollection, id)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, domain.NewNotFoundError("SESSION_NOT_FOUND", "Session not found")
+		}
+		return nil, domain.NewInternalError("SESSION_QUERY_FAILED", "Failed to query session", err)
+	}
+
+	return r.recordToSession(record), nil
+}
+
+// Update persists a session's current refresh token, expiry, device and revocation.
+func (r *pocketbaseSessionRepository) Update(_ context.Context, session *domain.Session) error {
+	record, err := r.app.FindRecordById(sessionsCollection, session.ID)
+	if err != nil {
+		return domain.NewNotFoundError("SESSION_NOT_FOUND", "Session not found")
+	}
+
+	r.setMutableFields(record, session)
+
+	if err := r.app.Save(record); err != nil {
+		return domain.NewInternalError("SESSION_UPDATE_FAILED", "Failed to update session", err)
+	}
+
+	return nil
+}
+
+// Rotate persists a session's new refresh token, expiry and device if its refresh token is still
+// previousRefreshTokenID. The check and the write are one UPDATE, so of two refreshes racing with
+// the same token only one can win.
+func (r *pocketbaseSessionRepository) Rotate(
+	ctx context.Context, session *domain.Session, previousRefreshTokenID string,
+) (bool, error) {
+	result, err := r.app.DB().Update(
+		sessionsCollection,
+		dbx.Params{
+			"refresh_token_id": session.RefreshTokenID,
+			"user_agent":       session.UserAgent,
+			"ip_address":       session.IPAddress,
+			"last_seen_at":     session.LastSeenAt.UTC().Format(types.DefaultDateLayout),
+			"expires_at":       session.ExpiresAt.UTC().Format(types.DefaultDateLayout),
+			"updated":          time.Now().UTC().Format(types.DefaultDateLayout),
+		},
+		dbx.HashExp{"id": session.ID, "refresh_token_id": previousRefreshTokenID, "revoked_at": ""},
+	).WithContext(ctx).Execute()
+	if err != nil {
+		return false, domain.NewInternalError("SESSION_UPDATE_FAILED", "Failed to rotate session", err)
+	}
+
+	changed, err := result.RowsAffected()
+	if err != nil {
+		return false, domain.NewInternalError("SESSION_UPDATE_FAILED", "Failed to rotate session", err)
+	}
+
+	return changed == 1, nil
+}
+
+// ListByUser retrieves a user's sessions, most recently seen first.
+func (r *pocketbaseSessionRepository) ListByUser(_ context.Context, userID string) ([]*domain.Session, error) {
+	records, err := r.app.FindRecordsByFilter(
+		sessionsCollection,
+		"user = {:user}",
+		"-last_seen_at",
+		0, 0,
+		dbx.Params{"user": userID},
+	)
+	if err != nil {
+		return nil, domain.NewInternalError("SESSION_QUERY_FAILED", "Failed to list sessions", err)
+	}
+
+	sessions := make([]*domain.Session, len(records))
+	for i, record := range records {
+		sessions[i] = r.recordToSession(record)
+	}
+
+	return sessions, nil
+}
+
+// DeleteExpired removes sessions whose refresh tokens have expired.
+func (r *pocketbaseSessionRepository) DeleteExpired(_ context.Context) error {
+	records, err := r.app.FindRecordsByFilter(
+		sessionsCollection,
+		"expires_at <= {:now}",
+		"",
+		0, 0,
+		dbx.Params{"now": time.Now().UTC()},
+	)
+	if err != nil {
+		return domain.NewInternalError("SESSION_QUERY_FAILED", "Failed to find expired sessions", err)
+	}
+
+	for _, record := range records {
+		if err := r.app.Delete(record); err != nil {
+			return domain.NewInternalError("SESSION_DELETE_FAILED", "Failed to delete expired session", err)
+		}
+	}
+
+	return nil
+}
+
+// setMutableFields copies the fields that change as a session is used onto a record.
+func (r *pocketbaseSessionRepository) setMutableFields(record *core.Record, session *domain.Session) {
+	record.Set("refresh_token_id", session.RefreshTokenID)
+	record.Set("user_agent", session.UserAgent)
+	record.Set("ip_address", session.IPAddress)
+	record.Set("last_seen_at", session.LastSeenAt.UTC())
+	record.Set("expires_at", session.ExpiresAt.UTC())
+	if session.RevokedAt != nil {
+		record.Set("revoked_at", session.RevokedAt.UTC())
+	}
+}
+
+// recordToSession converts a PocketBase record to a domain.Session.
+func (r *pocketbaseSessionRepository) recordToSession(record *core.Record) *domain.Session {
+	return &domain.Session{
+		CreatedAt:      record.GetDateTime("created").Time(),
+		LastSeenAt:     record.GetDateTime("last_seen_at").Time(),
+		ExpiresAt:      record.GetDateTime("expires_at").Time(),
+		RevokedAt:      optionalTime(record, "revoked_at"),
+		ID:             record.Id,
+		UserID:         record.GetString("user"),
+		UserAgent:      record.GetString("user_agent"),
+		IPAddress:      record.GetString("ip_address"),
+		RefreshTokenID: record.GetString("refresh_token_id"),
+		TokenVersion:   record.GetInt("token_version"),
+	}
+}
@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/types"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+const taskHistoryCollection = "task_history"
+
+type pocketbaseTaskHistoryRepository struct {
+	app core.App
+}
+
+// NewPocketBaseTaskHistoryRepository creates a new PocketBase task history repository.
+func NewPocketBaseTaskHistoryRepository(app core.App) TaskHistoryRepository {
+	return &pocketbaseTaskHistoryRepository{app: app}
+}
+
+// Create appends a new history entry.
+func (r *pocketbaseTaskHistoryRepository) Create(_ context.Context, entry *domain.TaskHistoryEntry) error {
+	if err := entry.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	collection, err := r.app.FindCollectionByNameOrId(taskHistoryCollection)
+	if err != nil {
+		return fmt.Errorf("failed to find task_history collection: %w", err)
+	}
+
+	record := core.NewRecord(collection)
+	record.Set("task_id", entry.TaskID)
+	record.Set("user", entry.UserID)
+	record.Set("action", string(entry.Action))
+
+	// A deleted task can no longer be referenced by the relation field;
+	// task_id alone keeps the entry attached to it.
+	if entry.Action != domain.ActionDeleted {
+		record.Set("task", entry.TaskID)
+	}
+
+	if entry.FieldName != nil {
+		record.Set("field_name", *entry.FieldName)
+	}
+	if len(entry.OldValue) > 0 {
+		record.Set("old_value", types.JSONRaw(entry.OldValue))
+	}
+	if len(entry.NewValue) > 0 {
+		record.Set("new_value", types.JSONRaw(entry.NewValue))
+	}
+	if len(entry.Metadata) > 0 {
+		record.Set("metadata", types.JSONRaw(entry.Metadata))
+	}
+
+	if !entry.CreatedAt.IsZero() {
+		record.Set("created", entry.CreatedAt)
+	}
+	if entry.ID != "" {
+		record.Id = entry.ID
+	}
+
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to save task history record: %w", err)
+	}
+
+	entry.ID = record.Id
+	if createdTime := record.GetDateTime("created"); !createdTime.IsZero() {
+		entry.CreatedAt = createdTime.Time()
+	}
+
+	return nil
+}
+
+// List retrieves history entries matching the filter, newest first.
+func (r *pocketbaseTaskHistoryRepository) List(
+	_ context.Context, filter domain.TaskHistoryFilter,
+) ([]*domain.TaskHistoryEntry, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid history filter: %w", err)
+	}
+
+	query := r.app.RecordQuery(taskHistoryCollection).
+		AndWhere(dbx.And(r.buildFilterExpressions(filter)...)).
+		OrderBy("created DESC", "id DESC")
+
+	if filter.Limit > 0 {
+		query = query.Limit(int64(filter.Limit))
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(int64(filter.Offset))
+	}
+
+	var records []*core.Record
+	if err := query.All(&records); err != nil {
+		return nil, fmt.Errorf("failed to list task history: %w", err)
+	}
+
+	entries := make([]*domain.TaskHistoryEntry, len(records))
+	for i, record := range records {
+		entries[i] = r.recordToEntry(record)
+	}
+
+	return entries, nil
+}
+
+// Count returns the number of history entries matching the filter.
+func (r *pocketbaseTaskHistoryRepository) Count(_ context.Context, filter domain.TaskHistoryFilter) (int, error) {
+	if err := filter.Validate(); err != nil {
+		return 0, fmt.Errorf("invalid history filter: %w", err)
+	}
+
+	total, err := r.app.CountRecords(taskHistoryCollection, r.buildFilterExpressions(filter)...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count task history: %w", err)
+	}
+
+	return int(total), nil
+}
+
+// buildFilterExpressions translates a history filter into SQL expressions.
+func (r *pocketbaseTaskHistoryRepository) buildFilterExpressions(filter domain.TaskHistoryFilter) []dbx.Expression {
+	var exprs []dbx.Expression
+
+	if filter.TaskID != nil && *filter.TaskID != "" {
+		exprs = append(exprs, dbx.HashExp{"task_id": *filter.TaskID})
+	}
+	if filter.UserID != nil && *filter.UserID != "" {
+		exprs = append(exprs, dbx.HashExp{"user": *filter.UserID})
+	}
+	if filter.FieldName != nil && *filter.FieldName != "" {
+		exprs = append(exprs, dbx.HashExp{"field_name": *filter.FieldName})
+	}
+	if filter.StartDate != nil {
+		exprs = append(exprs, dbx.NewExp("created >= {:startDate}",
+			dbx.Params{"startDate": filter.StartDate.UTC().Format(types.DefaultDateLayout)}))
+	}
+	if filter.EndDate != nil {
+		exprs = append(exprs, dbx.NewExp("created <= {:endDate}",
+			dbx.Params{"endDate": filter.EndDate.UTC().Format(types.DefaultDateLayout)}))
+	}
+	if len(filter.Actions) > 0 {
+		actions := make([]interface{}, len(filter.Actions))
+		for i, action := range filter.Actions {
+			actions[i] = string(action)
+		}
+		exprs = append(exprs, dbx.In("action", actions...))
+	}
+
+	return exprs
+}
+
+// recordToEntry converts a PocketBase record to a domain.TaskHistoryEntry.
+func (r *pocketbaseTaskHistoryRepository) recordToEntry(record *core.Record) *domain.TaskHistoryEntry {
+	entry := &domain.TaskHistoryEntry{
+		ID:        record.Id,
+		TaskID:    record.GetString("task_id"),
+		UserID:    record.GetString("user"),
+		Action:    domain.TaskHistoryAction(record.GetString("action")),
+		CreatedAt: record.GetDateTime("created").Time(),
+	}
+
+	// Entries written before task_id existed only carry the relation
+	if entry.TaskID == "" {
+		entry.TaskID = record.GetString("task")
+	}
+
+	if fieldName := record.GetString("field_name"); fieldName != "" {
+		entry.FieldName = &fieldName
+	}
+
+	entry.OldValue = r.rawJSONField(record, "old_value")
+	entry.NewValue = r.rawJSONField(record, "new_value")
+	entry.Metadata = r.rawJSONField(record, "metadata")
+
+	return entry
+}
+
+// rawJSONField returns a JSON field as raw bytes, or nil when it is empty.
+func (r *pocketbaseTaskHistoryRepository) rawJSONField(record *core.Record, field string) json.RawMessage {
+	var raw json.RawMessage
+	if err := record.UnmarshalJSONField(field, &raw); err != nil || len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	return raw
+}
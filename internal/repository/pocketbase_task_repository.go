@@ -132,6 +132,13 @@ func (r *pocketbaseTaskRepository) Search(
 		return nil, fmt.Errorf("search query cannot be empty")
 	}
 
+	// Use the ranked full-text index when it exists and the query parses
+	if r.app.HasTable(searchIndexTable) {
+		if terms, err := domain.ParseSearchQuery(query); err == nil {
+			return r.searchIndexed(terms, projectID, offset, limit)
+		}
+	}
+
 	// Sanitize search query for LIKE operations
 	searchTerm := "%" + strings.ReplaceAll(query, "%", "\\%") + "%"
 
@@ -154,6 +161,50 @@ func (r *pocketbaseTaskRepository) Search(
 	return r.recordsToTasks(records)
 }
 
+// searchIndexed finds tasks through the full-text index, best match first
+func (r *pocketbaseTaskRepository) searchIndexed(
+	terms []domain.SearchTerm, projectID string, offset, limit int,
+) ([]*domain.Task, error) {
+	where := "s.search_index MATCH {:match} AND s.doc_type = 'task'"
+	params := dbx.Params{"match": buildMatchExpression(terms)}
+	if projectID != "" {
+		where += " AND s.project_id = {:projectID}"
+		params["projectID"] = projectID
+	}
+
+	sql := fmt.Sprintf("SELECT s.task_id FROM %s s WHERE %s ORDER BY %s", searchIndexTable, where, searchRank)
+	if limit > 0 {
+		sql += fmt.Sprintf(" LIMIT %d OFFSET %d", limit, offset)
+	}
+
+	var ids []string
+	if err := r.app.DB().NewQuery(sql).Bind(params).Column(&ids); err != nil {
+		return nil, fmt.Errorf("failed to search task index: %w", err)
+	}
+	if len(ids) == 0 {
+		return []*domain.Task{}, nil
+	}
+
+	records, err := r.app.FindRecordsByIds("tasks", ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load searched tasks: %w", err)
+	}
+
+	// Restore rank order, which FindRecordsByIds does not preserve
+	byID := make(map[string]*core.Record, len(records))
+	for _, record := range records {
+		byID[record.Id] = record
+	}
+	ordered := make([]*core.Record, 0, len(records))
+	for _, id := range ids {
+		if record, ok := byID[id]; ok {
+			ordered = append(ordered, record)
+		}
+	}
+
+	return r.recordsToTasks(ordered)
+}
+
 // Count returns the total number of tasks matching criteria.
 func (r *pocketbaseTaskRepository) Count(_ context.Context) (int, error) {
 	total, err := r.app.CountRecords("tasks")
@@ -448,6 +499,22 @@ func (r *pocketbaseTaskRepository) GetDependencies(ctx context.Context, taskID s
 	return r.recordsToTasks(records)
 }
 
+// GetDependents retrieves the tasks that depend on a task
+func (r *pocketbaseTaskRepository) GetDependents(_ context.Context, taskID string) ([]*domain.Task, error) {
+	if taskID == "" {
+		return nil, fmt.Errorf("task ID cannot be empty")
+	}
+
+	records, err := r.app.FindRecordsByFilter(
+		"tasks", "dependencies ?= {:taskID}", "position", 0, 0, dbx.Params{"taskID": taskID},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dependents of task %s: %w", taskID, err)
+	}
+
+	return r.recordsToTasks(records)
+}
+
 // GetTasksByFilter retrieves tasks using advanced filters
 func (r *pocketbaseTaskRepository) GetTasksByFilter(ctx context.Context, filters TaskFilters) ([]*domain.Task, error) {
 	return r.getTasksWithFiltersAndParams(ctx, "", dbx.Params{}, filters)
@@ -482,8 +549,12 @@ func (r *pocketbaseTaskRepository) Move(
 		return fmt.Errorf("failed to convert record to task: %w", err)
 	}
 
-	// Validate status transition using domain logic
-	if !task.CanTransitionTo(newStatus) {
+	// Validate the status and transition against the project's workflow
+	workflow := r.projectWorkflow(task.ProjectID)
+	if !workflow.HasStatus(newStatus) {
+		return fmt.Errorf("invalid task status: %s", newStatus)
+	}
+	if !workflow.CanTransition(task.Status, newStatus) {
 		return fmt.Errorf("cannot transition from %s to %s", task.Status, newStatus)
 	}
 
@@ -520,6 +591,7 @@ func (r *pocketbaseTaskRepository) BulkUpdateStatus(
 	}
 
 	// Process updates individually (could be optimized with transactions)
+	workflows := make(map[string]*domain.Workflow)
 	for i, taskID := range taskIDs {
 		record, err := r.app.FindRecordById("tasks", taskID)
 		if err != nil {
@@ -532,7 +604,15 @@ func (r *pocketbaseTaskRepository) BulkUpdateStatus(
 			return fmt.Errorf("failed to convert task %d to domain object: %w", i, err)
 		}
 
-		if !task.CanTransitionTo(newStatus) {
+		workflow, ok := workflows[task.ProjectID]
+		if !ok {
+			workflow = r.projectWorkflow(task.ProjectID)
+			workflows[task.ProjectID] = workflow
+		}
+		if !workflow.HasStatus(newStatus) {
+			return fmt.Errorf("invalid task status for task %d (ID: %s): %s", i, taskID, newStatus)
+		}
+		if !workflow.CanTransition(task.Status, newStatus) {
 			return fmt.Errorf("task %d (ID: %s) cannot transition from %s to %s",
 				i, taskID, task.Status, newStatus)
 		}
@@ -548,6 +628,21 @@ func (r *pocketbaseTaskRepository) BulkUpdateStatus(
 	return nil
 }
 
+// projectWorkflow returns the workflow of a project, or the default one when the
+// project can't be loaded or hasn't configured its own
+func (r *pocketbaseTaskRepository) projectWorkflow(projectID string) *domain.Workflow {
+	record, err := r.app.FindRecordById("projects", projectID)
+	if err != nil {
+		return domain.DefaultWorkflow()
+	}
+
+	project := &domain.Project{}
+	if err := record.UnmarshalJSONField("settings", &project.Settings); err != nil {
+		return domain.DefaultWorkflow()
+	}
+	return project.Workflow()
+}
+
 // updateArchiveStatus handles archiving/unarchiving tasks
 func (r *pocketbaseTaskRepository) updateArchiveStatus(_ context.Context, id string, archive bool) error {
 	if id == "" {
@@ -599,19 +694,20 @@ func (r *pocketbaseTaskRepository) updateArchiveStatus(_ context.Context, id str
 // recordToTask converts a PocketBase record to a domain.Task.
 func (r *pocketbaseTaskRepository) recordToTask(record *core.Record) (*domain.Task, error) {
 	task := &domain.Task{
-		ID:          record.Id,
-		Title:       record.GetString("title"),
-		Description: record.GetString("description"),
-		ProjectID:   record.GetString("project"),
-		ReporterID:  record.GetString("reporter"),
-		Status:      domain.TaskStatus(record.GetString("status")),
-		Priority:    domain.TaskPriority(record.GetString("priority")),
-		Position:    record.GetInt("position"),
-		TimeSpent:   record.GetFloat("time_spent"),
-		Progress:    record.GetInt("progress"),
-		CreatedAt:   record.GetDateTime("created").Time(),
-		UpdatedAt:   record.GetDateTime("updated").Time(),
-		Archived:    record.GetBool("archived"),
+		ID:              record.Id,
+		Title:           record.GetString("title"),
+		Description:     record.GetString("description"),
+		DescriptionHTML: storedMarkdown(record, "description"),
+		ProjectID:       record.GetString("project"),
+		ReporterID:      record.GetString("reporter"),
+		Status:          domain.TaskStatus(record.GetString("status")),
+		Priority:        domain.TaskPriority(record.GetString("priority")),
+		Position:        record.GetInt("position"),
+		TimeSpent:       record.GetFloat("time_spent"),
+		Progress:        record.GetInt("progress"),
+		CreatedAt:       record.GetDateTime("created").Time(),
+		UpdatedAt:       record.GetDateTime("updated").Time(),
+		Archived:        record.GetBool("archived"),
 	}
 
 	r.populateOptionalFields(task, record)
@@ -630,6 +726,9 @@ func (r *pocketbaseTaskRepository) populateOptionalFields(task *domain.Task, rec
 	if parentTask := record.GetString("parent_task"); parentTask != "" {
 		task.ParentTaskID = &parentTask
 	}
+	if series := record.GetString("series"); series != "" {
+		task.SeriesID = &series
+	}
 	if effortEstimate := record.GetFloat("effort_estimate"); effortEstimate > 0 {
 		task.EffortEstimate = &effortEstimate
 	}
@@ -676,10 +775,13 @@ func (r *pocketbaseTaskRepository) populateArrayFields(task *domain.Task, record
 		task.Tags = tags
 	}
 
-	var attachments []string
-	if err := record.UnmarshalJSONField("attachments", &attachments); err == nil && len(attachments) > 0 {
+	if attachments := record.GetStringSlice(attachmentsField); len(attachments) > 0 {
 		task.Attachments = attachments
 	}
+
+	if watchers := record.GetStringSlice("watchers"); len(watchers) > 0 {
+		task.Watchers = watchers
+	}
 }
 
 // recordsToTasks converts PocketBase records to domain.Task slice.
@@ -697,7 +799,8 @@ func (r *pocketbaseTaskRepository) recordsToTasks(records []*core.Record) ([]*do
 
 // Helper functions to reduce cyclomatic complexity
 
-// setTaskFields sets all task fields on a PocketBase record
+// setTaskFields sets all task fields on a PocketBase record.
+// Attachments are left alone; they are only changed through the attachment repository.
 func (r *pocketbaseTaskRepository) setTaskFields(record *core.Record, task *domain.Task) {
 	// Required fields
 	record.Set("title", task.Title)
@@ -705,7 +808,9 @@ func (r *pocketbaseTaskRepository) setTaskFields(record *core.Record, task *doma
 	record.Set("reporter", task.ReporterID)
 	record.Set("status", string(task.Status))
 	record.Set("position", task.Position)
+	task.DescriptionHTML = renderedMarkdown(record, "description", task.Description)
 	record.Set("description", task.Description)
+	record.Set("description_html", task.DescriptionHTML)
 
 	// Set priority with default
 	priority := string(task.Priority)
@@ -736,6 +841,12 @@ func (r *pocketbaseTaskRepository) setOptionalFields(record *core.Record, task *
 		record.Set("parent_task", "")
 	}
 
+	if task.SeriesID != nil && *task.SeriesID != "" {
+		record.Set("series", *task.SeriesID)
+	} else {
+		record.Set("series", "")
+	}
+
 	if task.DueDate != nil && !task.DueDate.IsZero() {
 		record.Set("due_date", *task.DueDate)
 	} else {
@@ -781,10 +892,10 @@ func (r *pocketbaseTaskRepository) setJSONAndArrayFields(record *core.Record, ta
 	} else {
 		record.Set("tags", []string{})
 	}
-	if len(task.Attachments) > 0 {
-		record.Set("attachments", task.Attachments)
+	if len(task.Watchers) > 0 {
+		record.Set("watchers", task.Watchers)
 	} else {
-		record.Set("attachments", []string{})
+		record.Set("watchers", []string{})
 	}
 }
 
@@ -922,7 +1033,7 @@ func (r *pocketbaseTaskRepository) applySearchFilters(filters TaskFilters, filte
 	params["searchTerm"] = searchTerm
 }
 
-// applyParentFilters adds parent task filters to the query
+// applyParentFilters adds parent task and recurring series filters to the query
 func (r *pocketbaseTaskRepository) applyParentFilters(filters TaskFilters, filterParts *[]string, params dbx.Params) {
 	if filters.HasParent != nil {
 		if *filters.HasParent {
@@ -935,6 +1046,10 @@ func (r *pocketbaseTaskRepository) applyParentFilters(filters TaskFilters, filte
 		*filterParts = append(*filterParts, "parent_task = {:parentID}")
 		params["parentID"] = *filters.ParentID
 	}
+	if filters.SeriesID != nil {
+		*filterParts = append(*filterParts, "series = {:seriesID}")
+		params["seriesID"] = *filters.SeriesID
+	}
 }
 
 // applyArchivedFilter adds archived filter to the query
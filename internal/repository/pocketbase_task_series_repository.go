@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/types"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+const taskSeriesCollection = "task_series"
+
+type pocketbaseTaskSeriesRepository struct {
+	app core.App
+}
+
+// NewPocketBaseTaskSeriesRepository creates a new PocketBase task series repository.
+func NewPocketBaseTaskSeriesRepository(app core.App) TaskSeriesRepository {
+	return &pocketbaseTaskSeriesRepository{app: app}
+}
+
+// Create stores a new series.
+func (r *pocketbaseTaskSeriesRepository) Create(_ context.Context, series *domain.TaskSeries) error {
+	if err := series.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	collection, err := r.app.FindCollectionByNameOrId(taskSeriesCollection)
+	if err != nil {
+		return fmt.Errorf("failed to find task_series collection: %w", err)
+	}
+
+	record := core.NewRecord(collection)
+	record.Set("project", series.ProjectID)
+	record.Set("created_by", series.CreatedBy)
+	r.setScheduleFields(record, series)
+
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to save task series record: %w", err)
+	}
+
+	series.ID = record.Id
+	series.CreatedAt = record.GetDateTime("created").Time()
+	series.UpdatedAt = record.GetDateTime("updated").Time()
+
+	return nil
+}
+
+// GetByID retrieves a series by its ID.
+func (r *pocketbaseTaskSeriesRepository) GetByID(_ context.Context, id string) (*domain.TaskSeries, error) {
+	if id == "" {
+		return nil, fmt.Errorf("task series ID cannot be empty")
+	}
+
+	record, err := r.app.FindRecordById(taskSeriesCollection, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find task series by ID %s: %w", id, err)
+	}
+
+	return r.recordToSeries(record), nil
+}
+
+// Update persists changes to a series.
+func (r *pocketbaseTaskSeriesRepository) Update(_ context.Context, series *domain.TaskSeries) error {
+	if err := series.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	record, err := r.app.FindRecordById(taskSeriesCollection, series.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find task series %s: %w", series.ID, err)
+	}
+
+	r.setScheduleFields(record, series)
+
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to update task series record: %w", err)
+	}
+
+	series.UpdatedAt = record.GetDateTime("updated").Time()
+
+	return nil
+}
+
+// ListDue retrieves active series whose latest occurrence's date has arrived, earliest first.
+func (r *pocketbaseTaskSeriesRepository) ListDue(
+	_ context.Context, now time.Time, limit int,
+) ([]*domain.TaskSeries, error) {
+	records, err := r.app.FindRecordsByFilter(
+		taskSeriesCollection,
+		"ended_at = '' && latest_at <= {:now}",
+		"latest_at",
+		limit, 0,
+		dbx.Params{"now": now.UTC().Format(types.DefaultDateLayout)},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due task series: %w", err)
+	}
+
+	series := make([]*domain.TaskSeries, len(records))
+	for i, record := range records {
+		series[i] = r.recordToSeries(record)
+	}
+
+	return series, nil
+}
+
+// setScheduleFields copies the fields that change as a series runs onto a record.
+func (r *pocketbaseTaskSeriesRepository) setScheduleFields(record *core.Record, series *domain.TaskSeries) {
+	record.Set("rule", series.Rule)
+	record.Set("template", series.Template)
+	record.Set("starts_at", series.StartsAt.UTC())
+	record.Set("latest_at", series.LatestAt.UTC())
+	record.Set("latest_task", series.LatestTaskID)
+	record.Set("occurrences", series.Occurrences)
+	if series.EndedAt != nil {
+		record.Set("ended_at", series.EndedAt.UTC())
+	} else {
+		record.Set("ended_at", nil)
+	}
+}
+
+// recordToSeries converts a PocketBase record to a domain.TaskSeries.
+func (r *pocketbaseTaskSeriesRepository) recordToSeries(record *core.Record) *domain.TaskSeries {
+	series := &domain.TaskSeries{
+		ID:           record.Id,
+		ProjectID:    record.GetString("project"),
+		CreatedBy:    record.GetString("created_by"),
+		LatestTaskID: record.GetString("latest_task"),
+		Occurrences:  record.GetInt("occurrences"),
+		StartsAt:     record.GetDateTime("starts_at").Time(),
+		LatestAt:     record.GetDateTime("latest_at").Time(),
+		CreatedAt:    record.GetDateTime("created").Time(),
+		UpdatedAt:    record.GetDateTime("updated").Time(),
+	}
+	_ = record.UnmarshalJSONField("rule", &series.Rule)
+	_ = record.UnmarshalJSONField("template", &series.Template)
+	if endedAt := record.GetDateTime("ended_at"); !endedAt.IsZero() {
+		ended := endedAt.Time()
+		series.EndedAt = &ended
+	}
+	return series
+}
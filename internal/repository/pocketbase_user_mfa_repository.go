@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+const userMFACollection = "user_mfa"
+
+type pocketbaseUserMFARepository struct {
+	app core.App
+}
+
+// NewPocketBaseUserMFARepository creates a new PocketBase two-factor authentication repository.
+func NewPocketBaseUserMFARepository(app core.App) UserMFARepository {
+	return &pocketbaseUserMFARepository{app: app}
+}
+
+// GetByUserID retrieves a user's two-factor authentication.
+func (r *pocketbaseUserMFARepository) GetByUserID(_ context.Context, userID string) (*domain.UserMFA, error) {
+	record, err := r.findByUser(userID)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, domain.NewNotFoundError("MFA_NOT_FOUND", "Two-factor authentication is not set up")
+		}
+		return nil, domain.NewInternalError("MFA_QUERY_FAILED", "Failed to query two-factor authentication", err)
+	}
+
+	return r.recordToMFA(record), nil
+}
+
+// Save creates or replaces a user's two-factor authentication.
+func (r *pocketbaseUserMFARepository) Save(_ context.Context, mfa *domain.UserMFA) error {
+	record, err := r.findByUser(mfa.UserID)
+	if err != nil {
+		if !IsNotFound(err) {
+			return domain.NewInternalError("MFA_QUERY_FAILED", "Failed to query two-factor authentication", err)
+		}
+		collection, err := r.app.FindCollectionByNameOrId(userMFACollection)
+		if err != nil {
+			return domain.NewInternalError("COLLECTION_NOT_FOUND", "User MFA collection not found", err)
+		}
+		record = core.NewRecord(collection)
+		record.Set("user", mfa.UserID)
+	}
+
+	record.Set("secret", mfa.Secret)
+	record.Set("recovery_codes", mfa.RecoveryCodes)
+	record.Set("last_used_step", mfa.LastUsedStep)
+	if mfa.EnabledAt != nil {
+		record.Set("enabled_at", mfa.EnabledAt.UTC())
+	} else {
+		record.Set("enabled_at", "")
+	}
+
+	if err := r.app.Save(record); err != nil {
+		return domain.NewInternalError("MFA_SAVE_FAILED", "Failed to save two-factor authentication", err)
+	}
+
+	mfa.ID = record.Id
+	mfa.CreatedAt = record.GetDateTime("created").Time()
+	mfa.UpdatedAt = record.GetDateTime("updated").Time()
+
+	return nil
+}
+
+// Delete removes a user's two-factor authentication.
+func (r *pocketbaseUserMFARepository) Delete(_ context.Context, userID string) error {
+	record, err := r.findByUser(userID)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil
+		}
+		return domain.NewInternalError("MFA_QUERY_FAILED", "Failed to query two-factor authentication", err)
+	}
+
+	if err := r.app.Delete(record); err != nil {
+		return domain.NewInternalError("MFA_DELETE_FAILED", "Failed to delete two-factor authentication", err)
+	}
+	return nil
+}
+
+// findByUser finds a user's record.
+func (r *pocketbaseUserMFARepository) findByUser(userID string) (*core.Record, error) {
+	return r.app.FindFirstRecordByFilter(userMFACollection, "user = {:user}", dbx.Params{"user": userID})
+}
+
+// recordToMFA converts a PocketBase record to a domain.UserMFA.
+func (r *pocketbaseUserMFARepository) recordToMFA(record *core.Record) *domain.UserMFA {
+	var codes []string
+	if err := record.UnmarshalJSONField("recovery_codes", &codes); err != nil {
+		codes = nil
+	}
+
+	return &domain.UserMFA{
+		CreatedAt:     record.GetDateTime("created").Time(),
+		UpdatedAt:     record.GetDateTime("updated").Time(),
+		EnabledAt:     optionalTime(record, "enabled_at"),
+		ID:            record.Id,
+		UserID:        record.GetString("user"),
+		Secret:        record.GetString("secret"),
+		RecoveryCodes: codes,
+		LastUsedStep:  int64(record.GetInt("last_used_step")),
+	}
+}
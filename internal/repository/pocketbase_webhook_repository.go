@@ -0,0 +1,375 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/types"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+const (
+	webhooksCollection          = "webhooks"
+	webhookDeliveriesCollection = "webhook_deliveries"
+)
+
+type pocketbaseWebhookRepository struct {
+	app core.App
+}
+
+// NewPocketBaseWebhookRepository creates a new PocketBase webhook repository.
+func NewPocketBaseWebhookRepository(app core.App) WebhookRepository {
+	return &pocketbaseWebhookRepository{app: app}
+}
+
+// Create stores a new webhook.
+func (r *pocketbaseWebhookRepository) Create(_ context.Context, webhook *domain.Webhook) error {
+	if err := webhook.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	collection, err := r.app.FindCollectionByNameOrId(webhooksCollection)
+	if err != nil {
+		return fmt.Errorf("failed to find webhooks collection: %w", err)
+	}
+
+	record := core.NewRecord(collection)
+	record.Set("project", webhook.ProjectID)
+	record.Set("created_by", webhook.CreatedBy)
+	r.setWebhookFields(record, webhook)
+
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to save webhook record: %w", err)
+	}
+
+	webhook.ID = record.Id
+	webhook.CreatedAt = record.GetDateTime("created").Time()
+	webhook.UpdatedAt = record.GetDateTime("updated").Time()
+
+	return nil
+}
+
+// GetByID retrieves a webhook by its ID.
+func (r *pocketbaseWebhookRepository) GetByID(_ context.Context, id string) (*domain.Webhook, error) {
+	if id == "" {
+		return nil, fmt.Errorf("webhook ID cannot be empty")
+	}
+
+	record, err := r.app.FindRecordById(webhooksCollection, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhook by ID %s: %w", id, err)
+	}
+
+	return r.recordToWebhook(record), nil
+}
+
+// Update persists changes to a webhook.
+func (r *pocketbaseWebhookRepository) Update(_ context.Context, webhook *domain.Webhook) error {
+	if err := webhook.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	record, err := r.app.FindRecordById(webhooksCollection, webhook.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find webhook %s: %w", webhook.ID, err)
+	}
+
+	r.setWebhookFields(record, webhook)
+
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to update webhook record: %w", err)
+	}
+
+	webhook.UpdatedAt = record.GetDateTime("updated").Time()
+
+	return nil
+}
+
+// Delete removes a webhook; its deliveries are removed by the cascading relation.
+func (r *pocketbaseWebhookRepository) Delete(_ context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("webhook ID cannot be empty")
+	}
+
+	record, err := r.app.FindRecordById(webhooksCollection, id)
+	if err != nil {
+		return fmt.Errorf("failed to find webhook for deletion: %w", err)
+	}
+
+	if err := r.app.Delete(record); err != nil {
+		return fmt.Errorf("failed to delete webhook record: %w", err)
+	}
+
+	return nil
+}
+
+// ListByProject retrieves every webhook of a project, oldest first.
+func (r *pocketbaseWebhookRepository) ListByProject(_ context.Context, projectID string) ([]*domain.Webhook, error) {
+	return r.list("project = {:projectID}", projectID)
+}
+
+// ListActiveByProject retrieves the project's webhooks that are receiving events.
+func (r *pocketbaseWebhookRepository) ListActiveByProject(
+	_ context.Context, projectID string,
+) ([]*domain.Webhook, error) {
+	return r.list("project = {:projectID} && active = true", projectID)
+}
+
+// list retrieves the webhooks of a project matching filter, oldest first.
+func (r *pocketbaseWebhookRepository) list(filter, projectID string) ([]*domain.Webhook, error) {
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID cannot be empty")
+	}
+
+	records, err := r.app.FindRecordsByFilter(
+		webhooksCollection, filter, "created", 0, 0, dbx.Params{"projectID": projectID},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks for project %s: %w", projectID, err)
+	}
+
+	webhooks := make([]*domain.Webhook, len(records))
+	for i, record := range records {
+		webhooks[i] = r.recordToWebhook(record)
+	}
+
+	return webhooks, nil
+}
+
+// setWebhookFields copies the mutable webhook fields onto a record.
+func (r *pocketbaseWebhookRepository) setWebhookFields(record *core.Record, webhook *domain.Webhook) {
+	eventTypes := webhook.EventTypes
+	if eventTypes == nil {
+		eventTypes = []domain.TaskEventType{}
+	}
+
+	record.Set("url", webhook.URL)
+	record.Set("description", webhook.Description)
+	record.Set("secret", webhook.Secret)
+	record.Set("event_types", eventTypes)
+	record.Set("active", webhook.Active)
+	record.Set("consecutive_failures", webhook.ConsecutiveFailures)
+	record.Set("disabled_reason", webhook.DisabledReason)
+	if webhook.DisabledAt != nil {
+		record.Set("disabled_at", webhook.DisabledAt.UTC())
+	} else {
+		record.Set("disabled_at", "")
+	}
+}
+
+// recordToWebhook converts a PocketBase record to a domain.Webhook.
+func (r *pocketbaseWebhookRepository) recordToWebhook(record *core.Record) *domain.Webhook {
+	webhook := &domain.Webhook{
+		ID:                  record.Id,
+		ProjectID:           record.GetString("project"),
+		URL:                 record.GetString("url"),
+		Description:         record.GetString("description"),
+		Secret:              record.GetString("secret"),
+		CreatedBy:           record.GetString("created_by"),
+		DisabledReason:      record.GetString("disabled_reason"),
+		EventTypes:          []domain.TaskEventType{},
+		ConsecutiveFailures: record.GetInt("consecutive_failures"),
+		Active:              record.GetBool("active"),
+		CreatedAt:           record.GetDateTime("created").Time(),
+		UpdatedAt:           record.GetDateTime("updated").Time(),
+	}
+	_ = record.UnmarshalJSONField("event_types", &webhook.EventTypes)
+	if disabledAt := record.GetDateTime("disabled_at"); !disabledAt.IsZero() {
+		disabled := disabledAt.Time()
+		webhook.DisabledAt = &disabled
+	}
+	return webhook
+}
+
+type pocketbaseWebhookDeliveryRepository struct {
+	app core.App
+}
+
+// NewPocketBaseWebhookDeliveryRepository creates a new PocketBase webhook delivery repository.
+func NewPocketBaseWebhookDeliveryRepository(app core.App) WebhookDeliveryRepository {
+	return &pocketbaseWebhookDeliveryRepository{app: app}
+}
+
+// Create queues a new delivery.
+func (r *pocketbaseWebhookDeliveryRepository) Create(_ context.Context, delivery *domain.WebhookDelivery) error {
+	if err := delivery.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	collection, err := r.app.FindCollectionByNameOrId(webhookDeliveriesCollection)
+	if err != nil {
+		return fmt.Errorf("failed to find webhook_deliveries collection: %w", err)
+	}
+
+	record := core.NewRecord(collection)
+	record.Set("webhook", delivery.WebhookID)
+	record.Set("project", delivery.ProjectID)
+	record.Set("event_id", delivery.EventID)
+	record.Set("event_type", string(delivery.EventType))
+	record.Set("payload", string(delivery.Payload))
+	record.Set("redelivery_of", delivery.RedeliveryOf)
+	r.setDeliveryFields(record, delivery)
+
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to save webhook delivery record: %w", err)
+	}
+
+	delivery.ID = record.Id
+	delivery.CreatedAt = record.GetDateTime("created").Time()
+	delivery.UpdatedAt = record.GetDateTime("updated").Time()
+
+	return nil
+}
+
+// GetByID retrieves a delivery by its ID.
+func (r *pocketbaseWebhookDeliveryRepository) GetByID(_ context.Context, id string) (*domain.WebhookDelivery, error) {
+	if id == "" {
+		return nil, fmt.Errorf("webhook delivery ID cannot be empty")
+	}
+
+	record, err := r.app.FindRecordById(webhookDeliveriesCollection, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhook delivery by ID %s: %w", id, err)
+	}
+
+	return r.recordToDelivery(record), nil
+}
+
+// Update persists the state of a delivery.
+func (r *pocketbaseWebhookDeliveryRepository) Update(_ context.Context, delivery *domain.WebhookDelivery) error {
+	if err := delivery.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	record, err := r.app.FindRecordById(webhookDeliveriesCollection, delivery.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find webhook delivery %s: %w", delivery.ID, err)
+	}
+
+	r.setDeliveryFields(record, delivery)
+
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to update webhook delivery record: %w", err)
+	}
+
+	delivery.UpdatedAt = record.GetDateTime("updated").Time()
+
+	return nil
+}
+
+// ListByWebhook retrieves a webhook's most recent deliveries, newest first.
+func (r *pocketbaseWebhookDeliveryRepository) ListByWebhook(
+	_ context.Context, webhookID string, limit int,
+) ([]*domain.WebhookDelivery, error) {
+	if webhookID == "" {
+		return nil, fmt.Errorf("webhook ID cannot be empty")
+	}
+
+	records, err := r.app.FindRecordsByFilter(
+		webhookDeliveriesCollection, "webhook = {:webhookID}", "-created", limit, 0,
+		dbx.Params{"webhookID": webhookID},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deliveries for webhook %s: %w", webhookID, err)
+	}
+
+	return r.recordsToDeliveries(records), nil
+}
+
+// ListDue retrieves pending deliveries whose next attempt is due, oldest first.
+func (r *pocketbaseWebhookDeliveryRepository) ListDue(
+	_ context.Context, now time.Time, limit int,
+) ([]*domain.WebhookDelivery, error) {
+	records, err := r.app.FindRecordsByFilter(
+		webhookDeliveriesCollection,
+		"status = {:status} && next_attempt_at <= {:now}",
+		"next_attempt_at",
+		limit, 0,
+		dbx.Params{
+			"status": string(domain.WebhookDeliveryPending),
+			"now":    now.UTC().Format(types.DefaultDateLayout),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due webhook deliveries: %w", err)
+	}
+
+	return r.recordsToDeliveries(records), nil
+}
+
+// DeleteBefore removes finished deliveries created before the cutoff.
+func (r *pocketbaseWebhookDeliveryRepository) DeleteBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	result, err := r.app.DB().Delete(webhookDeliveriesCollection, dbx.NewExp(
+		"status != {:pending} AND created < {:cutoff}",
+		dbx.Params{
+			"pending": string(domain.WebhookDeliveryPending),
+			"cutoff":  cutoff.UTC().Format(types.DefaultDateLayout),
+		},
+	)).WithContext(ctx).Execute()
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete old webhook deliveries: %w", err)
+	}
+
+	removed, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count deleted webhook deliveries: %w", err)
+	}
+
+	return int(removed), nil
+}
+
+// setDeliveryFields copies the fields that change while a delivery is attempted onto a record.
+func (r *pocketbaseWebhookDeliveryRepository) setDeliveryFields(
+	record *core.Record, delivery *domain.WebhookDelivery,
+) {
+	record.Set("status", string(delivery.Status))
+	record.Set("attempts", delivery.Attempts)
+	record.Set("max_attempts", delivery.MaxAttempts)
+	record.Set("response_status", delivery.ResponseStatus)
+	record.Set("duration_ms", delivery.DurationMs)
+	record.Set("last_error", delivery.LastError)
+	record.Set("next_attempt_at", delivery.NextAttemptAt.UTC())
+	if delivery.DeliveredAt != nil {
+		record.Set("delivered_at", delivery.DeliveredAt.UTC())
+	}
+}
+
+// recordsToDeliveries converts PocketBase records to domain.WebhookDelivery values.
+func (r *pocketbaseWebhookDeliveryRepository) recordsToDeliveries(records []*core.Record) []*domain.WebhookDelivery {
+	deliveries := make([]*domain.WebhookDelivery, len(records))
+	for i, record := range records {
+		deliveries[i] = r.recordToDelivery(record)
+	}
+	return deliveries
+}
+
+// recordToDelivery converts a PocketBase record to a domain.WebhookDelivery.
+func (r *pocketbaseWebhookDeliveryRepository) recordToDelivery(record *core.Record) *domain.WebhookDelivery {
+	delivery := &domain.WebhookDelivery{
+		ID:             record.Id,
+		WebhookID:      record.GetString("webhook"),
+		ProjectID:      record.GetString("project"),
+		EventID:        record.GetString("event_id"),
+		EventType:      domain.TaskEventType(record.GetString("event_type")),
+		Status:         domain.WebhookDeliveryStatus(record.GetString("status")),
+		LastError:      record.GetString("last_error"),
+		RedeliveryOf:   record.GetString("redelivery_of"),
+		Payload:        []byte(record.GetString("payload")),
+		Attempts:       record.GetInt("attempts"),
+		MaxAttempts:    record.GetInt("max_attempts"),
+		ResponseStatus: record.GetInt("response_status"),
+		DurationMs:     int64(record.GetInt("duration_ms")),
+		NextAttemptAt:  record.GetDateTime("next_attempt_at").Time(),
+		CreatedAt:      record.GetDateTime("created").Time(),
+		UpdatedAt:      record.GetDateTime("updated").Time(),
+	}
+	if deliveredAt := record.GetDateTime("delivered_at"); !deliveredAt.IsZero() {
+		delivered := deliveredAt.Time()
+		delivery.DeliveredAt = &delivered
+	}
+	return delivery
+}
@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+const (
+	wipLimitsCollection         = "wip_limits"
+	wipLimitOverridesCollection = "wip_limit_overrides"
+)
+
+type pocketbaseWIPLimitRepository struct {
+	app core.App
+}
+
+// NewPocketBaseWIPLimitRepository creates a new PocketBase WIP limit repository.
+func NewPocketBaseWIPLimitRepository(app core.App) WIPLimitRepository {
+	return &pocketbaseWIPLimitRepository{app: app}
+}
+
+// ListByProject retrieves all configured WIP limits for a project.
+func (r *pocketbaseWIPLimitRepository) ListByProject(_ context.Context, projectID string) ([]*domain.WIPLimit, error) {
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID cannot be empty")
+	}
+
+	records, err := r.app.FindRecordsByFilter(
+		wipLimitsCollection, "project = {:projectID}", "", 0, 0, dbx.Params{"projectID": projectID},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list WIP limits for project %s: %w", projectID, err)
+	}
+
+	limits := make([]*domain.WIPLimit, len(records))
+	for i, record := range records {
+		limits[i] = r.recordToLimit(record)
+	}
+
+	return limits, nil
+}
+
+// GetByProjectAndStatus retrieves the WIP limit for a single column.
+func (r *pocketbaseWIPLimitRepository) GetByProjectAndStatus(
+	_ context.Context, projectID string, status domain.TaskStatus,
+) (*domain.WIPLimit, error) {
+	record, err := r.findLimitRecord(projectID, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.recordToLimit(record), nil
+}
+
+// Upsert creates or replaces the WIP limit for the limit's project and status.
+func (r *pocketbaseWIPLimitRepository) Upsert(_ context.Context, limit *domain.WIPLimit) error {
+	if err := limit.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	record, err := r.findLimitRecord(limit.ProjectID, limit.Status)
+	if err != nil {
+		if !IsNotFound(err) {
+			return err
+		}
+
+		collection, findErr := r.app.FindCollectionByNameOrId(wipLimitsCollection)
+		if findErr != nil {
+			return fmt.Errorf("failed to find wip_limits collection: %w", findErr)
+		}
+		record = core.NewRecord(collection)
+		record.Set("project", limit.ProjectID)
+		record.Set("status", string(limit.Status))
+	}
+
+	record.Set("soft_limit", limit.SoftLimit)
+	record.Set("hard_limit", limit.HardLimit)
+	record.Set("enabled", limit.Enabled)
+
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to save WIP limit record: %w", err)
+	}
+
+	limit.ID = record.Id
+	limit.CreatedAt = record.GetDateTime("created").Time()
+	limit.UpdatedAt = record.GetDateTime("updated").Time()
+
+	return nil
+}
+
+// RecordOverride stores an audit record for a move that bypassed a hard limit.
+func (r *pocketbaseWIPLimitRepository) RecordOverride(_ context.Context, override *domain.WIPLimitOverride) error {
+	if err := override.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	collection, err := r.app.FindCollectionByNameOrId(wipLimitOverridesCollection)
+	if err != nil {
+		return fmt.Errorf("failed to find wip_limit_overrides collection: %w", err)
+	}
+
+	record := core.NewRecord(collection)
+	record.Set("project", override.ProjectID)
+	record.Set("task", override.TaskID)
+	record.Set("user", override.UserID)
+	record.Set("status", string(override.Status))
+	record.Set("reason", override.Reason)
+	record.Set("comment", override.Comment)
+	record.Set("hard_limit", override.HardLimit)
+	record.Set("column_count", override.ColumnCount)
+
+	if err := r.app.Save(record); err != nil {
+		return fmt.Errorf("failed to save WIP override record: %w", err)
+	}
+
+	override.ID = record.Id
+	override.CreatedAt = record.GetDateTime("created").Time()
+
+	return nil
+}
+
+// ListOverrides retrieves recorded WIP overrides for a project, newest first.
+func (r *pocketbaseWIPLimitRepository) ListOverrides(
+	_ context.Context, projectID string, offset, limit int,
+) ([]*domain.WIPLimitOverride, error) {
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID cannot be empty")
+	}
+
+	records, err := r.app.FindRecordsByFilter(
+		wipLimitOverridesCollection, "project = {:projectID}", "-created", limit, offset,
+		dbx.Params{"projectID": projectID},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list WIP overrides for project %s: %w", projectID, err)
+	}
+
+	overrides := make([]*domain.WIPLimitOverride, len(records))
+	for i, record := range records {
+		overrides[i] = &domain.WIPLimitOverride{
+			ID:          record.Id,
+			ProjectID:   record.GetString("project"),
+			TaskID:      record.GetString("task"),
+			UserID:      record.GetString("user"),
+			Status:      domain.TaskStatus(record.GetString("status")),
+			Reason:      record.GetString("reason"),
+			Comment:     record.GetString("comment"),
+			HardLimit:   record.GetInt("hard_limit"),
+			ColumnCount: record.GetInt("column_count"),
+			CreatedAt:   record.GetDateTime("created").Time(),
+		}
+	}
+
+	return overrides, nil
+}
+
+// findLimitRecord looks up the record for a project column, wrapping ErrNotFound when absent.
+func (r *pocketbaseWIPLimitRepository) findLimitRecord(projectID string, status domain.TaskStatus) (*core.Record, error) {
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID cannot be empty")
+	}
+
+	record, err := r.app.FindFirstRecordByFilter(
+		wipLimitsCollection,
+		"project = {:projectID} && status = {:status}",
+		dbx.Params{"projectID": projectID, "status": string(status)},
+	)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, fmt.Errorf("%w: WIP limit for project %s status %s", ErrNotFound, projectID, status)
+		}
+		return nil, fmt.Errorf("failed to find WIP limit for project %s status %s: %w", projectID, status, err)
+	}
+
+	return record, nil
+}
+
+// recordToLimit converts a PocketBase record to a domain.WIPLimit.
+func (r *pocketbaseWIPLimitRepository) recordToLimit(record *core.Record) *domain.WIPLimit {
+	return &domain.WIPLimit{
+		ID:        record.Id,
+		ProjectID: record.GetString("project"),
+		Status:    domain.TaskStatus(record.GetString("status")),
+		SoftLimit: record.GetInt("soft_limit"),
+		HardLimit: record.GetInt("hard_limit"),
+		Enabled:   record.GetBool("enabled"),
+		CreatedAt: record.GetDateTime("created").Time(),
+		UpdatedAt: record.GetDateTime("updated").Time(),
+	}
+}
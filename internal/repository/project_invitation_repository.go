@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// ProjectInvitationRepository defines the interface for project invitation data access operations.
+// Invitation tokens are stored hashed; GetByToken takes the raw token.
+type ProjectInvitationRepository interface {
+	// Create stores a new invitation
+	Create(ctx context.Context, invitation *domain.ProjectInvitation) error
+
+	// GetByID retrieves an invitation by its ID
+	GetByID(ctx context.Context, id string) (*domain.ProjectInvitation, error)
+
+	// GetByToken retrieves an invitation by its raw token
+	GetByToken(ctx context.Context, token string) (*domain.ProjectInvitation, error)
+
+	// Update persists changes to an invitation's status and use count
+	Update(ctx context.Context, invitation *domain.ProjectInvitation) error
+
+	// ListPendingByProject retrieves a project's pending invitations, newest first
+	ListPendingByProject(ctx context.Context, projectID string) ([]*domain.ProjectInvitation, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// SearchRepository defines full-text search over tasks and comments.
+type SearchRepository interface {
+	// Search returns ranked hits for the query, best match first
+	Search(ctx context.Context, query domain.SearchQuery) ([]*domain.SearchResult, error)
+
+	// Count returns the total number of hits for the query, ignoring pagination
+	Count(ctx context.Context, query domain.SearchQuery) (int, error)
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// SessionRepository defines the interface for sign-in session data access operations.
+type SessionRepository interface {
+	// Create stores a new session
+	Create(ctx context.Context, session *domain.Session) error
+
+	// GetByID retrieves a session by its ID
+	GetByID(ctx context.Context, id string) (*domain.Session, error)
+
+	// Update persists a session's current refresh token, expiry, device and revocation
+	Update(ctx context.Context, session *domain.Session) error
+
+	// Rotate persists a session's new refresh token, expiry and device, but only if the session
+	// hasn't been revoked and its refresh token is still previousRefreshTokenID. It returns false
+	// when another refresh got there first.
+	Rotate(ctx context.Context, session *domain.Session, previousRefreshTokenID string) (bool, error)
+
+	// ListByUser retrieves a user's sessions, most recently seen first
+	ListByUser(ctx context.Context, userID string) ([]*domain.Session, error)
+
+	// DeleteExpired removes sessions whose refresh tokens have expired
+	DeleteExpired(ctx context.Context) error
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// TaskHistoryRepository defines the interface for task audit log data access operations.
+type TaskHistoryRepository interface {
+	TaskHistoryQueryRepository
+	TaskHistoryCommandRepository
+}
+
+// TaskHistoryQueryRepository defines query operations for task history.
+type TaskHistoryQueryRepository interface {
+	// List retrieves history entries matching the filter, newest first
+	List(ctx context.Context, filter domain.TaskHistoryFilter) ([]*domain.TaskHistoryEntry, error)
+
+	// Count returns the number of history entries matching the filter, ignoring limit and offset
+	Count(ctx context.Context, filter domain.TaskHistoryFilter) (int, error)
+}
+
+// TaskHistoryCommandRepository defines command operations for task history.
+type TaskHistoryCommandRepository interface {
+	// Create appends a new history entry
+	Create(ctx context.Context, entry *domain.TaskHistoryEntry) error
+}
@@ -42,6 +42,9 @@ type TaskQueryRepository interface {
 	// GetDependencies retrieves dependency tasks for a task
 	GetDependencies(ctx context.Context, taskID string) ([]*domain.Task, error)
 
+	// GetDependents retrieves the tasks that depend on a task
+	GetDependents(ctx context.Context, taskID string) ([]*domain.Task, error)
+
 	// GetTasksByFilter retrieves tasks using advanced filters
 	GetTasksByFilter(ctx context.Context, filters TaskFilters) ([]*domain.Task, error)
 
@@ -104,6 +107,7 @@ type TaskFilters struct {
 	AssigneeID *string               `json:"assignee_id,omitempty"` // 8 bytes
 	ReporterID *string               `json:"reporter_id,omitempty"` // 8 bytes
 	ParentID   *string               `json:"parent_id,omitempty"`   // 8 bytes
+	SeriesID   *string               `json:"series_id,omitempty"`   // 8 bytes
 	Archived   *bool                 `json:"archived,omitempty"`    // 8 bytes
 	HasParent  *bool                 `json:"has_parent,omitempty"`  // 8 bytes
 	Limit      int                   `json:"limit,omitempty"`       // 8 bytes
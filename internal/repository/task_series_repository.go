@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// TaskSeriesRepository defines the interface for recurring task series data access operations.
+type TaskSeriesRepository interface {
+	// Create stores a new series
+	Create(ctx context.Context, series *domain.TaskSeries) error
+
+	// GetByID retrieves a series by its ID
+	GetByID(ctx context.Context, id string) (*domain.TaskSeries, error)
+
+	// Update persists changes to a series
+	Update(ctx context.Context, series *domain.TaskSeries) error
+
+	// ListDue retrieves active series whose latest occurrence's date has arrived, earliest first
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.TaskSeries, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// UserMFARepository defines the interface for two-factor authentication data access operations.
+// Each user has at most one record.
+type UserMFARepository interface {
+	// GetByUserID retrieves a user's two-factor authentication
+	GetByUserID(ctx context.Context, userID string) (*domain.UserMFA, error)
+
+	// Save creates or replaces a user's two-factor authentication
+	Save(ctx context.Context, mfa *domain.UserMFA) error
+
+	// Delete removes a user's two-factor authentication
+	Delete(ctx context.Context, userID string) error
+}
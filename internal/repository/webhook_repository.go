@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// WebhookRepository defines the interface for outbound webhook data access operations.
+type WebhookRepository interface {
+	// Create stores a new webhook
+	Create(ctx context.Context, webhook *domain.Webhook) error
+
+	// GetByID retrieves a webhook by its ID
+	GetByID(ctx context.Context, id string) (*domain.Webhook, error)
+
+	// Update persists changes to a webhook
+	Update(ctx context.Context, webhook *domain.Webhook) error
+
+	// Delete removes a webhook along with its deliveries
+	Delete(ctx context.Context, id string) error
+
+	// ListByProject retrieves every webhook of a project, oldest first
+	ListByProject(ctx context.Context, projectID string) ([]*domain.Webhook, error)
+
+	// ListActiveByProject retrieves the project's webhooks that are receiving events
+	ListActiveByProject(ctx context.Context, projectID string) ([]*domain.Webhook, error)
+}
+
+// WebhookDeliveryRepository defines the interface for webhook delivery data access operations.
+type WebhookDeliveryRepository interface {
+	// Create queues a new delivery
+	Create(ctx context.Context, delivery *domain.WebhookDelivery) error
+
+	// GetByID retrieves a delivery by its ID
+	GetByID(ctx context.Context, id string) (*domain.WebhookDelivery, error)
+
+	// Update persists the state of a delivery
+	Update(ctx context.Context, delivery *domain.WebhookDelivery) error
+
+	// ListByWebhook retrieves a webhook's most recent deliveries, newest first
+	ListByWebhook(ctx context.Context, webhookID string, limit int) ([]*domain.WebhookDelivery, error)
+
+	// ListDue retrieves pending deliveries whose next attempt is due, oldest first
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.WebhookDelivery, error)
+
+	// DeleteBefore removes finished deliveries created before the cutoff and returns how many were removed
+	DeleteBefore(ctx context.Context, cutoff time.Time) (int, error)
+}
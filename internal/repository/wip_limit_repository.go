@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// WIPLimitRepository defines the interface for WIP limit data access operations.
+type WIPLimitRepository interface {
+	WIPLimitQueryRepository
+	WIPLimitCommandRepository
+}
+
+// WIPLimitQueryRepository defines query operations for WIP limits.
+type WIPLimitQueryRepository interface {
+	// ListByProject retrieves all configured WIP limits for a project
+	ListByProject(ctx context.Context, projectID string) ([]*domain.WIPLimit, error)
+
+	// GetByProjectAndStatus retrieves the WIP limit for a single column; returns ErrNotFound when unset
+	GetByProjectAndStatus(ctx context.Context, projectID string, status domain.TaskStatus) (*domain.WIPLimit, error)
+
+	// ListOverrides retrieves recorded WIP overrides for a project, newest first
+	ListOverrides(ctx context.Context, projectID string, offset, limit int) ([]*domain.WIPLimitOverride, error)
+}
+
+// WIPLimitCommandRepository defines command operations for WIP limits.
+type WIPLimitCommandRepository interface {
+	// Upsert creates or replaces the WIP limit for the limit's project and status
+	Upsert(ctx context.Context, limit *domain.WIPLimit) error
+
+	// RecordOverride stores an audit record for a move that bypassed a hard limit
+	RecordOverride(ctx context.Context, override *domain.WIPLimitOverride) error
+}
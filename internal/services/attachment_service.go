@@ -0,0 +1,312 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
+)
+
+// AttachmentLinkTTL is how long the download links handed out for attachments keep working
+const AttachmentLinkTTL = 15 * time.Minute
+
+// AttachmentService defines the interface for attaching files to tasks and comments.
+type AttachmentService interface {
+	// AddTaskAttachment attaches a file to a task
+	AddTaskAttachment(
+		ctx context.Context, taskID string, upload *domain.AttachmentUpload, userID string,
+	) (*domain.Attachment, error)
+
+	// ListTaskAttachments lists the files attached to a task, with fresh download links
+	ListTaskAttachments(ctx context.Context, taskID string, userID string) ([]*domain.Attachment, error)
+
+	// RemoveTaskAttachment deletes a file from a task
+	RemoveTaskAttachment(ctx context.Context, taskID, name, userID string) error
+
+	// AddCommentAttachment attaches a file to the user's own comment
+	AddCommentAttachment(
+		ctx context.Context, commentID string, upload *domain.AttachmentUpload, userID string,
+	) (*domain.Attachment, error)
+
+	// ListCommentAttachments lists the files attached to a comment, with fresh download links
+	ListCommentAttachments(ctx context.Context, commentID string, userID string) ([]*domain.Attachment, error)
+
+	// RemoveCommentAttachment deletes a file from the user's own comment
+	RemoveCommentAttachment(ctx context.Context, commentID, name, userID string) error
+
+	// OpenAttachment opens the file a download link points to, once its signature is checked
+	OpenAttachment(ctx context.Context, link domain.AttachmentLink) (*domain.AttachmentContent, error)
+}
+
+// attachmentService implements AttachmentService interface.
+type attachmentService struct {
+	attachmentRepo repository.AttachmentRepository
+	taskRepo       repository.TaskRepository
+	commentRepo    repository.CommentRepository
+	projectRepo    repository.ProjectRepository
+	authz          AuthorizationService
+	signingKey     []byte
+	now            func() time.Time
+}
+
+// NewAttachmentService creates a new attachment service. Who may change a task's files comes
+// from authz. Download links are signed with signingKey, so they can be followed without an auth
+// header until they expire.
+func NewAttachmentService(
+	attachmentRepo repository.AttachmentRepository,
+	taskRepo repository.TaskRepository,
+	commentRepo repository.CommentRepository,
+	projectRepo repository.ProjectRepository,
+	authz AuthorizationService,
+	signingKey string,
+) AttachmentService {
+	return &attachmentService{
+		attachmentRepo: attachmentRepo,
+		taskRepo:       taskRepo,
+		commentRepo:    commentRepo,
+		projectRepo:    projectRepo,
+		authz:          authz,
+		signingKey:     []byte(signingKey),
+		now:            time.Now,
+	}
+}
+
+// AddTaskAttachment attaches a file to a task. Anyone who may edit the project's tasks may.
+func (s *attachmentService) AddTaskAttachment(
+	ctx context.Context, taskID string, upload *domain.AttachmentUpload, userID string,
+) (*domain.Attachment, error) {
+	task, project, err := s.task(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authz.Check(ctx, project, userID, domain.PermissionEditTasks); err != nil {
+		return nil, err
+	}
+
+	return s.add(ctx, domain.TaskAttachments(task.ID), task.Attachments, upload)
+}
+
+// ListTaskAttachments lists the files attached to a task.
+func (s *attachmentService) ListTaskAttachments(
+	ctx context.Context, taskID string, userID string,
+) ([]*domain.Attachment, error) {
+	task, _, err := s.task(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.links(domain.TaskAttachments(task.ID), task.Attachments), nil
+}
+
+// RemoveTaskAttachment deletes a file from a task. Anyone who may edit the project's tasks may.
+func (s *attachmentService) RemoveTaskAttachment(ctx context.Context, taskID, name, userID string) error {
+	task, project, err := s.task(ctx, taskID, userID)
+	if err != nil {
+		return err
+	}
+	if err := s.authz.Check(ctx, project, userID, domain.PermissionEditTasks); err != nil {
+		return err
+	}
+
+	return s.remove(ctx, domain.TaskAttachments(task.ID), task.Attachments, name)
+}
+
+// AddCommentAttachment attaches a file to the user's own comment.
+func (s *attachmentService) AddCommentAttachment(
+	ctx context.Context, commentID string, upload *domain.AttachmentUpload, userID string,
+) (*domain.Attachment, error) {
+	comment, err := s.comment(ctx, commentID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.AuthorID != userID {
+		return nil, domain.NewAuthorizationError("ACCESS_DENIED", "You can only attach files to your own comments")
+	}
+
+	return s.add(ctx, domain.CommentAttachments(comment.ID), comment.Attachments, upload)
+}
+
+// ListCommentAttachments lists the files attached to a comment.
+func (s *attachmentService) ListCommentAttachments(
+	ctx context.Context, commentID string, userID string,
+) ([]*domain.Attachment, error) {
+	comment, err := s.comment(ctx, commentID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.links(domain.CommentAttachments(comment.ID), comment.Attachments), nil
+}
+
+// RemoveCommentAttachment deletes a file from the user's own comment.
+func (s *attachmentService) RemoveCommentAttachment(ctx context.Context, commentID, name, userID string) error {
+	comment, err := s.comment(ctx, commentID, userID)
+	if err != nil {
+		return err
+	}
+	if comment.AuthorID != userID {
+		return domain.NewAuthorizationError("ACCESS_DENIED", "You can only remove files from your own comments")
+	}
+
+	return s.remove(ctx, domain.CommentAttachments(comment.ID), comment.Attachments, name)
+}
+
+// OpenAttachment opens the file a download link points to. Expired links and links whose
+// signature doesn't match are reported as not found, like files that don't exist.
+func (s *attachmentService) OpenAttachment(
+	ctx context.Context, link domain.AttachmentLink,
+) (*domain.AttachmentContent, error) {
+	notFound := domain.NewNotFoundError("ATTACHMENT_NOT_FOUND", "Attachment not found or link expired")
+
+	if !link.Owner.Type.IsValid() || link.Owner.ID == "" || link.Name == "" {
+		return nil, notFound
+	}
+	if link.ThumbSize != "" && link.ThumbSize != domain.AttachmentThumbSize {
+		return nil, domain.NewValidationError("INVALID_THUMB_SIZE", "Unsupported thumbnail size",
+			map[string]interface{}{"supported": domain.AttachmentThumbSize})
+	}
+	if s.now().Unix() > link.Expires {
+		return nil, notFound
+	}
+
+	expected := s.sign(link.Owner, link.Name, link.Expires)
+	if !hmac.Equal([]byte(link.Signature), []byte(expected)) {
+		return nil, notFound
+	}
+
+	content, err := s.attachmentRepo.Open(ctx, link.Owner, link.Name, link.ThumbSize)
+	if err != nil {
+		return nil, notFound
+	}
+
+	return content, nil
+}
+
+// add stores an upload once the owner has room for it and returns it with its download links
+func (s *attachmentService) add(
+	ctx context.Context, owner domain.AttachmentOwner, existing []string, upload *domain.AttachmentUpload,
+) (*domain.Attachment, error) {
+	if upload == nil {
+		return nil, domain.NewValidationError("MISSING_FILE", "A file is required", nil)
+	}
+	if len(existing) >= domain.MaxAttachments {
+		return nil, domain.NewValidationError("TOO_MANY_ATTACHMENTS", "The maximum number of attachments is reached",
+			map[string]interface{}{"max_attachments": domain.MaxAttachments})
+	}
+
+	name, err := s.attachmentRepo.Add(ctx, owner, upload)
+	if err != nil {
+		return nil, domain.NewInternalError("ATTACHMENT_SAVE_FAILED", "Failed to save attachment", err)
+	}
+
+	return s.link(owner, name, s.now().Add(AttachmentLinkTTL)), nil
+}
+
+// remove deletes one of an owner's attachments
+func (s *attachmentService) remove(
+	ctx context.Context, owner domain.AttachmentOwner, existing []string, name string,
+) error {
+	if !slices.Contains(existing, name) {
+		return domain.NewNotFoundError("ATTACHMENT_NOT_FOUND", "Attachment not found")
+	}
+
+	if err := s.attachmentRepo.Remove(ctx, owner, name); err != nil {
+		return domain.NewInternalError("ATTACHMENT_DELETE_FAILED", "Failed to delete attachment", err)
+	}
+
+	return nil
+}
+
+// links describes an owner's attachments, with download links that expire together
+func (s *attachmentService) links(owner domain.AttachmentOwner, names []string) []*domain.Attachment {
+	expiresAt := s.now().Add(AttachmentLinkTTL)
+
+	attachments := make([]*domain.Attachment, 0, len(names))
+	for _, name := range names {
+		attachments = append(attachments, s.link(owner, name, expiresAt))
+	}
+	return attachments
+}
+
+// link describes an attachment with signed download links
+func (s *attachmentService) link(owner domain.AttachmentOwner, name string, expiresAt time.Time) *domain.Attachment {
+	expires := expiresAt.Unix()
+
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("signature", s.sign(owner, name, expires))
+
+	path := "/api/files/" + url.PathEscape(string(owner.Type)) + "/" + url.PathEscape(owner.ID) + "/" +
+		url.PathEscape(name)
+
+	attachment := &domain.Attachment{
+		Name:      name,
+		URL:       path + "?" + query.Encode(),
+		ExpiresAt: time.Unix(expires, 0).UTC(),
+	}
+
+	if domain.IsImageAttachment(name) {
+		query.Set("thumb", domain.AttachmentThumbSize)
+		attachment.ThumbnailURL = path + "?" + query.Encode()
+	}
+
+	return attachment
+}
+
+// sign returns the signature of a download link. The thumbnail of an image shares the link of the file.
+func (s *attachmentService) sign(owner domain.AttachmentOwner, name string, expires int64) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte("attachment\n" + string(owner.Type) + "\n" + owner.ID + "\n" + name + "\n" +
+		strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// task loads a task and its project, checking the user may see the task
+func (s *attachmentService) task(
+	ctx context.Context, taskID string, userID string,
+) (*domain.Task, *domain.Project, error) {
+	if taskID == "" {
+		return nil, nil, domain.NewValidationError("INVALID_TASK_ID", "Task ID cannot be empty", nil)
+	}
+
+	task, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, nil, domain.NewNotFoundError("TASK_NOT_FOUND", "Task not found")
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, task.ProjectID)
+	if err != nil {
+		return nil, nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
+	}
+
+	if !project.HasAccess(userID) && project.Settings.IsPrivate {
+		return nil, nil, domain.NewAuthorizationError("ACCESS_DENIED", "You don't have access to this task")
+	}
+
+	return task, project, nil
+}
+
+// comment loads a comment, checking the user may see its task
+func (s *attachmentService) comment(ctx context.Context, commentID string, userID string) (*domain.Comment, error) {
+	if commentID == "" {
+		return nil, domain.NewValidationError("INVALID_COMMENT_ID", "Comment ID cannot be empty", nil)
+	}
+
+	comment, err := s.commentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		return nil, domain.NewNotFoundError("COMMENT_NOT_FOUND", "Comment not found")
+	}
+
+	if _, _, err := s.task(ctx, comment.TaskID, userID); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
@@ -0,0 +1,251 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+// pngHeader is enough of a PNG file for its type to be detected
+const pngHeader = "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"
+
+type attachmentTestEnv struct {
+	service  AttachmentService
+	files    *testutil.MockAttachmentRepository
+	tasks    *testutil.MockTaskRepository
+	comments *testutil.MockCommentRepository
+	projects *testutil.MockProjectRepository
+}
+
+// newAttachmentTestEnv sets up a private project owned by the owner with a member and a viewer,
+// a task in it and a comment by the member. The outsider is not part of the project.
+func newAttachmentTestEnv(t *testing.T) *attachmentTestEnv {
+	t.Helper()
+	env := &attachmentTestEnv{
+		tasks:    testutil.NewMockTaskRepository(),
+		comments: testutil.NewMockCommentRepository(),
+		projects: testutil.NewMockProjectRepository(),
+	}
+	env.files = testutil.NewMockAttachmentRepository(env.tasks, env.comments)
+
+	project := testutil.MockProject("project-1", "Project", "project", "owner")
+	project.MemberIDs = []string{"member", "viewer"}
+	project.MemberRoles = map[string]domain.ProjectRole{"viewer": domain.ProjectViewer}
+	project.Settings.IsPrivate = true
+	env.projects.AddProject(project)
+
+	env.tasks.AddTask(testutil.MockTask("task-1", "Ship it", "project-1", "owner"))
+	require.NoError(t, env.comments.Create(context.Background(), &domain.Comment{
+		TaskID: "task-1", AuthorID: "member", Content: "Screenshot below", Type: domain.CommentTypeRegular,
+	}))
+
+	env.service = NewAttachmentService(
+		env.files, env.tasks, env.comments, env.projects, NewAuthorizationService(env.projects), "test-signing-key",
+	)
+	return env
+}
+
+// upload builds an upload from raw content
+func upload(t *testing.T, name, content string) *domain.AttachmentUpload {
+	t.Helper()
+	attachment, err := domain.NewAttachmentUpload(name, []byte(content))
+	require.NoError(t, err)
+	return attachment
+}
+
+// linkFromURL reads a download link back out of an attachment URL
+func linkFromURL(t *testing.T, rawURL string) domain.AttachmentLink {
+	t.Helper()
+	parsed, err := url.Parse(rawURL)
+	require.NoError(t, err)
+
+	parts := strings.Split(strings.TrimPrefix(parsed.Path, "/api/files/"), "/")
+	require.Len(t, parts, 3)
+	expires, err := strconv.ParseInt(parsed.Query().Get("expires"), 10, 64)
+	require.NoError(t, err)
+
+	return domain.AttachmentLink{
+		Owner:     domain.AttachmentOwner{Type: domain.AttachmentOwnerType(parts[0]), ID: parts[1]},
+		Name:      parts[2],
+		ThumbSize: parsed.Query().Get("thumb"),
+		Expires:   expires,
+		Signature: parsed.Query().Get("signature"),
+	}
+}
+
+func TestAttachmentService_TaskAttachments(t *testing.T) {
+	ctx := context.Background()
+	env := newAttachmentTestEnv(t)
+
+	attachment, err := env.service.AddTaskAttachment(ctx, "task-1", upload(t, "diagram.png", pngHeader), "member")
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(attachment.Name, "diagram.png"))
+	assert.True(t, strings.HasPrefix(attachment.URL, "/api/files/tasks/task-1/"))
+	assert.Contains(t, attachment.ThumbnailURL, "thumb=100x100")
+
+	t.Run("ListedWithFreshLinks", func(t *testing.T) {
+		attachments, err := env.service.ListTaskAttachments(ctx, "task-1", "owner")
+		require.NoError(t, err)
+		require.Len(t, attachments, 1)
+		assert.Equal(t, attachment.Name, attachments[0].Name)
+		assert.NotEmpty(t, attachments[0].URL)
+	})
+
+	t.Run("OutsiderCannotAttachOrList", func(t *testing.T) {
+		_, err := env.service.AddTaskAttachment(ctx, "task-1", upload(t, "notes.txt", "hello"), "outsider")
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+
+		_, err = env.service.ListTaskAttachments(ctx, "task-1", "outsider")
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+	})
+
+	t.Run("ViewerCanListButNotChange", func(t *testing.T) {
+		_, err := env.service.ListTaskAttachments(ctx, "task-1", "viewer")
+		require.NoError(t, err)
+
+		_, err = env.service.AddTaskAttachment(ctx, "task-1", upload(t, "notes.txt", "hello"), "viewer")
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+
+		err = env.service.RemoveTaskAttachment(ctx, "task-1", attachment.Name, "viewer")
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+	})
+
+	t.Run("TextFilesHaveNoThumbnail", func(t *testing.T) {
+		notes, err := env.service.AddTaskAttachment(ctx, "task-1", upload(t, "notes.txt", "hello"), "owner")
+		require.NoError(t, err)
+		assert.Empty(t, notes.ThumbnailURL)
+		require.NoError(t, env.service.RemoveTaskAttachment(ctx, "task-1", notes.Name, "owner"))
+	})
+
+	t.Run("RemoveUnknownAttachment", func(t *testing.T) {
+		err := env.service.RemoveTaskAttachment(ctx, "task-1", "missing.png", "owner")
+		assert.Equal(t, domain.NotFoundError, webhookErrorType(err))
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		require.NoError(t, env.service.RemoveTaskAttachment(ctx, "task-1", attachment.Name, "member"))
+
+		attachments, err := env.service.ListTaskAttachments(ctx, "task-1", "owner")
+		require.NoError(t, err)
+		assert.Empty(t, attachments)
+		assert.Empty(t, env.files.Files)
+	})
+}
+
+func TestAttachmentService_TooManyAttachments(t *testing.T) {
+	ctx := context.Background()
+	env := newAttachmentTestEnv(t)
+
+	for i := 0; i < domain.MaxAttachments; i++ {
+		_, err := env.service.AddTaskAttachment(ctx, "task-1", upload(t, "notes.txt", "hello"), "owner")
+		require.NoError(t, err)
+	}
+
+	_, err := env.service.AddTaskAttachment(ctx, "task-1", upload(t, "notes.txt", "hello"), "owner")
+	require.Error(t, err)
+	var domainErr *domain.Error
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "TOO_MANY_ATTACHMENTS", domainErr.Code)
+}
+
+func TestAttachmentService_CommentAttachments(t *testing.T) {
+	ctx := context.Background()
+	env := newAttachmentTestEnv(t)
+	commentID := env.comments.Comments[0].ID
+
+	_, err := env.service.AddCommentAttachment(ctx, commentID, upload(t, "notes.txt", "hello"), "owner")
+	assert.Equal(t, domain.AuthorizationError, webhookErrorType(err), "only the author attaches files to a comment")
+
+	attachment, err := env.service.AddCommentAttachment(ctx, commentID, upload(t, "notes.txt", "hello"), "member")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(attachment.URL, "/api/files/comments/"+commentID+"/"))
+
+	attachments, err := env.service.ListCommentAttachments(ctx, commentID, "owner")
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+
+	err = env.service.RemoveCommentAttachment(ctx, commentID, attachment.Name, "owner")
+	assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+	require.NoError(t, env.service.RemoveCommentAttachment(ctx, commentID, attachment.Name, "member"))
+}
+
+func TestAttachmentService_OpenAttachment(t *testing.T) {
+	ctx := context.Background()
+	env := newAttachmentTestEnv(t)
+
+	attachment, err := env.service.AddTaskAttachment(ctx, "task-1", upload(t, "diagram.png", pngHeader), "owner")
+	require.NoError(t, err)
+
+	t.Run("SignedLink", func(t *testing.T) {
+		content, err := env.service.OpenAttachment(ctx, linkFromURL(t, attachment.URL))
+		require.NoError(t, err)
+		defer func() { _ = content.Close() }()
+
+		data, err := io.ReadAll(content)
+		require.NoError(t, err)
+		assert.Equal(t, pngHeader, string(data))
+		assert.Equal(t, "image/png", content.ContentType)
+	})
+
+	t.Run("Thumbnail", func(t *testing.T) {
+		content, err := env.service.OpenAttachment(ctx, linkFromURL(t, attachment.ThumbnailURL))
+		require.NoError(t, err)
+		defer func() { _ = content.Close() }()
+		assert.Equal(t, "100x100_"+attachment.Name, content.Name)
+	})
+
+	t.Run("UnsupportedThumbSize", func(t *testing.T) {
+		link := linkFromURL(t, attachment.URL)
+		link.ThumbSize = "2000x2000"
+		_, err := env.service.OpenAttachment(ctx, link)
+		assert.Equal(t, domain.ValidationError, webhookErrorType(err))
+	})
+
+	t.Run("TamperedLink", func(t *testing.T) {
+		link := linkFromURL(t, attachment.URL)
+		link.Expires += 3600
+		_, err := env.service.OpenAttachment(ctx, link)
+		assert.Equal(t, domain.NotFoundError, webhookErrorType(err))
+
+		link = linkFromURL(t, attachment.URL)
+		link.Owner = domain.CommentAttachments("comment-1")
+		_, err = env.service.OpenAttachment(ctx, link)
+		assert.Equal(t, domain.NotFoundError, webhookErrorType(err))
+	})
+
+	t.Run("ExpiredLink", func(t *testing.T) {
+		env.service.(*attachmentService).now = func() time.Time {
+			return time.Now().Add(AttachmentLinkTTL + time.Minute)
+		}
+		defer func() { env.service.(*attachmentService).now = time.Now }()
+
+		_, err := env.service.OpenAttachment(ctx, linkFromURL(t, attachment.URL))
+		assert.Equal(t, domain.NotFoundError, webhookErrorType(err))
+	})
+}
+
+func TestTaskService_DeleteTaskRemovesAttachments(t *testing.T) {
+	ctx := context.Background()
+	env := newAttachmentTestEnv(t)
+	taskService := NewTaskService(
+		env.tasks, env.projects, testutil.NewMockUserRepository(), testutil.NewMockTaskHistoryRepository(),
+		NewAuthorizationService(env.projects), nil, env.files,
+	)
+
+	_, err := env.service.AddTaskAttachment(ctx, "task-1", upload(t, "notes.txt", "hello"), "owner")
+	require.NoError(t, err)
+
+	require.NoError(t, taskService.DeleteTask(ctx, "task-1", "owner"))
+	assert.Equal(t, []string{"task-1"}, env.files.RemovedTaskFiles)
+	assert.Empty(t, env.files.Files)
+}
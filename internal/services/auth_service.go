@@ -8,6 +8,8 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"log/slog"
+	"sync"
 	"time"
 
 	"github.com/ericfisherdev/simple-easy-tasks/internal/config"
@@ -20,20 +22,29 @@ import (
 // AuthService defines the interface for authentication operations.
 // Following Interface Segregation Principle.
 type AuthService interface {
-	// Login authenticates a user and returns JWT tokens.
-	Login(ctx context.Context, req domain.LoginRequest) (*domain.TokenPair, error)
+	// Login authenticates a user and returns JWT tokens, or a challenge for a second factor
+	// when the user has two-factor authentication or it is required.
+	Login(ctx context.Context, req domain.LoginRequest) (*domain.LoginResult, error)
+
+	// CompleteMFALogin finishes a login with the challenge token and a TOTP or recovery code.
+	// For users who had to enroll, the code confirms enrollment and the recovery codes are returned.
+	CompleteMFALogin(ctx context.Context, mfaToken string, code string) (*domain.LoginResult, error)
+
+	// ValidateMFAChallenge returns the user a login challenge token was issued to
+	ValidateMFAChallenge(ctx context.Context, mfaToken string) (*domain.User, error)
 
 	// Register creates a new user account.
 	Register(ctx context.Context, req domain.CreateUserRequest) (*domain.User, error)
 
-	// RefreshToken generates new tokens using a refresh token.
+	// RefreshToken generates new tokens using a refresh token. Refresh tokens rotate: each can
+	// be used once, and using one again signs its session out.
 	RefreshToken(ctx context.Context, refreshToken string) (*domain.TokenPair, error)
 
 	// ValidateToken validates a JWT token and returns user claims.
 	ValidateToken(ctx context.Context, tokenString string) (*domain.User, error)
 
-	// Logout invalidates tokens (placeholder for future blacklist implementation).
-	Logout(ctx context.Context, userID string) error
+	// Logout invalidates an access token and signs its session out.
+	Logout(ctx context.Context, tokenString string) error
 
 	// ForgotPassword initiates the password reset flow.
 	ForgotPassword(ctx context.Context, email string) error
@@ -44,8 +55,28 @@ type AuthService interface {
 
 	// InvalidateAllUserTokens invalidates all tokens for a user
 	InvalidateAllUserTokens(ctx context.Context, userID string) error
+
+	// SetMFA makes logins ask for a second factor. Without it, passwords are enough.
+	SetMFA(mfa MFAService)
+
+	// SetSessions makes logins start sessions that can be listed and signed out one at a time,
+	// and makes refresh tokens rotate. Without it, tokens are only tied to the user.
+	SetSessions(sessions repository.SessionRepository)
 }
 
+// JWT audiences tell access, refresh and login challenge tokens apart
+const (
+	accessAudience  = "simple-easy-tasks-app"
+	refreshAudience = "simple-easy-tasks-refresh"
+	mfaAudience     = "simple-easy-tasks-mfa"
+)
+
+// mfaChallengeExpiration is how long users have to enter their second factor
+const mfaChallengeExpiration = 5 * time.Minute
+
+// maxMFAAttempts is how many wrong codes a login challenge takes before the user has to sign in again
+const maxMFAAttempts = 5
+
 // TokenClaims represents JWT token claims.
 type TokenClaims struct {
 	jwt.RegisteredClaims
@@ -54,6 +85,7 @@ type TokenClaims struct {
 	Username     string `json:"username"`
 	Role         string `json:"role"`
 	TokenVersion int    `json:"token_version"`
+	SessionID    string `json:"sid,omitempty"`
 }
 
 // authService implements AuthService interface.
@@ -61,8 +93,21 @@ type authService struct {
 	userRepo       repository.UserRepository
 	blacklistRepo  domain.TokenBlacklistRepository
 	resetTokenRepo domain.PasswordResetTokenRepository
+	outbox         EmailOutbox
+	mfa            MFAService
+	sessions       repository.SessionRepository
 	config         config.SecurityConfig
 	jwtSecret      []byte
+
+	// mfaFailures counts wrong codes per login challenge ID
+	mfaFailures   map[string]*mfaFailureCount
+	mfaFailuresMu sync.Mutex
+}
+
+// mfaFailureCount is how many wrong codes a login challenge has taken, kept until it expires
+type mfaFailureCount struct {
+	expiresAt time.Time
+	count     int
 }
 
 // NewAuthService creates a new authentication service.
@@ -71,18 +116,31 @@ func NewAuthService(
 	blacklistRepo domain.TokenBlacklistRepository,
 	resetTokenRepo domain.PasswordResetTokenRepository,
 	cfg config.SecurityConfig,
+	outbox EmailOutbox,
 ) AuthService {
 	return &authService{
 		userRepo:       userRepo,
 		blacklistRepo:  blacklistRepo,
 		resetTokenRepo: resetTokenRepo,
+		outbox:         outbox,
 		config:         cfg,
 		jwtSecret:      []byte(cfg.GetJWTSecret()),
+		mfaFailures:    make(map[string]*mfaFailureCount),
 	}
 }
 
-// Login authenticates a user and returns JWT tokens.
-func (s *authService) Login(ctx context.Context, req domain.LoginRequest) (*domain.TokenPair, error) {
+// SetMFA makes logins ask for a second factor.
+func (s *authService) SetMFA(mfa MFAService) {
+	s.mfa = mfa
+}
+
+// SetSessions makes logins start sessions and refresh tokens rotate.
+func (s *authService) SetSessions(sessions repository.SessionRepository) {
+	s.sessions = sessions
+}
+
+// Login authenticates a user and returns JWT tokens, or a challenge for a second factor.
+func (s *authService) Login(ctx context.Context, req domain.LoginRequest) (*domain.LoginResult, error) {
 	// Get user by email
 	user, err := s.userRepo.GetByEmail(ctx, req.Email)
 	if err != nil {
@@ -94,13 +152,146 @@ func (s *authService) Login(ctx context.Context, req domain.LoginRequest) (*doma
 		return nil, domain.NewAuthenticationError("INVALID_CREDENTIALS", "Invalid email or password")
 	}
 
+	// Ask for a second factor from users who have one, and users who must set one up
+	if s.mfa != nil {
+		enabled, err := s.mfa.IsEnabled(ctx, user.ID)
+		if err != nil {
+			return nil, domain.NewInternalError("MFA_CHECK_FAILED", "Failed to check two-factor authentication", err)
+		}
+		if enabled || s.config.GetRequireMFA() {
+			challenge, err := s.generateMFAChallenge(user, !enabled)
+			if err != nil {
+				return nil, domain.NewInternalError("TOKEN_GENERATION_FAILED", "Failed to generate login challenge", err)
+			}
+			return &domain.LoginResult{Challenge: challenge}, nil
+		}
+	}
+
 	// Generate tokens
-	tokenPair, err := s.generateTokenPair(user)
+	tokenPair, err := s.startSession(ctx, user)
 	if err != nil {
-		return nil, domain.NewInternalError("TOKEN_GENERATION_FAILED", "Failed to generate authentication tokens", err)
+		return nil, err
 	}
 
-	return tokenPair, nil
+	return &domain.LoginResult{Tokens: tokenPair}, nil
+}
+
+// CompleteMFALogin finishes a login with the challenge token and a TOTP or recovery code.
+func (s *authService) CompleteMFALogin(
+	ctx context.Context, mfaToken string, code string,
+) (*domain.LoginResult, error) {
+	user, claims, err := s.validateMFAChallenge(ctx, mfaToken)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, err := s.mfa.IsEnabled(ctx, user.ID)
+	if err != nil {
+		return nil, domain.NewInternalError("MFA_CHECK_FAILED", "Failed to check two-factor authentication", err)
+	}
+
+	result := &domain.LoginResult{}
+	if enabled {
+		err = s.mfa.Verify(ctx, user.ID, code)
+	} else {
+		// Users who had to enroll finish enrolling with their first code
+		result.RecoveryCodes, err = s.mfa.ConfirmEnrollment(ctx, user.ID, code)
+	}
+	if err != nil {
+		if isInvalidMFACode(err) && s.recordMFAFailure(claims) >= maxMFAAttempts {
+			// Guessing codes takes a password login for every few tries
+			s.endMFAChallenge(ctx, user, claims)
+			return nil, domain.NewAuthenticationError("MFA_ATTEMPTS_EXCEEDED",
+				"Too many incorrect codes; sign in again")
+		}
+		return nil, err
+	}
+
+	// Each challenge finishes one login
+	s.endMFAChallenge(ctx, user, claims)
+
+	result.Tokens, err = s.startSession(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// recordMFAFailure counts a wrong code for a login challenge and returns how many it has taken.
+func (s *authService) recordMFAFailure(claims *TokenClaims) int {
+	s.mfaFailuresMu.Lock()
+	defer s.mfaFailuresMu.Unlock()
+
+	now := time.Now()
+	for id, failures := range s.mfaFailures {
+		if now.After(failures.expiresAt) {
+			delete(s.mfaFailures, id)
+		}
+	}
+
+	failures, ok := s.mfaFailures[claims.ID]
+	if !ok {
+		failures = &mfaFailureCount{expiresAt: claims.ExpiresAt.Time}
+		s.mfaFailures[claims.ID] = failures
+	}
+	failures.count++
+	return failures.count
+}
+
+// endMFAChallenge stops a login challenge from being used again.
+func (s *authService) endMFAChallenge(ctx context.Context, user *domain.User, claims *TokenClaims) {
+	s.mfaFailuresMu.Lock()
+	delete(s.mfaFailures, claims.ID)
+	s.mfaFailuresMu.Unlock()
+
+	if err := s.blacklistRepo.BlacklistToken(ctx, &domain.BlacklistedToken{
+		TokenID:   claims.ID,
+		UserID:    user.ID,
+		ExpiresAt: claims.ExpiresAt.Time,
+		CreatedAt: time.Now(),
+	}); err != nil {
+		slog.Warn("Failed to blacklist login challenge", "user_id", user.ID, "error", err)
+	}
+}
+
+// ValidateMFAChallenge returns the user a login challenge token was issued to.
+func (s *authService) ValidateMFAChallenge(ctx context.Context, mfaToken string) (*domain.User, error) {
+	user, _, err := s.validateMFAChallenge(ctx, mfaToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove password hash from response
+	user.PasswordHash = ""
+
+	return user, nil
+}
+
+// validateMFAChallenge checks a login challenge token is current and loads its user.
+func (s *authService) validateMFAChallenge(
+	ctx context.Context, mfaToken string,
+) (*domain.User, *TokenClaims, error) {
+	invalid := domain.NewAuthenticationError("INVALID_MFA_TOKEN", "Invalid or expired login challenge")
+	if s.mfa == nil {
+		return nil, nil, invalid
+	}
+
+	claims, err := s.parseClaims(mfaToken)
+	if err != nil || !hasAudience(claims, mfaAudience) {
+		return nil, nil, invalid
+	}
+
+	if blacklisted, err := s.blacklistRepo.IsTokenBlacklisted(ctx, claims.ID); err == nil && blacklisted {
+		return nil, nil, invalid
+	}
+
+	user, err := s.userRepo.GetByID(ctx, claims.UserID)
+	if err != nil || claims.TokenVersion < user.TokenVersion {
+		return nil, nil, invalid
+	}
+
+	return user, claims, nil
 }
 
 // Register creates a new user account.
@@ -166,10 +357,12 @@ func (s *authService) Register(ctx context.Context, req domain.CreateUserRequest
 
 // RefreshToken generates new tokens using a refresh token.
 func (s *authService) RefreshToken(ctx context.Context, refreshToken string) (*domain.TokenPair, error) {
+	invalid := domain.NewAuthenticationError("INVALID_REFRESH_TOKEN", "Invalid or expired refresh token")
+
 	// Parse and validate refresh token
 	claims, err := s.parseToken(refreshToken)
-	if err != nil {
-		return nil, domain.NewAuthenticationError("INVALID_REFRESH_TOKEN", "Invalid or expired refresh token")
+	if err != nil || !hasAudience(claims, refreshAudience) {
+		return nil, invalid
 	}
 
 	// Get user to ensure they still exist
@@ -178,8 +371,46 @@ func (s *authService) RefreshToken(ctx context.Context, refreshToken string) (*d
 		return nil, domain.NewAuthenticationError("USER_NOT_FOUND", "User not found")
 	}
 
+	if claims.TokenVersion < user.TokenVersion {
+		return nil, invalid
+	}
+
+	// Without sessions there's nothing to rotate
+	if s.sessions == nil {
+		return s.startSession(ctx, user)
+	}
+
+	// Tokens from before sessions were tracked can't be rotated safely, so they sign in again
+	if claims.SessionID == "" {
+		return nil, invalid
+	}
+
+	session, err := s.sessions.GetByID(ctx, claims.SessionID)
+	if err != nil || session.UserID != user.ID || !session.IsActive() {
+		return nil, invalid
+	}
+
+	// Only the latest refresh token in a session works. An older one coming back means it was
+	// copied, and there's no telling whether the thief or the user has the latest, so both are
+	// signed out.
+	if session.RefreshTokenID != claims.ID {
+		return nil, s.revokeReusedSession(ctx, session)
+	}
+
+	session.RefreshTokenID = uuid.New().String()
+	session.ExpiresAt = time.Now().UTC().Add(s.config.GetRefreshTokenExpiration())
+	session.Seen(clientInfoFromContext(ctx))
+	rotated, err := s.sessions.Rotate(ctx, session, claims.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !rotated {
+		// Another refresh with the same token won the race, so it was used twice
+		return nil, s.revokeReusedSession(ctx, session)
+	}
+
 	// Generate new token pair
-	tokenPair, err := s.generateTokenPair(user)
+	tokenPair, err := s.generateTokenPair(user, session)
 	if err != nil {
 		return nil, domain.NewInternalError("TOKEN_GENERATION_FAILED", "Failed to generate new tokens", err)
 	}
@@ -189,9 +420,10 @@ func (s *authService) RefreshToken(ctx context.Context, refreshToken string) (*d
 
 // ValidateToken validates a JWT token and returns user claims.
 func (s *authService) ValidateToken(ctx context.Context, tokenString string) (*domain.User, error) {
-	// Parse token
+	// Parse token. Only access tokens authenticate requests; refresh tokens are only good for
+	// /auth/refresh, where rotation and reuse are checked.
 	claims, err := s.parseToken(tokenString)
-	if err != nil {
+	if err != nil || !hasAudience(claims, accessAudience) {
 		return nil, domain.NewAuthenticationError("INVALID_TOKEN", "Invalid or expired token")
 	}
 
@@ -214,6 +446,10 @@ func (s *authService) ValidateToken(ctx context.Context, tokenString string) (*d
 		return nil, domain.NewAuthenticationError("TOKEN_OUTDATED", "Token version is outdated")
 	}
 
+	if err := s.checkSession(ctx, claims); err != nil {
+		return nil, err
+	}
+
 	// Remove password hash from response
 	user.PasswordHash = ""
 
@@ -229,6 +465,16 @@ func (s *authService) Logout(ctx context.Context, tokenString string) error {
 		return nil
 	}
 
+	// Sign the session out too, so its refresh token stops working
+	if s.sessions != nil && claims.SessionID != "" {
+		if session, err := s.sessions.GetByID(ctx, claims.SessionID); err == nil && session.UserID == claims.UserID {
+			session.Revoke()
+			if err := s.sessions.Update(ctx, session); err != nil {
+				return domain.NewInternalError("SESSION_UPDATE_FAILED", "Failed to revoke session", err)
+			}
+		}
+	}
+
 	// Create blacklist entry
 	blacklistedToken := &domain.BlacklistedToken{
 		TokenID:   claims.ID,
@@ -262,9 +508,73 @@ func (s *authService) InvalidateAllUserTokens(ctx context.Context, userID string
 	return s.blacklistRepo.BlacklistAllUserTokens(ctx, userID, maxExpiry)
 }
 
-// generateTokenPair creates both access and refresh tokens.
-func (s *authService) generateTokenPair(user *domain.User) (*domain.TokenPair, error) {
+// revokeReusedSession signs out a session whose refresh token was used twice, and returns the error for it.
+func (s *authService) revokeReusedSession(ctx context.Context, session *domain.Session) error {
+	session.Revoke()
+	if err := s.sessions.Update(ctx, session); err != nil {
+		return domain.NewInternalError("SESSION_UPDATE_FAILED", "Failed to revoke session", err)
+	}
+	slog.Warn("Refresh token reused, session revoked", "user_id", session.UserID, "session_id", session.ID)
+	return domain.NewAuthenticationError("REFRESH_TOKEN_REUSED", "Refresh token has already been used; sign in again")
+}
+
+// startSession records a new sign-in and creates its first tokens.
+func (s *authService) startSession(ctx context.Context, user *domain.User) (*domain.TokenPair, error) {
+	var session *domain.Session
+	if s.sessions != nil {
+		session = &domain.Session{
+			UserID:         user.ID,
+			RefreshTokenID: uuid.New().String(),
+			TokenVersion:   user.TokenVersion,
+			ExpiresAt:      time.Now().UTC().Add(s.config.GetRefreshTokenExpiration()),
+		}
+		session.Seen(clientInfoFromContext(ctx))
+		if err := s.sessions.Create(ctx, session); err != nil {
+			return nil, domain.NewInternalError("SESSION_SAVE_FAILED", "Failed to start session", err)
+		}
+	}
+
+	tokenPair, err := s.generateTokenPair(user, session)
+	if err != nil {
+		return nil, domain.NewInternalError("TOKEN_GENERATION_FAILED", "Failed to generate authentication tokens", err)
+	}
+
+	return tokenPair, nil
+}
+
+// checkSession rejects tokens from sessions that were signed out, and records that the session was seen.
+func (s *authService) checkSession(ctx context.Context, claims *TokenClaims) error {
+	if s.sessions == nil || claims.SessionID == "" {
+		return nil
+	}
+
+	session, err := s.sessions.GetByID(ctx, claims.SessionID)
+	if err != nil || session.UserID != claims.UserID || session.RevokedAt != nil {
+		return domain.NewAuthenticationError("SESSION_REVOKED", "Session has been signed out")
+	}
+
+	if time.Since(session.LastSeenAt) >= lastUsedPrecision {
+		session.Seen(clientInfoFromContext(ctx))
+		if err := s.sessions.Update(ctx, session); err != nil {
+			// Failing to record activity shouldn't fail the request
+			slog.Warn("Failed to record session activity", "session_id", session.ID, "error", err)
+		}
+	}
+
+	return nil
+}
+
+// generateTokenPair creates both access and refresh tokens. Tokens for a session carry its ID,
+// and the refresh token gets the ID the session expects next.
+func (s *authService) generateTokenPair(user *domain.User, session *domain.Session) (*domain.TokenPair, error) {
 	now := time.Now()
+	sessionID := ""
+	refreshTokenID := uuid.New().String()
+	if session != nil {
+		sessionID = session.ID
+		refreshTokenID = session.RefreshTokenID
+	}
+
 	accessExpiry := now.Add(s.config.GetJWTExpiration())
 	refreshExpiry := now.Add(s.config.GetRefreshTokenExpiration())
 
@@ -275,13 +585,14 @@ func (s *authService) generateTokenPair(user *domain.User) (*domain.TokenPair, e
 		Username:     user.Username,
 		Role:         string(user.Role),
 		TokenVersion: user.TokenVersion,
+		SessionID:    sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   user.ID,
 			ExpiresAt: jwt.NewNumericDate(accessExpiry),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
 			Issuer:    "simple-easy-tasks",
-			Audience:  []string{"simple-easy-tasks-app"},
+			Audience:  []string{accessAudience},
 			ID:        uuid.New().String(),
 		},
 	}
@@ -293,14 +604,15 @@ func (s *authService) generateTokenPair(user *domain.User) (*domain.TokenPair, e
 		Username:     user.Username,
 		Role:         string(user.Role),
 		TokenVersion: user.TokenVersion,
+		SessionID:    sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   user.ID,
 			ExpiresAt: jwt.NewNumericDate(refreshExpiry),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
 			Issuer:    "simple-easy-tasks",
-			Audience:  []string{"simple-easy-tasks-refresh"},
-			ID:        uuid.New().String(),
+			Audience:  []string{refreshAudience},
+			ID:        refreshTokenID,
 		},
 	}
 
@@ -325,8 +637,65 @@ func (s *authService) generateTokenPair(user *domain.User) (*domain.TokenPair, e
 	}, nil
 }
 
-// parseToken parses and validates a JWT token.
+// generateMFAChallenge creates the short-lived token that finishes a login with a second factor.
+func (s *authService) generateMFAChallenge(user *domain.User, enrollmentRequired bool) (*domain.MFAChallenge, error) {
+	now := time.Now()
+	expiry := now.Add(mfaChallengeExpiration)
+
+	claims := &TokenClaims{
+		UserID:       user.ID,
+		Email:        user.Email,
+		Username:     user.Username,
+		Role:         string(user.Role),
+		TokenVersion: user.TokenVersion,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   user.ID,
+			ExpiresAt: jwt.NewNumericDate(expiry),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Issuer:    "simple-easy-tasks",
+			Audience:  []string{mfaAudience},
+			ID:        uuid.New().String(),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.jwtSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign login challenge: %w", err)
+	}
+
+	return &domain.MFAChallenge{
+		ExpiresAt:          expiry,
+		Token:              token,
+		EnrollmentRequired: enrollmentRequired,
+	}, nil
+}
+
+// parseToken parses and validates an access or refresh token. Login challenge tokens are
+// rejected, since they mustn't work until the second factor is checked.
 func (s *authService) parseToken(tokenString string) (*TokenClaims, error) {
+	claims, err := s.parseClaims(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if hasAudience(claims, mfaAudience) {
+		return nil, fmt.Errorf("login challenge tokens can't be used to authenticate")
+	}
+	return claims, nil
+}
+
+// hasAudience checks a token was issued for the audience
+func hasAudience(claims *TokenClaims, audience string) bool {
+	for _, aud := range claims.Audience {
+		if aud == audience {
+			return true
+		}
+	}
+	return false
+}
+
+// parseClaims parses and validates a JWT token.
+func (s *authService) parseClaims(tokenString string) (*TokenClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &TokenClaims{}, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
@@ -360,7 +729,7 @@ func (s *authService) ForgotPassword(ctx context.Context, email string) error {
 	}
 
 	// Generate secure random token
-	tokenValue, err := s.generateSecureToken()
+	tokenValue, err := generateSecureToken()
 	if err != nil {
 		return domain.NewInternalError("TOKEN_GENERATION_FAILED", "Failed to generate reset token", err)
 	}
@@ -394,9 +763,20 @@ func (s *authService) ForgotPassword(ctx context.Context, email string) error {
 		}
 	}()
 
-	// TODO: Send email with reset link containing the token
-	// For now, we'll log the token (in production, this should be sent via email)
-	fmt.Printf("Password reset token for user %s: %s\n", user.Email, tokenValue)
+	// Queue the reset link. The token is only ever written into the email itself.
+	name := user.Name
+	if name == "" {
+		name = user.Username
+	}
+	err = s.outbox.Enqueue(ctx, user.Email, EmailPasswordReset, map[string]interface{}{
+		"Name":      name,
+		"Token":     tokenValue,
+		"ExpiresIn": "1 hour",
+	})
+	if err != nil {
+		// Respond as if the email was sent so the endpoint doesn't reveal which addresses exist
+		slog.Error("Failed to queue password reset email", "user_id", user.ID, "error", err)
+	}
 
 	return nil
 }
@@ -462,7 +842,8 @@ func (s *authService) ResetPassword(ctx context.Context, tokenValue string, newP
 }
 
 // generateSecureToken generates a cryptographically secure random token.
-func (s *authService) generateSecureToken() (string, error) {
+// Password reset and invitation tokens are both made this way.
+func generateSecureToken() (string, error) {
 	bytes := make([]byte, 32)
 	if _, err := rand.Read(bytes); err != nil {
 		return "", err
@@ -470,8 +851,14 @@ func (s *authService) generateSecureToken() (string, error) {
 	return hex.EncodeToString(bytes), nil
 }
 
-// CleanupExpiredTokens removes expired password reset tokens.
+// CleanupExpiredTokens removes expired password reset tokens and sessions.
 // This method can be called periodically by a background job.
 func (s *authService) CleanupExpiredTokens(ctx context.Context) error {
-	return s.resetTokenRepo.CleanupExpiredTokens(ctx)
+	if err := s.resetTokenRepo.CleanupExpiredTokens(ctx); err != nil {
+		return err
+	}
+	if s.sessions != nil {
+		return s.sessions.DeleteExpired(ctx)
+	}
+	return nil
 }
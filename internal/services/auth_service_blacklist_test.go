@@ -16,6 +16,7 @@ type testConfig struct {
 	passwordResetSecret string
 	jwtExpiration       time.Duration
 	refreshExpiration   time.Duration
+	requireMFA          bool
 }
 
 func (t *testConfig) GetJWTSecret() string {
@@ -40,6 +41,10 @@ func (t *testConfig) GetPasswordResetSecret() string {
 	return t.passwordResetSecret
 }
 
+func (t *testConfig) GetRequireMFA() bool {
+	return t.requireMFA
+}
+
 // Mock implementation of TokenBlacklistRepository for testing
 type mockTokenBlacklistRepository struct {
 	blacklistedTokens map[string]*domain.BlacklistedToken
@@ -156,7 +161,8 @@ func TestAuthService_Logout(t *testing.T) {
 	// Generate 32-character JWT secret at runtime to avoid gitleaks false-positives
 	jwtSecret := strings.Repeat("test", 8) // "test" * 8 = 32 characters
 	cfg := &testConfig{jwtSecret: jwtSecret, jwtExpiration: time.Hour}
-	authService := NewAuthService(userRepo, blacklistRepo, newMockPasswordResetTokenRepository(), cfg).(*authService)
+	resetTokenRepo := newMockPasswordResetTokenRepository()
+	authService := NewAuthService(userRepo, blacklistRepo, resetTokenRepo, cfg, newTestEmailOutbox(t)).(*authService)
 
 	// Create a test user
 	user := &domain.User{
@@ -169,7 +175,7 @@ func TestAuthService_Logout(t *testing.T) {
 	userRepo.AddUser(user)
 
 	// Generate a token pair
-	tokenPair, err := authService.generateTokenPair(user)
+	tokenPair, err := authService.generateTokenPair(user, nil)
 	if err != nil {
 		t.Fatalf("Failed to generate token pair: %v", err)
 	}
@@ -202,7 +208,8 @@ func TestAuthService_ValidateToken_BlacklistedToken(t *testing.T) {
 	// Generate 32-character JWT secret at runtime to avoid gitleaks false-positives
 	jwtSecret := strings.Repeat("test", 8) // "test" * 8 = 32 characters
 	cfg := &testConfig{jwtSecret: jwtSecret, jwtExpiration: time.Hour}
-	authService := NewAuthService(userRepo, blacklistRepo, newMockPasswordResetTokenRepository(), cfg).(*authService)
+	resetTokenRepo := newMockPasswordResetTokenRepository()
+	authService := NewAuthService(userRepo, blacklistRepo, resetTokenRepo, cfg, newTestEmailOutbox(t)).(*authService)
 
 	// Create a test user
 	user := &domain.User{
@@ -215,7 +222,7 @@ func TestAuthService_ValidateToken_BlacklistedToken(t *testing.T) {
 	userRepo.AddUser(user)
 
 	// Generate a token pair
-	tokenPair, err := authService.generateTokenPair(user)
+	tokenPair, err := authService.generateTokenPair(user, nil)
 	if err != nil {
 		t.Fatalf("Failed to generate token pair: %v", err)
 	}
@@ -248,7 +255,8 @@ func TestAuthService_ValidateToken_OutdatedTokenVersion(t *testing.T) {
 	// Generate 32-character JWT secret at runtime to avoid gitleaks false-positives
 	jwtSecret := strings.Repeat("test", 8) // "test" * 8 = 32 characters
 	cfg := &testConfig{jwtSecret: jwtSecret, jwtExpiration: time.Hour}
-	authService := NewAuthService(userRepo, blacklistRepo, newMockPasswordResetTokenRepository(), cfg).(*authService)
+	resetTokenRepo := newMockPasswordResetTokenRepository()
+	authService := NewAuthService(userRepo, blacklistRepo, resetTokenRepo, cfg, newTestEmailOutbox(t)).(*authService)
 
 	// Create a test user
 	user := &domain.User{
@@ -261,7 +269,7 @@ func TestAuthService_ValidateToken_OutdatedTokenVersion(t *testing.T) {
 	userRepo.AddUser(user)
 
 	// Generate a token pair
-	tokenPair, err := authService.generateTokenPair(user)
+	tokenPair, err := authService.generateTokenPair(user, nil)
 	if err != nil {
 		t.Fatalf("Failed to generate token pair: %v", err)
 	}
@@ -291,7 +299,8 @@ func TestAuthService_InvalidateAllUserTokens(t *testing.T) {
 	// Generate 32-character JWT secret at runtime to avoid gitleaks false-positives
 	jwtSecret := strings.Repeat("test", 8) // "test" * 8 = 32 characters
 	cfg := &testConfig{jwtSecret: jwtSecret, jwtExpiration: time.Hour}
-	authService := NewAuthService(userRepo, blacklistRepo, newMockPasswordResetTokenRepository(), cfg).(*authService)
+	resetTokenRepo := newMockPasswordResetTokenRepository()
+	authService := NewAuthService(userRepo, blacklistRepo, resetTokenRepo, cfg, newTestEmailOutbox(t)).(*authService)
 
 	// Create a test user
 	user := &domain.User{
@@ -304,7 +313,7 @@ func TestAuthService_InvalidateAllUserTokens(t *testing.T) {
 	userRepo.AddUser(user)
 
 	// Generate a token pair
-	tokenPair, err := authService.generateTokenPair(user)
+	tokenPair, err := authService.generateTokenPair(user, nil)
 	if err != nil {
 		t.Fatalf("Failed to generate token pair: %v", err)
 	}
@@ -15,7 +15,7 @@ func TestAuthService_ForgotPassword(t *testing.T) {
 	blacklistRepo := newMockTokenBlacklistRepository()
 	resetTokenRepo := newMockPasswordResetTokenRepository()
 	cfg := &testConfig{jwtSecret: "test-secret-that-is-32-characters-long", jwtExpiration: time.Hour}
-	authService := NewAuthService(userRepo, blacklistRepo, resetTokenRepo, cfg).(*authService)
+	authService := NewAuthService(userRepo, blacklistRepo, resetTokenRepo, cfg, newTestEmailOutbox(t)).(*authService)
 
 	// Create a test user
 	user := &domain.User{
@@ -68,7 +68,7 @@ func TestAuthService_ForgotPassword_NonExistentEmail(t *testing.T) {
 	blacklistRepo := newMockTokenBlacklistRepository()
 	resetTokenRepo := newMockPasswordResetTokenRepository()
 	cfg := &testConfig{jwtSecret: "test-secret-that-is-32-characters-long", jwtExpiration: time.Hour}
-	authService := NewAuthService(userRepo, blacklistRepo, resetTokenRepo, cfg).(*authService)
+	authService := NewAuthService(userRepo, blacklistRepo, resetTokenRepo, cfg, newTestEmailOutbox(t)).(*authService)
 
 	// Test forgot password with non-existent email
 	err := authService.ForgotPassword(context.Background(), "nonexistent@example.com")
@@ -89,7 +89,7 @@ func TestAuthService_ResetPassword(t *testing.T) {
 	blacklistRepo := newMockTokenBlacklistRepository()
 	resetTokenRepo := newMockPasswordResetTokenRepository()
 	cfg := &testConfig{jwtSecret: "test-secret-that-is-32-characters-long", jwtExpiration: time.Hour}
-	authService := NewAuthService(userRepo, blacklistRepo, resetTokenRepo, cfg).(*authService)
+	authService := NewAuthService(userRepo, blacklistRepo, resetTokenRepo, cfg, newTestEmailOutbox(t)).(*authService)
 
 	// Create a test user
 	user := &domain.User{
@@ -166,7 +166,7 @@ func TestAuthService_ResetPassword_InvalidToken(t *testing.T) {
 	blacklistRepo := newMockTokenBlacklistRepository()
 	resetTokenRepo := newMockPasswordResetTokenRepository()
 	cfg := &testConfig{jwtSecret: "test-secret-that-is-32-characters-long", jwtExpiration: time.Hour}
-	authService := NewAuthService(userRepo, blacklistRepo, resetTokenRepo, cfg).(*authService)
+	authService := NewAuthService(userRepo, blacklistRepo, resetTokenRepo, cfg, newTestEmailOutbox(t)).(*authService)
 
 	// Test password reset with invalid token
 	err := authService.ResetPassword(context.Background(), "invalid-token", "newpassword")
@@ -193,7 +193,7 @@ func TestAuthService_ResetPassword_ExpiredToken(t *testing.T) {
 	blacklistRepo := newMockTokenBlacklistRepository()
 	resetTokenRepo := newMockPasswordResetTokenRepository()
 	cfg := &testConfig{jwtSecret: "test-secret-that-is-32-characters-long", jwtExpiration: time.Hour}
-	authService := NewAuthService(userRepo, blacklistRepo, resetTokenRepo, cfg).(*authService)
+	authService := NewAuthService(userRepo, blacklistRepo, resetTokenRepo, cfg, newTestEmailOutbox(t)).(*authService)
 
 	// Create a test user
 	user := &domain.User{
@@ -238,7 +238,7 @@ func TestAuthService_ResetPassword_UsedToken(t *testing.T) {
 	blacklistRepo := newMockTokenBlacklistRepository()
 	resetTokenRepo := newMockPasswordResetTokenRepository()
 	cfg := &testConfig{jwtSecret: "test-secret-that-is-32-characters-long", jwtExpiration: time.Hour}
-	authService := NewAuthService(userRepo, blacklistRepo, resetTokenRepo, cfg).(*authService)
+	authService := NewAuthService(userRepo, blacklistRepo, resetTokenRepo, cfg, newTestEmailOutbox(t)).(*authService)
 
 	// Create a test user
 	user := &domain.User{
@@ -283,7 +283,7 @@ func TestAuthService_CleanupExpiredTokens(t *testing.T) {
 	blacklistRepo := newMockTokenBlacklistRepository()
 	resetTokenRepo := newMockPasswordResetTokenRepository()
 	cfg := &testConfig{jwtSecret: "test-secret-that-is-32-characters-long", jwtExpiration: time.Hour}
-	authService := NewAuthService(userRepo, blacklistRepo, resetTokenRepo, cfg).(*authService)
+	authService := NewAuthService(userRepo, blacklistRepo, resetTokenRepo, cfg, newTestEmailOutbox(t)).(*authService)
 
 	// Create test tokens - some expired, some valid
 	expiredToken1 := &domain.PasswordResetToken{
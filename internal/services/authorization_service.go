@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
+)
+
+// permissionActions describes what each permission allows, for error messages
+var permissionActions = map[domain.Permission]string{
+	domain.PermissionViewProject:        "view this project",
+	domain.PermissionComment:            "comment on tasks",
+	domain.PermissionCreateTasks:        "create tasks",
+	domain.PermissionEditTasks:          "edit tasks",
+	domain.PermissionDeleteOwnTasks:     "delete tasks",
+	domain.PermissionDeleteTasks:        "delete tasks",
+	domain.PermissionManageMembers:      "manage members",
+	domain.PermissionManageIntegrations: "manage integrations",
+	domain.PermissionManageWIPLimits:    "change WIP limits",
+	domain.PermissionManageProject:      "change project settings",
+	domain.PermissionDeleteProject:      "delete this project",
+}
+
+// AuthorizationService decides what users may do in projects based on their project role
+type AuthorizationService interface {
+	// Authorize loads the project and checks the user holds the permission in it
+	Authorize(ctx context.Context, projectID, userID string, permission domain.Permission) (*domain.Project, error)
+
+	// Check checks the user holds the permission in a project that is already loaded
+	Check(ctx context.Context, project *domain.Project, userID string, permission domain.Permission) error
+
+	// CheckTaskDeletion checks the user may delete the task: any task with PermissionDeleteTasks,
+	// or one they reported with PermissionDeleteOwnTasks
+	CheckTaskDeletion(ctx context.Context, project *domain.Project, task *domain.Task, userID string) error
+
+	// SetMFAChecker enforces projects' two-factor authentication requirement
+	SetMFAChecker(mfa MFAChecker)
+}
+
+// MFAChecker reports whether users have two-factor authentication enabled
+type MFAChecker interface {
+	IsEnabled(ctx context.Context, userID string) (bool, error)
+}
+
+type authorizationService struct {
+	projectRepo repository.ProjectRepository
+	mfa         MFAChecker
+}
+
+// NewAuthorizationService creates a new authorization service
+func NewAuthorizationService(projectRepo repository.ProjectRepository) AuthorizationService {
+	return &authorizationService{projectRepo: projectRepo}
+}
+
+// Authorize loads the project and checks the user holds the permission in it
+func (s *authorizationService) Authorize(
+	ctx context.Context, projectID, userID string, permission domain.Permission,
+) (*domain.Project, error) {
+	project, err := s.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
+	}
+
+	if err := s.Check(ctx, project, userID, permission); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// SetMFAChecker enforces projects' two-factor authentication requirement. Without one,
+// the requirement is ignored.
+func (s *authorizationService) SetMFAChecker(mfa MFAChecker) {
+	s.mfa = mfa
+}
+
+// Check checks the user holds the permission in a project that is already loaded. Users without
+// any role are told they have no access, so private projects don't reveal more than that.
+func (s *authorizationService) Check(
+	ctx context.Context, project *domain.Project, userID string, permission domain.Permission,
+) error {
+	role, ok := project.RoleOf(userID)
+	if !ok {
+		return domain.NewAuthorizationError("ACCESS_DENIED", "You don't have access to this project")
+	}
+	if !role.Can(permission) {
+		return domain.NewAuthorizationError("ACCESS_DENIED",
+			"Your "+string(role)+" role doesn't allow you to "+permissionActions[permission])
+	}
+	return s.checkMFA(ctx, project, userID)
+}
+
+// CheckTaskDeletion checks the user may delete the task
+func (s *authorizationService) CheckTaskDeletion(
+	ctx context.Context, project *domain.Project, task *domain.Task, userID string,
+) error {
+	if task.ReporterID == userID && project.Can(userID, domain.PermissionDeleteOwnTasks) {
+		return s.checkMFA(ctx, project, userID)
+	}
+	return s.Check(ctx, project, userID, domain.PermissionDeleteTasks)
+}
+
+// checkMFA checks the user has two-factor authentication enabled if the project requires it
+func (s *authorizationService) checkMFA(ctx context.Context, project *domain.Project, userID string) error {
+	if !project.Settings.RequireMFA || s.mfa == nil {
+		return nil
+	}
+
+	enabled, err := s.mfa.IsEnabled(ctx, userID)
+	if err != nil {
+		return domain.NewInternalError("MFA_CHECK_FAILED", "Failed to check two-factor authentication", err)
+	}
+	if !enabled {
+		return domain.NewAuthorizationError("MFA_REQUIRED",
+			"This project requires two-factor authentication; enable it to continue")
+	}
+	return nil
+}
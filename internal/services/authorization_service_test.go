@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+func TestAuthorizationService_ProjectRoles(t *testing.T) {
+	ctx := context.Background()
+	taskRepo := testutil.NewMockTaskRepository()
+	projectRepo := testutil.NewMockProjectRepository()
+	userRepo := testutil.NewMockUserRepository()
+	authz := NewAuthorizationService(projectRepo)
+	tasks := NewTaskService(taskRepo, projectRepo, userRepo, testutil.NewMockTaskHistoryRepository(), authz, nil, nil)
+	projects := NewProjectService(projectRepo, userRepo, authz)
+
+	for _, id := range []string{"owner", "viewer", "commenter", "member", "maintainer", "outsider"} {
+		userRepo.AddUser(testutil.MockUser(id, id+"@example.com", id, id))
+	}
+
+	project := testutil.MockProject("project-1", "Project", "project", "owner")
+	project.Settings.IsPrivate = true
+	project.MemberIDs = []string{"viewer", "commenter", "member", "maintainer"}
+	project.MemberRoles = map[string]domain.ProjectRole{
+		"viewer":     domain.ProjectViewer,
+		"commenter":  domain.ProjectCommenter,
+		"maintainer": domain.ProjectMaintainer,
+	}
+	projectRepo.AddProject(project)
+
+	createTask := func(t *testing.T, userID string) (*domain.Task, error) {
+		t.Helper()
+		return tasks.CreateTask(ctx, domain.CreateTaskRequest{
+			Title: "Task by " + userID, ProjectID: project.ID, Priority: domain.PriorityMedium,
+		}, userID)
+	}
+
+	t.Run("Authorize", func(t *testing.T) {
+		loaded, err := authz.Authorize(ctx, project.ID, "commenter", domain.PermissionComment)
+		require.NoError(t, err)
+		assert.Equal(t, project.ID, loaded.ID)
+
+		_, err = authz.Authorize(ctx, project.ID, "viewer", domain.PermissionComment)
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+
+		_, err = authz.Authorize(ctx, "missing", "owner", domain.PermissionViewProject)
+		assert.Equal(t, domain.NotFoundError, webhookErrorType(err))
+	})
+
+	t.Run("CreatingTasksTakesMember", func(t *testing.T) {
+		for _, userID := range []string{"viewer", "commenter", "outsider"} {
+			_, err := createTask(t, userID)
+			assert.Equal(t, domain.AuthorizationError, webhookErrorType(err), userID)
+		}
+		for _, userID := range []string{"member", "maintainer", "owner"} {
+			_, err := createTask(t, userID)
+			assert.NoError(t, err, userID)
+		}
+	})
+
+	t.Run("ViewersCanRead", func(t *testing.T) {
+		task, err := createTask(t, "owner")
+		require.NoError(t, err)
+
+		_, err = tasks.GetTask(ctx, task.ID, "viewer")
+		assert.NoError(t, err)
+
+		_, err = tasks.UpdateTask(ctx, task.ID, domain.UpdateTaskRequest{Title: stringPtr("Renamed")}, "viewer")
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+
+		_, err = tasks.GetTask(ctx, task.ID, "outsider")
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+	})
+
+	t.Run("MembersDeleteOnlyTheirOwnTasks", func(t *testing.T) {
+		own, err := createTask(t, "member")
+		require.NoError(t, err)
+		other, err := createTask(t, "owner")
+		require.NoError(t, err)
+
+		assert.NoError(t, tasks.DeleteTask(ctx, own.ID, "member"))
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(tasks.DeleteTask(ctx, other.ID, "member")))
+		assert.NoError(t, tasks.DeleteTask(ctx, other.ID, "maintainer"))
+	})
+
+	t.Run("MaintainersManageMembers", func(t *testing.T) {
+		err := projects.AddMember(ctx, project.ID, "outsider", domain.ProjectCommenter, "member")
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+
+		require.NoError(t, projects.AddMember(ctx, project.ID, "outsider", domain.ProjectCommenter, "maintainer"))
+		role, _ := project.RoleOf("outsider")
+		assert.Equal(t, domain.ProjectCommenter, role)
+
+		updated, err := projects.SetMemberRole(ctx, project.ID, "outsider", domain.ProjectMember, "maintainer")
+		require.NoError(t, err)
+		role, _ = updated.RoleOf("outsider")
+		assert.Equal(t, domain.ProjectMember, role)
+
+		_, err = projects.SetMemberRole(ctx, project.ID, "outsider", domain.ProjectOwner, "owner")
+		assert.Equal(t, domain.ValidationError, webhookErrorType(err))
+
+		_, err = projects.SetMemberRole(ctx, project.ID, "owner", domain.ProjectViewer, "maintainer")
+		assert.Equal(t, domain.ValidationError, webhookErrorType(err))
+
+		// Anyone may leave, but removing others takes managing members
+		err = projects.RemoveMember(ctx, project.ID, "outsider", "commenter")
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+		require.NoError(t, projects.RemoveMember(ctx, project.ID, "outsider", "outsider"))
+		_, ok := project.RoleOf("outsider")
+		assert.False(t, ok)
+	})
+
+	t.Run("OnlyTheOwnerDeletesTheProject", func(t *testing.T) {
+		err := projects.DeleteProject(ctx, project.ID, "maintainer")
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+	})
+}
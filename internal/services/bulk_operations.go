@@ -5,6 +5,7 @@ import (
 	"encoding/csv"
 	"fmt"
 	"io"
+	"log/slog"
 	"strconv"
 	"strings"
 	"time"
@@ -13,33 +14,48 @@ import (
 	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
 )
 
-// BulkOperationService defines the interface for bulk task operations
+// MaxBulkItems caps how many tasks a single bulk call may touch
+const MaxBulkItems = 500
+
+// exportPageSize is how many tasks ExportToCSV loads per query while streaming
+const exportPageSize = 200
+
+// BulkOperationService defines the interface for bulk task operations.
+// Every call is scoped to one project and publishes a single aggregated
+// TasksBulkUpdated event rather than one event per task.
 type BulkOperationService interface {
-	// BulkUpdate performs multiple update operations in a single transaction
-	BulkUpdate(ctx context.Context, ops []BulkTaskOperation, userID string) (*BulkResult, error)
+	// BulkUpdate performs multiple update operations on tasks of a project
+	BulkUpdate(ctx context.Context, projectID string, ops []BulkTaskOperation, userID string) (*BulkResult, error)
 
-	// BulkCreate creates multiple tasks from a list or CSV data
+	// BulkCreate creates multiple tasks from a list or template
 	BulkCreate(ctx context.Context, req BulkCreateRequest, userID string) (*BulkResult, error)
 
 	// BulkStatusUpdate updates the status of multiple tasks
 	BulkStatusUpdate(
-		ctx context.Context, taskIDs []string, newStatus domain.TaskStatus, userID string,
+		ctx context.Context, projectID string, taskIDs []string, newStatus domain.TaskStatus, userID string,
 	) (*BulkResult, error)
 
-	// BulkAssign assigns multiple tasks to a user
-	BulkAssign(ctx context.Context, taskIDs []string, assigneeID string, userID string) (*BulkResult, error)
+	// BulkAssign assigns multiple tasks to a user, or unassigns them when assigneeID is empty
+	BulkAssign(
+		ctx context.Context, projectID string, taskIDs []string, assigneeID string, userID string,
+	) (*BulkResult, error)
 
 	// BulkTagUpdate adds or removes tags from multiple tasks
-	BulkTagUpdate(ctx context.Context, req BulkTagUpdateRequest, userID string) (*BulkResult, error)
+	BulkTagUpdate(ctx context.Context, projectID string, req BulkTagUpdateRequest, userID string) (*BulkResult, error)
 
 	// BulkDelete deletes multiple tasks with cascade handling
-	BulkDelete(ctx context.Context, taskIDs []string, options BulkDeleteOptions, userID string) (*BulkResult, error)
+	BulkDelete(
+		ctx context.Context, projectID string, taskIDs []string, options BulkDeleteOptions, userID string,
+	) (*BulkResult, error)
 
-	// ImportFromCSV creates tasks from CSV data
+	// ImportFromCSV creates tasks from CSV data; each data row gets its own result or error
 	ImportFromCSV(ctx context.Context, csvData io.Reader, projectID string, userID string) (*BulkResult, error)
 
-	// ExportToCSV exports tasks to CSV format
-	ExportToCSV(ctx context.Context, projectID string, filters repository.TaskFilters, userID string) ([]byte, error)
+	// ExportToCSV streams the project's tasks as CSV to w, one page at a time.
+	// Access errors are returned before anything is written.
+	ExportToCSV(
+		ctx context.Context, w io.Writer, projectID string, filters repository.TaskFilters, userID string,
+	) error
 }
 
 // BulkTaskOperation represents a single operation in a bulk update
@@ -87,96 +103,94 @@ type BulkResult struct {
 	Results        []interface{}          `json:"results,omitempty"` // Successful operation results
 	Duration       time.Duration          `json:"duration"`
 	Summary        map[string]interface{} `json:"summary,omitempty"`
+
+	affected []string // IDs of tasks touched by successful items, for the aggregated event
 }
 
 // BulkOperationError represents an error in a bulk operation
 type BulkOperationError struct {
 	TaskID    string `json:"task_id,omitempty"`
-	Index     int    `json:"index"`
+	Index     int    `json:"index"` // Position in the request, or data row for CSV imports
 	Operation string `json:"operation"`
 	Error     string `json:"error"`
 }
 
+// addError records a failed item
+func (r *BulkResult) addError(index int, taskID, operation string, err error) {
+	r.Errors = append(r.Errors, BulkOperationError{
+		TaskID:    taskID,
+		Index:     index,
+		Operation: operation,
+		Error:     err.Error(),
+	})
+	r.Failed++
+}
+
+// addSuccess records a successful item and the task it touched
+func (r *BulkResult) addSuccess(taskID string, item interface{}) {
+	r.Results = append(r.Results, item)
+	r.affected = append(r.affected, taskID)
+	r.Successful++
+}
+
 // bulkOperationService implements bulk operations
 type bulkOperationService struct {
-	taskRepo    repository.TaskRepository
-	projectRepo repository.ProjectRepository
-	taskService TaskService
+	taskRepo         repository.TaskRepository
+	projectRepo      repository.ProjectRepository
+	taskService      TaskService
+	eventBroadcaster EventBroadcaster
 }
 
-// NewBulkOperationService creates a new bulk operation service
+// NewBulkOperationService creates a new bulk operation service.
+// taskService should be the plain service rather than a RealtimeTaskService, otherwise
+// every item is broadcast on top of the aggregated event. eventBroadcaster may be nil.
 func NewBulkOperationService(
 	taskRepo repository.TaskRepository,
 	projectRepo repository.ProjectRepository,
 	taskService TaskService,
+	eventBroadcaster EventBroadcaster,
 ) BulkOperationService {
 	return &bulkOperationService{
-		taskRepo:    taskRepo,
-		projectRepo: projectRepo,
-		taskService: taskService,
+		taskRepo:         taskRepo,
+		projectRepo:      projectRepo,
+		taskService:      taskService,
+		eventBroadcaster: eventBroadcaster,
 	}
 }
 
-// BulkUpdate performs multiple update operations in a single transaction
+// BulkUpdate performs multiple update operations on tasks of a project.
+// Errors are reported per task, indexed by the operation they belong to.
 func (b *bulkOperationService) BulkUpdate(
-	ctx context.Context, ops []BulkTaskOperation, userID string,
+	ctx context.Context, projectID string, ops []BulkTaskOperation, userID string,
 ) (*BulkResult, error) {
 	startTime := time.Now()
-	result := &BulkResult{
-		TotalRequested: len(ops),
-		Results:        make([]interface{}, 0),
-		Errors:         make([]BulkOperationError, 0),
+
+	total := 0
+	for _, op := range ops {
+		total += len(op.TaskIDs)
+	}
+	project, err := b.checkBulkRequest(ctx, projectID, total, userID)
+	if err != nil {
+		return nil, err
 	}
 
+	result := newBulkResult(total)
 	for i, op := range ops {
-		switch op.Operation {
-		case "update":
-			if err := b.processBulkTaskUpdate(ctx, op, userID, i, result); err != nil {
-				result.Errors = append(result.Errors, BulkOperationError{
-					Index:     i,
-					Operation: op.Operation,
-					Error:     err.Error(),
-				})
-				result.Failed++
-			} else {
-				result.Successful++
-			}
-
-		case "status":
-			if err := b.processBulkStatusUpdate(ctx, op, userID, i, result); err != nil {
-				result.Errors = append(result.Errors, BulkOperationError{
-					Index:     i,
-					Operation: op.Operation,
-					Error:     err.Error(),
-				})
-				result.Failed++
-			} else {
-				result.Successful++
-			}
-
-		case "assign":
-			if err := b.processBulkAssign(ctx, op, userID, i, result); err != nil {
-				result.Errors = append(result.Errors, BulkOperationError{
-					Index:     i,
-					Operation: op.Operation,
-					Error:     err.Error(),
-				})
-				result.Failed++
-			} else {
-				result.Successful++
+		apply, err := b.operationFunc(ctx, op, project.Workflow(), userID)
+		if err != nil {
+			for _, taskID := range op.TaskIDs {
+				result.addError(i, taskID, op.Operation, err)
 			}
+			continue
+		}
 
-		default:
-			result.Errors = append(result.Errors, BulkOperationError{
-				Index:     i,
-				Operation: op.Operation,
-				Error:     fmt.Sprintf("Unknown operation: %s", op.Operation),
-			})
-			result.Failed++
+		for _, taskID := range op.TaskIDs {
+			b.applyToTask(ctx, projectID, taskID, i, op.Operation, result, apply)
 		}
 	}
 
 	result.Duration = time.Since(startTime)
+	b.publishBulkEvent(ctx, projectID, "update", userID, result)
 	return result, nil
 }
 
@@ -186,20 +200,6 @@ func (b *bulkOperationService) BulkCreate(
 ) (*BulkResult, error) {
 	startTime := time.Now()
 
-	if req.ProjectID == "" {
-		return nil, domain.NewValidationError("INVALID_PROJECT_ID", "Project ID cannot be empty", nil)
-	}
-
-	// Validate project access
-	project, err := b.projectRepo.GetByID(ctx, req.ProjectID)
-	if err != nil {
-		return nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
-	}
-
-	if !project.HasAccess(userID) {
-		return nil, domain.NewAuthorizationError("ACCESS_DENIED", "You don't have access to this project")
-	}
-
 	var tasksToCreate []domain.CreateTaskRequest
 
 	// Generate tasks from template if provided
@@ -209,175 +209,113 @@ func (b *bulkOperationService) BulkCreate(
 		tasksToCreate = req.Tasks
 	}
 
-	result := &BulkResult{
-		TotalRequested: len(tasksToCreate),
-		Results:        make([]interface{}, 0),
-		Errors:         make([]BulkOperationError, 0),
+	if _, err := b.checkBulkRequest(ctx, req.ProjectID, len(tasksToCreate), userID); err != nil {
+		return nil, err
 	}
 
+	result := newBulkResult(len(tasksToCreate))
+
 	// Create tasks
 	for i, taskReq := range tasksToCreate {
 		taskReq.ProjectID = req.ProjectID // Ensure project ID is set
 
 		task, err := b.taskService.CreateTask(ctx, taskReq, userID)
 		if err != nil {
-			result.Errors = append(result.Errors, BulkOperationError{
-				Index:     i,
-				Operation: "create",
-				Error:     err.Error(),
-			})
-			result.Failed++
-		} else {
-			result.Results = append(result.Results, task)
-			result.Successful++
+			result.addError(i, "", "create", err)
+			continue
 		}
+		result.addSuccess(task.ID, task)
 	}
 
 	result.Duration = time.Since(startTime)
+	b.publishBulkEvent(ctx, req.ProjectID, "create", userID, result)
 	return result, nil
 }
 
 // BulkStatusUpdate updates the status of multiple tasks
 func (b *bulkOperationService) BulkStatusUpdate(
-	ctx context.Context, taskIDs []string, newStatus domain.TaskStatus, userID string,
+	ctx context.Context, projectID string, taskIDs []string, newStatus domain.TaskStatus, userID string,
 ) (*BulkResult, error) {
 	startTime := time.Now()
 
 	if !newStatus.IsValid() {
 		return nil, domain.NewValidationError("INVALID_STATUS", "Invalid task status", nil)
 	}
-
-	result := &BulkResult{
-		TotalRequested: len(taskIDs),
-		Results:        make([]interface{}, 0),
-		Errors:         make([]BulkOperationError, 0),
+	project, err := b.checkBulkRequest(ctx, projectID, len(taskIDs), userID)
+	if err != nil {
+		return nil, err
+	}
+	workflow := project.Workflow()
+	if err := workflow.ValidateStatus(newStatus); err != nil {
+		return nil, err
 	}
 
+	result := newBulkResult(len(taskIDs))
+	apply := b.statusFunc(ctx, workflow, newStatus, userID)
 	for i, taskID := range taskIDs {
-		task, err := b.taskService.UpdateTaskStatus(ctx, taskID, newStatus, userID)
-		if err != nil {
-			result.Errors = append(result.Errors, BulkOperationError{
-				TaskID:    taskID,
-				Index:     i,
-				Operation: "status_update",
-				Error:     err.Error(),
-			})
-			result.Failed++
-		} else {
-			result.Results = append(result.Results, task)
-			result.Successful++
-		}
+		b.applyToTask(ctx, projectID, taskID, i, "status_update", result, apply)
 	}
 
 	result.Duration = time.Since(startTime)
+	b.publishBulkEvent(ctx, projectID, "status_update", userID, result)
 	return result, nil
 }
 
 // BulkAssign assigns multiple tasks to a user
 func (b *bulkOperationService) BulkAssign(
-	ctx context.Context, taskIDs []string, assigneeID string, userID string,
+	ctx context.Context, projectID string, taskIDs []string, assigneeID string, userID string,
 ) (*BulkResult, error) {
 	startTime := time.Now()
 
-	result := &BulkResult{
-		TotalRequested: len(taskIDs),
-		Results:        make([]interface{}, 0),
-		Errors:         make([]BulkOperationError, 0),
+	if _, err := b.checkBulkRequest(ctx, projectID, len(taskIDs), userID); err != nil {
+		return nil, err
 	}
 
+	result := newBulkResult(len(taskIDs))
+	apply := b.assignFunc(ctx, assigneeID, userID)
 	for i, taskID := range taskIDs {
-		var task *domain.Task
-		var err error
-
-		if assigneeID == "" {
-			// Unassign task
-			task, err = b.taskService.UnassignTask(ctx, taskID, userID)
-		} else {
-			// Assign task
-			task, err = b.taskService.AssignTask(ctx, taskID, assigneeID, userID)
-		}
-
-		if err != nil {
-			result.Errors = append(result.Errors, BulkOperationError{
-				TaskID:    taskID,
-				Index:     i,
-				Operation: "assign",
-				Error:     err.Error(),
-			})
-			result.Failed++
-		} else {
-			result.Results = append(result.Results, task)
-			result.Successful++
-		}
+		b.applyToTask(ctx, projectID, taskID, i, "assign", result, apply)
 	}
 
 	result.Duration = time.Since(startTime)
+	b.publishBulkEvent(ctx, projectID, "assign", userID, result)
 	return result, nil
 }
 
 // BulkTagUpdate adds or removes tags from multiple tasks
 func (b *bulkOperationService) BulkTagUpdate(
-	ctx context.Context, req BulkTagUpdateRequest, userID string,
+	ctx context.Context, projectID string, req BulkTagUpdateRequest, userID string,
 ) (*BulkResult, error) {
 	startTime := time.Now()
 
-	result := &BulkResult{
-		TotalRequested: len(req.TaskIDs),
-		Results:        make([]interface{}, 0),
-		Errors:         make([]BulkOperationError, 0),
+	if _, err := b.checkBulkRequest(ctx, projectID, len(req.TaskIDs), userID); err != nil {
+		return nil, err
 	}
 
-	for i, taskID := range req.TaskIDs {
-		// Get current task
-		task, err := b.taskService.GetTask(ctx, taskID, userID)
-		if err != nil {
-			result.Errors = append(result.Errors, BulkOperationError{
-				TaskID:    taskID,
-				Index:     i,
-				Operation: "tag_update",
-				Error:     err.Error(),
-			})
-			result.Failed++
-			continue
-		}
-
-		// Update tags
-		newTags := b.calculateNewTags(task.Tags, req)
-
-		// Update task with new tags
+	result := newBulkResult(len(req.TaskIDs))
+	apply := func(task *domain.Task) (interface{}, error) {
 		updateReq := domain.UpdateTaskRequest{
-			Tags: newTags,
-		}
-
-		updatedTask, err := b.taskService.UpdateTask(ctx, taskID, updateReq, userID)
-		if err != nil {
-			result.Errors = append(result.Errors, BulkOperationError{
-				TaskID:    taskID,
-				Index:     i,
-				Operation: "tag_update",
-				Error:     err.Error(),
-			})
-			result.Failed++
-		} else {
-			result.Results = append(result.Results, updatedTask)
-			result.Successful++
+			Tags: b.calculateNewTags(task.Tags, req),
 		}
+		return b.taskService.UpdateTask(ctx, task.ID, updateReq, userID)
+	}
+	for i, taskID := range req.TaskIDs {
+		b.applyToTask(ctx, projectID, taskID, i, "tag_update", result, apply)
 	}
 
 	result.Duration = time.Since(startTime)
+	b.publishBulkEvent(ctx, projectID, "tag_update", userID, result)
 	return result, nil
 }
 
 // BulkDelete deletes multiple tasks with cascade handling
 func (b *bulkOperationService) BulkDelete(
-	ctx context.Context, taskIDs []string, options BulkDeleteOptions, userID string,
+	ctx context.Context, projectID string, taskIDs []string, options BulkDeleteOptions, userID string,
 ) (*BulkResult, error) {
 	startTime := time.Now()
 
-	result := &BulkResult{
-		TotalRequested: len(taskIDs),
-		Results:        make([]interface{}, 0),
-		Errors:         make([]BulkOperationError, 0),
+	if _, err := b.checkBulkRequest(ctx, projectID, len(taskIDs), userID); err != nil {
+		return nil, err
 	}
 
 	// If including subtasks, expand the list of tasks to delete
@@ -388,26 +326,21 @@ func (b *bulkOperationService) BulkDelete(
 			return nil, domain.NewInternalError("SUBTASK_EXPANSION_FAILED", "Failed to expand subtasks", err)
 		}
 		allTaskIDs = expandedIDs
-		result.TotalRequested = len(allTaskIDs)
 	}
 
-	for i, taskID := range allTaskIDs {
-		err := b.taskService.DeleteTask(ctx, taskID, userID)
-		if err != nil {
-			result.Errors = append(result.Errors, BulkOperationError{
-				TaskID:    taskID,
-				Index:     i,
-				Operation: "delete",
-				Error:     err.Error(),
-			})
-			result.Failed++
-		} else {
-			result.Results = append(result.Results, map[string]string{"deleted": taskID})
-			result.Successful++
+	result := newBulkResult(len(allTaskIDs))
+	apply := func(task *domain.Task) (interface{}, error) {
+		if err := b.taskService.DeleteTask(ctx, task.ID, userID); err != nil {
+			return nil, err
 		}
+		return map[string]string{"deleted": task.ID}, nil
+	}
+	for i, taskID := range allTaskIDs {
+		b.applyToTask(ctx, projectID, taskID, i, "delete", result, apply)
 	}
 
 	result.Duration = time.Since(startTime)
+	b.publishBulkEvent(ctx, projectID, "delete", userID, result)
 	return result, nil
 }
 
@@ -418,6 +351,7 @@ func (b *bulkOperationService) ImportFromCSV(
 	startTime := time.Now()
 
 	reader := csv.NewReader(csvData)
+	reader.FieldsPerRecord = -1
 
 	// Read header
 	header, err := reader.Read()
@@ -427,8 +361,11 @@ func (b *bulkOperationService) ImportFromCSV(
 
 	// Map header columns
 	columnMap := b.mapCSVColumns(header)
+	if _, ok := columnMap["title"]; !ok {
+		return nil, domain.NewValidationError("CSV_MISSING_TITLE", "CSV must have a title column", nil)
+	}
 
-	var tasks []domain.CreateTaskRequest
+	var records [][]string
 
 	// Read data rows
 	for {
@@ -437,46 +374,55 @@ func (b *bulkOperationService) ImportFromCSV(
 			break
 		}
 		if readErr != nil {
-			return nil, domain.NewValidationError("CSV_READ_ERROR", "Failed to read CSV data", nil)
+			return nil, domain.NewValidationError("CSV_READ_ERROR", "Failed to read CSV data", map[string]interface{}{
+				"row": len(records) + 1,
+			})
 		}
+		records = append(records, record)
+	}
 
-		task, parseErr := b.parseCSVRecord(record, columnMap, projectID)
+	if _, err := b.checkBulkRequest(ctx, projectID, len(records), userID); err != nil {
+		return nil, err
+	}
+
+	// Rows are numbered from 1, not counting the header
+	result := newBulkResult(len(records))
+	for i, record := range records {
+		row := i + 1
+
+		taskReq, parseErr := b.parseCSVRecord(record, columnMap, projectID)
 		if parseErr != nil {
-			// Skip invalid rows but continue processing
+			result.addError(row, "", "import", parseErr)
 			continue
 		}
 
-		tasks = append(tasks, task)
-	}
-
-	// Create tasks using bulk create
-	req := BulkCreateRequest{
-		ProjectID: projectID,
-		Tasks:     tasks,
-	}
-
-	result, err := b.BulkCreate(ctx, req, userID)
-	if err != nil {
-		return nil, err
+		task, createErr := b.taskService.CreateTask(ctx, taskReq, userID)
+		if createErr != nil {
+			result.addError(row, "", "import", createErr)
+			continue
+		}
+		result.addSuccess(task.ID, task)
 	}
 
 	result.Duration = time.Since(startTime)
+	b.publishBulkEvent(ctx, projectID, "import", userID, result)
 	return result, nil
 }
 
-// ExportToCSV exports tasks to CSV format
+// ExportToCSV streams the project's tasks to w in CSV format
 func (b *bulkOperationService) ExportToCSV(
-	ctx context.Context, projectID string, filters repository.TaskFilters, userID string,
-) ([]byte, error) {
-	// Get tasks to export
+	ctx context.Context, w io.Writer, projectID string, filters repository.TaskFilters, userID string,
+) error {
+	filters.Limit = exportPageSize
+	filters.Offset = 0
+
+	// Load the first page before writing so access errors can still become a proper response
 	tasks, err := b.taskService.GetProjectTasksFiltered(ctx, projectID, filters, userID)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	// Create CSV content
-	var csvContent strings.Builder
-	writer := csv.NewWriter(&csvContent)
+	writer := csv.NewWriter(w)
 
 	// Write header
 	header := []string{
@@ -484,35 +430,140 @@ func (b *bulkOperationService) ExportToCSV(
 		"Assignee", "Reporter", "Due Date", "Created", "Updated",
 	}
 	if err := writer.Write(header); err != nil {
-		return nil, domain.NewInternalError("CSV_WRITE_ERROR", "Failed to write CSV header", err)
-	}
-
-	// Write task data
-	for _, task := range tasks {
-		record := []string{
-			task.ID,
-			task.Title,
-			task.Description,
-			string(task.Status),
-			string(task.Priority),
-			b.getStringValue(task.AssigneeID),
-			task.ReporterID,
-			b.formatTime(task.DueDate),
-			task.CreatedAt.Format(time.RFC3339),
-			task.UpdatedAt.Format(time.RFC3339),
+		return domain.NewInternalError("CSV_WRITE_ERROR", "Failed to write CSV header", err)
+	}
+
+	for {
+		// Write task data
+		for _, task := range tasks {
+			record := []string{
+				task.ID,
+				task.Title,
+				task.Description,
+				string(task.Status),
+				string(task.Priority),
+				b.getStringValue(task.AssigneeID),
+				task.ReporterID,
+				b.formatTime(task.DueDate),
+				task.CreatedAt.Format(time.RFC3339),
+				task.UpdatedAt.Format(time.RFC3339),
+			}
+
+			if err := writer.Write(record); err != nil {
+				return domain.NewInternalError("CSV_WRITE_ERROR", "Failed to write CSV record", err)
+			}
 		}
 
-		if err := writer.Write(record); err != nil {
-			return nil, domain.NewInternalError("CSV_WRITE_ERROR", "Failed to write CSV record", err)
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return domain.NewInternalError("CSV_FLUSH_ERROR", "Failed to flush CSV writer", err)
 		}
+		if f, ok := w.(interface{ Flush() }); ok {
+			f.Flush()
+		}
+
+		if len(tasks) < exportPageSize {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		filters.Offset += exportPageSize
+		tasks, err = b.taskService.GetProjectTasksFiltered(ctx, projectID, filters, userID)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// newBulkResult creates an empty result for the given number of items
+func newBulkResult(total int) *BulkResult {
+	return &BulkResult{
+		TotalRequested: total,
+		Results:        make([]interface{}, 0),
+		Errors:         make([]BulkOperationError, 0),
 	}
+}
 
-	writer.Flush()
-	if err := writer.Error(); err != nil {
-		return nil, domain.NewInternalError("CSV_FLUSH_ERROR", "Failed to flush CSV writer", err)
+// checkBulkRequest validates the batch size and that the user can work in the project,
+// returning the project
+func (b *bulkOperationService) checkBulkRequest(
+	ctx context.Context, projectID string, count int, userID string,
+) (*domain.Project, error) {
+	if projectID == "" {
+		return nil, domain.NewValidationError("INVALID_PROJECT_ID", "Project ID cannot be empty", nil)
 	}
 
-	return []byte(csvContent.String()), nil
+	if count == 0 {
+		return nil, domain.NewValidationError("EMPTY_BULK_REQUEST", "No tasks given for bulk operation", nil)
+	}
+
+	if count > MaxBulkItems {
+		return nil, domain.NewValidationError("BULK_REQUEST_TOO_LARGE",
+			fmt.Sprintf("Bulk operations are limited to %d tasks", MaxBulkItems), map[string]interface{}{
+				"requested": count,
+				"max":       MaxBulkItems,
+			})
+	}
+
+	// Validate project access
+	project, err := b.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
+	}
+
+	if !project.HasAccess(userID) {
+		return nil, domain.NewAuthorizationError("ACCESS_DENIED", "You don't have access to this project")
+	}
+
+	return project, nil
+}
+
+// applyToTask loads a task of the project and applies fn to it, recording the outcome
+func (b *bulkOperationService) applyToTask(
+	ctx context.Context, projectID, taskID string, index int, operation string, result *BulkResult,
+	fn func(task *domain.Task) (interface{}, error),
+) {
+	task, err := b.taskRepo.GetByID(ctx, taskID)
+	if err != nil || task.ProjectID != projectID {
+		// Tasks of other projects are reported the same as missing ones
+		result.addError(index, taskID, operation, domain.NewNotFoundError("TASK_NOT_FOUND", "Task not found in project"))
+		return
+	}
+
+	item, err := fn(task)
+	if err != nil {
+		result.addError(index, taskID, operation, err)
+		return
+	}
+	result.addSuccess(taskID, item)
+}
+
+// publishBulkEvent broadcasts one event summarising a bulk operation
+func (b *bulkOperationService) publishBulkEvent(
+	ctx context.Context, projectID, operation, userID string, result *BulkResult,
+) {
+	if b.eventBroadcaster == nil || result.Successful == 0 {
+		return
+	}
+
+	event, err := domain.NewTaskEvent(domain.TasksBulkUpdated, "", projectID, userID, &domain.TasksBulkUpdatedData{
+		Operation:  operation,
+		TaskIDs:    result.affected,
+		Successful: result.Successful,
+		Failed:     result.Failed,
+	})
+	if err == nil {
+		err = b.eventBroadcaster.BroadcastEvent(ctx, event)
+	}
+	if err != nil {
+		// Don't fail the operation if event broadcasting fails
+		slog.Error("Failed to broadcast bulk operation event",
+			"project_id", projectID,
+			"operation", operation,
+			"error", err)
+	}
 }
 
 // Helper methods
@@ -556,52 +607,85 @@ func (b *bulkOperationService) generateTasksFromTemplate(
 
 // Process individual bulk operations
 
-func (b *bulkOperationService) processBulkTaskUpdate(
-	ctx context.Context, op BulkTaskOperation, userID string, _ int, result *BulkResult,
-) error {
-	for _, taskID := range op.TaskIDs {
-		updateReq := domain.UpdateTaskRequest{}
-
-		// Map data fields to update request
-		if title, ok := op.Data["title"].(string); ok {
-			updateReq.Title = &title
-		}
-		if desc, ok := op.Data["description"].(string); ok {
-			updateReq.Description = &desc
+// operationFunc returns the per-task function for a BulkUpdate operation
+func (b *bulkOperationService) operationFunc(
+	ctx context.Context, op BulkTaskOperation, workflow *domain.Workflow, userID string,
+) (func(task *domain.Task) (interface{}, error), error) {
+	switch op.Operation {
+	case "update":
+		return b.updateFunc(ctx, op, userID), nil
+
+	case "status":
+		status, ok := op.Data["status"].(string)
+		if !ok || !domain.TaskStatus(status).IsValid() {
+			return nil, domain.NewValidationError("INVALID_STATUS", "A valid status is required for status operation", nil)
 		}
-		if priority, ok := op.Data["priority"].(string); ok {
-			p := domain.TaskPriority(priority)
-			updateReq.Priority = &p
+		if err := workflow.ValidateStatus(domain.TaskStatus(status)); err != nil {
+			return nil, err
 		}
+		return b.statusFunc(ctx, workflow, domain.TaskStatus(status), userID), nil
 
-		task, err := b.taskService.UpdateTask(ctx, taskID, updateReq, userID)
-		if err != nil {
-			return err
-		}
+	case "assign":
+		assigneeID, _ := op.Data["assignee_id"].(string) // Empty string for unassign
+		return b.assignFunc(ctx, assigneeID, userID), nil
 
-		result.Results = append(result.Results, task)
+	default:
+		return nil, domain.NewValidationError("UNKNOWN_OPERATION",
+			fmt.Sprintf("Unknown operation: %s", op.Operation), nil)
 	}
-	return nil
 }
 
-func (b *bulkOperationService) processBulkStatusUpdate(
-	ctx context.Context, op BulkTaskOperation, userID string, _ int, _ *BulkResult,
-) error {
-	status, ok := op.Data["status"].(string)
-	if !ok {
-		return fmt.Errorf("status field is required for status operation")
+func (b *bulkOperationService) updateFunc(
+	ctx context.Context, op BulkTaskOperation, userID string,
+) func(task *domain.Task) (interface{}, error) {
+	updateReq := domain.UpdateTaskRequest{}
+
+	// Map data fields to update request
+	if title, ok := op.Data["title"].(string); ok {
+		updateReq.Title = &title
+	}
+	if desc, ok := op.Data["description"].(string); ok {
+		updateReq.Description = &desc
+	}
+	if priority, ok := op.Data["priority"].(string); ok {
+		p := domain.TaskPriority(priority)
+		updateReq.Priority = &p
 	}
 
-	_, err := b.BulkStatusUpdate(ctx, op.TaskIDs, domain.TaskStatus(status), userID)
-	return err
+	return func(task *domain.Task) (interface{}, error) {
+		return b.taskService.UpdateTask(ctx, task.ID, updateReq, userID)
+	}
 }
 
-func (b *bulkOperationService) processBulkAssign(
-	ctx context.Context, op BulkTaskOperation, userID string, _ int, _ *BulkResult,
-) error {
-	assigneeID, _ := op.Data["assignee_id"].(string) // Empty string for unassign
-	_, err := b.BulkAssign(ctx, op.TaskIDs, assigneeID, userID)
-	return err
+func (b *bulkOperationService) statusFunc(
+	ctx context.Context, workflow *domain.Workflow, status domain.TaskStatus, userID string,
+) func(task *domain.Task) (interface{}, error) {
+	return func(task *domain.Task) (interface{}, error) {
+		wasComplete := workflow.IsDone(task.Status)
+		updated, err := b.taskService.UpdateTaskStatus(ctx, task.ID, status, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Dependents learn they're unblocked individually; the bulk event only lists the changed tasks
+		if !wasComplete && workflow.IsDone(updated.Status) && b.eventBroadcaster != nil {
+			if err := publishTasksUnblocked(ctx, b.taskService, b.eventBroadcaster, updated, userID); err != nil {
+				slog.Error("Failed to broadcast task unblocked events", "task_id", updated.ID, "error", err)
+			}
+		}
+		return updated, nil
+	}
+}
+
+func (b *bulkOperationService) assignFunc(
+	ctx context.Context, assigneeID string, userID string,
+) func(task *domain.Task) (interface{}, error) {
+	return func(task *domain.Task) (interface{}, error) {
+		if assigneeID == "" {
+			return b.taskService.UnassignTask(ctx, task.ID, userID)
+		}
+		return b.taskService.AssignTask(ctx, task.ID, assigneeID, userID)
+	}
 }
 
 // calculateNewTags determines the new tag list based on the update request
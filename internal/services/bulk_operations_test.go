@@ -0,0 +1,178 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+func TestBulkOperationService(t *testing.T) {
+	ctx := context.Background()
+
+	taskRepo := testutil.NewMockTaskRepository()
+	projectRepo := testutil.NewMockProjectRepository()
+	userRepo := testutil.NewMockUserRepository()
+	wipManager := NewWIPManager(taskRepo, projectRepo, testutil.NewMockWIPLimitRepository())
+	taskService := NewTaskService(
+		taskRepo, projectRepo, userRepo, testutil.NewMockTaskHistoryRepository(),
+		NewAuthorizationService(projectRepo), wipManager, nil,
+	)
+
+	// Record every broadcast event
+	broadcaster := NewEventBroadcaster(nil, EventBroadcasterConfig{}).(*eventBroadcaster)
+	var events []*domain.TaskEvent
+	broadcaster.AddEventHandler(func(event *domain.TaskEvent, _ *domain.EventSubscription) error {
+		events = append(events, event)
+		return nil
+	})
+	require.NoError(t, broadcaster.Subscribe(ctx, domain.NewEventSubscription("watcher", nil, []domain.TaskEventType{
+		domain.TaskCreated, domain.TaskUpdated, domain.TaskMoved, domain.TaskAssigned, domain.TaskDeleted,
+		domain.TasksBulkUpdated,
+	})))
+
+	service := NewBulkOperationService(taskRepo, projectRepo, taskService, broadcaster)
+
+	owner := testutil.MockUser("owner", "owner@test.com", "owner", "Owner")
+	userRepo.AddUser(owner)
+	project := testutil.MockProject("bulk-proj", "Bulk Project", "bulk", owner.ID)
+	projectRepo.AddProject(project)
+	otherProject := testutil.MockProject("other-proj", "Other Project", "other", owner.ID)
+	projectRepo.AddProject(otherProject)
+
+	taskRepo.AddTask(testutil.MockTask("task-1", "Task 1", project.ID, owner.ID))
+	taskRepo.AddTask(testutil.MockTask("task-2", "Task 2", project.ID, owner.ID))
+	taskRepo.AddTask(testutil.MockTask("foreign", "Foreign", otherProject.ID, owner.ID))
+
+	t.Run("BulkStatusUpdate_PerItemBreakdown", func(t *testing.T) {
+		events = nil
+
+		result, err := service.BulkStatusUpdate(ctx, project.ID,
+			[]string{"task-1", "task-2", "foreign", "missing"}, domain.StatusDeveloping, owner.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, 4, result.TotalRequested)
+		assert.Equal(t, 2, result.Successful)
+		assert.Equal(t, 2, result.Failed)
+		require.Len(t, result.Errors, 2)
+		assert.Equal(t, "foreign", result.Errors[0].TaskID)
+		assert.Equal(t, 2, result.Errors[0].Index)
+		assert.Equal(t, "missing", result.Errors[1].TaskID)
+
+		foreign, err := taskRepo.GetByID(ctx, "foreign")
+		require.NoError(t, err)
+		assert.Equal(t, domain.StatusTodo, foreign.Status, "tasks of other projects must not be touched")
+	})
+
+	t.Run("BulkStatusUpdate_OneAggregatedEvent", func(t *testing.T) {
+		require.Len(t, events, 1)
+		assert.Equal(t, domain.TasksBulkUpdated, events[0].Type)
+		assert.Equal(t, project.ID, events[0].ProjectID)
+
+		var data domain.TasksBulkUpdatedData
+		require.NoError(t, events[0].GetDataAs(&data))
+		assert.Equal(t, "status_update", data.Operation)
+		assert.ElementsMatch(t, []string{"task-1", "task-2"}, data.TaskIDs)
+		assert.Equal(t, 2, data.Failed)
+	})
+
+	t.Run("BulkUpdate_ReportsUnknownOperation", func(t *testing.T) {
+		events = nil
+
+		result, err := service.BulkUpdate(ctx, project.ID, []BulkTaskOperation{
+			{Operation: "update", TaskIDs: []string{"task-1"}, Data: map[string]interface{}{"title": "Renamed"}},
+			{Operation: "explode", TaskIDs: []string{"task-2"}},
+		}, owner.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, result.Successful)
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, 1, result.Errors[0].Index)
+		assert.Equal(t, "task-2", result.Errors[0].TaskID)
+		assert.Len(t, events, 1)
+	})
+
+	t.Run("BulkTagUpdate_AddsTags", func(t *testing.T) {
+		result, err := service.BulkTagUpdate(ctx, project.ID, BulkTagUpdateRequest{
+			TaskIDs:   []string{"task-1", "task-2"},
+			TagsToAdd: []string{"release"},
+		}, owner.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Successful)
+
+		task, err := taskRepo.GetByID(ctx, "task-2")
+		require.NoError(t, err)
+		assert.Contains(t, task.Tags, "release")
+	})
+
+	t.Run("Rejects_EmptyAndOversizedBatches", func(t *testing.T) {
+		_, err := service.BulkAssign(ctx, project.ID, nil, owner.ID, owner.ID)
+		assert.Error(t, err)
+
+		tooMany := make([]string, MaxBulkItems+1)
+		_, err = service.BulkDelete(ctx, project.ID, tooMany, BulkDeleteOptions{}, owner.ID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "BULK_REQUEST_TOO_LARGE")
+	})
+
+	t.Run("Rejects_UserWithoutAccess", func(t *testing.T) {
+		otherProject.Settings.IsPrivate = true
+		defer func() { otherProject.Settings.IsPrivate = false }()
+
+		_, err := service.BulkStatusUpdate(ctx, otherProject.ID, []string{"foreign"}, domain.StatusDeveloping, "outsider")
+		assert.Error(t, err)
+	})
+
+	t.Run("ImportFromCSV_ReportsBadRows", func(t *testing.T) {
+		events = nil
+		data := "title,description,priority\n" +
+			"Imported one,First,high\n" +
+			",Missing title,low\n" +
+			"Imported two,Second,medium\n"
+
+		result, err := service.ImportFromCSV(ctx, strings.NewReader(data), project.ID, owner.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, result.TotalRequested)
+		assert.Equal(t, 2, result.Successful)
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, 2, result.Errors[0].Index, "errors are indexed by data row")
+
+		require.Len(t, events, 1)
+		assert.Equal(t, domain.TasksBulkUpdated, events[0].Type)
+	})
+
+	t.Run("ImportFromCSV_RequiresTitleColumn", func(t *testing.T) {
+		_, err := service.ImportFromCSV(ctx, strings.NewReader("name\nfoo\n"), project.ID, owner.ID)
+		assert.Error(t, err)
+	})
+
+	t.Run("ExportToCSV_StreamsProjectTasks", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := service.ExportToCSV(ctx, &buf, project.ID, repository.TaskFilters{}, owner.ID)
+		require.NoError(t, err)
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.NotEmpty(t, records)
+		assert.Equal(t, "ID", records[0][0])
+		for _, record := range records[1:] {
+			assert.NotEqual(t, "foreign", record[0])
+		}
+	})
+
+	t.Run("ExportToCSV_WritesNothingOnAccessError", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := service.ExportToCSV(ctx, &buf, "missing-project", repository.TaskFilters{}, owner.ID)
+		assert.Error(t, err)
+		assert.Zero(t, buf.Len())
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
@@ -86,6 +87,17 @@ type CacheConfig struct {
 	Partitioning bool          `json:"partitioning"`
 }
 
+// DefaultCacheConfig returns cache TTLs suited to interactive board usage
+func DefaultCacheConfig() CacheConfig {
+	return CacheConfig{
+		TaskTTL:      10 * time.Minute,
+		BoardTTL:     5 * time.Minute,
+		StatsTTL:     5 * time.Minute,
+		QueryTTL:     2 * time.Minute,
+		UserTasksTTL: 5 * time.Minute,
+	}
+}
+
 // cacheManager implements sophisticated caching strategies
 type cacheManager struct {
 	backend CacheBackend
@@ -95,9 +107,9 @@ type cacheManager struct {
 
 // cacheStats tracks cache performance internally
 type cacheStats struct {
-	hits      int64
-	misses    int64
-	evictions int64
+	hits      atomic.Int64
+	misses    atomic.Int64
+	evictions atomic.Int64
 }
 
 // Cache key constants and patterns
@@ -158,7 +170,7 @@ func (cm *cacheManager) GetCachedTask(ctx context.Context, taskID string) (*doma
 	key := cm.buildTaskKey(taskID)
 	data, err := cm.backend.Get(ctx, key)
 	if err != nil {
-		cm.stats.misses++
+		cm.stats.misses.Add(1)
 		return nil, nil // Cache miss, not an error
 	}
 
@@ -166,11 +178,11 @@ func (cm *cacheManager) GetCachedTask(ctx context.Context, taskID string) (*doma
 	if err := json.Unmarshal(data, &task); err != nil {
 		// Invalid cached data, delete it
 		_ = cm.backend.Delete(ctx, key) // Ignore error when cleaning up invalid cache
-		cm.stats.misses++
+		cm.stats.misses.Add(1)
 		return nil, nil
 	}
 
-	cm.stats.hits++
+	cm.stats.hits.Add(1)
 	return &task, nil
 }
 
@@ -258,17 +270,17 @@ func (cm *cacheManager) GetCachedQueryResult(ctx context.Context, key string, de
 	cacheKey := cm.buildQueryKey(key)
 	data, err := cm.backend.Get(ctx, cacheKey)
 	if err != nil {
-		cm.stats.misses++
+		cm.stats.misses.Add(1)
 		return nil // Cache miss
 	}
 
 	if err := json.Unmarshal(data, dest); err != nil {
 		_ = cm.backend.Delete(ctx, cacheKey) // Ignore error when cleaning up invalid cache
-		cm.stats.misses++
+		cm.stats.misses.Add(1)
 		return nil
 	}
 
-	cm.stats.hits++
+	cm.stats.hits.Add(1)
 	return nil
 }
 
@@ -344,18 +356,18 @@ func (cm *cacheManager) GetCachedUserTasks(ctx context.Context, userID string) (
 	key := cm.buildUserTasksKey(userID)
 	data, err := cm.backend.Get(ctx, key)
 	if err != nil {
-		cm.stats.misses++
+		cm.stats.misses.Add(1)
 		return nil, nil
 	}
 
 	var tasks []*domain.Task
 	if err := json.Unmarshal(data, &tasks); err != nil {
 		_ = cm.backend.Delete(ctx, key) // Ignore error when cleaning up invalid cache
-		cm.stats.misses++
+		cm.stats.misses.Add(1)
 		return nil, nil
 	}
 
-	cm.stats.hits++
+	cm.stats.hits.Add(1)
 	return tasks, nil
 }
 
@@ -380,19 +392,21 @@ func (cm *cacheManager) GetCacheStats(ctx context.Context) (*CacheStats, error)
 		return nil, domain.NewInternalError("CACHE_STATS_ERROR", "Failed to get backend stats", err)
 	}
 
-	totalRequests := cm.stats.hits + cm.stats.misses
+	hits := cm.stats.hits.Load()
+	misses := cm.stats.misses.Load()
+	totalRequests := hits + misses
 	hitRatio := 0.0
 	if totalRequests > 0 {
-		hitRatio = float64(cm.stats.hits) / float64(totalRequests)
+		hitRatio = float64(hits) / float64(totalRequests)
 	}
 
 	return &CacheStats{
-		Hits:      cm.stats.hits,
-		Misses:    cm.stats.misses,
+		Hits:      hits,
+		Misses:    misses,
 		HitRatio:  hitRatio,
 		Keys:      backendStats.Keys,
 		Memory:    backendStats.Memory,
-		Evictions: cm.stats.evictions,
+		Evictions: cm.stats.evictions.Load(),
 	}, nil
 }
 
@@ -501,17 +515,17 @@ func (cm *cacheManager) BuildProjectQueryKey(projectID string, operation string,
 func (cm *cacheManager) getCachedData(ctx context.Context, key string, dest interface{}) error {
 	data, err := cm.backend.Get(ctx, key)
 	if err != nil {
-		cm.stats.misses++
+		cm.stats.misses.Add(1)
 		return err
 	}
 
 	if err := json.Unmarshal(data, dest); err != nil {
 		_ = cm.backend.Delete(ctx, key) // Ignore error when cleaning up invalid cache
-		cm.stats.misses++
+		cm.stats.misses.Add(1)
 		return err
 	}
 
-	cm.stats.hits++
+	cm.stats.hits.Add(1)
 	return nil
 }
 
@@ -2,11 +2,20 @@ package services
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
 
 	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
 	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
 )
 
+const (
+	// DefaultCommentLimit is the page size used when a comment listing does not set one
+	DefaultCommentLimit = 20
+	// MaxCommentLimit caps the page size of a single comment listing
+	MaxCommentLimit = 100
+)
+
 // CommentService defines the interface for comment-related business logic.
 type CommentService interface {
 	// CreateComment creates a new comment on a task
@@ -27,29 +36,77 @@ type CommentService interface {
 	DeleteComment(ctx context.Context, commentID string, userID string) error
 
 	// ListTaskComments lists comments for a task
-	ListTaskComments(ctx context.Context, taskID string, userID string, offset, limit int) ([]*domain.Comment, error)
+	ListTaskComments(
+		ctx context.Context, taskID string, userID string, req ListCommentsRequest,
+	) ([]*domain.Comment, error)
 
 	// GetCommentThread gets a comment thread (comment and its replies)
 	GetCommentThread(ctx context.Context, commentID string, userID string) ([]*domain.Comment, error)
+
+	// ListMentions lists the comments that @mention the user, newest first
+	ListMentions(ctx context.Context, userID string, offset, limit int) (*MentionPage, error)
+
+	// AddReaction adds the user's emoji reaction to a comment
+	AddReaction(ctx context.Context, commentID, emoji, userID string) (*domain.Comment, error)
+
+	// RemoveReaction removes the user's emoji reaction from a comment
+	RemoveReaction(ctx context.Context, commentID, emoji, userID string) (*domain.Comment, error)
+
+	// ResolveThread marks the thread a root comment starts resolved
+	ResolveThread(ctx context.Context, commentID string, userID string) (*domain.Comment, error)
+
+	// ReopenThread marks a resolved thread open again
+	ReopenThread(ctx context.Context, commentID string, userID string) (*domain.Comment, error)
+}
+
+// ListCommentsRequest selects a page of a task's comments
+type ListCommentsRequest struct {
+	Offset       int
+	Limit        int
+	HideResolved bool // leave out resolved root comments and their replies
+}
+
+// MentionPage is a page of the comments that mention a user
+type MentionPage struct {
+	Comments []*domain.Comment `json:"comments"`
+	Total    int               `json:"total"` // every mention of the user, including ones no longer visible
 }
 
 // commentService implements CommentService interface.
 type commentService struct {
-	commentRepo repository.CommentRepository
-	taskRepo    repository.TaskRepository
-	userRepo    repository.UserRepository
+	commentRepo      repository.CommentRepository
+	mentionRepo      repository.CommentMentionRepository
+	reactionRepo     repository.CommentReactionRepository
+	taskRepo         repository.TaskRepository
+	projectRepo      repository.ProjectRepository
+	userRepo         repository.UserRepository
+	authz            AuthorizationService
+	eventBroadcaster EventBroadcaster
 }
 
 // NewCommentService creates a new comment service.
+// New comments are broadcast as task.commented events, and each user they @mention gets a
+// task.mentioned event, when an event broadcaster is given. Reactions and thread resolution
+// are broadcast too.
 func NewCommentService(
 	commentRepo repository.CommentRepository,
+	mentionRepo repository.CommentMentionRepository,
+	reactionRepo repository.CommentReactionRepository,
 	taskRepo repository.TaskRepository,
+	projectRepo repository.ProjectRepository,
 	userRepo repository.UserRepository,
+	authz AuthorizationService,
+	eventBroadcaster EventBroadcaster,
 ) CommentService {
 	return &commentService{
-		commentRepo: commentRepo,
-		taskRepo:    taskRepo,
-		userRepo:    userRepo,
+		commentRepo:      commentRepo,
+		mentionRepo:      mentionRepo,
+		reactionRepo:     reactionRepo,
+		taskRepo:         taskRepo,
+		projectRepo:      projectRepo,
+		userRepo:         userRepo,
+		authz:            authz,
+		eventBroadcaster: eventBroadcaster,
 	}
 }
 
@@ -65,15 +122,11 @@ func (s *commentService) CreateComment(
 	}
 
 	// Check if task exists and user has access
-	task, err := s.taskRepo.GetByID(ctx, req.TaskID)
+	task, project, err := s.viewableTask(ctx, req.TaskID, userID, domain.PermissionComment)
 	if err != nil {
-		return nil, domain.NewNotFoundError("TASK_NOT_FOUND", "Task not found")
+		return nil, err
 	}
 
-	// For now, assume we have access if task exists
-	// In a full implementation, we'd check project access here
-	_ = task
-
 	// If this is a reply, check if parent comment exists
 	if req.ParentID != "" {
 		parentComment, err := s.commentRepo.GetByID(ctx, req.ParentID)
@@ -87,6 +140,11 @@ func (s *commentService) CreateComment(
 		}
 	}
 
+	mentions, err := s.resolveMentions(ctx, project, task, req.Content)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create comment
 	comment := &domain.Comment{
 		TaskID:   req.TaskID,
@@ -104,29 +162,211 @@ func (s *commentService) CreateComment(
 		return nil, domain.NewInternalError("COMMENT_CREATE_FAILED", "Failed to create comment", err)
 	}
 
+	if err := s.mentionRepo.ReplaceForComment(ctx, comment.ID, mentions); err != nil {
+		return nil, domain.NewInternalError("COMMENT_MENTIONS_FAILED", "Failed to save comment mentions", err)
+	}
+	comment.Mentions = mentions
+
+	// Commenting on a task makes the author follow it
+	if task.AddWatcher(userID) {
+		if err := s.taskRepo.Update(ctx, task); err != nil {
+			slog.Error("Failed to add comment author as task watcher",
+				"task_id", task.ID,
+				"user_id", userID,
+				"error", err)
+		}
+	}
+
+	if err := s.broadcastCommented(ctx, task, comment); err != nil {
+		slog.Error("Failed to broadcast task comment event",
+			"task_id", task.ID,
+			"comment_id", comment.ID,
+			"error", err)
+		// Don't fail the operation if event broadcasting fails
+	}
+	s.broadcastMentioned(ctx, task, comment, comment.MentionedUserIDs())
+
 	return comment, nil
 }
 
-// GetComment gets a comment by ID.
-func (s *commentService) GetComment(ctx context.Context, commentID string, _ string) (*domain.Comment, error) {
+// viewableTask loads a task and its project, checking the user holds the permission in the project
+func (s *commentService) viewableTask(
+	ctx context.Context, taskID string, userID string, permission domain.Permission,
+) (*domain.Task, *domain.Project, error) {
+	task, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, nil, domain.NewNotFoundError("TASK_NOT_FOUND", "Task not found")
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, task.ProjectID)
+	if err != nil {
+		return nil, nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
+	}
+
+	if err := s.authz.Check(ctx, project, userID, permission); err != nil {
+		return nil, nil, err
+	}
+
+	return task, project, nil
+}
+
+// getComment loads a comment, reporting a missing one as not found
+func (s *commentService) getComment(ctx context.Context, commentID string) (*domain.Comment, error) {
 	if commentID == "" {
 		return nil, domain.NewValidationError("INVALID_COMMENT_ID", "Comment ID cannot be empty", nil)
 	}
 
 	comment, err := s.commentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		return nil, domain.NewNotFoundError("COMMENT_NOT_FOUND", "Comment not found")
+	}
+
+	return comment, nil
+}
+
+// visibleComment loads a comment with its task and project, checking the user holds the permission there
+func (s *commentService) visibleComment(
+	ctx context.Context, commentID string, userID string, permission domain.Permission,
+) (*domain.Comment, *domain.Task, *domain.Project, error) {
+	comment, err := s.getComment(ctx, commentID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	task, project, err := s.viewableTask(ctx, comment.TaskID, userID, permission)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return comment, task, project, nil
+}
+
+// resolveMentions looks up the users @mentioned in content. Usernames that match nobody are
+// left as plain text, but mentioning someone without access to the project is rejected.
+func (s *commentService) resolveMentions(
+	ctx context.Context, project *domain.Project, task *domain.Task, content string,
+) ([]*domain.CommentMention, error) {
+	usernames := domain.ParseMentions(content)
+	mentions := make([]*domain.CommentMention, 0, len(usernames))
+
+	seen := make(map[string]bool, len(usernames))
+	for _, username := range usernames {
+		user, err := s.userRepo.GetByUsername(ctx, username)
+		if err != nil || seen[user.ID] {
+			continue
+		}
+		if !project.HasAccess(user.ID) {
+			return nil, domain.NewValidationError("MENTION_NO_ACCESS",
+				fmt.Sprintf("@%s does not have access to this task", username),
+				map[string]interface{}{"username": username})
+		}
+		seen[user.ID] = true
+		mentions = append(mentions, &domain.CommentMention{TaskID: task.ID, UserID: user.ID, Username: username})
+	}
+
+	return mentions, nil
+}
+
+// attachDetails loads the mentions and reactions of the comments onto them
+func (s *commentService) attachDetails(ctx context.Context, comments ...*domain.Comment) error {
+	commentIDs := make([]string, len(comments))
+	for i, comment := range comments {
+		commentIDs[i] = comment.ID
+	}
+
+	mentions, err := s.mentionRepo.ListByComments(ctx, commentIDs)
+	if err != nil {
+		return domain.NewInternalError("COMMENT_MENTIONS_LIST_FAILED", "Failed to load comment mentions", err)
+	}
+
+	reactions, err := s.reactionRepo.ListByComments(ctx, commentIDs)
+	if err != nil {
+		return domain.NewInternalError("COMMENT_REACTIONS_LIST_FAILED", "Failed to load comment reactions", err)
+	}
+
+	for _, comment := range comments {
+		comment.Mentions = mentions[comment.ID]
+		if comment.Mentions == nil {
+			comment.Mentions = []*domain.CommentMention{}
+		}
+		comment.Reactions = domain.GroupReactions(reactions[comment.ID])
+	}
+	return nil
+}
+
+// broadcastCommented broadcasts a task.commented event for a new comment
+func (s *commentService) broadcastCommented(ctx context.Context, task *domain.Task, comment *domain.Comment) error {
+	if s.eventBroadcaster == nil {
+		return nil
+	}
+
+	eventData := &domain.TaskCommentedData{
+		Task:      task,
+		CommentID: comment.ID,
+		Comment:   comment.Content,
+		Author:    comment.AuthorID,
+		Mentions:  comment.MentionedUserIDs(),
+	}
+
+	event, err := domain.NewTaskEvent(domain.TaskCommented, task.ID, task.ProjectID, comment.AuthorID, eventData)
+	if err != nil {
+		return err
+	}
+
+	return s.eventBroadcaster.BroadcastEvent(ctx, event)
+}
+
+// broadcastMentioned sends a task.mentioned event to each of the users; authors mentioning
+// themselves are skipped. Failures are logged rather than failing the comment.
+func (s *commentService) broadcastMentioned(
+	ctx context.Context, task *domain.Task, comment *domain.Comment, userIDs []string,
+) {
+	if s.eventBroadcaster == nil {
+		return
+	}
+
+	for _, userID := range userIDs {
+		if userID == comment.AuthorID {
+			continue
+		}
+
+		eventData := &domain.TaskMentionedData{
+			Task:            task,
+			CommentID:       comment.ID,
+			Comment:         comment.Content,
+			Author:          comment.AuthorID,
+			MentionedUserID: userID,
+		}
+
+		event, err := domain.NewTaskEvent(domain.TaskMentioned, task.ID, task.ProjectID, comment.AuthorID, eventData)
+		if err == nil {
+			err = s.eventBroadcaster.BroadcastEvent(ctx, event)
+		}
+		if err != nil {
+			slog.Error("Failed to broadcast task mention event",
+				"task_id", task.ID,
+				"comment_id", comment.ID,
+				"user_id", userID,
+				"error", err)
+		}
+	}
+}
+
+// GetComment gets a comment by ID.
+func (s *commentService) GetComment(ctx context.Context, commentID string, userID string) (*domain.Comment, error) {
+	comment, err := s.getComment(ctx, commentID)
 	if err != nil {
 		return nil, err
 	}
 
 	// Check if user has access to the task (and thus the comment)
-	task, err := s.taskRepo.GetByID(ctx, comment.TaskID)
-	if err != nil {
-		return nil, domain.NewNotFoundError("TASK_NOT_FOUND", "Task not found")
+	if _, _, err := s.viewableTask(ctx, comment.TaskID, userID, domain.PermissionViewProject); err != nil {
+		return nil, err
 	}
 
-	// For now, assume access if task exists
-	// In full implementation, check project access
-	_ = task
+	if err := s.attachDetails(ctx, comment); err != nil {
+		return nil, err
+	}
 
 	return comment, nil
 }
@@ -138,12 +378,8 @@ func (s *commentService) UpdateComment(
 	req domain.UpdateCommentRequest,
 	userID string,
 ) (*domain.Comment, error) {
-	if commentID == "" {
-		return nil, domain.NewValidationError("INVALID_COMMENT_ID", "Comment ID cannot be empty", nil)
-	}
-
 	// Get existing comment
-	comment, err := s.commentRepo.GetByID(ctx, commentID)
+	comment, err := s.getComment(ctx, commentID)
 	if err != nil {
 		return nil, err
 	}
@@ -157,6 +393,11 @@ func (s *commentService) UpdateComment(
 		}
 	}
 
+	task, project, err := s.viewableTask(ctx, comment.TaskID, userID, domain.PermissionComment)
+	if err != nil {
+		return nil, err
+	}
+
 	// Apply updates
 	if req.Content != nil {
 		// Only mark as edited if content actually changes
@@ -171,22 +412,45 @@ func (s *commentService) UpdateComment(
 		return nil, err
 	}
 
+	if err := s.attachDetails(ctx, comment); err != nil {
+		return nil, err
+	}
+	previous := make(map[string]bool, len(comment.Mentions))
+	for _, userID := range comment.MentionedUserIDs() {
+		previous[userID] = true
+	}
+
+	mentions, err := s.resolveMentions(ctx, project, task, comment.Content)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update in repository
 	if err := s.commentRepo.Update(ctx, comment); err != nil {
 		return nil, domain.NewInternalError("COMMENT_UPDATE_FAILED", "Failed to update comment", err)
 	}
 
+	if err := s.mentionRepo.ReplaceForComment(ctx, comment.ID, mentions); err != nil {
+		return nil, domain.NewInternalError("COMMENT_MENTIONS_FAILED", "Failed to save comment mentions", err)
+	}
+	comment.Mentions = mentions
+
+	// Only users the edit newly mentions are told about it
+	var added []string
+	for _, userID := range comment.MentionedUserIDs() {
+		if !previous[userID] {
+			added = append(added, userID)
+		}
+	}
+	s.broadcastMentioned(ctx, task, comment, added)
+
 	return comment, nil
 }
 
 // DeleteComment deletes a comment.
 func (s *commentService) DeleteComment(ctx context.Context, commentID string, userID string) error {
-	if commentID == "" {
-		return domain.NewValidationError("INVALID_COMMENT_ID", "Comment ID cannot be empty", nil)
-	}
-
 	// Get existing comment
-	comment, err := s.commentRepo.GetByID(ctx, commentID)
+	comment, err := s.getComment(ctx, commentID)
 	if err != nil {
 		return err
 	}
@@ -212,59 +476,57 @@ func (s *commentService) DeleteComment(ctx context.Context, commentID string, us
 func (s *commentService) ListTaskComments(
 	ctx context.Context,
 	taskID string,
-	_ string,
-	offset, limit int,
+	userID string,
+	req ListCommentsRequest,
 ) ([]*domain.Comment, error) {
 	if taskID == "" {
 		return nil, domain.NewValidationError("INVALID_TASK_ID", "Task ID cannot be empty", nil)
 	}
 
 	// Check if user has access to the task
-	task, err := s.taskRepo.GetByID(ctx, taskID)
-	if err != nil {
-		return nil, domain.NewNotFoundError("TASK_NOT_FOUND", "Task not found")
+	if _, _, err := s.viewableTask(ctx, taskID, userID, domain.PermissionViewProject); err != nil {
+		return nil, err
 	}
 
-	// For now, assume access if task exists
-	// In full implementation, check project access
-	_ = task
-
-	if offset < 0 {
-		offset = 0
+	if req.Offset < 0 {
+		req.Offset = 0
 	}
-	if limit <= 0 || limit > 100 {
-		limit = 20
+	if req.Limit <= 0 || req.Limit > MaxCommentLimit {
+		req.Limit = DefaultCommentLimit
 	}
 
-	comments, err := s.commentRepo.ListByTask(ctx, taskID, offset, limit)
+	list := s.commentRepo.ListByTask
+	if req.HideResolved {
+		list = s.commentRepo.ListOpenByTask
+	}
+
+	comments, err := list(ctx, taskID, req.Offset, req.Limit)
 	if err != nil {
 		return nil, domain.NewInternalError("COMMENT_LIST_FAILED", "Failed to list comments", err)
 	}
 
+	if err := s.attachDetails(ctx, comments...); err != nil {
+		return nil, err
+	}
+
 	return comments, nil
 }
 
 // GetCommentThread gets a comment thread (comment and its replies).
-func (s *commentService) GetCommentThread(ctx context.Context, commentID string, _ string) ([]*domain.Comment, error) {
-	if commentID == "" {
-		return nil, domain.NewValidationError("INVALID_COMMENT_ID", "Comment ID cannot be empty", nil)
-	}
-
+func (s *commentService) GetCommentThread(
+	ctx context.Context, commentID string, userID string,
+) ([]*domain.Comment, error) {
 	// Get the root comment
-	rootComment, err := s.commentRepo.GetByID(ctx, commentID)
+	rootComment, err := s.getComment(ctx, commentID)
 	if err != nil {
 		return nil, err
 	}
 
 	// Check if user has access to the task
-	task, err := s.taskRepo.GetByID(ctx, rootComment.TaskID)
-	if err != nil {
-		return nil, domain.NewNotFoundError("TASK_NOT_FOUND", "Task not found")
+	if _, _, err := s.viewableTask(ctx, rootComment.TaskID, userID, domain.PermissionViewProject); err != nil {
+		return nil, err
 	}
 
-	// For now, assume access if task exists
-	_ = task
-
 	// Get all replies to this comment
 	replies, err := s.commentRepo.ListReplies(ctx, commentID)
 	if err != nil {
@@ -276,5 +538,55 @@ func (s *commentService) GetCommentThread(ctx context.Context, commentID string,
 	thread = append(thread, rootComment)
 	thread = append(thread, replies...)
 
+	if err := s.attachDetails(ctx, thread...); err != nil {
+		return nil, err
+	}
+
 	return thread, nil
 }
+
+// ListMentions lists the comments that @mention the user, newest first. Comments on tasks
+// in projects the user can no longer see are left out.
+func (s *commentService) ListMentions(ctx context.Context, userID string, offset, limit int) (*MentionPage, error) {
+	if offset < 0 {
+		offset = 0
+	}
+	if limit <= 0 || limit > MaxCommentLimit {
+		limit = DefaultCommentLimit
+	}
+
+	mentions, err := s.mentionRepo.ListByUser(ctx, userID, offset, limit)
+	if err != nil {
+		return nil, domain.NewInternalError("MENTION_LIST_FAILED", "Failed to list mentions", err)
+	}
+	total, err := s.mentionRepo.CountByUser(ctx, userID)
+	if err != nil {
+		return nil, domain.NewInternalError("MENTION_COUNT_FAILED", "Failed to count mentions", err)
+	}
+
+	access := make(map[string]bool)
+	comments := make([]*domain.Comment, 0, len(mentions))
+	for _, mention := range mentions {
+		visible, known := access[mention.TaskID]
+		if !known {
+			_, _, err := s.viewableTask(ctx, mention.TaskID, userID, domain.PermissionViewProject)
+			visible = err == nil
+			access[mention.TaskID] = visible
+		}
+		if !visible {
+			continue
+		}
+
+		comment, err := s.commentRepo.GetByID(ctx, mention.CommentID)
+		if err != nil {
+			continue
+		}
+		comments = append(comments, comment)
+	}
+
+	if err := s.attachDetails(ctx, comments...); err != nil {
+		return nil, err
+	}
+
+	return &MentionPage{Comments: comments, Total: total}, nil
+}
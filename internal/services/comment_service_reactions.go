@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// AddReaction adds the user's emoji reaction to a comment. Anyone who may comment on the task may react;
+// reacting twice with the same emoji is a no-op.
+func (s *commentService) AddReaction(
+	ctx context.Context, commentID, emoji, userID string,
+) (*domain.Comment, error) {
+	if err := domain.ValidateReactionEmoji(emoji); err != nil {
+		return nil, err
+	}
+
+	comment, task, _, err := s.visibleComment(ctx, commentID, userID, domain.PermissionComment)
+	if err != nil {
+		return nil, err
+	}
+
+	added, err := s.reactionRepo.Add(ctx, &domain.CommentReaction{CommentID: comment.ID, UserID: userID, Emoji: emoji})
+	if err != nil {
+		return nil, domain.NewInternalError("REACTION_ADD_FAILED", "Failed to add reaction", err)
+	}
+
+	return s.reactionChanged(ctx, task, comment, userID, emoji, added, true)
+}
+
+// RemoveReaction removes the user's emoji reaction from a comment
+func (s *commentService) RemoveReaction(
+	ctx context.Context, commentID, emoji, userID string,
+) (*domain.Comment, error) {
+	comment, task, _, err := s.visibleComment(ctx, commentID, userID, domain.PermissionViewProject)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := s.reactionRepo.Remove(ctx, comment.ID, userID, emoji)
+	if err != nil {
+		return nil, domain.NewInternalError("REACTION_REMOVE_FAILED", "Failed to remove reaction", err)
+	}
+
+	return s.reactionChanged(ctx, task, comment, userID, emoji, removed, false)
+}
+
+// reactionChanged reloads a comment's details and, when its reactions changed, broadcasts them
+func (s *commentService) reactionChanged(
+	ctx context.Context, task *domain.Task, comment *domain.Comment, userID, emoji string, changed, added bool,
+) (*domain.Comment, error) {
+	if err := s.attachDetails(ctx, comment); err != nil {
+		return nil, err
+	}
+	if !changed || s.eventBroadcaster == nil {
+		return comment, nil
+	}
+
+	eventData := &domain.TaskCommentReactedData{
+		Task:      task,
+		CommentID: comment.ID,
+		UserID:    userID,
+		Emoji:     emoji,
+		Reactions: comment.Reactions,
+		Added:     added,
+	}
+
+	event, err := domain.NewTaskEvent(domain.TaskCommentReacted, task.ID, task.ProjectID, userID, eventData)
+	if err == nil {
+		err = s.eventBroadcaster.BroadcastEvent(ctx, event)
+	}
+	if err != nil {
+		slog.Error("Failed to broadcast comment reaction event",
+			"task_id", task.ID,
+			"comment_id", comment.ID,
+			"error", err)
+		// Don't fail the operation if event broadcasting fails
+	}
+
+	return comment, nil
+}
@@ -0,0 +1,249 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+type commentTestEnv struct {
+	service     CommentService
+	mentions    *testutil.MockCommentMentionRepository
+	projects    *testutil.MockProjectRepository
+	broadcaster *mockEventBroadcaster
+}
+
+// newCommentTestEnv sets up a private project owned by the author with one member, and a
+// task in it. The outsider has an account but is not part of the project.
+func newCommentTestEnv() *commentTestEnv {
+	env := &commentTestEnv{
+		mentions:    testutil.NewMockCommentMentionRepository(),
+		projects:    testutil.NewMockProjectRepository(),
+		broadcaster: &mockEventBroadcaster{},
+	}
+
+	users := testutil.NewMockUserRepository()
+	users.AddUser(testutil.MockUser("author", "author@example.com", "author", "Author"))
+	users.AddUser(testutil.MockUser("member", "member@example.com", "member", "Member"))
+	users.AddUser(testutil.MockUser("outsider", "outsider@example.com", "outsider", "Outsider"))
+
+	project := testutil.MockProject("project-1", "Project", "project", "author")
+	project.MemberIDs = []string{"member"}
+	project.Settings.IsPrivate = true
+	env.projects.AddProject(project)
+
+	tasks := testutil.NewMockTaskRepository()
+	tasks.AddTask(testutil.MockTask("task-1", "Ship it", "project-1", "author"))
+
+	env.service = NewCommentService(
+		testutil.NewMockCommentRepository(), env.mentions, testutil.NewMockCommentReactionRepository(),
+		tasks, env.projects, users, NewAuthorizationService(env.projects), env.broadcaster,
+	)
+	return env
+}
+
+// comment posts a comment on the task, as a reply when parentID is set
+func (e *commentTestEnv) comment(t *testing.T, authorID, content, parentID string) *domain.Comment {
+	t.Helper()
+	comment, err := e.service.CreateComment(context.Background(), domain.CreateCommentRequest{
+		TaskID: "task-1", Content: content, ParentID: parentID,
+	}, authorID)
+	require.NoError(t, err)
+	return comment
+}
+
+// eventsOfType returns the broadcast events of one type
+func (e *commentTestEnv) eventsOfType(eventType domain.TaskEventType) []*domain.TaskEvent {
+	var events []*domain.TaskEvent
+	for _, event := range e.broadcaster.broadcastedEvents {
+		if event.Type == eventType {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func TestCommentService_Mentions(t *testing.T) {
+	ctx := context.Background()
+	env := newCommentTestEnv()
+
+	comment, err := env.service.CreateComment(ctx, domain.CreateCommentRequest{
+		TaskID: "task-1", Content: "@member can you look? Thanks, @author. Cc @nobody",
+	}, "author")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"member", "author"}, comment.MentionedUserIDs(), "unknown usernames are ignored")
+	assert.Equal(t, "member", comment.Mentions[0].Username)
+
+	commented := env.eventsOfType(domain.TaskCommented)
+	require.Len(t, commented, 1)
+	assert.Contains(t, string(commented[0].Data), `"mentions":["member","author"]`)
+
+	mentioned := env.eventsOfType(domain.TaskMentioned)
+	require.Len(t, mentioned, 1, "authors are not told they mentioned themselves")
+	assert.Equal(t, []string{"member"}, mentioned[0].Recipients)
+
+	t.Run("ReturnedWithComments", func(t *testing.T) {
+		fetched, err := env.service.GetComment(ctx, comment.ID, "member")
+		require.NoError(t, err)
+		assert.Len(t, fetched.Mentions, 2)
+
+		listed, err := env.service.ListTaskComments(ctx, "task-1", "member", ListCommentsRequest{Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		assert.Len(t, listed[0].Mentions, 2)
+	})
+
+	t.Run("NoAccess", func(t *testing.T) {
+		_, err := env.service.CreateComment(ctx, domain.CreateCommentRequest{
+			TaskID: "task-1", Content: "@outsider have a look",
+		}, "author")
+		assert.Equal(t, domain.ValidationError, webhookErrorType(err))
+		assert.Len(t, env.mentions.Mentions, 2, "nothing is stored for a rejected comment")
+	})
+
+	t.Run("UpdateMentionsOnlyNewUsers", func(t *testing.T) {
+		project, err := env.projects.GetByID(ctx, "project-1")
+		require.NoError(t, err)
+		project.MemberIDs = append(project.MemberIDs, "outsider")
+
+		content := "@member and @outsider, over to you"
+		updated, err := env.service.UpdateComment(ctx, comment.ID, domain.UpdateCommentRequest{Content: &content}, "author")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"member", "outsider"}, updated.MentionedUserIDs())
+
+		mentioned := env.eventsOfType(domain.TaskMentioned)
+		require.Len(t, mentioned, 2, "the member was already mentioned")
+		assert.Equal(t, []string{"outsider"}, mentioned[1].Recipients)
+	})
+
+	t.Run("ListMentions", func(t *testing.T) {
+		page, err := env.service.ListMentions(ctx, "member", 0, 10)
+		require.NoError(t, err)
+		require.Len(t, page.Comments, 1)
+		assert.Equal(t, comment.ID, page.Comments[0].ID)
+		assert.Equal(t, 1, page.Total)
+
+		page, err = env.service.ListMentions(ctx, "author", 0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, page.Comments, "the edit dropped the author's mention")
+	})
+
+	t.Run("ListMentionsAfterLosingAccess", func(t *testing.T) {
+		project, err := env.projects.GetByID(ctx, "project-1")
+		require.NoError(t, err)
+		project.RemoveMember("outsider")
+
+		page, err := env.service.ListMentions(ctx, "outsider", 0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, page.Comments)
+	})
+}
+
+func TestCommentService_Reactions(t *testing.T) {
+	ctx := context.Background()
+	env := newCommentTestEnv()
+
+	comment := env.comment(t, "author", "Shipped", "")
+
+	_, err := env.service.AddReaction(ctx, comment.ID, "🎉", "member")
+	require.NoError(t, err)
+	_, err = env.service.AddReaction(ctx, comment.ID, "👍", "author")
+	require.NoError(t, err)
+	reacted, err := env.service.AddReaction(ctx, comment.ID, "🎉", "author")
+	require.NoError(t, err)
+
+	require.Len(t, reacted.Reactions, 2)
+	assert.Equal(t, []string{"member", "author"}, reacted.Reactions[0].UserIDs)
+	assert.Equal(t, 2, reacted.Reactions[0].Count)
+	assert.Equal(t, "👍", reacted.Reactions[1].Emoji)
+
+	events := env.eventsOfType(domain.TaskCommentReacted)
+	require.Len(t, events, 3)
+	assert.Contains(t, string(events[2].Data), `"added":true`)
+
+	t.Run("ReactingTwice", func(t *testing.T) {
+		again, err := env.service.AddReaction(ctx, comment.ID, "🎉", "member")
+		require.NoError(t, err)
+		assert.Equal(t, 2, again.Reactions[0].Count)
+		assert.Len(t, env.eventsOfType(domain.TaskCommentReacted), 3, "nothing changed, so nothing is broadcast")
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		removed, err := env.service.RemoveReaction(ctx, comment.ID, "👍", "author")
+		require.NoError(t, err)
+		require.Len(t, removed.Reactions, 1)
+
+		events := env.eventsOfType(domain.TaskCommentReacted)
+		require.Len(t, events, 4)
+		assert.Contains(t, string(events[3].Data), `"added":false`)
+	})
+
+	t.Run("InvalidEmoji", func(t *testing.T) {
+		_, err := env.service.AddReaction(ctx, comment.ID, "thumbs up", "member")
+		assert.Equal(t, domain.ValidationError, webhookErrorType(err))
+	})
+
+	t.Run("PrivateProjectOutsider", func(t *testing.T) {
+		_, err := env.service.AddReaction(ctx, comment.ID, "👀", "outsider")
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+	})
+}
+
+func TestCommentService_ResolveThreads(t *testing.T) {
+	ctx := context.Background()
+	env := newCommentTestEnv()
+
+	root := env.comment(t, "author", "Needs a test", "")
+	reply := env.comment(t, "member", "Added one", root.ID)
+	open := env.comment(t, "member", "Rename it?", "")
+
+	resolved, err := env.service.ResolveThread(ctx, root.ID, "member")
+	require.NoError(t, err)
+	assert.True(t, resolved.IsResolved())
+	assert.Equal(t, "member", *resolved.ResolvedBy)
+
+	events := env.eventsOfType(domain.TaskCommentResolved)
+	require.Len(t, events, 1)
+	assert.Contains(t, string(events[0].Data), `"resolved":true`)
+
+	t.Run("HideResolved", func(t *testing.T) {
+		all, err := env.service.ListTaskComments(ctx, "task-1", "author", ListCommentsRequest{})
+		require.NoError(t, err)
+		assert.Len(t, all, 3)
+
+		unresolved, err := env.service.ListTaskComments(ctx, "task-1", "author", ListCommentsRequest{HideResolved: true})
+		require.NoError(t, err)
+		require.Len(t, unresolved, 1, "the resolved thread and its reply are hidden")
+		assert.Equal(t, open.ID, unresolved[0].ID)
+	})
+
+	t.Run("RepliesCannotBeResolved", func(t *testing.T) {
+		_, err := env.service.ResolveThread(ctx, reply.ID, "author")
+		assert.Equal(t, domain.ValidationError, webhookErrorType(err))
+	})
+
+	t.Run("Reopen", func(t *testing.T) {
+		reopened, err := env.service.ReopenThread(ctx, root.ID, "author")
+		require.NoError(t, err)
+		assert.False(t, reopened.IsResolved())
+		assert.Nil(t, reopened.ResolvedBy)
+
+		events := env.eventsOfType(domain.TaskCommentResolved)
+		require.Len(t, events, 2)
+		assert.Contains(t, string(events[1].Data), `"resolved":false`)
+
+		_, err = env.service.ReopenThread(ctx, root.ID, "author")
+		require.NoError(t, err)
+		assert.Len(t, env.eventsOfType(domain.TaskCommentResolved), 2, "reopening an open thread is a no-op")
+	})
+
+	t.Run("OutsidersCannotResolve", func(t *testing.T) {
+		_, err := env.service.ResolveThread(ctx, root.ID, "outsider")
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+	})
+}
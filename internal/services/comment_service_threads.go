@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// ResolveThread marks the thread a root comment starts resolved. Anyone who may comment in the
+// project may resolve any thread; resolving a resolved thread is a no-op.
+func (s *commentService) ResolveThread(ctx context.Context, commentID string, userID string) (*domain.Comment, error) {
+	return s.changeResolution(ctx, commentID, userID, func(comment *domain.Comment) (bool, error) {
+		return comment.Resolve(userID, time.Now())
+	})
+}
+
+// ReopenThread marks a resolved thread open again
+func (s *commentService) ReopenThread(ctx context.Context, commentID string, userID string) (*domain.Comment, error) {
+	return s.changeResolution(ctx, commentID, userID, func(comment *domain.Comment) (bool, error) {
+		if comment.IsReply() {
+			return false, domain.NewValidationError("NOT_A_THREAD", "Only root comments can be reopened", nil)
+		}
+		return comment.Reopen(), nil
+	})
+}
+
+// changeResolution applies a resolution change to a root comment, then stores and broadcasts it if it changed
+func (s *commentService) changeResolution(
+	ctx context.Context, commentID string, userID string, change func(*domain.Comment) (bool, error),
+) (*domain.Comment, error) {
+	comment, task, _, err := s.visibleComment(ctx, commentID, userID, domain.PermissionComment)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := change(comment)
+	if err != nil {
+		return nil, err
+	}
+
+	if changed {
+		if err := s.commentRepo.Update(ctx, comment); err != nil {
+			return nil, domain.NewInternalError("COMMENT_RESOLVE_FAILED", "Failed to update thread resolution", err)
+		}
+		s.broadcastResolution(ctx, task, comment, userID)
+	}
+
+	if err := s.attachDetails(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// broadcastResolution broadcasts a task.comment_resolved event; failures are logged
+func (s *commentService) broadcastResolution(
+	ctx context.Context, task *domain.Task, comment *domain.Comment, userID string,
+) {
+	if s.eventBroadcaster == nil {
+		return
+	}
+
+	eventData := &domain.TaskCommentResolvedData{
+		Task:       task,
+		CommentID:  comment.ID,
+		ResolvedAt: comment.ResolvedAt,
+		Resolved:   comment.IsResolved(),
+	}
+	if comment.ResolvedBy != nil {
+		eventData.ResolvedBy = *comment.ResolvedBy
+	}
+
+	event, err := domain.NewTaskEvent(domain.TaskCommentResolved, task.ID, task.ProjectID, userID, eventData)
+	if err == nil {
+		err = s.eventBroadcaster.BroadcastEvent(ctx, event)
+	}
+	if err != nil {
+		slog.Error("Failed to broadcast comment resolution event",
+			"task_id", task.ID,
+			"comment_id", comment.ID,
+			"error", err)
+	}
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
+)
+
+// maxScheduledTasks caps how many tasks one critical path analysis loads, matching the board
+const maxScheduledTasks = 1000
+
+// CriticalPathService analyses the dependency graph of a project
+type CriticalPathService interface {
+	// Analyze schedules the project's open work and returns its critical path, slack and due date risk
+	Analyze(
+		ctx context.Context, projectID string, options domain.ScheduleOptions, userID string,
+	) (*domain.CriticalPathAnalysis, error)
+}
+
+type criticalPathService struct {
+	taskRepo    repository.TaskRepository
+	projectRepo repository.ProjectRepository
+}
+
+// NewCriticalPathService creates a new critical path service
+func NewCriticalPathService(
+	taskRepo repository.TaskRepository,
+	projectRepo repository.ProjectRepository,
+) CriticalPathService {
+	return &criticalPathService{
+		taskRepo:    taskRepo,
+		projectRepo: projectRepo,
+	}
+}
+
+// Analyze schedules the project's open work and returns its critical path, slack and due date risk.
+// The schedule starts now unless options set a start.
+func (s *criticalPathService) Analyze(
+	ctx context.Context, projectID string, options domain.ScheduleOptions, userID string,
+) (*domain.CriticalPathAnalysis, error) {
+	if projectID == "" {
+		return nil, domain.NewValidationError("INVALID_PROJECT_ID", "Project ID cannot be empty", nil)
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
+	}
+
+	if !project.HasAccess(userID) && project.Settings.IsPrivate {
+		return nil, domain.NewAuthorizationError("ACCESS_DENIED", "You don't have access to this project")
+	}
+
+	archived := false
+	tasks, err := s.taskRepo.GetByProject(ctx, projectID, repository.TaskFilters{
+		Archived:  &archived,
+		SortBy:    "position",
+		SortOrder: "asc",
+		Limit:     maxScheduledTasks,
+	})
+	if err != nil {
+		return nil, domain.NewInternalError("TASK_LIST_FAILED", "Failed to load project tasks", err)
+	}
+
+	if options.StartsAt.IsZero() {
+		options.StartsAt = time.Now().UTC()
+	}
+	options.Workflow = project.Workflow()
+
+	return domain.AnalyzeCriticalPath(projectID, tasks, options)
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+func TestCriticalPathService_Analyze(t *testing.T) {
+	ctx := context.Background()
+
+	taskRepo := testutil.NewMockTaskRepository()
+	projectRepo := testutil.NewMockProjectRepository()
+	service := NewCriticalPathService(taskRepo, projectRepo)
+
+	project := testutil.MockProject("cp-proj", "Critical Path Project", "cp", "owner")
+	projectRepo.AddProject(project)
+	private := testutil.MockProject("private-proj", "Private Project", "private", "owner")
+	private.Settings.IsPrivate = true
+	projectRepo.AddProject(private)
+
+	effort := func(hours float64) *float64 { return &hours }
+
+	design := testutil.MockTask("design", "Design", project.ID, "owner")
+	design.EffortEstimate = effort(8)
+	build := testutil.MockTask("build", "Build", project.ID, "owner")
+	build.EffortEstimate = effort(16)
+	build.Dependencies = []string{"design"}
+	docs := testutil.MockTask("docs", "Docs", project.ID, "owner")
+	docs.EffortEstimate = effort(4)
+	docs.Dependencies = []string{"design"}
+	old := testutil.MockTask("old", "Old", project.ID, "owner")
+	old.Archived = true
+	for _, task := range []*domain.Task{design, build, docs, old} {
+		taskRepo.AddTask(task)
+	}
+
+	t.Run("ComputesCriticalPath", func(t *testing.T) {
+		start := time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC)
+		analysis, err := service.Analyze(ctx, project.ID, domain.ScheduleOptions{StartsAt: start}, "owner")
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"design", "build"}, analysis.CriticalPath)
+		assert.Equal(t, 24.0, analysis.Duration)
+		assert.Len(t, analysis.Tasks, 3, "archived tasks are not scheduled")
+		assert.Equal(t, start.Add(72*time.Hour), analysis.ProjectedFinish)
+	})
+
+	t.Run("StartsNowByDefault", func(t *testing.T) {
+		before := time.Now().UTC()
+		analysis, err := service.Analyze(ctx, project.ID, domain.ScheduleOptions{}, "owner")
+		require.NoError(t, err)
+		assert.False(t, analysis.StartsAt.Before(before))
+	})
+
+	t.Run("PrivateProjectDenied", func(t *testing.T) {
+		_, err := service.Analyze(ctx, private.ID, domain.ScheduleOptions{}, "stranger")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ACCESS_DENIED")
+	})
+
+	t.Run("ProjectNotFound", func(t *testing.T) {
+		_, err := service.Analyze(ctx, "missing", domain.ScheduleOptions{}, "owner")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "PROJECT_NOT_FOUND")
+	})
+}
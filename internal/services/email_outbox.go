@@ -0,0 +1,205 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
+)
+
+// EmailOutbox queues transactional emails in the database and delivers them in the
+// background, retrying failed deliveries with exponential backoff.
+type EmailOutbox interface {
+	// Enqueue renders the named template and queues the email for delivery
+	Enqueue(ctx context.Context, to, template string, data map[string]interface{}) error
+	// DeliverDue attempts every email that is due and returns how many were sent
+	DeliverDue(ctx context.Context) (int, error)
+	// StartDeliveryRoutine delivers due emails every interval until the context is done
+	StartDeliveryRoutine(ctx context.Context, interval time.Duration)
+}
+
+// EmailOutboxConfig configures retries of the email outbox
+type EmailOutboxConfig struct {
+	RetryInterval time.Duration // delay before the first retry, doubled for every later one
+	MaxAttempts   int
+	BatchSize     int // emails attempted per delivery run
+}
+
+// DefaultEmailOutboxConfig returns the default outbox configuration
+func DefaultEmailOutboxConfig() EmailOutboxConfig {
+	return EmailOutboxConfig{
+		RetryInterval: time.Minute,
+		MaxAttempts:   5,
+		BatchSize:     50,
+	}
+}
+
+type emailOutbox struct {
+	repo      repository.EmailOutboxRepository
+	mailer    Mailer
+	templates *EmailTemplates
+	logger    *slog.Logger
+	wake      chan struct{}
+	config    EmailOutboxConfig
+	deliverMu sync.Mutex
+}
+
+// NewEmailOutbox creates a new email outbox
+func NewEmailOutbox(
+	repo repository.EmailOutboxRepository,
+	mailer Mailer,
+	templates *EmailTemplates,
+	cfg EmailOutboxConfig,
+) EmailOutbox {
+	defaults := DefaultEmailOutboxConfig()
+	if cfg.RetryInterval <= 0 {
+		cfg.RetryInterval = defaults.RetryInterval
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaults.MaxAttempts
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaults.BatchSize
+	}
+
+	return &emailOutbox{
+		repo:      repo,
+		mailer:    mailer,
+		templates: templates,
+		logger:    slog.Default().With("component", "email_outbox"),
+		wake:      make(chan struct{}, 1),
+		config:    cfg,
+	}
+}
+
+// Enqueue renders the named template and queues the email for delivery
+func (o *emailOutbox) Enqueue(ctx context.Context, to, template string, data map[string]interface{}) error {
+	msg, err := o.templates.Render(template, to, data)
+	if err != nil {
+		return domain.NewInternalError("EMAIL_RENDER_FAILED", "Failed to render email", err)
+	}
+
+	now := time.Now().UTC()
+	email := &domain.OutboxEmail{
+		ID:            uuid.New().String(),
+		To:            msg.To,
+		Subject:       msg.Subject,
+		TextBody:      msg.TextBody,
+		HTMLBody:      msg.HTMLBody,
+		Template:      template,
+		Status:        domain.EmailPending,
+		MaxAttempts:   o.config.MaxAttempts,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := email.Validate(); err != nil {
+		return err
+	}
+
+	if err := o.repo.Create(ctx, email); err != nil {
+		return domain.NewInternalError("EMAIL_QUEUE_FAILED", "Failed to queue email", err)
+	}
+
+	// Nudge the delivery routine so the email doesn't wait for the next tick
+	select {
+	case o.wake <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// DeliverDue attempts every email that is due and returns how many were sent
+func (o *emailOutbox) DeliverDue(ctx context.Context) (int, error) {
+	o.deliverMu.Lock()
+	defer o.deliverMu.Unlock()
+
+	emails, err := o.repo.ListDue(ctx, time.Now().UTC(), o.config.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list due emails: %w", err)
+	}
+
+	sent := 0
+	for _, email := range emails {
+		if ctx.Err() != nil {
+			return sent, ctx.Err()
+		}
+
+		sendErr := o.mailer.Send(ctx, &EmailMessage{
+			To:       email.To,
+			Subject:  email.Subject,
+			TextBody: email.TextBody,
+			HTMLBody: email.HTMLBody,
+		})
+
+		now := time.Now().UTC()
+		if sendErr == nil {
+			email.MarkSent(now)
+			sent++
+		} else {
+			email.MarkAttemptFailed(sendErr, now, o.config.RetryInterval)
+			o.logFailure(email, sendErr)
+		}
+
+		if err := o.repo.Update(ctx, email); err != nil {
+			// The email stays due and will be sent again on the next run
+			o.logger.Error("Failed to record email delivery", "email_id", email.ID, "error", err)
+		}
+	}
+
+	return sent, nil
+}
+
+// logFailure reports a failed attempt without the email body
+func (o *emailOutbox) logFailure(email *domain.OutboxEmail, cause error) {
+	if email.Status == domain.EmailFailed {
+		o.logger.Error("Giving up on email after repeated failures",
+			"email_id", email.ID,
+			"template", email.Template,
+			"attempts", email.Attempts,
+			"error", cause)
+		return
+	}
+
+	o.logger.Warn("Email delivery failed, will retry",
+		"email_id", email.ID,
+		"template", email.Template,
+		"attempts", email.Attempts,
+		"next_attempt_at", email.NextAttemptAt,
+		"error", cause)
+}
+
+// StartDeliveryRoutine delivers due emails every interval until the context is done
+func (o *emailOutbox) StartDeliveryRoutine(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		o.logger.Info("Started email delivery routine", "interval", interval)
+
+		for {
+			select {
+			case <-ctx.Done():
+				o.logger.Info("Stopping email delivery routine due to context cancellation")
+				return
+			case <-ticker.C:
+			case <-o.wake:
+			}
+
+			if _, err := o.DeliverDue(ctx); err != nil && ctx.Err() == nil {
+				o.logger.Error("Failed to deliver queued emails", "error", err)
+			}
+		}
+	}()
+}
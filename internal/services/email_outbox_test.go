@@ -0,0 +1,296 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+// newTestEmailOutbox creates an outbox over an in-memory repository that never delivers
+func newTestEmailOutbox(t *testing.T) EmailOutbox {
+	return newEmailOutboxWithMailer(t, testutil.NewMockEmailOutboxRepository(), &recordingMailer{})
+}
+
+func newEmailOutboxWithMailer(t *testing.T, repo *testutil.MockEmailOutboxRepository, mailer Mailer) EmailOutbox {
+	t.Helper()
+	templates, err := NewEmailTemplates("https://tasks.example.com/")
+	require.NoError(t, err)
+	return NewEmailOutbox(repo, mailer, templates, EmailOutboxConfig{RetryInterval: time.Minute, MaxAttempts: 3})
+}
+
+// recordingMailer records sent messages and fails while failures remain
+type recordingMailer struct {
+	sent     []*EmailMessage
+	failures int
+	mu       sync.Mutex
+}
+
+func (m *recordingMailer) Send(_ context.Context, msg *EmailMessage) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.failures > 0 {
+		m.failures--
+		return errors.New("421 service not available")
+	}
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+func TestEmailOutbox_RetriesUntilDelivered(t *testing.T) {
+	ctx := context.Background()
+	repo := testutil.NewMockEmailOutboxRepository()
+	mailer := &recordingMailer{failures: 1}
+	outbox := newEmailOutboxWithMailer(t, repo, mailer)
+
+	require.NoError(t, outbox.Enqueue(ctx, "ada@example.com", EmailPasswordReset, map[string]interface{}{
+		"Name": "Ada", "Token": "abc123", "ExpiresIn": "1 hour",
+	}))
+	require.Len(t, repo.Emails, 1)
+
+	sent, err := outbox.DeliverDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent)
+
+	var email *domain.OutboxEmail
+	for _, queued := range repo.Emails {
+		email = queued
+	}
+	assert.Equal(t, domain.EmailPending, email.Status)
+	assert.Equal(t, 1, email.Attempts)
+	assert.Contains(t, email.LastError, "421")
+	assert.True(t, email.NextAttemptAt.After(time.Now()), "the retry waits for the backoff")
+
+	sent, err = outbox.DeliverDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent, "nothing is due before the backoff expires")
+
+	repo.Emails[email.ID].NextAttemptAt = time.Now().Add(-time.Second)
+	sent, err = outbox.DeliverDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+
+	delivered := repo.Emails[email.ID]
+	assert.Equal(t, domain.EmailSent, delivered.Status)
+	assert.Empty(t, delivered.TextBody, "bodies with one-time links are dropped once sent")
+	require.Len(t, mailer.sent, 1)
+	assert.Contains(t, mailer.sent[0].TextBody, "https://tasks.example.com/reset-password?token=abc123")
+	assert.Contains(t, mailer.sent[0].HTMLBody, `href="https://tasks.example.com/reset-password?token=abc123"`)
+}
+
+func TestEmailOutbox_GivesUpAfterMaxAttempts(t *testing.T) {
+	ctx := context.Background()
+	repo := testutil.NewMockEmailOutboxRepository()
+	outbox := newEmailOutboxWithMailer(t, repo, &recordingMailer{failures: 10})
+
+	require.NoError(t, outbox.Enqueue(ctx, "ada@example.com", EmailPasswordReset, map[string]interface{}{
+		"Name": "Ada", "Token": "abc123", "ExpiresIn": "1 hour",
+	}))
+
+	for attempt := 0; attempt < 3; attempt++ {
+		for _, email := range repo.Emails {
+			email.NextAttemptAt = time.Now().Add(-time.Second)
+		}
+		_, err := outbox.DeliverDue(ctx)
+		require.NoError(t, err)
+	}
+
+	for _, email := range repo.Emails {
+		assert.Equal(t, domain.EmailFailed, email.Status)
+		assert.Equal(t, 3, email.Attempts)
+		assert.Empty(t, email.TextBody)
+		assert.False(t, email.IsDue(time.Now().Add(24*time.Hour)))
+	}
+}
+
+func TestEmailOutbox_UnknownTemplate(t *testing.T) {
+	repo := testutil.NewMockEmailOutboxRepository()
+	outbox := newEmailOutboxWithMailer(t, repo, &recordingMailer{})
+
+	err := outbox.Enqueue(context.Background(), "ada@example.com", "welcome", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "EMAIL_RENDER_FAILED")
+	assert.Empty(t, repo.Emails)
+}
+
+func TestAuthService_ForgotPassword_QueuesResetEmail(t *testing.T) {
+	var logs bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	userRepo := testutil.NewMockUserRepository()
+	resetTokenRepo := newMockPasswordResetTokenRepository()
+	outboxRepo := testutil.NewMockEmailOutboxRepository()
+	outbox := newEmailOutboxWithMailer(t, outboxRepo, NewLogMailer(nil))
+	cfg := &testConfig{jwtSecret: "test-secret-that-is-32-characters-long", jwtExpiration: time.Hour}
+	service := NewAuthService(userRepo, newMockTokenBlacklistRepository(), resetTokenRepo, cfg, outbox)
+
+	userRepo.AddUser(&domain.User{ID: "user123", Email: "ada@example.com", Username: "ada", Name: "Ada Lovelace"})
+
+	require.NoError(t, service.ForgotPassword(context.Background(), "ada@example.com"))
+	require.Len(t, resetTokenRepo.tokens, 1)
+	require.Len(t, outboxRepo.Emails, 1)
+
+	var token string
+	for value := range resetTokenRepo.tokens {
+		token = value
+	}
+	for _, email := range outboxRepo.Emails {
+		assert.Equal(t, "ada@example.com", email.To)
+		assert.Equal(t, EmailPasswordReset, email.Template)
+		assert.Contains(t, email.TextBody, "Hi Ada Lovelace")
+		assert.Contains(t, email.TextBody, "/reset-password?token="+token)
+	}
+
+	_, err := outbox.DeliverDue(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, logs.String(), "ada@example.com")
+	assert.NotContains(t, logs.String(), token, "the reset token must never be logged")
+}
+
+func TestSMTPMailer_Send(t *testing.T) {
+	server := newFakeSMTPServer(t)
+
+	mailer := NewSMTPMailer(SMTPSettings{
+		Host:    "127.0.0.1",
+		Port:    server.port(),
+		From:    "Simple Easy Tasks <no-reply@example.com>",
+		Timeout: 5 * time.Second,
+	})
+
+	err := mailer.Send(context.Background(), &EmailMessage{
+		To:       "ada@example.com",
+		Subject:  "Reset your password",
+		TextBody: "Open https://tasks.example.com/reset-password?token=abc123",
+		HTMLBody: `<p><a href="https://tasks.example.com/reset-password?token=abc123">Reset</a></p>`,
+	})
+	require.NoError(t, err)
+
+	received := server.wait(t)
+	assert.Equal(t, "<no-reply@example.com>", received.from)
+	assert.Equal(t, []string{"<ada@example.com>"}, received.recipients)
+	assert.Contains(t, received.data, "Subject: Reset your password")
+	assert.Contains(t, received.data, "Content-Type: multipart/alternative")
+	assert.Contains(t, received.data, "Content-Type: text/plain; charset=utf-8")
+	assert.Contains(t, received.data, "Content-Type: text/html; charset=utf-8")
+	assert.Contains(t, received.data, "token=3Dabc123", "bodies are quoted-printable encoded")
+}
+
+func TestSMTPMailer_RequiresStartTLS(t *testing.T) {
+	server := newFakeSMTPServer(t)
+
+	mailer := NewSMTPMailer(SMTPSettings{
+		Host:     "127.0.0.1",
+		Port:     server.port(),
+		From:     "no-reply@example.com",
+		StartTLS: true,
+		Timeout:  5 * time.Second,
+	})
+
+	err := mailer.Send(context.Background(), &EmailMessage{
+		To: "ada@example.com", Subject: "Hello", TextBody: "Hi",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "STARTTLS")
+}
+
+// receivedMail is a message accepted by the fake SMTP server
+type receivedMail struct {
+	from       string
+	data       string
+	recipients []string
+}
+
+// fakeSMTPServer accepts one SMTP session on a local port without TLS or authentication
+type fakeSMTPServer struct {
+	listener net.Listener
+	received chan receivedMail
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	server := &fakeSMTPServer{listener: listener, received: make(chan receivedMail, 1)}
+	go server.serve()
+	return server
+}
+
+func (s *fakeSMTPServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSMTPServer) wait(t *testing.T) receivedMail {
+	t.Helper()
+	select {
+	case mail := <-s.received:
+		return mail
+	case <-time.After(5 * time.Second):
+		t.Fatal("the fake SMTP server received no message")
+		return receivedMail{}
+	}
+}
+
+func (s *fakeSMTPServer) serve() {
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer func() { _ = conn.Close() }()
+
+	text := textproto.NewConn(conn)
+	reply := func(line string) { _ = text.PrintfLine("%s", line) }
+
+	var mail receivedMail
+	reply("220 localhost ESMTP ready")
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch command {
+		case "EHLO", "HELO":
+			reply("250-localhost")
+			reply("250 8BITMIME")
+		case "MAIL":
+			mail.from = strings.TrimPrefix(line, "MAIL FROM:")
+			if i := strings.Index(mail.from, " "); i >= 0 {
+				mail.from = mail.from[:i]
+			}
+			reply("250 OK")
+		case "RCPT":
+			mail.recipients = append(mail.recipients, strings.TrimPrefix(line, "RCPT TO:"))
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			lines, err := text.ReadDotLines()
+			if err != nil {
+				return
+			}
+			mail.data = strings.Join(lines, "\n")
+			reply("250 OK queued")
+			s.received <- mail
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+	}
+}
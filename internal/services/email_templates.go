@@ -0,0 +1,103 @@
+package services
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+//go:embed email_templates/*.tmpl
+var emailTemplateFS embed.FS
+
+// Email template names
+const (
+	// EmailPasswordReset is sent with a one-time link when a user forgets their password
+	EmailPasswordReset = "password_reset"
+	// EmailProjectInvitation invites someone to join a project
+	EmailProjectInvitation = "project_invitation"
+)
+
+// emailTemplateNames lists the templates parsed at startup
+var emailTemplateNames = []string{EmailPasswordReset, EmailProjectInvitation}
+
+type emailTemplate struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+// EmailTemplates renders transactional emails from the embedded templates.
+// Each email has a plain-text template defining "subject" and "body" and an
+// HTML template defining "content", which is wrapped in the shared layout.
+type EmailTemplates struct {
+	templates map[string]*emailTemplate
+	baseURL   string
+}
+
+// NewEmailTemplates parses the embedded email templates. Links in emails point at baseURL.
+func NewEmailTemplates(baseURL string) (*EmailTemplates, error) {
+	layout, err := htmltemplate.ParseFS(emailTemplateFS, "email_templates/layout.html.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse email layout: %w", err)
+	}
+
+	templates := make(map[string]*emailTemplate, len(emailTemplateNames))
+	for _, name := range emailTemplateNames {
+		text, err := texttemplate.ParseFS(emailTemplateFS, "email_templates/"+name+".txt.tmpl")
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s text template: %w", name, err)
+		}
+
+		html, err := layout.Clone()
+		if err == nil {
+			html, err = html.ParseFS(emailTemplateFS, "email_templates/"+name+".html.tmpl")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s HTML template: %w", name, err)
+		}
+
+		templates[name] = &emailTemplate{text: text, html: html}
+	}
+
+	return &EmailTemplates{
+		templates: templates,
+		baseURL:   strings.TrimRight(baseURL, "/"),
+	}, nil
+}
+
+// Render renders the named email for a recipient
+func (t *EmailTemplates) Render(name, to string, data map[string]interface{}) (*EmailMessage, error) {
+	tmpl, ok := t.templates[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown email template %q", name)
+	}
+
+	values := make(map[string]interface{}, len(data)+2)
+	for key, value := range data {
+		values[key] = value
+	}
+	values["BaseURL"] = t.baseURL
+
+	var subject, text, html bytes.Buffer
+	if err := tmpl.text.ExecuteTemplate(&subject, "subject", values); err != nil {
+		return nil, fmt.Errorf("failed to render %s subject: %w", name, err)
+	}
+	if err := tmpl.text.ExecuteTemplate(&text, "body", values); err != nil {
+		return nil, fmt.Errorf("failed to render %s text body: %w", name, err)
+	}
+
+	subjectLine := strings.TrimSpace(subject.String())
+	values["Subject"] = subjectLine
+	if err := tmpl.html.ExecuteTemplate(&html, "layout", values); err != nil {
+		return nil, fmt.Errorf("failed to render %s HTML body: %w", name, err)
+	}
+
+	return &EmailMessage{
+		To:       to,
+		Subject:  subjectLine,
+		TextBody: strings.TrimSpace(text.String()) + "\n",
+		HTMLBody: html.String(),
+	}, nil
+}
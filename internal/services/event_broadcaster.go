@@ -32,6 +32,14 @@ type EventBroadcaster interface {
 
 	// Cleanup removes inactive or expired subscriptions
 	Cleanup(ctx context.Context) error
+
+	// Listen delivers the events matching a subscription on the returned channel until stop is called.
+	// The channel is closed early when the subscription is removed or the listener falls too far behind.
+	Listen(subscriptionID string) (events <-chan *domain.TaskEvent, stop func(), err error)
+
+	// StartClusterSync delivers events broadcast on other replicas to local subscribers until ctx is done.
+	// It does nothing when no cluster transport is configured.
+	StartClusterSync(ctx context.Context) error
 }
 
 // EventHandler is a callback function for processing events
@@ -40,11 +48,15 @@ type EventHandler func(event *domain.TaskEvent, subscription *domain.EventSubscr
 // eventBroadcaster implements the EventBroadcaster interface
 type eventBroadcaster struct {
 	subscriptions     map[string]*domain.EventSubscription
-	userSubscriptions map[string][]string // userID -> []subscriptionID
+	userSubscriptions map[string][]string               // userID -> []subscriptionID
+	listeners         map[string]chan *domain.TaskEvent // subscriptionID -> live delivery channel
 	eventHandlers     []EventHandler
 	mu                sync.RWMutex
 	logger            *slog.Logger
 	pbService         *PocketBaseService
+	eventLog          EventLog
+	cluster           ClusterTransport
+	deduper           *eventDeduper // set with cluster; drops the echo of our own published events
 
 	// Configuration
 	maxSubscriptionsPerUser int
@@ -54,10 +66,12 @@ type eventBroadcaster struct {
 
 // EventBroadcasterConfig holds configuration for the event broadcaster
 type EventBroadcasterConfig struct {
-	MaxSubscriptionsPerUser int           // Maximum subscriptions per user (default: 10)
-	SubscriptionTimeout     time.Duration // Timeout for inactive subscriptions (default: 1 hour)
-	EventQueueSize          int           // Size of event queue per subscription (default: 100)
-	Logger                  *slog.Logger  // Logger instance
+	MaxSubscriptionsPerUser int              // Maximum subscriptions per user (default: 10)
+	SubscriptionTimeout     time.Duration    // Timeout for inactive subscriptions (default: 1 hour)
+	EventQueueSize          int              // Size of event queue per subscription (default: 100)
+	Logger                  *slog.Logger     // Logger instance
+	EventLog                EventLog         // Log that sequences events for replay (optional)
+	Cluster                 ClusterTransport // Relays events to and from other replicas (optional)
 }
 
 // NewEventBroadcaster creates a new event broadcaster service
@@ -75,16 +89,24 @@ func NewEventBroadcaster(pbService *PocketBaseService, config EventBroadcasterCo
 		config.Logger = slog.Default()
 	}
 
-	return &eventBroadcaster{
+	broadcaster := &eventBroadcaster{
 		subscriptions:           make(map[string]*domain.EventSubscription),
 		userSubscriptions:       make(map[string][]string),
+		listeners:               make(map[string]chan *domain.TaskEvent),
 		eventHandlers:           make([]EventHandler, 0),
 		logger:                  config.Logger,
 		pbService:               pbService,
+		eventLog:                config.EventLog,
+		cluster:                 config.Cluster,
 		maxSubscriptionsPerUser: config.MaxSubscriptionsPerUser,
 		subscriptionTimeout:     config.SubscriptionTimeout,
 		eventQueueSize:          config.EventQueueSize,
 	}
+	if config.Cluster != nil {
+		broadcaster.deduper = newEventDeduper(clusterDedupeCapacity)
+	}
+
+	return broadcaster
 }
 
 // BroadcastEvent broadcasts a task event to all relevant subscribers
@@ -97,16 +119,66 @@ func (b *eventBroadcaster) BroadcastEvent(ctx context.Context, event *domain.Tas
 		return err
 	}
 
+	if !b.deliver(ctx, event, true) {
+		return nil
+	}
+
+	// Other replicas deliver the event to their own subscribers; the log already has it
+	if b.cluster != nil {
+		if err := b.cluster.Publish(ctx, event); err != nil {
+			b.logger.Error("Failed to publish event to cluster",
+				"error", err,
+				"event_id", event.EventID)
+		}
+	}
+
+	return nil
+}
+
+// StartClusterSync delivers events broadcast on other replicas to local subscribers until ctx is done
+func (b *eventBroadcaster) StartClusterSync(ctx context.Context) error {
+	if b.cluster == nil {
+		return nil
+	}
+
+	return b.cluster.Subscribe(ctx, func(event *domain.TaskEvent) {
+		if err := event.Validate(); err != nil {
+			b.logger.Warn("Dropping invalid cluster event",
+				"error", err,
+				"event_id", event.EventID)
+			return
+		}
+		b.deliver(ctx, event, false)
+	})
+}
+
+// deliver fans an event out to the matching local subscribers, logging it first when logEvent is set.
+// It reports false for an event this node has already delivered.
+func (b *eventBroadcaster) deliver(ctx context.Context, event *domain.TaskEvent, logEvent bool) bool {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
+	if b.deduper != nil && !b.deduper.firstSeen(event.EventID) {
+		return false
+	}
+
+	// Log the event before fanning it out so every delivered event carries its sequence.
+	// Appending under the lock keeps sequence order and delivery order the same.
+	if logEvent && b.eventLog != nil && event.Sequence == 0 {
+		if err := b.eventLog.Append(ctx, event); err != nil {
+			b.logger.Error("Failed to append event to event log",
+				"error", err,
+				"event_id", event.EventID)
+		}
+	}
+
 	matchingSubscriptions := b.findMatchingSubscriptions(event)
 	if len(matchingSubscriptions) == 0 {
 		b.logger.Debug("No matching subscriptions found for event",
 			"event_type", event.Type,
 			"task_id", event.TaskID,
 			"project_id", event.ProjectID)
-		return nil
+		return true
 	}
 
 	b.logger.Info("Broadcasting event to subscribers",
@@ -129,6 +201,8 @@ func (b *eventBroadcaster) BroadcastEvent(ctx context.Context, event *domain.Tas
 		// Update the subscription in the map to persist the activity change
 		b.subscriptions[subscription.ID] = subscription
 
+		b.deliverToListener(subscription.ID, event)
+
 		for _, handler := range b.eventHandlers {
 			if err := handler(event, subscription); err != nil {
 				b.logger.Error("Event handler failed",
@@ -139,7 +213,7 @@ func (b *eventBroadcaster) BroadcastEvent(ctx context.Context, event *domain.Tas
 		}
 	}
 
-	return nil
+	return true
 }
 
 // Subscribe adds a new event subscription
@@ -203,6 +277,7 @@ func (b *eventBroadcaster) Unsubscribe(_ context.Context, subscriptionID string)
 
 	// Remove main subscription
 	delete(b.subscriptions, subscriptionID)
+	b.closeListener(subscriptionID)
 
 	b.logger.Info("Removed event subscription",
 		"subscription_id", subscriptionID,
@@ -290,6 +365,7 @@ func (b *eventBroadcaster) Cleanup(_ context.Context) error {
 
 			// Remove main subscription
 			delete(b.subscriptions, subID)
+			b.closeListener(subID)
 			removedCount++
 		}
 	}
@@ -303,6 +379,58 @@ func (b *eventBroadcaster) Cleanup(_ context.Context) error {
 	return nil
 }
 
+// Listen delivers the events matching a subscription on the returned channel until stop is called
+func (b *eventBroadcaster) Listen(subscriptionID string) (<-chan *domain.TaskEvent, func(), error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, exists := b.subscriptions[subscriptionID]; !exists {
+		return nil, nil, domain.NewNotFoundError("SUBSCRIPTION_NOT_FOUND", "Subscription not found")
+	}
+
+	// A subscription has one live listener; a new one replaces the old
+	b.closeListener(subscriptionID)
+
+	events := make(chan *domain.TaskEvent, b.eventQueueSize)
+	b.listeners[subscriptionID] = events
+
+	stop := func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if b.listeners[subscriptionID] == events {
+			b.closeListener(subscriptionID)
+		}
+	}
+
+	return events, stop, nil
+}
+
+// deliverToListener hands an event to the subscription's live listener without blocking.
+// A listener whose queue is full is disconnected; the client resumes from the event log.
+func (b *eventBroadcaster) deliverToListener(subscriptionID string, event *domain.TaskEvent) {
+	listener, exists := b.listeners[subscriptionID]
+	if !exists {
+		return
+	}
+
+	select {
+	case listener <- event:
+	default:
+		b.logger.Warn("Disconnecting slow event listener",
+			"subscription_id", subscriptionID,
+			"event_id", event.EventID)
+		b.closeListener(subscriptionID)
+	}
+}
+
+// closeListener closes and removes a subscription's listener; callers hold the lock
+func (b *eventBroadcaster) closeListener(subscriptionID string) {
+	if listener, exists := b.listeners[subscriptionID]; exists {
+		close(listener)
+		delete(b.listeners, subscriptionID)
+	}
+}
+
 // AddEventHandler adds a custom event handler
 func (b *eventBroadcaster) AddEventHandler(handler EventHandler) {
 	b.mu.Lock()
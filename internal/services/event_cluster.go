@@ -0,0 +1,213 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// DefaultClusterChannel is the pub/sub channel realtime events are relayed on
+const DefaultClusterChannel = "set:realtime:events"
+
+// clusterDedupeCapacity bounds how many recent event IDs each node remembers
+const clusterDedupeCapacity = 10000
+
+// ClusterTransport relays realtime events between the replicas of a deployment,
+// so that clients connected to any replica see events raised on all of them.
+type ClusterTransport interface {
+	// Publish sends an event to every replica, including this one
+	Publish(ctx context.Context, event *domain.TaskEvent) error
+
+	// Subscribe calls handle for every event published by any replica until ctx is done.
+	// It returns once the subscription is active.
+	Subscribe(ctx context.Context, handle func(event *domain.TaskEvent)) error
+}
+
+// RedisClusterTransport implements ClusterTransport using Redis pub/sub.
+// Pub/sub is fire-and-forget: a replica that is disconnected misses events,
+// and its clients catch up from the event log when they reconnect.
+type RedisClusterTransport struct {
+	client  *redis.Client
+	channel string
+	logger  *slog.Logger
+}
+
+// NewRedisClusterTransport creates a new Redis cluster transport
+func NewRedisClusterTransport(addr, password string, db int, channel string) *RedisClusterTransport {
+	return &RedisClusterTransport{
+		client: redis.NewClient(&redis.Options{
+			Addr:     addr,
+			Password: password,
+			DB:       db,
+		}),
+		channel: channel,
+		logger:  slog.Default().With("component", "cluster_transport"),
+	}
+}
+
+// Ping checks that the Redis server is reachable
+func (r *RedisClusterTransport) Ping(ctx context.Context) error {
+	return r.client.Ping(ctx).Err()
+}
+
+// Close releases the underlying Redis connections
+func (r *RedisClusterTransport) Close() error {
+	return r.client.Close()
+}
+
+// Publish sends an event to every replica, including this one
+func (r *RedisClusterTransport) Publish(ctx context.Context, event *domain.TaskEvent) error {
+	payload, err := event.ToJSON()
+	if err != nil {
+		return err
+	}
+
+	if err := r.client.Publish(ctx, r.channel, payload).Err(); err != nil {
+		return domain.NewInternalError("CLUSTER_PUBLISH_FAILED", "Failed to publish event to cluster", err)
+	}
+	return nil
+}
+
+// Subscribe calls handle for every event published by any replica until ctx is done
+func (r *RedisClusterTransport) Subscribe(ctx context.Context, handle func(event *domain.TaskEvent)) error {
+	pubsub := r.client.Subscribe(ctx, r.channel)
+
+	// Wait for the subscription to be confirmed so no event published after we return is missed
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return domain.NewInternalError("CLUSTER_SUBSCRIBE_FAILED", "Failed to subscribe to cluster events", err)
+	}
+
+	go func() {
+		defer func() { _ = pubsub.Close() }()
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case message, open := <-messages:
+				if !open {
+					return
+				}
+
+				var event domain.TaskEvent
+				if err := json.Unmarshal([]byte(message.Payload), &event); err != nil {
+					r.logger.Warn("Dropping malformed cluster event", "error", err)
+					continue
+				}
+				handle(&event)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// MemoryClusterBus is an in-process stand-in for Redis pub/sub. Each transport
+// it hands out behaves like a separate replica connected to the same channel.
+type MemoryClusterBus struct {
+	handlers map[int]func(event *domain.TaskEvent)
+	mu       sync.RWMutex
+	nextID   int
+}
+
+// NewMemoryClusterBus creates a new in-process cluster bus
+func NewMemoryClusterBus() *MemoryClusterBus {
+	return &MemoryClusterBus{handlers: make(map[int]func(event *domain.TaskEvent))}
+}
+
+// Transport returns a new transport connected to the bus
+func (b *MemoryClusterBus) Transport() ClusterTransport {
+	return &memoryClusterTransport{bus: b}
+}
+
+type memoryClusterTransport struct {
+	bus *MemoryClusterBus
+}
+
+// Publish delivers the event to every subscriber on the bus before returning
+func (t *memoryClusterTransport) Publish(_ context.Context, event *domain.TaskEvent) error {
+	payload, err := event.ToJSON()
+	if err != nil {
+		return err
+	}
+
+	t.bus.mu.RLock()
+	handlers := make([]func(event *domain.TaskEvent), 0, len(t.bus.handlers))
+	for _, handle := range t.bus.handlers {
+		handlers = append(handlers, handle)
+	}
+	t.bus.mu.RUnlock()
+
+	// Every subscriber decodes its own copy, as it would from the wire
+	for _, handle := range handlers {
+		var received domain.TaskEvent
+		if err := json.Unmarshal(payload, &received); err != nil {
+			return domain.NewInternalError("CLUSTER_PUBLISH_FAILED", "Failed to decode cluster event", err)
+		}
+		handle(&received)
+	}
+	return nil
+}
+
+// Subscribe registers handle on the bus until ctx is done
+func (t *memoryClusterTransport) Subscribe(ctx context.Context, handle func(event *domain.TaskEvent)) error {
+	t.bus.mu.Lock()
+	id := t.bus.nextID
+	t.bus.nextID++
+	t.bus.handlers[id] = handle
+	t.bus.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		t.bus.mu.Lock()
+		delete(t.bus.handlers, id)
+		t.bus.mu.Unlock()
+	}()
+
+	return nil
+}
+
+// eventDeduper remembers the most recent event IDs a node has delivered, so an
+// event that reaches it twice (its own echo from the cluster) is delivered once.
+type eventDeduper struct {
+	seen     map[string]struct{}
+	order    []string // ring buffer of remembered IDs, oldest at next
+	mu       sync.Mutex
+	next     int
+	capacity int
+}
+
+func newEventDeduper(capacity int) *eventDeduper {
+	return &eventDeduper{
+		seen:     make(map[string]struct{}, capacity),
+		order:    make([]string, 0, capacity),
+		capacity: capacity,
+	}
+}
+
+// firstSeen records eventID and reports whether it had not been seen before
+func (d *eventDeduper) firstSeen(eventID string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, exists := d.seen[eventID]; exists {
+		return false
+	}
+
+	if len(d.order) < d.capacity {
+		d.order = append(d.order, eventID)
+	} else {
+		delete(d.seen, d.order[d.next])
+		d.order[d.next] = eventID
+		d.next = (d.next + 1) % d.capacity
+	}
+	d.seen[eventID] = struct{}{}
+	return true
+}
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+// newClusterNode creates a broadcaster on transport with a listening project1 subscription
+func newClusterNode(
+	ctx context.Context, t *testing.T, transport ClusterTransport, eventLog EventLog,
+) (EventBroadcaster, <-chan *domain.TaskEvent) {
+	t.Helper()
+
+	broadcaster := NewEventBroadcaster(nil, EventBroadcasterConfig{EventLog: eventLog, Cluster: transport})
+	require.NoError(t, broadcaster.StartClusterSync(ctx))
+
+	subscription := domain.NewEventSubscription("user1", stringPtr("project1"), []domain.TaskEventType{domain.TaskUpdated})
+	require.NoError(t, broadcaster.Subscribe(ctx, subscription))
+
+	events, stop, err := broadcaster.Listen(subscription.ID)
+	require.NoError(t, err)
+	t.Cleanup(stop)
+
+	return broadcaster, events
+}
+
+func receiveEvent(t *testing.T, events <-chan *domain.TaskEvent) *domain.TaskEvent {
+	t.Helper()
+
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for event")
+		return nil
+	}
+}
+
+func assertNoEvent(t *testing.T, events <-chan *domain.TaskEvent) {
+	t.Helper()
+
+	select {
+	case event := <-events:
+		t.Fatalf("Unexpected event %s", event.EventID)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestEventBroadcaster_ClusterFanOut(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Both replicas share one database, and so one event log
+	repo := testutil.NewMockEventLogRepository()
+	eventLog := NewEventLog(repo, DefaultEventLogConfig())
+
+	bus := NewMemoryClusterBus()
+	nodeA, eventsA := newClusterNode(ctx, t, bus.Transport(), eventLog)
+	nodeB, eventsB := newClusterNode(ctx, t, bus.Transport(), eventLog)
+
+	t.Run("EventReachesOtherReplica", func(t *testing.T) {
+		event := newLoggedEvent(t, "task1")
+		require.NoError(t, nodeA.BroadcastEvent(ctx, event))
+
+		local := receiveEvent(t, eventsA)
+		remote := receiveEvent(t, eventsB)
+		assert.Equal(t, event.EventID, local.EventID)
+		assert.Equal(t, event.EventID, remote.EventID)
+		assert.Equal(t, int64(1), remote.Sequence, "the sequence travels with the event")
+		assert.Len(t, repo.Events, 1, "only the origin logs the event")
+
+		assertNoEvent(t, eventsA)
+		assertNoEvent(t, eventsB)
+	})
+
+	t.Run("DuplicateEventIsDeliveredOnce", func(t *testing.T) {
+		event := newLoggedEvent(t, "task2")
+		require.NoError(t, nodeB.BroadcastEvent(ctx, event))
+		receiveEvent(t, eventsA)
+		receiveEvent(t, eventsB)
+
+		require.NoError(t, nodeA.BroadcastEvent(ctx, event))
+		assertNoEvent(t, eventsA)
+		assertNoEvent(t, eventsB)
+	})
+
+	t.Run("StopsReceivingAfterShutdown", func(t *testing.T) {
+		standaloneCtx, stopSync := context.WithCancel(ctx)
+		standalone := NewEventBroadcaster(nil, EventBroadcasterConfig{Cluster: bus.Transport()})
+		require.NoError(t, standalone.StartClusterSync(standaloneCtx))
+		stopSync()
+
+		// The bus drops the subscriber asynchronously
+		assert.Eventually(t, func() bool {
+			bus.mu.RLock()
+			defer bus.mu.RUnlock()
+			return len(bus.handlers) == 2
+		}, time.Second, 10*time.Millisecond)
+	})
+}
+
+func TestRealtimeEventService_ClusterFanOut(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bus := NewMemoryClusterBus()
+	nodeA := NewRealtimeEventService(nil, RealtimeEventConfig{QueueSize: 10, Cluster: bus.Transport()})
+	nodeB := NewRealtimeEventService(nil, RealtimeEventConfig{QueueSize: 10, Cluster: bus.Transport()})
+	require.NoError(t, nodeA.StartClusterSync(ctx))
+	require.NoError(t, nodeB.StartClusterSync(ctx))
+
+	request := &SubscriptionRequest{UserID: "user1", EventTypes: []domain.TaskEventType{domain.TaskUpdated}}
+	subscriptionA, err := nodeA.Subscribe(ctx, request)
+	require.NoError(t, err)
+	subscriptionB, err := nodeB.Subscribe(ctx, request)
+	require.NoError(t, err)
+
+	event := newLoggedEvent(t, "task1")
+	require.NoError(t, nodeA.PublishTaskEvent(ctx, event))
+
+	assert.Equal(t, event.EventID, receiveEvent(t, subscriptionA.Channel).EventID)
+	assert.Equal(t, event.EventID, receiveEvent(t, subscriptionB.Channel).EventID)
+	assertNoEvent(t, subscriptionA.Channel)
+	assertNoEvent(t, subscriptionB.Channel)
+}
+
+// TestRedisClusterTransport runs against the Redis server in REDIS_TEST_ADDR, e.g. localhost:6379
+func TestRedisClusterTransport(t *testing.T) {
+	addr := os.Getenv("REDIS_TEST_ADDR")
+	if addr == "" {
+		t.Skip("REDIS_TEST_ADDR not set")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	channel := "set:test:realtime:" + generateSubscriptionID()
+	nodes := make([]*RedisClusterTransport, 2)
+	for i := range nodes {
+		nodes[i] = NewRedisClusterTransport(addr, "", 0, channel)
+		t.Cleanup(func() { _ = nodes[i].Close() })
+		require.NoError(t, nodes[i].Ping(ctx))
+	}
+
+	received := make(chan *domain.TaskEvent, 1)
+	require.NoError(t, nodes[1].Subscribe(ctx, func(event *domain.TaskEvent) { received <- event }))
+
+	event := newLoggedEvent(t, "task1")
+	event.Sequence = 7
+	require.NoError(t, nodes[0].Publish(ctx, event))
+
+	delivered := receiveEvent(t, received)
+	assert.Equal(t, event.EventID, delivered.EventID)
+	assert.Equal(t, event.Type, delivered.Type)
+	assert.Equal(t, int64(7), delivered.Sequence)
+}
+
+func TestEventDeduper(t *testing.T) {
+	deduper := newEventDeduper(2)
+
+	assert.True(t, deduper.firstSeen("a"))
+	assert.False(t, deduper.firstSeen("a"))
+	assert.True(t, deduper.firstSeen("b"))
+	assert.True(t, deduper.firstSeen("c"), "evicts the oldest ID")
+	assert.True(t, deduper.firstSeen("a"), "an evicted ID is seen again")
+	assert.False(t, deduper.firstSeen("c"))
+}
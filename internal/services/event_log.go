@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
+)
+
+// EventLog persists broadcast task events under a monotonic sequence so that
+// clients which lost their connection can replay the events they missed.
+type EventLog interface {
+	// Append logs an event and sets its Sequence
+	Append(ctx context.Context, event *domain.TaskEvent) error
+
+	// Since returns the events logged after lastSequence, or a reset when they
+	// can no longer all be replayed
+	Since(ctx context.Context, lastSequence int64) (*EventReplay, error)
+}
+
+// EventReplay holds the events a reconnecting client missed
+type EventReplay struct {
+	Events         []*domain.TaskEvent `json:"events"`
+	LatestSequence int64               `json:"latest_sequence"`
+	// Reset means the gap is too old or too large to replay; the client must reload its state
+	Reset bool `json:"reset"`
+}
+
+// EventLogConfig bounds the event log
+type EventLogConfig struct {
+	MaxEntries int // events kept in the log (default: 10000)
+	MaxReplay  int // most events replayed to one client before it gets a reset instead (default: 1000)
+	TrimEvery  int // appends between trims of the oldest events (default: 100)
+}
+
+// DefaultEventLogConfig returns the default event log bounds
+func DefaultEventLogConfig() EventLogConfig {
+	return EventLogConfig{
+		MaxEntries: 10000,
+		MaxReplay:  1000,
+		TrimEvery:  100,
+	}
+}
+
+type eventLog struct {
+	repo    repository.EventLogRepository
+	config  EventLogConfig
+	appends atomic.Int64
+}
+
+// NewEventLog creates a new bounded event log
+func NewEventLog(repo repository.EventLogRepository, config EventLogConfig) EventLog {
+	defaults := DefaultEventLogConfig()
+	if config.MaxEntries <= 0 {
+		config.MaxEntries = defaults.MaxEntries
+	}
+	if config.MaxReplay <= 0 {
+		config.MaxReplay = defaults.MaxReplay
+	}
+	if config.MaxReplay > config.MaxEntries {
+		config.MaxReplay = config.MaxEntries
+	}
+	if config.TrimEvery <= 0 {
+		config.TrimEvery = defaults.TrimEvery
+	}
+
+	return &eventLog{repo: repo, config: config}
+}
+
+// Append logs an event and sets its Sequence
+func (l *eventLog) Append(ctx context.Context, event *domain.TaskEvent) error {
+	if event == nil {
+		return domain.NewValidationError("NIL_EVENT", "Event cannot be nil", nil)
+	}
+
+	if err := l.repo.Append(ctx, event); err != nil {
+		return domain.NewInternalError("EVENT_LOG_APPEND_FAILED", "Failed to log event", err)
+	}
+
+	// Trim in batches rather than on every append
+	if l.appends.Add(1)%int64(l.config.TrimEvery) == 0 {
+		if cutoff := event.Sequence - int64(l.config.MaxEntries); cutoff > 0 {
+			if err := l.repo.DeleteUpTo(ctx, cutoff); err != nil {
+				return domain.NewInternalError("EVENT_LOG_TRIM_FAILED", "Failed to trim event log", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Since returns the events logged after lastSequence, or a reset when they can no longer all be replayed
+func (l *eventLog) Since(ctx context.Context, lastSequence int64) (*EventReplay, error) {
+	if lastSequence < 0 {
+		return nil, domain.NewValidationError("INVALID_LAST_EVENT_ID", "Last event ID cannot be negative", nil)
+	}
+
+	oldest, latest, err := l.repo.Bounds(ctx)
+	if err != nil {
+		return nil, domain.NewInternalError("EVENT_LOG_READ_FAILED", "Failed to read event log", err)
+	}
+
+	replay := &EventReplay{Events: []*domain.TaskEvent{}, LatestSequence: latest}
+
+	switch {
+	case lastSequence == latest:
+		return replay, nil
+	case lastSequence > latest:
+		// The client saw events the log no longer has, e.g. after the database was restored
+		replay.Reset = true
+		return replay, nil
+	case lastSequence+1 < oldest, latest-lastSequence > int64(l.config.MaxReplay):
+		// The missed events were trimmed or are too many to replay
+		replay.Reset = true
+		return replay, nil
+	}
+
+	events, err := l.repo.ListAfter(ctx, lastSequence, l.config.MaxReplay)
+	if err != nil {
+		return nil, domain.NewInternalError("EVENT_LOG_READ_FAILED", "Failed to read event log", err)
+	}
+
+	// A trim between reading the bounds and the events leaves a hole at the start
+	if len(events) > 0 && events[0].Sequence != lastSequence+1 {
+		replay.Reset = true
+		return replay, nil
+	}
+
+	replay.Events = events
+	if len(events) > 0 {
+		replay.LatestSequence = events[len(events)-1].Sequence
+	}
+	return replay, nil
+}
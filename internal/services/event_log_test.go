@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+func newLoggedEvent(t *testing.T, taskID string) *domain.TaskEvent {
+	t.Helper()
+	event, err := domain.NewTaskEvent(domain.TaskUpdated, taskID, "project1", "user1", nil)
+	require.NoError(t, err)
+	return event
+}
+
+func TestEventLog_Since(t *testing.T) {
+	ctx := context.Background()
+	repo := testutil.NewMockEventLogRepository()
+	log := NewEventLog(repo, EventLogConfig{MaxEntries: 5, MaxReplay: 3, TrimEvery: 5})
+
+	for i := 0; i < 4; i++ {
+		require.NoError(t, log.Append(ctx, newLoggedEvent(t, "task1")))
+	}
+	assert.Equal(t, int64(4), repo.Events[3].Sequence)
+
+	t.Run("ReplaysMissedEvents", func(t *testing.T) {
+		replay, err := log.Since(ctx, 2)
+		require.NoError(t, err)
+		assert.False(t, replay.Reset)
+		require.Len(t, replay.Events, 2)
+		assert.Equal(t, int64(3), replay.Events[0].Sequence)
+		assert.Equal(t, int64(4), replay.LatestSequence)
+	})
+
+	t.Run("UpToDate", func(t *testing.T) {
+		replay, err := log.Since(ctx, 4)
+		require.NoError(t, err)
+		assert.False(t, replay.Reset)
+		assert.Empty(t, replay.Events)
+	})
+
+	t.Run("TooManyToReplay", func(t *testing.T) {
+		replay, err := log.Since(ctx, 0)
+		require.NoError(t, err)
+		assert.True(t, replay.Reset)
+		assert.Equal(t, int64(4), replay.LatestSequence)
+	})
+
+	t.Run("AheadOfLog", func(t *testing.T) {
+		replay, err := log.Since(ctx, 42)
+		require.NoError(t, err)
+		assert.True(t, replay.Reset)
+	})
+
+	t.Run("TrimsOldestEvents", func(t *testing.T) {
+		for i := 0; i < 6; i++ {
+			require.NoError(t, log.Append(ctx, newLoggedEvent(t, "task1")))
+		}
+		oldest, latest, err := repo.Bounds(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), latest)
+		assert.Equal(t, int64(6), oldest, "the log keeps the newest MaxEntries events")
+
+		replay, err := log.Since(ctx, 4)
+		require.NoError(t, err)
+		assert.True(t, replay.Reset, "trimmed events can't be replayed")
+
+		replay, err = log.Since(ctx, 8)
+		require.NoError(t, err)
+		assert.False(t, replay.Reset)
+		assert.Len(t, replay.Events, 2)
+	})
+}
+
+func TestEventBroadcaster_SequencesAndDeliversToListeners(t *testing.T) {
+	ctx := context.Background()
+	repo := testutil.NewMockEventLogRepository()
+	broadcaster := NewEventBroadcaster(nil, EventBroadcasterConfig{
+		EventQueueSize: 2,
+		EventLog:       NewEventLog(repo, DefaultEventLogConfig()),
+	})
+
+	subscription := domain.NewEventSubscription("user1", stringPtr("project1"), []domain.TaskEventType{domain.TaskUpdated})
+	require.NoError(t, broadcaster.Subscribe(ctx, subscription))
+
+	t.Run("EventsAreLoggedWithoutListeners", func(t *testing.T) {
+		other, err := domain.NewTaskEvent(domain.TaskUpdated, "task9", "project9", "user1", nil)
+		require.NoError(t, err)
+		require.NoError(t, broadcaster.BroadcastEvent(ctx, other))
+		assert.Equal(t, int64(1), other.Sequence)
+	})
+
+	events, stop, err := broadcaster.Listen(subscription.ID)
+	require.NoError(t, err)
+	defer stop()
+
+	t.Run("DeliversSequencedEvents", func(t *testing.T) {
+		require.NoError(t, broadcaster.BroadcastEvent(ctx, newLoggedEvent(t, "task1")))
+		delivered := <-events
+		assert.Equal(t, int64(2), delivered.Sequence)
+		assert.Len(t, repo.Events, 2)
+	})
+
+	t.Run("DisconnectsSlowListener", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			require.NoError(t, broadcaster.BroadcastEvent(ctx, newLoggedEvent(t, "task1")))
+		}
+		<-events
+		<-events
+		_, open := <-events
+		assert.False(t, open, "a listener that falls behind is closed")
+		assert.Len(t, repo.Events, 5, "dropped events stay in the log for replay")
+	})
+
+	t.Run("UnsubscribeClosesListener", func(t *testing.T) {
+		events, _, err := broadcaster.Listen(subscription.ID)
+		require.NoError(t, err)
+		require.NoError(t, broadcaster.Unsubscribe(ctx, subscription.ID))
+		_, open := <-events
+		assert.False(t, open)
+
+		_, _, err = broadcaster.Listen(subscription.ID)
+		assert.Error(t, err)
+	})
+}
@@ -3,8 +3,6 @@ package services
 import (
 	"context"
 	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -226,13 +224,8 @@ func (s *GitHubWebhookService) verifySignature(signature string, body []byte) bo
 		return false
 	}
 
-	expectedSignature := signature[7:] // Remove "sha256=" prefix
-
-	mac := hmac.New(sha256.New, []byte(s.secret))
-	mac.Write(body)
-	actualSignature := hex.EncodeToString(mac.Sum(nil))
-
-	return hmac.Equal([]byte(expectedSignature), []byte(actualSignature))
+	// Outbound webhooks are signed with the same scheme
+	return hmac.Equal([]byte(signature), []byte(SignWebhookPayload(s.secret, body)))
 }
 
 // parsePayload parses webhook payload based on event type
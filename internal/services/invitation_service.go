@@ -0,0 +1,362 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
+)
+
+// InvitationService invites people to projects by email or shareable link.
+type InvitationService interface {
+	// CreateInvitation invites people to a project. Email invitations are sent to the address
+	// and their token is only ever written into the email; link invitations return their token.
+	CreateInvitation(
+		ctx context.Context, projectID string, req domain.CreateInvitationRequest, inviterID string,
+	) (*domain.ProjectInvitation, error)
+
+	// ListInvitations lists a project's pending invitations that haven't expired
+	ListInvitations(ctx context.Context, projectID string, requesterID string) ([]*domain.ProjectInvitation, error)
+
+	// RevokeInvitation withdraws a pending invitation
+	RevokeInvitation(ctx context.Context, projectID string, invitationID string, requesterID string) error
+
+	// AcceptInvitation adds an existing user to the invitation's project
+	AcceptInvitation(ctx context.Context, token string, userID string) (*domain.Project, error)
+
+	// AcceptInvitationAsNewUser registers an account and adds it to the invitation's project
+	AcceptInvitationAsNewUser(
+		ctx context.Context, req domain.RegisterWithInvitationRequest,
+	) (*domain.User, *domain.Project, error)
+
+	// DeclineInvitation turns down an email invitation
+	DeclineInvitation(ctx context.Context, token string, userID string) error
+}
+
+type invitationService struct {
+	invitationRepo repository.ProjectInvitationRepository
+	projectRepo    repository.ProjectRepository
+	userRepo       repository.UserRepository
+	authService    AuthService
+	authz          AuthorizationService
+	outbox         EmailOutbox
+}
+
+// NewInvitationService creates a new project invitation service.
+func NewInvitationService(
+	invitationRepo repository.ProjectInvitationRepository,
+	projectRepo repository.ProjectRepository,
+	userRepo repository.UserRepository,
+	authService AuthService,
+	authz AuthorizationService,
+	outbox EmailOutbox,
+) InvitationService {
+	return &invitationService{
+		invitationRepo: invitationRepo,
+		projectRepo:    projectRepo,
+		userRepo:       userRepo,
+		authService:    authService,
+		authz:          authz,
+		outbox:         outbox,
+	}
+}
+
+// CreateInvitation invites people to a project.
+func (s *invitationService) CreateInvitation(
+	ctx context.Context, projectID string, req domain.CreateInvitationRequest, inviterID string,
+) (*domain.ProjectInvitation, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	project, err := s.authz.Authorize(ctx, projectID, inviterID, domain.PermissionManageMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Email != "" {
+		if err := s.replacePendingEmailInvitations(ctx, project, req.Email); err != nil {
+			return nil, err
+		}
+	}
+
+	token, err := generateSecureToken()
+	if err != nil {
+		return nil, domain.NewInternalError("TOKEN_GENERATION_FAILED", "Failed to generate invitation token", err)
+	}
+
+	invitation := &domain.ProjectInvitation{
+		ProjectID: project.ID,
+		InviterID: inviterID,
+		Email:     req.Email,
+		Token:     token,
+		Role:      req.Role,
+		Status:    domain.InvitationPending,
+		MaxUses:   req.MaxUses,
+		ExpiresAt: time.Now().UTC().Add(req.ExpiresIn()),
+	}
+	if err := s.invitationRepo.Create(ctx, invitation); err != nil {
+		return nil, domain.NewInternalError("INVITATION_CREATE_FAILED", "Failed to create invitation", err)
+	}
+
+	if invitation.IsEmailInvitation() {
+		s.sendInvitationEmail(ctx, invitation, project, req.ExpiresIn())
+		// Only the invited address gets the token, so nobody else can accept in its name
+		invitation.Token = ""
+	}
+
+	return invitation, nil
+}
+
+// replacePendingEmailInvitations refuses to invite existing members and revokes earlier
+// invitations sent to the same address, so only the latest one works.
+func (s *invitationService) replacePendingEmailInvitations(
+	ctx context.Context, project *domain.Project, email string,
+) error {
+	if user, err := s.userRepo.GetByEmail(ctx, email); err == nil {
+		if project.IsOwner(user.ID) || project.IsMember(user.ID) {
+			return domain.NewConflictError("ALREADY_MEMBER", "This user is already a member of the project")
+		}
+	}
+
+	pending, err := s.invitationRepo.ListPendingByProject(ctx, project.ID)
+	if err != nil {
+		return domain.NewInternalError("INVITATION_LIST_FAILED", "Failed to list invitations", err)
+	}
+	for _, invitation := range pending {
+		if !invitation.IsFor(email) {
+			continue
+		}
+		invitation.Revoke()
+		if err := s.invitationRepo.Update(ctx, invitation); err != nil {
+			return domain.NewInternalError("INVITATION_UPDATE_FAILED", "Failed to revoke earlier invitation", err)
+		}
+	}
+	return nil
+}
+
+// sendInvitationEmail queues the invitation email. Failures are logged; the inviter can
+// revoke the invitation and send a new one.
+func (s *invitationService) sendInvitationEmail(
+	ctx context.Context, invitation *domain.ProjectInvitation, project *domain.Project, expiresIn time.Duration,
+) {
+	inviterName := "A teammate"
+	if inviter, err := s.userRepo.GetByID(ctx, invitation.InviterID); err == nil {
+		inviterName = inviter.Name
+		if inviterName == "" {
+			inviterName = inviter.Username
+		}
+	}
+
+	err := s.outbox.Enqueue(ctx, invitation.Email, EmailProjectInvitation, map[string]interface{}{
+		"InviterName":  inviterName,
+		"ProjectTitle": project.Title,
+		"Role":         string(invitation.Role),
+		"Token":        invitation.Token,
+		"ExpiresIn":    describeExpiry(expiresIn),
+	})
+	if err != nil {
+		slog.Error("Failed to queue project invitation email",
+			"invitation_id", invitation.ID, "project_id", project.ID, "error", err)
+	}
+}
+
+// ListInvitations lists a project's pending invitations that haven't expired.
+func (s *invitationService) ListInvitations(
+	ctx context.Context, projectID string, requesterID string,
+) ([]*domain.ProjectInvitation, error) {
+	if _, err := s.authz.Authorize(ctx, projectID, requesterID, domain.PermissionManageMembers); err != nil {
+		return nil, err
+	}
+
+	pending, err := s.invitationRepo.ListPendingByProject(ctx, projectID)
+	if err != nil {
+		return nil, domain.NewInternalError("INVITATION_LIST_FAILED", "Failed to list invitations", err)
+	}
+
+	invitations := make([]*domain.ProjectInvitation, 0, len(pending))
+	for _, invitation := range pending {
+		if !invitation.IsExpired() {
+			invitations = append(invitations, invitation)
+		}
+	}
+	return invitations, nil
+}
+
+// RevokeInvitation withdraws a pending invitation.
+func (s *invitationService) RevokeInvitation(
+	ctx context.Context, projectID string, invitationID string, requesterID string,
+) error {
+	if _, err := s.authz.Authorize(ctx, projectID, requesterID, domain.PermissionManageMembers); err != nil {
+		return err
+	}
+
+	invitation, err := s.invitationRepo.GetByID(ctx, invitationID)
+	if err != nil || invitation.ProjectID != projectID {
+		return domain.NewNotFoundError("INVITATION_NOT_FOUND", "Invitation not found")
+	}
+	if invitation.Status != domain.InvitationPending {
+		return domain.NewValidationError("INVITATION_NOT_PENDING", "Only pending invitations can be revoked", nil)
+	}
+
+	invitation.Revoke()
+	if err := s.invitationRepo.Update(ctx, invitation); err != nil {
+		return domain.NewInternalError("INVITATION_UPDATE_FAILED", "Failed to revoke invitation", err)
+	}
+	return nil
+}
+
+// AcceptInvitation adds an existing user to the invitation's project.
+func (s *invitationService) AcceptInvitation(
+	ctx context.Context, token string, userID string,
+) (*domain.Project, error) {
+	invitation, err := s.usableInvitation(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, domain.NewNotFoundError("USER_NOT_FOUND", "User not found")
+	}
+	if invitation.IsEmailInvitation() && !invitation.IsFor(user.Email) {
+		return nil, errInvitationEmailMismatch()
+	}
+
+	return s.join(ctx, invitation, user.ID)
+}
+
+// AcceptInvitationAsNewUser registers an account and adds it to the invitation's project.
+// Email invitations register the address they were sent to.
+func (s *invitationService) AcceptInvitationAsNewUser(
+	ctx context.Context, req domain.RegisterWithInvitationRequest,
+) (*domain.User, *domain.Project, error) {
+	invitation, err := s.usableInvitation(ctx, req.Token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	email := req.Email
+	if invitation.IsEmailInvitation() {
+		if email != "" && !invitation.IsFor(email) {
+			return nil, nil, errInvitationEmailMismatch()
+		}
+		email = invitation.Email
+	} else if email == "" {
+		return nil, nil, domain.NewValidationError("EMAIL_REQUIRED", "Email is required", map[string]interface{}{
+			"field": "email",
+		})
+	}
+
+	user, err := s.authService.Register(ctx, domain.CreateUserRequest{
+		Email:    email,
+		Username: req.Username,
+		Name:     req.Name,
+		Password: req.Password,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project, err := s.join(ctx, invitation, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return user, project, nil
+}
+
+// DeclineInvitation turns down an email invitation. Link invitations aren't addressed to
+// anyone, so they can't be declined; they simply go unused.
+func (s *invitationService) DeclineInvitation(ctx context.Context, token string, userID string) error {
+	invitation, err := s.usableInvitation(ctx, token)
+	if err != nil {
+		return err
+	}
+	if !invitation.IsEmailInvitation() {
+		return domain.NewValidationError("INVITATION_NOT_DECLINABLE", "Link invitations can't be declined", nil)
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return domain.NewNotFoundError("USER_NOT_FOUND", "User not found")
+	}
+	if !invitation.IsFor(user.Email) {
+		return errInvitationEmailMismatch()
+	}
+
+	invitation.Decline()
+	if err := s.invitationRepo.Update(ctx, invitation); err != nil {
+		return domain.NewInternalError("INVITATION_UPDATE_FAILED", "Failed to decline invitation", err)
+	}
+	return nil
+}
+
+// usableInvitation looks up an invitation by token and checks it can still be accepted
+func (s *invitationService) usableInvitation(ctx context.Context, token string) (*domain.ProjectInvitation, error) {
+	if token == "" {
+		return nil, domain.NewValidationError("INVALID_TOKEN", "Invitation token is required", nil)
+	}
+
+	invitation, err := s.invitationRepo.GetByToken(ctx, token)
+	if err != nil {
+		return nil, domain.NewNotFoundError("INVITATION_NOT_FOUND", "Invitation not found")
+	}
+
+	switch {
+	case invitation.Status == domain.InvitationPending && invitation.IsExpired():
+		return nil, domain.NewValidationError("INVITATION_EXPIRED", "This invitation has expired", nil)
+	case !invitation.CanBeAccepted():
+		return nil, domain.NewValidationError("INVITATION_UNAVAILABLE",
+			"This invitation has already been used, declined or revoked", nil)
+	}
+	return invitation, nil
+}
+
+// join adds the user to the invitation's project with its role and counts the use
+func (s *invitationService) join(
+	ctx context.Context, invitation *domain.ProjectInvitation, userID string,
+) (*domain.Project, error) {
+	project, err := s.projectRepo.GetByID(ctx, invitation.ProjectID)
+	if err != nil {
+		return nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
+	}
+	if project.IsOwner(userID) || project.IsMember(userID) {
+		return nil, domain.NewConflictError("ALREADY_MEMBER", "You're already a member of this project")
+	}
+
+	project.AddMember(userID)
+	project.SetMemberRole(userID, invitation.Role)
+	if err := s.projectRepo.Update(ctx, project); err != nil {
+		return nil, domain.NewInternalError("MEMBER_ADD_FAILED", "Failed to add member", err)
+	}
+
+	invitation.RecordUse()
+	if err := s.invitationRepo.Update(ctx, invitation); err != nil {
+		return nil, domain.NewInternalError("INVITATION_UPDATE_FAILED", "Failed to record invitation use", err)
+	}
+	return project, nil
+}
+
+// describeExpiry writes how long an invitation lasts in days, or hours when it isn't whole days
+func describeExpiry(d time.Duration) string {
+	days, hours := int(d/(24*time.Hour)), int(d/time.Hour)
+	switch {
+	case d%(24*time.Hour) != 0 && hours == 1:
+		return "1 hour"
+	case d%(24*time.Hour) != 0:
+		return fmt.Sprintf("%d hours", hours)
+	case days == 1:
+		return "1 day"
+	default:
+		return fmt.Sprintf("%d days", days)
+	}
+}
+
+// errInvitationEmailMismatch is returned when someone uses an email invitation sent to another address
+func errInvitationEmailMismatch() error {
+	return domain.NewAuthorizationError("INVITATION_EMAIL_MISMATCH",
+		"This invitation was sent to a different email address")
+}
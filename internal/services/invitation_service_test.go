@@ -0,0 +1,201 @@
+package services
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+var invitationLinkToken = regexp.MustCompile(`/invitations\?token=([0-9a-f]{64})`)
+
+func TestInvitationService(t *testing.T) {
+	ctx := context.Background()
+	projectRepo := testutil.NewMockProjectRepository()
+	userRepo := testutil.NewMockUserRepository()
+	invitationRepo := testutil.NewMockProjectInvitationRepository()
+	outboxRepo := testutil.NewMockEmailOutboxRepository()
+	outbox := newEmailOutboxWithMailer(t, outboxRepo, &recordingMailer{})
+	cfg := &testConfig{jwtSecret: "test-secret-that-is-32-characters-long", jwtExpiration: time.Hour}
+	authService := NewAuthService(userRepo, newMockTokenBlacklistRepository(), newMockPasswordResetTokenRepository(),
+		cfg, outbox)
+	authz := NewAuthorizationService(projectRepo)
+	invitations := NewInvitationService(invitationRepo, projectRepo, userRepo, authService, authz, outbox)
+
+	userRepo.AddUser(testutil.MockUser("owner", "owner@example.com", "owner", "Olive Owner"))
+	userRepo.AddUser(testutil.MockUser("member", "member@example.com", "member", "Max Member"))
+	userRepo.AddUser(testutil.MockUser("ada", "ada@example.com", "ada", "Ada"))
+	userRepo.AddUser(testutil.MockUser("grace", "grace@example.com", "grace", "Grace"))
+
+	project := testutil.MockProject("project-1", "Launch", "launch", "owner")
+	project.Settings.IsPrivate = true
+	project.MemberIDs = []string{"member"}
+	projectRepo.AddProject(project)
+
+	// sentToken returns the token from the invitation email sent to the address, taking the
+	// email out of the outbox so the next invitation to the same address can be told apart
+	sentToken := func(t *testing.T, to string) string {
+		t.Helper()
+		var token string
+		for id, email := range outboxRepo.Emails {
+			if email.To == to && email.Template == EmailProjectInvitation {
+				require.Empty(t, token, "more than one invitation email sent to %s", to)
+				match := invitationLinkToken.FindStringSubmatch(email.TextBody)
+				require.NotNil(t, match, email.TextBody)
+				token = match[1]
+				delete(outboxRepo.Emails, id)
+			}
+		}
+		require.NotEmpty(t, token, "no invitation email sent to %s", to)
+		return token
+	}
+
+	t.Run("OnlyMemberManagersInvite", func(t *testing.T) {
+		_, err := invitations.CreateInvitation(ctx, project.ID, domain.CreateInvitationRequest{}, "member")
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+
+		_, err = invitations.CreateInvitation(ctx, project.ID,
+			domain.CreateInvitationRequest{Role: domain.ProjectOwner}, "owner")
+		assert.Equal(t, domain.ValidationError, webhookErrorType(err))
+
+		_, err = invitations.CreateInvitation(ctx, project.ID,
+			domain.CreateInvitationRequest{Email: "member@example.com"}, "owner")
+		assert.Equal(t, domain.ConflictError, webhookErrorType(err))
+	})
+
+	t.Run("EmailInvitation", func(t *testing.T) {
+		invitation, err := invitations.CreateInvitation(ctx, project.ID, domain.CreateInvitationRequest{
+			Email: "ada@example.com", Role: domain.ProjectCommenter,
+		}, "owner")
+		require.NoError(t, err)
+		assert.Empty(t, invitation.Token, "email invitation tokens only go out by email")
+		assert.Equal(t, 1, invitation.MaxUses)
+
+		token := sentToken(t, "ada@example.com")
+
+		_, err = invitations.AcceptInvitation(ctx, token, "grace")
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+
+		joined, err := invitations.AcceptInvitation(ctx, token, "ada")
+		require.NoError(t, err)
+		role, _ := joined.RoleOf("ada")
+		assert.Equal(t, domain.ProjectCommenter, role)
+
+		_, err = invitations.AcceptInvitation(ctx, token, "ada")
+		assert.Equal(t, domain.ValidationError, webhookErrorType(err))
+	})
+
+	t.Run("ReinvitingRevokesTheEarlierInvitation", func(t *testing.T) {
+		_, err := invitations.CreateInvitation(ctx, project.ID,
+			domain.CreateInvitationRequest{Email: "new@example.com"}, "owner")
+		require.NoError(t, err)
+		first := sentToken(t, "new@example.com")
+
+		_, err = invitations.CreateInvitation(ctx, project.ID,
+			domain.CreateInvitationRequest{Email: "new@example.com"}, "owner")
+		require.NoError(t, err)
+
+		_, _, err = invitations.AcceptInvitationAsNewUser(ctx, domain.RegisterWithInvitationRequest{
+			Token: first, Username: "newbie", Name: "New", Password: "password123",
+		})
+		assert.Equal(t, domain.ValidationError, webhookErrorType(err))
+	})
+
+	t.Run("NewUsersRegister", func(t *testing.T) {
+		token := sentToken(t, "new@example.com")
+
+		_, _, err := invitations.AcceptInvitationAsNewUser(ctx, domain.RegisterWithInvitationRequest{
+			Token: token, Email: "other@example.com", Username: "newbie", Name: "New", Password: "password123",
+		})
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+
+		user, joined, err := invitations.AcceptInvitationAsNewUser(ctx, domain.RegisterWithInvitationRequest{
+			Token: token, Username: "newbie", Name: "New", Password: "password123",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "new@example.com", user.Email)
+		assert.Equal(t, domain.RegularUserRole, user.Role)
+		role, _ := joined.RoleOf(user.ID)
+		assert.Equal(t, domain.ProjectMember, role)
+	})
+
+	t.Run("MultiUseLink", func(t *testing.T) {
+		invitation, err := invitations.CreateInvitation(ctx, project.ID, domain.CreateInvitationRequest{
+			Role: domain.ProjectViewer, MaxUses: 2,
+		}, "owner")
+		require.NoError(t, err)
+		require.NotEmpty(t, invitation.Token)
+
+		_, err = invitations.AcceptInvitation(ctx, invitation.Token, "grace")
+		require.NoError(t, err)
+
+		err = invitations.DeclineInvitation(ctx, invitation.Token, "owner")
+		assert.Equal(t, domain.ValidationError, webhookErrorType(err), "links can't be declined")
+
+		_, _, err = invitations.AcceptInvitationAsNewUser(ctx, domain.RegisterWithInvitationRequest{
+			Token: invitation.Token, Username: "linked", Name: "Linked", Password: "password123",
+		})
+		assert.Equal(t, domain.ValidationError, webhookErrorType(err), "link registrations need an email")
+
+		_, _, err = invitations.AcceptInvitationAsNewUser(ctx, domain.RegisterWithInvitationRequest{
+			Token: invitation.Token, Email: "linked@example.com", Username: "linked", Name: "Linked",
+			Password: "password123",
+		})
+		require.NoError(t, err)
+
+		stored, err := invitationRepo.GetByID(ctx, invitation.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.InvitationAccepted, stored.Status)
+		assert.Equal(t, 2, stored.UseCount)
+	})
+
+	t.Run("DeclineListAndRevoke", func(t *testing.T) {
+		_, err := invitations.CreateInvitation(ctx, project.ID,
+			domain.CreateInvitationRequest{Email: "decliner@example.com"}, "owner")
+		require.NoError(t, err)
+		userRepo.AddUser(testutil.MockUser("decliner", "decliner@example.com", "decliner", "Decliner"))
+		require.NoError(t, invitations.DeclineInvitation(ctx, sentToken(t, "decliner@example.com"), "decliner"))
+
+		link, err := invitations.CreateInvitation(ctx, project.ID, domain.CreateInvitationRequest{}, "owner")
+		require.NoError(t, err)
+
+		_, err = invitations.ListInvitations(ctx, project.ID, "member")
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+
+		pending, err := invitations.ListInvitations(ctx, project.ID, "owner")
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		assert.Equal(t, link.ID, pending[0].ID)
+		assert.Empty(t, pending[0].Token)
+
+		require.NoError(t, invitations.RevokeInvitation(ctx, project.ID, link.ID, "owner"))
+		err = invitations.RevokeInvitation(ctx, project.ID, link.ID, "owner")
+		assert.Equal(t, domain.ValidationError, webhookErrorType(err))
+
+		_, err = invitations.AcceptInvitation(ctx, link.Token, "member")
+		assert.Equal(t, domain.ValidationError, webhookErrorType(err))
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		invitation, err := invitations.CreateInvitation(ctx, project.ID, domain.CreateInvitationRequest{}, "owner")
+		require.NoError(t, err)
+		invitationRepo.Invitations[invitation.ID].ExpiresAt = time.Now().Add(-time.Minute)
+
+		_, err = invitations.AcceptInvitation(ctx, invitation.Token, "grace")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "INVITATION_EXPIRED")
+	})
+}
+
+func TestDescribeExpiry(t *testing.T) {
+	assert.Equal(t, "7 days", describeExpiry(domain.DefaultInvitationExpiry))
+	assert.Equal(t, "1 day", describeExpiry(24*time.Hour))
+	assert.Equal(t, "36 hours", describeExpiry(36*time.Hour))
+	assert.Equal(t, "1 hour", describeExpiry(time.Hour))
+}
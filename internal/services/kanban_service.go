@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"log/slog"
 	"time"
 
 	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
@@ -10,10 +11,12 @@ import (
 
 // KanbanBoard represents the complete kanban board state
 type KanbanBoard struct {
-	ProjectID string                        `json:"project_id"`
-	Columns   map[domain.TaskStatus]*Column `json:"columns"`
-	Stats     *BoardStatistics              `json:"stats"`
-	UpdatedAt time.Time                     `json:"updated_at"`
+	ProjectID   string                        `json:"project_id"`
+	Columns     map[domain.TaskStatus]*Column `json:"columns"`
+	ColumnOrder []domain.TaskStatus           `json:"column_order"` // column statuses in board order
+	Stats       *BoardStatistics              `json:"stats"`
+	WIPWarnings []*WIPViolation               `json:"wip_warnings"`
+	UpdatedAt   time.Time                     `json:"updated_at"`
 }
 
 // Column represents a single column in the kanban board
@@ -60,6 +63,11 @@ type KanbanService interface {
 
 	// ValidateMove checks if a move is allowed based on WIP limits and business rules
 	ValidateMove(ctx context.Context, req MoveTaskRequest, userID string) error
+
+	// ListWIPOverrides returns who moved tasks past a hard WIP limit and why, newest first
+	ListWIPOverrides(
+		ctx context.Context, projectID string, userID string, offset, limit int,
+	) ([]*domain.WIPLimitOverride, error)
 }
 
 // kanbanService implements the KanbanService interface
@@ -67,6 +75,9 @@ type kanbanService struct {
 	taskRepo    repository.TaskRepository
 	projectRepo repository.ProjectRepository
 	taskService TaskService
+	wipManager  WIPManager
+	cache       CacheManager
+	authz       AuthorizationService
 }
 
 // NewKanbanService creates a new kanban service
@@ -74,11 +85,17 @@ func NewKanbanService(
 	taskRepo repository.TaskRepository,
 	projectRepo repository.ProjectRepository,
 	taskService TaskService,
+	wipManager WIPManager,
+	cache CacheManager,
+	authz AuthorizationService,
 ) KanbanService {
 	return &kanbanService{
 		taskRepo:    taskRepo,
 		projectRepo: projectRepo,
 		taskService: taskService,
+		wipManager:  wipManager,
+		cache:       cache,
+		authz:       authz,
 	}
 }
 
@@ -94,8 +111,13 @@ func (s *kanbanService) GetBoard(ctx context.Context, projectID string, userID s
 		return nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
 	}
 
-	if !project.HasAccess(userID) && project.Settings.IsPrivate {
-		return nil, domain.NewAuthorizationError("ACCESS_DENIED", "You don't have access to this project")
+	if err := s.authz.Check(ctx, project, userID, domain.PermissionViewProject); err != nil {
+		return nil, err
+	}
+
+	// Serve the cached board when available; task writes invalidate it
+	if cached, cacheErr := s.cache.GetCachedBoardState(ctx, projectID); cacheErr == nil && cached != nil {
+		return cached, nil
 	}
 
 	// Get all tasks for the project
@@ -110,51 +132,58 @@ func (s *kanbanService) GetBoard(ctx context.Context, projectID string, userID s
 		return nil, domain.NewInternalError("BOARD_LOAD_FAILED", "Failed to load board tasks", err)
 	}
 
-	// Organize tasks into columns
-	columns := s.organizeTasks(tasks)
+	// Cards show whether they're blocked; a dependency completing invalidates the board
+	if err := markBlocked(ctx, s.taskRepo, newWorkflowLookup(s.projectRepo, project), tasks); err != nil {
+		return nil, err
+	}
+
+	limits, err := s.wipManager.GetProjectWIPLimits(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Organize tasks into the workflow's columns
+	workflow := project.Workflow()
+	columns := s.organizeTasks(tasks, workflow, limits)
 
 	// Calculate statistics
-	stats := s.calculateStatistics(tasks)
+	stats := s.calculateStatistics(tasks, workflow)
 
 	// Create board
 	board := &KanbanBoard{
-		ProjectID: projectID,
-		Columns:   columns,
-		Stats:     stats,
-		UpdatedAt: time.Now().UTC(),
+		ProjectID:   projectID,
+		Columns:     columns,
+		ColumnOrder: workflow.StatusIDs(),
+		Stats:       stats,
+		WIPWarnings: s.collectWIPWarnings(projectID, workflow, columns),
+		UpdatedAt:   time.Now().UTC(),
+	}
+
+	if err := s.cache.CacheBoardState(ctx, projectID, board); err != nil {
+		slog.Warn("Failed to cache board state", "project_id", projectID, "error", err)
 	}
 
 	return board, nil
 }
 
-// organizeTasks groups tasks by status into columns
-func (s *kanbanService) organizeTasks(tasks []*domain.Task) map[domain.TaskStatus]*Column {
+// organizeTasks groups tasks by status into one column per workflow status
+func (s *kanbanService) organizeTasks(
+	tasks []*domain.Task, workflow *domain.Workflow, limits map[domain.TaskStatus]*WIPLimits,
+) map[domain.TaskStatus]*Column {
 	columns := make(map[domain.TaskStatus]*Column)
 
-	// Initialize all columns
-	statuses := []domain.TaskStatus{
-		domain.StatusBacklog,
-		domain.StatusTodo,
-		domain.StatusDeveloping,
-		domain.StatusReview,
-		domain.StatusComplete,
-	}
-
-	titles := map[domain.TaskStatus]string{
-		domain.StatusBacklog:    "Backlog",
-		domain.StatusTodo:       "To Do",
-		domain.StatusDeveloping: "In Progress",
-		domain.StatusReview:     "Review",
-		domain.StatusComplete:   "Complete",
-	}
+	for _, status := range workflow.Statuses {
+		wip := limits[status.ID]
+		if wip == nil {
+			wip = &WIPLimits{Enabled: false}
+		}
 
-	for _, status := range statuses {
-		columns[status] = &Column{
-			Status: status,
-			Title:  titles[status],
+		columns[status.ID] = &Column{
+			Status: status.ID,
+			Title:  status.Name,
 			Tasks:  []*domain.Task{},
 			Count:  0,
-			WIP:    &WIPLimits{Enabled: false}, // Default WIP limits off
+			WIP:    wip,
 		}
 	}
 
@@ -173,8 +202,22 @@ func (s *kanbanService) organizeTasks(tasks []*domain.Task) map[domain.TaskStatu
 	return columns
 }
 
+// collectWIPWarnings reports every column that has reached its soft or hard WIP limit
+func (s *kanbanService) collectWIPWarnings(
+	projectID string, workflow *domain.Workflow, columns map[domain.TaskStatus]*Column,
+) []*WIPViolation {
+	warnings := []*WIPViolation{}
+	for _, status := range workflow.StatusIDs() {
+		column := columns[status]
+		if violation := newWIPViolation(projectID, status, column.Count, column.WIP); violation != nil {
+			warnings = append(warnings, violation)
+		}
+	}
+	return warnings
+}
+
 // calculateStatistics computes board analytics
-func (s *kanbanService) calculateStatistics(tasks []*domain.Task) *BoardStatistics {
+func (s *kanbanService) calculateStatistics(tasks []*domain.Task, workflow *domain.Workflow) *BoardStatistics {
 	stats := &BoardStatistics{
 		TasksByStatus:   make(map[domain.TaskStatus]int),
 		TasksByPriority: make(map[domain.TaskPriority]int),
@@ -192,7 +235,7 @@ func (s *kanbanService) calculateStatistics(tasks []*domain.Task) *BoardStatisti
 		stats.TasksByPriority[task.Priority]++
 
 		// Check if task is overdue
-		if task.DueDate != nil && task.DueDate.Before(now) && task.Status != domain.StatusComplete {
+		if task.DueDate != nil && task.DueDate.Before(now) && !workflow.IsDone(task.Status) {
 			stats.OverdueTasks++
 		}
 
@@ -207,20 +250,13 @@ func (s *kanbanService) calculateStatistics(tasks []*domain.Task) *BoardStatisti
 
 // MoveTask moves a task between columns with position management
 func (s *kanbanService) MoveTask(ctx context.Context, req MoveTaskRequest, userID string) error {
-	// First validate the move
+	// First validate the move against the board's rules
 	if err := s.ValidateMove(ctx, req, userID); err != nil {
 		return err
 	}
 
-	// Get the task to move
-	task, err := s.taskRepo.GetByID(ctx, req.TaskID)
-	if err != nil {
-		return err
-	}
-
 	// Calculate new position if needed
-	newPosition := req.NewPosition
-	if newPosition == 0 {
+	if req.NewPosition == 0 {
 		// Calculate next position for the target column
 		filters := repository.TaskFilters{
 			Status:    []domain.TaskStatus{req.NewStatus},
@@ -235,37 +271,28 @@ func (s *kanbanService) MoveTask(ctx context.Context, req MoveTaskRequest, userI
 		}
 
 		if len(columnTasks) > 0 {
-			newPosition = columnTasks[0].Position + 1000 // Use large increments for easy reordering
+			req.NewPosition = columnTasks[0].Position + 1000 // Use large increments for easy reordering
 		} else {
-			newPosition = 1000 // First task in column
+			req.NewPosition = 1000 // First task in column
 		}
 	}
 
-	// Update task status and position using business logic
-	if err := task.UpdateStatus(req.NewStatus); err != nil {
+	// The task service enforces WIP limits, records any override and writes the history entry
+	if err := s.taskService.MoveTask(ctx, req, userID); err != nil {
 		return err
 	}
 
-	// Update position
-	task.Position = newPosition
-	task.UpdatedAt = time.Now().UTC()
-
-	// Save changes
-	if err := s.taskRepo.Update(ctx, task); err != nil {
-		return domain.NewInternalError("TASK_MOVE_FAILED", "Failed to move task", err)
-	}
-
 	// Reorder other tasks in the target column if necessary
 	if err := s.reorderColumnTasks(ctx, req.ProjectID, req.NewStatus); err != nil {
-		// Log error but don't fail the move
-		// In production, use structured logging here
-		_ = err
+		// A failed reorder leaves gaps in positions but the move itself succeeded
+		slog.Warn("Failed to reorder column tasks", "project_id", req.ProjectID, "status", req.NewStatus, "error", err)
 	}
 
 	return nil
 }
 
-// ValidateMove checks if a move is allowed based on WIP limits and business rules
+// ValidateMove checks if a move is allowed based on WIP limits and business rules.
+// A valid WIP override lets a move past a hard limit pass.
 func (s *kanbanService) ValidateMove(ctx context.Context, req MoveTaskRequest, userID string) error {
 	// Basic validation
 	if req.TaskID == "" {
@@ -295,22 +322,27 @@ func (s *kanbanService) ValidateMove(ctx context.Context, req MoveTaskRequest, u
 		return domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
 	}
 
-	if !project.HasAccess(userID) {
-		return domain.NewAuthorizationError("ACCESS_DENIED", "You don't have access to modify this task")
+	if err := s.authz.Check(ctx, project, userID, domain.PermissionEditTasks); err != nil {
+		return err
 	}
 
-	// Check if status transition is allowed
-	if !task.CanTransitionTo(req.NewStatus) {
+	// Check the status belongs to the project's workflow and the transition is allowed
+	workflow := project.Workflow()
+	if err := workflow.ValidateStatus(req.NewStatus); err != nil {
+		return err
+	}
+	if !workflow.CanTransition(task.Status, req.NewStatus) {
 		return domain.NewConflictError("INVALID_TRANSITION",
 			"Task cannot transition from "+string(task.Status)+" to "+string(req.NewStatus))
 	}
 
-	// TODO: Check WIP limits when they are implemented
-	// if err := s.checkWIPLimits(ctx, req.ProjectID, req.NewStatus); err != nil {
-	//     return err
-	// }
+	workflows := newWorkflowLookup(s.projectRepo, project)
+	if err := checkBlockedMove(ctx, s.taskRepo, workflows, task, req.NewStatus, req.OverrideBlocked); err != nil {
+		return err
+	}
 
-	return nil
+	_, err = checkWIPMove(ctx, s.wipManager, req.ProjectID, task.Status, req.NewStatus, req.WIPOverride)
+	return err
 }
 
 // reorderColumnTasks reorders tasks in a column to prevent position conflicts
@@ -347,7 +379,7 @@ func (s *kanbanService) reorderColumnTasks(ctx context.Context, projectID string
 
 // UpdateWIPLimits updates work-in-progress limits for a column
 func (s *kanbanService) UpdateWIPLimits(
-	ctx context.Context, projectID string, _ domain.TaskStatus, limits WIPLimits, userID string,
+	ctx context.Context, projectID string, status domain.TaskStatus, limits WIPLimits, userID string,
 ) error {
 	// Check if user has access to the project
 	project, err := s.projectRepo.GetByID(ctx, projectID)
@@ -355,9 +387,8 @@ func (s *kanbanService) UpdateWIPLimits(
 		return domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
 	}
 
-	// Only project owners can modify WIP limits
-	if !project.IsOwner(userID) {
-		return domain.NewAuthorizationError("ACCESS_DENIED", "Only project owners can modify WIP limits")
+	if err := s.authz.Check(ctx, project, userID, domain.PermissionManageWIPLimits); err != nil {
+		return err
 	}
 
 	// Validate limits
@@ -370,14 +401,38 @@ func (s *kanbanService) UpdateWIPLimits(
 		}
 	}
 
-	// TODO: Store WIP limits in project settings or dedicated table
-	// For now, this is a placeholder implementation
-	// In a real implementation, you'd store these in the database
-	// associated with the project and column status
+	if err := s.wipManager.SetWIPLimits(ctx, projectID, status, limits); err != nil {
+		return err
+	}
+
+	// Columns carry their limits, so the cached board is now stale
+	if err := s.cache.InvalidateBoardState(ctx, projectID); err != nil {
+		slog.Warn("Failed to invalidate board cache", "project_id", projectID, "error", err)
+	}
 
 	return nil
 }
 
+// ListWIPOverrides returns who moved tasks past a hard WIP limit and why, newest first
+func (s *kanbanService) ListWIPOverrides(
+	ctx context.Context, projectID string, userID string, offset, limit int,
+) ([]*domain.WIPLimitOverride, error) {
+	if projectID == "" {
+		return nil, domain.NewValidationError("INVALID_PROJECT_ID", "Project ID cannot be empty", nil)
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
+	}
+
+	if err := s.authz.Check(ctx, project, userID, domain.PermissionViewProject); err != nil {
+		return nil, err
+	}
+
+	return s.wipManager.ListOverrides(ctx, projectID, offset, limit)
+}
+
 // GetBoardStatistics returns analytics for the board
 func (s *kanbanService) GetBoardStatistics(
 	ctx context.Context, projectID string, userID string,
@@ -392,8 +447,12 @@ func (s *kanbanService) GetBoardStatistics(
 		return nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
 	}
 
-	if !project.HasAccess(userID) && project.Settings.IsPrivate {
-		return nil, domain.NewAuthorizationError("ACCESS_DENIED", "You don't have access to this project")
+	if err := s.authz.Check(ctx, project, userID, domain.PermissionViewProject); err != nil {
+		return nil, err
+	}
+
+	if cached, cacheErr := s.cache.GetCachedStatistics(ctx, projectID); cacheErr == nil && cached != nil {
+		return cached, nil
 	}
 
 	// Get all tasks for statistics
@@ -406,7 +465,10 @@ func (s *kanbanService) GetBoardStatistics(
 		return nil, domain.NewInternalError("STATS_LOAD_FAILED", "Failed to load board statistics", err)
 	}
 
-	// Calculate and return statistics
-	stats := s.calculateStatistics(tasks)
+	// Calculate, cache and return statistics
+	stats := s.calculateStatistics(tasks, project.Workflow())
+	if err := s.cache.CacheStatistics(ctx, projectID, stats); err != nil {
+		slog.Warn("Failed to cache board statistics", "project_id", projectID, "error", err)
+	}
 	return stats, nil
 }
@@ -0,0 +1,247 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+func TestKanbanService_WIPLimits(t *testing.T) {
+	ctx := context.Background()
+
+	mockTaskRepo := testutil.NewMockTaskRepository()
+	projectRepo := testutil.NewMockProjectRepository()
+	userRepo := testutil.NewMockUserRepository()
+	wipRepo := testutil.NewMockWIPLimitRepository()
+	cache := NewCacheManager(NewMemoryCacheBackend("test:"), DefaultCacheConfig())
+	taskRepo := NewCacheInvalidatingTaskRepository(mockTaskRepo, cache)
+
+	wipManager := NewWIPManager(taskRepo, projectRepo, wipRepo)
+	taskService := NewTaskService(
+		taskRepo, projectRepo, userRepo, testutil.NewMockTaskHistoryRepository(),
+		NewAuthorizationService(projectRepo), wipManager, nil,
+	)
+	service := NewKanbanService(
+		taskRepo, projectRepo, taskService, wipManager, cache, NewAuthorizationService(projectRepo),
+	)
+
+	owner := &domain.User{ID: "owner", Email: "owner@test.com", Username: "owner"}
+	member := &domain.User{ID: "member", Email: "member@test.com", Username: "member"}
+	userRepo.AddUser(owner)
+	userRepo.AddUser(member)
+
+	project := &domain.Project{
+		ID:        "wip-proj",
+		Title:     "WIP Project",
+		Slug:      "wip",
+		OwnerID:   owner.ID,
+		MemberIDs: []string{member.ID},
+		Status:    domain.ActiveProject,
+	}
+	projectRepo.AddProject(project)
+
+	addTask := func(id string, status domain.TaskStatus) *domain.Task {
+		task := &domain.Task{
+			ID:         id,
+			Title:      "Task " + id,
+			ProjectID:  project.ID,
+			ReporterID: owner.ID,
+			Status:     status,
+			Priority:   domain.PriorityMedium,
+		}
+		mockTaskRepo.AddTask(task)
+		return task
+	}
+
+	addTask("dev-1", domain.StatusDeveloping)
+	addTask("dev-2", domain.StatusDeveloping)
+	addTask("review-1", domain.StatusReview)
+	todo := addTask("todo-1", domain.StatusTodo)
+
+	t.Run("GetWIPLimits_DisabledUntilConfigured", func(t *testing.T) {
+		projectLimits, err := wipManager.GetProjectWIPLimits(ctx, project.ID)
+		require.NoError(t, err)
+		for status, limits := range projectLimits {
+			assert.False(t, limits.Enabled, "default limits for %s must not be enforced", status)
+		}
+	})
+
+	t.Run("UpdateWIPLimits_PersistsPerStatus", func(t *testing.T) {
+		err := service.UpdateWIPLimits(ctx, project.ID, domain.StatusDeveloping,
+			WIPLimits{SoftLimit: 1, HardLimit: 2, Enabled: true}, owner.ID)
+		require.NoError(t, err)
+
+		err = service.UpdateWIPLimits(ctx, project.ID, domain.StatusReview,
+			WIPLimits{SoftLimit: 1, HardLimit: 5, Enabled: true}, owner.ID)
+		require.NoError(t, err)
+
+		limits, err := wipManager.GetWIPLimits(ctx, project.ID, domain.StatusDeveloping)
+		require.NoError(t, err)
+		assert.Equal(t, 2, limits.HardLimit)
+		assert.Equal(t, 1, limits.SoftLimit)
+	})
+
+	t.Run("UpdateWIPLimits_OnlyOwner", func(t *testing.T) {
+		err := service.UpdateWIPLimits(ctx, project.ID, domain.StatusTodo,
+			WIPLimits{SoftLimit: 1, HardLimit: 2, Enabled: true}, member.ID)
+		assert.Error(t, err)
+	})
+
+	t.Run("MoveTask_RejectedAtHardLimit", func(t *testing.T) {
+		err := service.MoveTask(ctx, MoveTaskRequest{
+			TaskID:    todo.ID,
+			ProjectID: project.ID,
+			NewStatus: domain.StatusDeveloping,
+		}, member.ID)
+		require.Error(t, err)
+		assert.True(t, isWIPHardLimitViolation(err))
+		assert.Equal(t, domain.StatusTodo, todo.Status)
+	})
+
+	t.Run("MoveTask_InvalidOverrideReason", func(t *testing.T) {
+		err := service.MoveTask(ctx, MoveTaskRequest{
+			TaskID:      todo.ID,
+			ProjectID:   project.ID,
+			NewStatus:   domain.StatusDeveloping,
+			WIPOverride: &WIPOverride{Reason: "because"},
+		}, member.ID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "INVALID_OVERRIDE_REASON")
+	})
+
+	t.Run("MoveTask_OverrideRecordsWhoAndWhy", func(t *testing.T) {
+		err := service.MoveTask(ctx, MoveTaskRequest{
+			TaskID:    todo.ID,
+			ProjectID: project.ID,
+			NewStatus: domain.StatusDeveloping,
+			WIPOverride: &WIPOverride{
+				Reason:  OverrideHotfix,
+				Comment: "Production outage",
+				UserID:  "spoofed",
+			},
+		}, member.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.StatusDeveloping, todo.Status)
+
+		require.Len(t, wipRepo.Overrides, 1)
+		override := wipRepo.Overrides[0]
+		assert.Equal(t, member.ID, override.UserID)
+		assert.Equal(t, string(OverrideHotfix), override.Reason)
+		assert.Equal(t, "Production outage", override.Comment)
+		assert.Equal(t, 2, override.HardLimit)
+		assert.Equal(t, 2, override.ColumnCount)
+	})
+
+	t.Run("TaskService_StatusChangesRespectHardLimit", func(t *testing.T) {
+		queued := addTask("todo-2", domain.StatusTodo)
+
+		_, err := taskService.UpdateTaskStatus(ctx, queued.ID, domain.StatusDeveloping, member.ID)
+		require.Error(t, err)
+		assert.True(t, isWIPHardLimitViolation(err))
+
+		developing := domain.StatusDeveloping
+		_, err = taskService.UpdateTask(ctx, queued.ID, domain.UpdateTaskRequest{Status: &developing}, member.ID)
+		require.Error(t, err)
+		assert.True(t, isWIPHardLimitViolation(err))
+
+		err = taskService.MoveTask(ctx, MoveTaskRequest{
+			TaskID:    queued.ID,
+			ProjectID: project.ID,
+			NewStatus: domain.StatusDeveloping,
+		}, member.ID)
+		require.Error(t, err)
+		assert.True(t, isWIPHardLimitViolation(err))
+		assert.Equal(t, domain.StatusTodo, queued.Status)
+	})
+
+	t.Run("TaskService_MoveTaskHonoursOverride", func(t *testing.T) {
+		err := taskService.MoveTask(ctx, MoveTaskRequest{
+			TaskID:      "todo-2",
+			ProjectID:   project.ID,
+			NewStatus:   domain.StatusDeveloping,
+			WIPOverride: &WIPOverride{Reason: OverrideBlocker, Comment: "Unblocks release"},
+		}, owner.ID)
+		require.NoError(t, err)
+
+		require.Len(t, wipRepo.Overrides, 2)
+		assert.Equal(t, owner.ID, wipRepo.Overrides[1].UserID)
+		assert.Equal(t, "todo-2", wipRepo.Overrides[1].TaskID)
+
+		overrides, err := service.ListWIPOverrides(ctx, project.ID, member.ID, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, overrides, 2)
+		assert.Equal(t, "todo-2", overrides[0].TaskID)
+
+		_, err = service.ListWIPOverrides(ctx, project.ID, "outsider", 0, 10)
+		assert.Error(t, err)
+
+		// Move it back so later subtests see the original column counts
+		_, err = taskService.UpdateTaskStatus(ctx, "todo-2", domain.StatusTodo, owner.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("GetBoard_ReportsWIPWarnings", func(t *testing.T) {
+		board, err := service.GetBoard(ctx, project.ID, owner.ID)
+		require.NoError(t, err)
+
+		developing := board.Columns[domain.StatusDeveloping]
+		assert.Equal(t, 3, developing.Count)
+		assert.Equal(t, 2, developing.WIP.HardLimit)
+
+		warnings := make(map[domain.TaskStatus]*WIPViolation)
+		for _, warning := range board.WIPWarnings {
+			warnings[warning.Status] = warning
+		}
+
+		require.Contains(t, warnings, domain.StatusDeveloping)
+		assert.Equal(t, "hard", warnings[domain.StatusDeveloping].ViolationType)
+		require.Contains(t, warnings, domain.StatusReview)
+		assert.Equal(t, "soft", warnings[domain.StatusReview].ViolationType)
+		assert.Equal(t, "warning", warnings[domain.StatusReview].Severity)
+		assert.NotContains(t, warnings, domain.StatusTodo)
+	})
+
+	t.Run("GetBoard_ServedFromCacheUntilTaskWrite", func(t *testing.T) {
+		board, err := service.GetBoard(ctx, project.ID, owner.ID)
+		require.NoError(t, err)
+		require.Equal(t, 1, board.Columns[domain.StatusReview].Count)
+
+		// Writes that bypass the repository wrapper aren't seen until invalidation
+		addTask("review-2", domain.StatusReview)
+		cached, err := service.GetBoard(ctx, project.ID, owner.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, cached.Columns[domain.StatusReview].Count)
+
+		_, err = taskService.UpdateTaskStatus(ctx, "review-1", domain.StatusComplete, owner.ID)
+		require.NoError(t, err)
+
+		fresh, err := service.GetBoard(ctx, project.ID, owner.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, fresh.Columns[domain.StatusReview].Count)
+		assert.Equal(t, 1, fresh.Columns[domain.StatusComplete].Count)
+		assert.Equal(t, "review-2", fresh.Columns[domain.StatusReview].Tasks[0].ID)
+	})
+
+	t.Run("GetBoard_CachedBoardStillChecksAccess", func(t *testing.T) {
+		project.Settings.IsPrivate = true
+		defer func() { project.Settings.IsPrivate = false }()
+
+		_, err := service.GetBoard(ctx, project.ID, "outsider")
+		assert.Error(t, err)
+	})
+
+	t.Run("ValidateMove_SoftLimitDoesNotBlock", func(t *testing.T) {
+		dev := addTask("dev-3", domain.StatusDeveloping)
+		err := service.ValidateMove(ctx, MoveTaskRequest{
+			TaskID:    dev.ID,
+			ProjectID: project.ID,
+			NewStatus: domain.StatusReview,
+		}, member.ID)
+		assert.NoError(t, err)
+	})
+}
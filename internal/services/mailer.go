@@ -0,0 +1,237 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"time"
+)
+
+// defaultSMTPTimeout bounds a whole SMTP conversation
+const defaultSMTPTimeout = 30 * time.Second
+
+// EmailMessage is a rendered email ready to be sent
+type EmailMessage struct {
+	To       string
+	Subject  string
+	TextBody string
+	HTMLBody string
+}
+
+// Mailer delivers email messages
+type Mailer interface {
+	// Send delivers a message, returning an error when the mail server did not accept it
+	Send(ctx context.Context, msg *EmailMessage) error
+}
+
+// SMTPSettings configures the SMTP mailer
+type SMTPSettings struct {
+	Host     string
+	Username string // empty disables authentication
+	Password string
+	From     string
+	Port     int
+	Timeout  time.Duration // defaults to 30 seconds
+	StartTLS bool          // require upgrading the connection with STARTTLS
+}
+
+type smtpMailer struct {
+	settings SMTPSettings
+}
+
+// NewSMTPMailer creates a mailer that delivers through an SMTP server
+func NewSMTPMailer(settings SMTPSettings) Mailer {
+	if settings.Timeout <= 0 {
+		settings.Timeout = defaultSMTPTimeout
+	}
+	return &smtpMailer{settings: settings}
+}
+
+// Send delivers a message as multipart/alternative with plain-text and HTML parts
+func (m *smtpMailer) Send(ctx context.Context, msg *EmailMessage) error {
+	from, err := mail.ParseAddress(m.settings.From)
+	if err != nil {
+		return fmt.Errorf("invalid sender address: %w", err)
+	}
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return fmt.Errorf("invalid recipient address: %w", err)
+	}
+
+	body, err := buildMIMEMessage(from, to, msg)
+	if err != nil {
+		return err
+	}
+
+	dialer := net.Dialer{Timeout: m.settings.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(m.settings.Host, strconv.Itoa(m.settings.Port)))
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server: %w", err)
+	}
+
+	deadline := time.Now().Add(m.settings.Timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("failed to set SMTP deadline: %w", err)
+	}
+
+	client, err := smtp.NewClient(conn, m.settings.Host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("failed to start SMTP session: %w", err)
+	}
+	defer func() { _ = client.Close() }()
+
+	if err := m.secure(client); err != nil {
+		return err
+	}
+
+	if err := client.Mail(from.Address); err != nil {
+		return fmt.Errorf("SMTP server rejected sender: %w", err)
+	}
+	if err := client.Rcpt(to.Address); err != nil {
+		return fmt.Errorf("SMTP server rejected recipient: %w", err)
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("SMTP server refused message data: %w", err)
+	}
+	if _, err := writer.Write(body); err != nil {
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("SMTP server rejected message: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// secure upgrades the connection and authenticates as configured
+func (m *smtpMailer) secure(client *smtp.Client) error {
+	if m.settings.StartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			return fmt.Errorf("SMTP server does not support STARTTLS")
+		}
+		if err := client.StartTLS(&tls.Config{ServerName: m.settings.Host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("failed to start TLS: %w", err)
+		}
+	}
+
+	if m.settings.Username != "" {
+		auth := smtp.PlainAuth("", m.settings.Username, m.settings.Password, m.settings.Host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("SMTP authentication failed: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// buildMIMEMessage renders the headers and multipart body of a message
+func buildMIMEMessage(from, to *mail.Address, msg *EmailMessage) ([]byte, error) {
+	var body bytes.Buffer
+	parts := multipart.NewWriter(&body)
+
+	for _, part := range []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=utf-8", msg.TextBody},
+		{"text/html; charset=utf-8", msg.HTMLBody},
+	} {
+		if part.content == "" {
+			continue
+		}
+		writer, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create message part: %w", err)
+		}
+		encoder := quotedprintable.NewWriter(writer)
+		if _, err := encoder.Write([]byte(part.content)); err != nil {
+			return nil, fmt.Errorf("failed to encode message part: %w", err)
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, fmt.Errorf("failed to encode message part: %w", err)
+		}
+	}
+	if err := parts.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finish message: %w", err)
+	}
+
+	messageID, err := newMessageID(from.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	var message bytes.Buffer
+	headers := [][2]string{
+		{"From", from.String()},
+		{"To", to.String()},
+		{"Subject", mime.QEncoding.Encode("utf-8", msg.Subject)},
+		{"Date", time.Now().UTC().Format(time.RFC1123Z)},
+		{"Message-ID", messageID},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "multipart/alternative; boundary=" + parts.Boundary()},
+	}
+	for _, header := range headers {
+		fmt.Fprintf(&message, "%s: %s\r\n", header[0], header[1])
+	}
+	message.WriteString("\r\n")
+	message.Write(body.Bytes())
+
+	return message.Bytes(), nil
+}
+
+// newMessageID creates a unique Message-ID in the sender's domain
+func newMessageID(sender string) (string, error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", fmt.Errorf("failed to generate message ID: %w", err)
+	}
+
+	domainPart := "localhost"
+	if at := bytes.LastIndexByte([]byte(sender), '@'); at >= 0 {
+		domainPart = sender[at+1:]
+	}
+	return "<" + hex.EncodeToString(random) + "@" + domainPart + ">", nil
+}
+
+type logMailer struct {
+	logger *slog.Logger
+}
+
+// NewLogMailer creates a mailer for environments without SMTP. It logs who an email
+// was for and its subject but never the body, which may hold one-time links.
+func NewLogMailer(logger *slog.Logger) Mailer {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &logMailer{logger: logger}
+}
+
+// Send logs the message envelope instead of delivering it
+func (m *logMailer) Send(_ context.Context, msg *EmailMessage) error {
+	m.logger.Warn("SMTP is not configured; email not delivered",
+		"to", msg.To,
+		"subject", msg.Subject)
+	return nil
+}
@@ -0,0 +1,244 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/config"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
+)
+
+// MFAService manages TOTP two-factor authentication for local accounts.
+type MFAService interface {
+	// Status describes a user's two-factor authentication
+	Status(ctx context.Context, userID string) (*domain.MFAStatus, error)
+
+	// IsEnabled reports whether a user has confirmed two-factor authentication
+	IsEnabled(ctx context.Context, userID string) (bool, error)
+
+	// BeginEnrollment generates a new secret for the user to add to an authenticator app.
+	// It isn't enabled until ConfirmEnrollment.
+	BeginEnrollment(ctx context.Context, userID string) (*domain.MFAEnrollment, error)
+
+	// ConfirmEnrollment enables two-factor authentication once the user enters a code from
+	// their app, and returns their recovery codes, which can't be retrieved again
+	ConfirmEnrollment(ctx context.Context, userID string, code string) ([]string, error)
+
+	// Verify checks a TOTP code or spends a recovery code
+	Verify(ctx context.Context, userID string, code string) error
+
+	// RegenerateRecoveryCodes replaces a user's recovery codes after checking a code
+	RegenerateRecoveryCodes(ctx context.Context, userID string, code string) ([]string, error)
+
+	// Disable turns off two-factor authentication after checking a code, and signs the user
+	// out everywhere
+	Disable(ctx context.Context, userID string, code string) error
+}
+
+type mfaService struct {
+	mfaRepo  repository.UserMFARepository
+	userRepo repository.UserRepository
+	config   config.SecurityConfig
+}
+
+// NewMFAService creates a new two-factor authentication service.
+func NewMFAService(
+	mfaRepo repository.UserMFARepository,
+	userRepo repository.UserRepository,
+	cfg config.SecurityConfig,
+) MFAService {
+	return &mfaService{
+		mfaRepo:  mfaRepo,
+		userRepo: userRepo,
+		config:   cfg,
+	}
+}
+
+// Status describes a user's two-factor authentication.
+func (s *mfaService) Status(ctx context.Context, userID string) (*domain.MFAStatus, error) {
+	mfa, err := s.get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &domain.MFAStatus{
+		Enabled:  mfa.IsEnabled(),
+		Required: s.config.GetRequireMFA(),
+	}
+	if mfa.IsEnabled() {
+		status.RecoveryCodesRemaining = len(mfa.RecoveryCodes)
+	}
+	return status, nil
+}
+
+// IsEnabled reports whether a user has confirmed two-factor authentication.
+func (s *mfaService) IsEnabled(ctx context.Context, userID string) (bool, error) {
+	mfa, err := s.get(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return mfa.IsEnabled(), nil
+}
+
+// BeginEnrollment generates a new secret for the user to add to an authenticator app.
+func (s *mfaService) BeginEnrollment(ctx context.Context, userID string) (*domain.MFAEnrollment, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, domain.NewNotFoundError("USER_NOT_FOUND", "User not found")
+	}
+
+	existing, err := s.get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.IsEnabled() {
+		return nil, domain.NewConflictError("MFA_ALREADY_ENABLED", "Two-factor authentication is already enabled")
+	}
+
+	secret, err := generateTOTPSecret()
+	if err != nil {
+		return nil, domain.NewInternalError("MFA_SECRET_FAILED", "Failed to generate two-factor secret", err)
+	}
+
+	// Starting again replaces any enrollment that was never confirmed
+	if err := s.mfaRepo.Save(ctx, &domain.UserMFA{UserID: userID, Secret: secret}); err != nil {
+		return nil, err
+	}
+
+	return &domain.MFAEnrollment{
+		Secret:     secret,
+		OTPAuthURI: totpURI(secret, user.Email),
+	}, nil
+}
+
+// ConfirmEnrollment enables two-factor authentication once the user enters a code from their app.
+func (s *mfaService) ConfirmEnrollment(ctx context.Context, userID string, code string) ([]string, error) {
+	mfa, err := s.get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if mfa == nil {
+		return nil, domain.NewValidationError("MFA_NOT_ENROLLING",
+			"Start enrolling in two-factor authentication first", nil)
+	}
+	if mfa.IsEnabled() {
+		return nil, domain.NewConflictError("MFA_ALREADY_ENABLED", "Two-factor authentication is already enabled")
+	}
+
+	step, ok := verifyTOTP(mfa.Secret, code, time.Now(), mfa.LastUsedStep)
+	if !ok {
+		return nil, invalidMFACode()
+	}
+
+	codes, err := generateRecoveryCodes(domain.RecoveryCodeCount)
+	if err != nil {
+		return nil, domain.NewInternalError("RECOVERY_CODES_FAILED", "Failed to generate recovery codes", err)
+	}
+
+	mfa.LastUsedStep = step
+	mfa.SetRecoveryCodes(codes)
+	mfa.Enable()
+	if err := s.mfaRepo.Save(ctx, mfa); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// Verify checks a TOTP code or spends a recovery code.
+func (s *mfaService) Verify(ctx context.Context, userID string, code string) error {
+	mfa, err := s.get(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !mfa.IsEnabled() {
+		return domain.NewValidationError("MFA_NOT_ENABLED", "Two-factor authentication is not enabled", nil)
+	}
+
+	if step, ok := verifyTOTP(mfa.Secret, code, time.Now(), mfa.LastUsedStep); ok {
+		mfa.LastUsedStep = step
+	} else if !mfa.UseRecoveryCode(code) {
+		return invalidMFACode()
+	}
+
+	// Recording the step or the spent recovery code is what stops either being used again
+	return s.mfaRepo.Save(ctx, mfa)
+}
+
+// RegenerateRecoveryCodes replaces a user's recovery codes after checking a code.
+func (s *mfaService) RegenerateRecoveryCodes(ctx context.Context, userID string, code string) ([]string, error) {
+	if err := s.Verify(ctx, userID, code); err != nil {
+		return nil, err
+	}
+
+	mfa, err := s.get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := generateRecoveryCodes(domain.RecoveryCodeCount)
+	if err != nil {
+		return nil, domain.NewInternalError("RECOVERY_CODES_FAILED", "Failed to generate recovery codes", err)
+	}
+
+	mfa.SetRecoveryCodes(codes)
+	if err := s.mfaRepo.Save(ctx, mfa); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// Disable turns off two-factor authentication after checking a code, and signs the user out everywhere.
+func (s *mfaService) Disable(ctx context.Context, userID string, code string) error {
+	if s.config.GetRequireMFA() {
+		return domain.NewValidationError("MFA_REQUIRED",
+			"Two-factor authentication is required for every account on this server", nil)
+	}
+
+	if err := s.Verify(ctx, userID, code); err != nil {
+		return err
+	}
+
+	if err := s.mfaRepo.Delete(ctx, userID); err != nil {
+		return err
+	}
+
+	// Sessions started with the second factor shouldn't outlive it
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return domain.NewNotFoundError("USER_NOT_FOUND", "User not found")
+	}
+	user.IncrementTokenVersion()
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return domain.NewInternalError("USER_UPDATE_FAILED", "Failed to update user token version", err)
+	}
+
+	return nil
+}
+
+// get retrieves a user's two-factor authentication, or nil if they've never started enrolling
+func (s *mfaService) get(ctx context.Context, userID string) (*domain.UserMFA, error) {
+	mfa, err := s.mfaRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		var domainErr *domain.Error
+		if errors.As(err, &domainErr) && domainErr.Type == domain.NotFoundError {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return mfa, nil
+}
+
+// invalidMFACode is the error for a wrong, expired or already used code
+func invalidMFACode() error {
+	return domain.NewAuthenticationError("INVALID_MFA_CODE", "Invalid two-factor authentication code")
+}
+
+// isInvalidMFACode checks an error is a wrong code rather than a failure to check it
+func isInvalidMFACode(err error) bool {
+	var domainErr *domain.Error
+	return errors.As(err, &domainErr) && domainErr.Code == "INVALID_MFA_CODE"
+}
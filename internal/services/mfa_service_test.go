@@ -0,0 +1,228 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+func TestTOTPCode(t *testing.T) {
+	// RFC 6238 SHA1 test vectors, truncated to six digits
+	secret := totpEncoding.EncodeToString([]byte("12345678901234567890"))
+	vectors := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1234567890:  "005924",
+		20000000000: "353130",
+	}
+
+	for unix, want := range vectors {
+		code, err := totpCode(secret, totpStep(time.Unix(unix, 0)))
+		require.NoError(t, err)
+		assert.Equal(t, want, code, "at %d", unix)
+	}
+}
+
+func TestMFAService(t *testing.T) {
+	ctx := context.Background()
+	userRepo := testutil.NewMockUserRepository()
+	mfaRepo := testutil.NewMockUserMFARepository()
+	cfg := &testConfig{jwtSecret: "test-secret-that-is-32-characters-long", jwtExpiration: time.Hour}
+	mfa := NewMFAService(mfaRepo, userRepo, cfg)
+
+	userRepo.AddUser(testutil.MockUser("ada", "ada@example.com", "ada", "Ada"))
+
+	_, err := mfa.ConfirmEnrollment(ctx, "ada", "123456")
+	assert.Equal(t, domain.ValidationError, webhookErrorType(err), "confirming before enrolling")
+
+	enrollment, err := mfa.BeginEnrollment(ctx, "ada")
+	require.NoError(t, err)
+	assert.Contains(t, enrollment.OTPAuthURI, "otpauth://totp/Simple%20Easy%20Tasks:ada@example.com?")
+	assert.Contains(t, enrollment.OTPAuthURI, "secret="+enrollment.Secret)
+
+	enabled, err := mfa.IsEnabled(ctx, "ada")
+	require.NoError(t, err)
+	assert.False(t, enabled, "enrollment isn't enabled until it is confirmed")
+
+	_, err = mfa.ConfirmEnrollment(ctx, "ada", "000000")
+	assert.Equal(t, domain.AuthenticationError, webhookErrorType(err))
+
+	code := currentTOTPCode(t, enrollment.Secret)
+	recoveryCodes, err := mfa.ConfirmEnrollment(ctx, "ada", code)
+	require.NoError(t, err)
+	assert.Len(t, recoveryCodes, domain.RecoveryCodeCount)
+	assert.NotContains(t, mfaRepo.Records["ada"].RecoveryCodes, recoveryCodes[0], "codes are stored hashed")
+
+	t.Run("CodesCantBeReplayed", func(t *testing.T) {
+		err := mfa.Verify(ctx, "ada", code)
+		assert.Equal(t, domain.AuthenticationError, webhookErrorType(err))
+	})
+
+	t.Run("RecoveryCodesWorkOnce", func(t *testing.T) {
+		require.NoError(t, mfa.Verify(ctx, "ada", recoveryCodes[0]))
+		err := mfa.Verify(ctx, "ada", recoveryCodes[0])
+		assert.Equal(t, domain.AuthenticationError, webhookErrorType(err))
+
+		status, err := mfa.Status(ctx, "ada")
+		require.NoError(t, err)
+		assert.True(t, status.Enabled)
+		assert.Equal(t, domain.RecoveryCodeCount-1, status.RecoveryCodesRemaining)
+	})
+
+	t.Run("RequiredByTheInstance", func(t *testing.T) {
+		cfg.requireMFA = true
+		defer func() { cfg.requireMFA = false }()
+
+		err := mfa.Disable(ctx, "ada", recoveryCodes[1])
+		assert.Equal(t, domain.ValidationError, webhookErrorType(err))
+	})
+
+	t.Run("DisablingSignsOutEverywhere", func(t *testing.T) {
+		user, err := userRepo.GetByID(ctx, "ada")
+		require.NoError(t, err)
+		versionBefore := user.TokenVersion
+
+		require.NoError(t, mfa.Disable(ctx, "ada", recoveryCodes[1]))
+
+		user, err = userRepo.GetByID(ctx, "ada")
+		require.NoError(t, err)
+		assert.Equal(t, versionBefore+1, user.TokenVersion)
+
+		enabled, err := mfa.IsEnabled(ctx, "ada")
+		require.NoError(t, err)
+		assert.False(t, enabled)
+	})
+}
+
+func TestAuthService_MFALogin(t *testing.T) {
+	ctx := context.Background()
+	userRepo := testutil.NewMockUserRepository()
+	mfaRepo := testutil.NewMockUserMFARepository()
+	cfg := &testConfig{jwtSecret: "test-secret-that-is-32-characters-long", jwtExpiration: time.Hour}
+	mfa := NewMFAService(mfaRepo, userRepo, cfg)
+	auth := NewAuthService(userRepo, newMockTokenBlacklistRepository(), newMockPasswordResetTokenRepository(),
+		cfg, newTestEmailOutbox(t))
+	auth.SetMFA(mfa)
+
+	user := testutil.MockUser("ada", "ada@example.com", "ada", "Ada")
+	require.NoError(t, user.SetPassword("password123"))
+	userRepo.AddUser(user)
+	login := domain.LoginRequest{Email: "ada@example.com", Password: "password123"}
+
+	t.Run("PasswordIsEnoughWithoutMFA", func(t *testing.T) {
+		result, err := auth.Login(ctx, login)
+		require.NoError(t, err)
+		assert.NotNil(t, result.Tokens)
+		assert.Nil(t, result.Challenge)
+	})
+
+	t.Run("RequiredEnrollment", func(t *testing.T) {
+		cfg.requireMFA = true
+		defer func() { cfg.requireMFA = false }()
+
+		result, err := auth.Login(ctx, login)
+		require.NoError(t, err)
+		require.NotNil(t, result.Challenge)
+		assert.Nil(t, result.Tokens)
+		assert.True(t, result.Challenge.EnrollmentRequired)
+
+		enrollment, err := mfa.BeginEnrollment(ctx, user.ID)
+		require.NoError(t, err)
+
+		finished, err := auth.CompleteMFALogin(ctx, result.Challenge.Token, currentTOTPCode(t, enrollment.Secret))
+		require.NoError(t, err)
+		assert.NotNil(t, finished.Tokens)
+		assert.Len(t, finished.RecoveryCodes, domain.RecoveryCodeCount)
+	})
+
+	t.Run("TwoSteps", func(t *testing.T) {
+		result, err := auth.Login(ctx, login)
+		require.NoError(t, err)
+		require.NotNil(t, result.Challenge)
+		assert.False(t, result.Challenge.EnrollmentRequired)
+
+		// The challenge isn't a session
+		_, err = auth.ValidateToken(ctx, result.Challenge.Token)
+		assert.Equal(t, domain.AuthenticationError, webhookErrorType(err))
+		_, err = auth.RefreshToken(ctx, result.Challenge.Token)
+		assert.Equal(t, domain.AuthenticationError, webhookErrorType(err))
+
+		challengeUser, err := auth.ValidateMFAChallenge(ctx, result.Challenge.Token)
+		require.NoError(t, err)
+		assert.Equal(t, user.ID, challengeUser.ID)
+
+		_, err = auth.CompleteMFALogin(ctx, result.Challenge.Token, "000000")
+		assert.Equal(t, domain.AuthenticationError, webhookErrorType(err))
+
+		// Use a recovery code, as this step's TOTP code was spent enrolling moments ago
+		mfaRepo.Records[user.ID].SetRecoveryCodes([]string{"aaaaa-bbbbb"})
+
+		finished, err := auth.CompleteMFALogin(ctx, result.Challenge.Token, "AAAAA-BBBBB")
+		require.NoError(t, err)
+		require.NotNil(t, finished.Tokens)
+		assert.Empty(t, finished.RecoveryCodes)
+
+		_, err = auth.ValidateToken(ctx, finished.Tokens.AccessToken)
+		assert.NoError(t, err)
+
+		_, err = auth.CompleteMFALogin(ctx, result.Challenge.Token, "AAAAA-BBBBB")
+		assert.Equal(t, domain.AuthenticationError, webhookErrorType(err), "challenges finish one login")
+	})
+
+	t.Run("TooManyWrongCodesEndTheChallenge", func(t *testing.T) {
+		require.NoError(t, user.SetPassword("password123"))
+		result, err := auth.Login(ctx, login)
+		require.NoError(t, err)
+		require.NotNil(t, result.Challenge)
+
+		for i := 1; i < maxMFAAttempts; i++ {
+			_, err = auth.CompleteMFALogin(ctx, result.Challenge.Token, "000000")
+			assert.Equal(t, "INVALID_MFA_CODE", errorCode(err))
+		}
+		_, err = auth.CompleteMFALogin(ctx, result.Challenge.Token, "000000")
+		assert.Equal(t, "MFA_ATTEMPTS_EXCEEDED", errorCode(err))
+
+		// Even the right code needs a new password login now
+		mfaRepo.Records[user.ID].SetRecoveryCodes([]string{"ccccc-ddddd"})
+		_, err = auth.CompleteMFALogin(ctx, result.Challenge.Token, "CCCCC-DDDDD")
+		assert.Equal(t, "INVALID_MFA_TOKEN", errorCode(err))
+	})
+}
+
+func TestAuthorizationService_ProjectRequiresMFA(t *testing.T) {
+	ctx := context.Background()
+	projectRepo := testutil.NewMockProjectRepository()
+	mfaRepo := testutil.NewMockUserMFARepository()
+	authz := NewAuthorizationService(projectRepo)
+	authz.SetMFAChecker(NewMFAService(mfaRepo, testutil.NewMockUserRepository(), &testConfig{}))
+
+	project := testutil.MockProject("project-1", "Project", "project", "owner")
+	project.MemberIDs = []string{"member"}
+	project.Settings.RequireMFA = true
+	projectRepo.AddProject(project)
+
+	enabledAt := time.Now()
+	mfaRepo.Records["owner"] = &domain.UserMFA{UserID: "owner", EnabledAt: &enabledAt}
+	mfaRepo.Records["member"] = &domain.UserMFA{UserID: "member"} // enrollment never confirmed
+
+	_, err := authz.Authorize(ctx, project.ID, "owner", domain.PermissionViewProject)
+	assert.NoError(t, err)
+
+	_, err = authz.Authorize(ctx, project.ID, "member", domain.PermissionViewProject)
+	require.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+	assert.Contains(t, err.Error(), "two-factor")
+}
+
+// currentTOTPCode returns the code an authenticator app would show now
+func currentTOTPCode(t *testing.T, secret string) string {
+	t.Helper()
+	code, err := totpCode(secret, totpStep(time.Now()))
+	require.NoError(t, err)
+	return code
+}
@@ -0,0 +1,456 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
+)
+
+const (
+	// DefaultNotificationLimit is the page size used when a listing does not set one
+	DefaultNotificationLimit = 20
+	// MaxNotificationLimit caps the page size of a single listing
+	MaxNotificationLimit = 100
+)
+
+// NotificationService turns task events into per-user inbox entries and manages their read state
+type NotificationService interface {
+	// ListNotifications returns a page of the user's notifications, newest first
+	ListNotifications(ctx context.Context, req ListNotificationsRequest, userID string) (*NotificationPage, error)
+
+	// UnreadCount returns how many of the user's notifications are unread
+	UnreadCount(ctx context.Context, userID string) (int, error)
+
+	// MarkRead marks one of the user's notifications read
+	MarkRead(ctx context.Context, notificationID string, userID string) (*domain.Notification, error)
+
+	// MarkAllRead marks every notification of the user read and returns how many changed
+	MarkAllRead(ctx context.Context, userID string) (int, error)
+
+	// HandleEvent creates the notifications an event calls for
+	HandleEvent(ctx context.Context, event *domain.TaskEvent) error
+
+	// SetUnreadCountPublisher sets where unread counts are pushed when they change
+	SetUnreadCountPublisher(publisher UnreadCountPublisher)
+}
+
+// UnreadCountPublisher pushes a user's unread notification count to their live connections
+type UnreadCountPublisher interface {
+	PublishUnreadCount(userID string, count int)
+}
+
+// ListNotificationsRequest selects a page of notifications
+type ListNotificationsRequest struct {
+	Limit      int
+	Offset     int
+	UnreadOnly bool
+}
+
+// NotificationPage is a page of a user's notifications
+type NotificationPage struct {
+	Notifications []*domain.Notification `json:"notifications"`
+	Total         int                    `json:"total"`  // notifications matching the request
+	Unread        int                    `json:"unread"` // all unread notifications of the user
+	Limit         int                    `json:"limit"`
+	Offset        int                    `json:"offset"`
+}
+
+type notificationService struct {
+	notificationRepo repository.NotificationRepository
+	projectRepo      repository.ProjectRepository
+	userRepo         repository.UserRepository
+	publisher        UnreadCountPublisher
+	logger           *slog.Logger
+	publisherMu      sync.RWMutex
+}
+
+// NewNotificationService creates a new notification service
+func NewNotificationService(
+	notificationRepo repository.NotificationRepository,
+	projectRepo repository.ProjectRepository,
+	userRepo repository.UserRepository,
+) NotificationService {
+	return &notificationService{
+		notificationRepo: notificationRepo,
+		projectRepo:      projectRepo,
+		userRepo:         userRepo,
+		logger:           slog.Default().With("component", "notifications"),
+	}
+}
+
+// SetUnreadCountPublisher sets where unread counts are pushed when they change
+func (s *notificationService) SetUnreadCountPublisher(publisher UnreadCountPublisher) {
+	s.publisherMu.Lock()
+	defer s.publisherMu.Unlock()
+	s.publisher = publisher
+}
+
+// ListNotifications returns a page of the user's notifications, newest first
+func (s *notificationService) ListNotifications(
+	ctx context.Context, req ListNotificationsRequest, userID string,
+) (*NotificationPage, error) {
+	if userID == "" {
+		return nil, domain.NewValidationError("INVALID_USER_ID", "User ID cannot be empty", nil)
+	}
+	if req.Limit <= 0 || req.Limit > MaxNotificationLimit {
+		req.Limit = DefaultNotificationLimit
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	notifications, err := s.notificationRepo.ListByUser(ctx, userID, req.UnreadOnly, req.Offset, req.Limit)
+	if err != nil {
+		return nil, domain.NewInternalError("NOTIFICATION_LIST_FAILED", "Failed to list notifications", err)
+	}
+
+	total, err := s.notificationRepo.CountByUser(ctx, userID, req.UnreadOnly)
+	if err != nil {
+		return nil, domain.NewInternalError("NOTIFICATION_COUNT_FAILED", "Failed to count notifications", err)
+	}
+
+	unread := total
+	if !req.UnreadOnly {
+		if unread, err = s.UnreadCount(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &NotificationPage{
+		Notifications: notifications,
+		Total:         total,
+		Unread:        unread,
+		Limit:         req.Limit,
+		Offset:        req.Offset,
+	}, nil
+}
+
+// UnreadCount returns how many of the user's notifications are unread
+func (s *notificationService) UnreadCount(ctx context.Context, userID string) (int, error) {
+	if userID == "" {
+		return 0, domain.NewValidationError("INVALID_USER_ID", "User ID cannot be empty", nil)
+	}
+
+	count, err := s.notificationRepo.CountByUser(ctx, userID, true)
+	if err != nil {
+		return 0, domain.NewInternalError("NOTIFICATION_COUNT_FAILED", "Failed to count notifications", err)
+	}
+	return count, nil
+}
+
+// MarkRead marks one of the user's notifications read; reading it again is a no-op
+func (s *notificationService) MarkRead(
+	ctx context.Context, notificationID string, userID string,
+) (*domain.Notification, error) {
+	notification, err := s.notificationRepo.GetByID(ctx, notificationID)
+	if err != nil || notification.UserID != userID {
+		return nil, domain.NewNotFoundError("NOTIFICATION_NOT_FOUND", "Notification not found")
+	}
+
+	if notification.MarkRead(time.Now()) {
+		if err := s.notificationRepo.Update(ctx, notification); err != nil {
+			return nil, domain.NewInternalError("NOTIFICATION_UPDATE_FAILED", "Failed to mark notification read", err)
+		}
+		s.publishUnreadCount(ctx, userID)
+	}
+
+	return notification, nil
+}
+
+// MarkAllRead marks every notification of the user read and returns how many changed
+func (s *notificationService) MarkAllRead(ctx context.Context, userID string) (int, error) {
+	if userID == "" {
+		return 0, domain.NewValidationError("INVALID_USER_ID", "User ID cannot be empty", nil)
+	}
+
+	changed, err := s.notificationRepo.MarkAllRead(ctx, userID, time.Now())
+	if err != nil {
+		return 0, domain.NewInternalError("NOTIFICATION_UPDATE_FAILED", "Failed to mark notifications read", err)
+	}
+	if changed > 0 {
+		s.publishUnreadCount(ctx, userID)
+	}
+
+	return changed, nil
+}
+
+// notificationTarget is one notification an event calls for, before preferences are applied
+type notificationTarget struct {
+	userID string
+	kind   domain.NotificationKind
+	action string // completes the message after the actor's name
+}
+
+// HandleEvent creates the notifications an event calls for. Whoever caused the event is
+// never notified, recipients must belong to the project, and the project's notification
+// settings and each recipient's preferences can turn kinds off.
+func (s *notificationService) HandleEvent(ctx context.Context, event *domain.TaskEvent) error {
+	task, targets, err := notificationTargets(event)
+	if err != nil || len(targets) == 0 {
+		return err
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, event.ProjectID)
+	if err != nil {
+		return fmt.Errorf("failed to load project %s: %w", event.ProjectID, err)
+	}
+
+	actor := s.displayName(ctx, event.UserID)
+	notified := make(map[string]bool)
+	for _, target := range targets {
+		if !s.shouldNotify(ctx, project, target, event.UserID) {
+			continue
+		}
+
+		notification := &domain.Notification{
+			UserID:    target.userID,
+			ProjectID: event.ProjectID,
+			TaskID:    task.ID,
+			ActorID:   event.UserID,
+			EventID:   event.EventID,
+			Kind:      target.kind,
+			TaskTitle: task.Title,
+			Message:   truncateNotificationMessage(actor + " " + target.action),
+		}
+		if err := s.notificationRepo.Create(ctx, notification); err != nil {
+			return fmt.Errorf("failed to create %s notification for user %s: %w", target.kind, target.userID, err)
+		}
+		notified[target.userID] = true
+	}
+
+	for userID := range notified {
+		s.publishUnreadCount(ctx, userID)
+	}
+	return nil
+}
+
+// notificationTargets works out who an event concerns and why. Each user appears at most once;
+// someone @mentioned in a comment is told about the mention rather than the comment.
+func notificationTargets(event *domain.TaskEvent) (*domain.Task, []notificationTarget, error) {
+	var (
+		task    *domain.Task
+		targets []notificationTarget
+	)
+	switch event.Type {
+	case domain.TaskAssigned:
+		var data domain.TaskAssignedData
+		if err := decodeTaskEventData(event, &data, &data.Task); err != nil {
+			return nil, nil, err
+		}
+		task = data.Task
+		if data.NewAssignee != nil && *data.NewAssignee != "" &&
+			(data.OldAssignee == nil || *data.OldAssignee != *data.NewAssignee) {
+			targets = append(targets, notificationTarget{
+				userID: *data.NewAssignee,
+				kind:   domain.NotificationAssigned,
+				action: fmt.Sprintf("assigned you to %q", task.Title),
+			})
+		}
+
+	case domain.TaskCommented:
+		var data domain.TaskCommentedData
+		if err := decodeTaskEventData(event, &data, &data.Task); err != nil {
+			return nil, nil, err
+		}
+		task = data.Task
+		mentioned := make(map[string]bool, len(data.Mentions))
+		for _, userID := range data.Mentions {
+			mentioned[userID] = true
+		}
+		for _, userID := range task.Watchers {
+			if mentioned[userID] {
+				continue // told by the task.mentioned event instead
+			}
+			targets = append(targets, notificationTarget{
+				userID: userID,
+				kind:   domain.NotificationCommented,
+				action: fmt.Sprintf("commented on %q", task.Title),
+			})
+		}
+
+	case domain.TaskMentioned:
+		var data domain.TaskMentionedData
+		if err := decodeTaskEventData(event, &data, &data.Task); err != nil {
+			return nil, nil, err
+		}
+		task = data.Task
+		targets = append(targets, notificationTarget{
+			userID: data.MentionedUserID,
+			kind:   domain.NotificationMentioned,
+			action: fmt.Sprintf("mentioned you in a comment on %q", task.Title),
+		})
+
+	case domain.TaskMoved, domain.TaskUpdated:
+		statusTask, from, to, err := statusChange(event)
+		if err != nil {
+			return nil, nil, err
+		}
+		if statusTask == nil || from == to {
+			return nil, nil, nil
+		}
+		task = statusTask
+		for _, userID := range task.Watchers {
+			targets = append(targets, notificationTarget{
+				userID: userID,
+				kind:   domain.NotificationStatusChanged,
+				action: fmt.Sprintf("moved %q from %s to %s", task.Title, from, to),
+			})
+		}
+	}
+
+	return task, dedupeNotificationTargets(targets), nil
+}
+
+// statusChange extracts the task and its old and new status from a move or update event.
+// The task is nil when an update left the status alone.
+func statusChange(event *domain.TaskEvent) (*domain.Task, domain.TaskStatus, domain.TaskStatus, error) {
+	if event.Type == domain.TaskMoved {
+		var data domain.TaskMovedData
+		if err := decodeTaskEventData(event, &data, &data.Task); err != nil {
+			return nil, "", "", err
+		}
+		return data.Task, data.OldStatus, data.NewStatus, nil
+	}
+
+	var data domain.TaskUpdatedData
+	if err := decodeTaskEventData(event, &data, &data.Task); err != nil {
+		return nil, "", "", err
+	}
+	newStatus, changed := data.Changes["status"].(string)
+	oldStatus, _ := data.OldValues["status"].(string)
+	if !changed {
+		return nil, "", "", nil
+	}
+	return data.Task, domain.TaskStatus(oldStatus), domain.TaskStatus(newStatus), nil
+}
+
+// decodeTaskEventData unmarshals an event's data, which must carry the task it is about
+func decodeTaskEventData(event *domain.TaskEvent, data interface{}, task **domain.Task) error {
+	if err := json.Unmarshal(event.Data, data); err != nil {
+		return fmt.Errorf("invalid %s event data: %w", event.Type, err)
+	}
+	if *task == nil {
+		return fmt.Errorf("%s event %s carries no task", event.Type, event.EventID)
+	}
+	return nil
+}
+
+// dedupeNotificationTargets keeps the first target of each user
+func dedupeNotificationTargets(targets []notificationTarget) []notificationTarget {
+	seen := make(map[string]bool, len(targets))
+	deduped := targets[:0]
+	for _, target := range targets {
+		if target.userID == "" || seen[target.userID] {
+			continue
+		}
+		seen[target.userID] = true
+		deduped = append(deduped, target)
+	}
+	return deduped
+}
+
+// shouldNotify applies the actor, membership, project and user preference rules to a target
+func (s *notificationService) shouldNotify(
+	ctx context.Context, project *domain.Project, target notificationTarget, actorID string,
+) bool {
+	if target.userID == actorID {
+		return false
+	}
+	if !project.IsOwner(target.userID) && !project.IsMember(target.userID) {
+		return false
+	}
+	if !target.kind.EnabledForProject(project.Settings) {
+		return false
+	}
+
+	user, err := s.userRepo.GetByID(ctx, target.userID)
+	if err != nil {
+		s.logger.Warn("Skipping notification for unknown user", "user_id", target.userID, "error", err)
+		return false
+	}
+	return target.kind.EnabledForUser(user.Preferences)
+}
+
+// displayName names a user in notification messages
+func (s *notificationService) displayName(ctx context.Context, userID string) string {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	switch {
+	case err != nil:
+		return "Someone"
+	case user.Name != "":
+		return user.Name
+	case user.Username != "":
+		return user.Username
+	default:
+		return "Someone"
+	}
+}
+
+// publishUnreadCount pushes the user's current unread count, if anyone is listening
+func (s *notificationService) publishUnreadCount(ctx context.Context, userID string) {
+	s.publisherMu.RLock()
+	publisher := s.publisher
+	s.publisherMu.RUnlock()
+	if publisher == nil {
+		return
+	}
+
+	count, err := s.UnreadCount(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to count unread notifications", "user_id", userID, "error", err)
+		return
+	}
+	publisher.PublishUnreadCount(userID, count)
+}
+
+// truncateNotificationMessage keeps a message within the stored length
+func truncateNotificationMessage(message string) string {
+	if utf8.RuneCountInString(message) <= domain.MaxNotificationMessageLen {
+		return message
+	}
+	runes := []rune(message)
+	return string(runes[:domain.MaxNotificationMessageLen-3]) + "..."
+}
+
+// notificationEventBroadcaster creates notifications for every event it broadcasts
+type notificationEventBroadcaster struct {
+	EventBroadcaster
+	notifications NotificationService
+	logger        *slog.Logger
+}
+
+// NewNotificationEventBroadcaster wraps a broadcaster so that every event broadcast on this
+// replica also notifies the users it concerns. Like webhooks, events relayed from other
+// replicas are skipped so each event notifies once across the cluster.
+func NewNotificationEventBroadcaster(
+	broadcaster EventBroadcaster, notifications NotificationService,
+) EventBroadcaster {
+	return &notificationEventBroadcaster{
+		EventBroadcaster: broadcaster,
+		notifications:    notifications,
+		logger:           slog.Default().With("component", "notifications"),
+	}
+}
+
+// BroadcastEvent broadcasts the event and notifies the users it concerns
+func (b *notificationEventBroadcaster) BroadcastEvent(ctx context.Context, event *domain.TaskEvent) error {
+	if err := b.EventBroadcaster.BroadcastEvent(ctx, event); err != nil {
+		return err
+	}
+
+	// Notifications are best-effort from the caller's point of view; the change already happened
+	if err := b.notifications.HandleEvent(ctx, event); err != nil {
+		b.logger.Error("Failed to create notifications",
+			"error", err,
+			"event_id", event.EventID,
+			"project_id", event.ProjectID)
+	}
+	return nil
+}
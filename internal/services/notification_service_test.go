@@ -0,0 +1,277 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+// recordingUnreadPublisher remembers the last unread count pushed for each user
+type recordingUnreadPublisher struct {
+	counts map[string]int
+	mu     sync.Mutex
+}
+
+func (p *recordingUnreadPublisher) PublishUnreadCount(userID string, count int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.counts[userID] = count
+}
+
+func (p *recordingUnreadPublisher) count(userID string) (int, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	count, ok := p.counts[userID]
+	return count, ok
+}
+
+type notificationTestEnv struct {
+	service       NotificationService
+	notifications *testutil.MockNotificationRepository
+	projects      *testutil.MockProjectRepository
+	users         *testutil.MockUserRepository
+	publisher     *recordingUnreadPublisher
+	project       *domain.Project
+}
+
+// newNotificationTestEnv sets up a project owned by the reporter with an assignee and a reviewer as members.
+// The outsider has an account but is not part of the project.
+func newNotificationTestEnv() *notificationTestEnv {
+	env := &notificationTestEnv{
+		notifications: testutil.NewMockNotificationRepository(),
+		projects:      testutil.NewMockProjectRepository(),
+		users:         testutil.NewMockUserRepository(),
+		publisher:     &recordingUnreadPublisher{counts: make(map[string]int)},
+	}
+
+	env.users.AddUser(testutil.MockUser("reporter", "reporter@example.com", "reporter", "Rita Reporter"))
+	env.users.AddUser(testutil.MockUser("assignee", "assignee@example.com", "assignee", "Andy Assignee"))
+	env.users.AddUser(testutil.MockUser("reviewer", "reviewer@example.com", "reviewer", "Rob Reviewer"))
+	env.users.AddUser(testutil.MockUser("outsider", "outsider@example.com", "outsider", "Olga Outsider"))
+
+	env.project = testutil.MockProject("project1", "Project", "project", "reporter")
+	env.project.MemberIDs = []string{"assignee", "reviewer"}
+	env.projects.AddProject(env.project)
+
+	env.service = NewNotificationService(env.notifications, env.projects, env.users)
+	env.service.SetUnreadCountPublisher(env.publisher)
+	return env
+}
+
+// notificationTask returns a task reported by the reporter and assigned to the assignee, who both watch it
+func notificationTask() *domain.Task {
+	task := testutil.MockTask("task1", "Ship it", "project1", "reporter")
+	assignee := "assignee"
+	task.AssigneeID = &assignee
+	task.WatchParticipants()
+	return task
+}
+
+func (e *notificationTestEnv) handle(t *testing.T, eventType domain.TaskEventType, actorID string, data interface{}) {
+	t.Helper()
+	event, err := domain.NewTaskEvent(eventType, "task1", "project1", actorID, data)
+	require.NoError(t, err)
+	require.NoError(t, e.service.HandleEvent(context.Background(), event))
+}
+
+func (e *notificationTestEnv) received(userID string) []*domain.Notification {
+	var received []*domain.Notification
+	for _, notification := range e.notifications.Notifications {
+		if notification.UserID == userID {
+			received = append(received, notification)
+		}
+	}
+	return received
+}
+
+func TestNotificationService_Assignment(t *testing.T) {
+	env := newNotificationTestEnv()
+	task := notificationTask()
+	assignee := "assignee"
+
+	env.handle(t, domain.TaskAssigned, "reporter", &domain.TaskAssignedData{
+		Task: task, NewAssignee: &assignee, AssignedBy: "reporter",
+	})
+
+	received := env.received("assignee")
+	require.Len(t, received, 1)
+	assert.Equal(t, domain.NotificationAssigned, received[0].Kind)
+	assert.Equal(t, `Rita Reporter assigned you to "Ship it"`, received[0].Message)
+	assert.Equal(t, "reporter", received[0].ActorID)
+	assert.Equal(t, "task1", received[0].TaskID)
+	assert.Len(t, env.notifications.Notifications, 1, "the actor is not notified")
+
+	count, pushed := env.publisher.count("assignee")
+	assert.True(t, pushed)
+	assert.Equal(t, 1, count)
+
+	t.Run("SelfAssignment", func(t *testing.T) {
+		env.handle(t, domain.TaskAssigned, "assignee", &domain.TaskAssignedData{
+			Task: task, NewAssignee: &assignee, AssignedBy: "assignee",
+		})
+		assert.Len(t, env.received("assignee"), 1)
+	})
+}
+
+func TestNotificationService_CommentsAndMentions(t *testing.T) {
+	env := newNotificationTestEnv()
+	task := notificationTask()
+	comment := "@assignee please double check"
+
+	env.handle(t, domain.TaskCommented, "reviewer", &domain.TaskCommentedData{
+		Task: task, CommentID: "comment1", Comment: comment, Author: "reviewer", Mentions: []string{"assignee"},
+	})
+	env.handle(t, domain.TaskMentioned, "reviewer", &domain.TaskMentionedData{
+		Task: task, CommentID: "comment1", Comment: comment, Author: "reviewer", MentionedUserID: "assignee",
+	})
+
+	assigneeNotifications := env.received("assignee")
+	require.Len(t, assigneeNotifications, 1, "a mentioned watcher is told once")
+	assert.Equal(t, domain.NotificationMentioned, assigneeNotifications[0].Kind)
+	assert.Equal(t, `Rob Reviewer mentioned you in a comment on "Ship it"`, assigneeNotifications[0].Message)
+
+	reporterNotifications := env.received("reporter")
+	require.Len(t, reporterNotifications, 1)
+	assert.Equal(t, domain.NotificationCommented, reporterNotifications[0].Kind)
+
+	assert.Empty(t, env.received("reviewer"), "the author is not notified")
+
+	t.Run("MentionedOutsider", func(t *testing.T) {
+		env.handle(t, domain.TaskMentioned, "reviewer", &domain.TaskMentionedData{
+			Task: task, CommentID: "comment1", Comment: comment, Author: "reviewer", MentionedUserID: "outsider",
+		})
+		assert.Empty(t, env.received("outsider"), "users outside the project are not notified")
+	})
+}
+
+func TestNotificationService_StatusChanges(t *testing.T) {
+	env := newNotificationTestEnv()
+	task := notificationTask()
+	task.Status = domain.StatusDeveloping
+
+	env.handle(t, domain.TaskMoved, "reviewer", &domain.TaskMovedData{
+		Task: task, OldStatus: domain.StatusTodo, NewStatus: domain.StatusDeveloping, OldPosition: 0, NewPosition: 1,
+	})
+	require.Len(t, env.received("reporter"), 1)
+	require.Len(t, env.received("assignee"), 1)
+	assert.Equal(t, domain.NotificationStatusChanged, env.received("assignee")[0].Kind)
+	assert.Contains(t, env.received("assignee")[0].Message, "from todo to developing")
+
+	env.handle(t, domain.TaskMoved, "reviewer", &domain.TaskMovedData{
+		Task: task, OldStatus: domain.StatusDeveloping, NewStatus: domain.StatusDeveloping, NewPosition: 3,
+	})
+	assert.Len(t, env.notifications.Notifications, 2, "reordering within a column is not a status change")
+
+	env.handle(t, domain.TaskUpdated, "reporter", &domain.TaskUpdatedData{
+		Task:      task,
+		Changes:   map[string]interface{}{"status": domain.StatusReview},
+		OldValues: map[string]interface{}{"status": domain.StatusDeveloping},
+	})
+	require.Len(t, env.received("assignee"), 2)
+	assert.Contains(t, env.received("assignee")[1].Message, "from developing to review")
+
+	env.handle(t, domain.TaskUpdated, "reporter", &domain.TaskUpdatedData{
+		Task:    task,
+		Changes: map[string]interface{}{"title": "Ship it now"},
+	})
+	assert.Len(t, env.received("assignee"), 2, "other updates do not notify")
+}
+
+func TestNotificationService_NotifiesWatchers(t *testing.T) {
+	env := newNotificationTestEnv()
+	task := notificationTask()
+	task.AddWatcher("reviewer")
+	task.RemoveWatcher("reporter")
+
+	env.handle(t, domain.TaskMoved, "assignee", &domain.TaskMovedData{
+		Task: task, OldStatus: domain.StatusTodo, NewStatus: domain.StatusReview,
+	})
+
+	assert.Len(t, env.received("reviewer"), 1, "watchers are notified")
+	assert.Empty(t, env.received("reporter"), "reporters who stopped watching are not")
+}
+
+func TestNotificationService_Preferences(t *testing.T) {
+	env := newNotificationTestEnv()
+	env.project.Settings.Notifications = map[string]bool{string(domain.NotificationCommented): false}
+	assignee, _ := env.users.GetByID(context.Background(), "assignee")
+	assignee.Preferences.Preferences = map[string]string{"notification_status_changed": "false"}
+
+	env.handle(t, domain.TaskCommented, "reviewer", &domain.TaskCommentedData{
+		Task: notificationTask(), CommentID: "comment1", Comment: "Done", Author: "reviewer",
+	})
+	assert.Empty(t, env.notifications.Notifications, "the project turned comment notifications off")
+
+	env.handle(t, domain.TaskMoved, "reviewer", &domain.TaskMovedData{
+		Task: notificationTask(), OldStatus: domain.StatusTodo, NewStatus: domain.StatusComplete,
+	})
+	assert.Empty(t, env.received("assignee"), "the assignee turned status notifications off")
+	assert.Len(t, env.received("reporter"), 1)
+}
+
+func TestNotificationService_Inbox(t *testing.T) {
+	ctx := context.Background()
+	env := newNotificationTestEnv()
+	assignee := "assignee"
+	for i := 0; i < 3; i++ {
+		env.handle(t, domain.TaskAssigned, "reporter", &domain.TaskAssignedData{
+			Task: notificationTask(), NewAssignee: &assignee, AssignedBy: "reporter",
+		})
+	}
+
+	page, err := env.service.ListNotifications(ctx, ListNotificationsRequest{Limit: 2}, "assignee")
+	require.NoError(t, err)
+	assert.Len(t, page.Notifications, 2)
+	assert.Equal(t, 3, page.Total)
+	assert.Equal(t, 3, page.Unread)
+	assert.Equal(t, "notification-3", page.Notifications[0].ID, "newest first")
+
+	t.Run("MarkRead", func(t *testing.T) {
+		_, err := env.service.MarkRead(ctx, "notification-3", "reporter")
+		assert.Equal(t, domain.NotFoundError, webhookErrorType(err), "other users' notifications are hidden")
+
+		read, err := env.service.MarkRead(ctx, "notification-3", "assignee")
+		require.NoError(t, err)
+		assert.True(t, read.IsRead())
+		count, _ := env.publisher.count("assignee")
+		assert.Equal(t, 2, count)
+
+		page, err := env.service.ListNotifications(ctx, ListNotificationsRequest{UnreadOnly: true}, "assignee")
+		require.NoError(t, err)
+		assert.Len(t, page.Notifications, 2)
+		assert.Equal(t, 2, page.Total)
+		assert.Equal(t, 2, page.Unread)
+	})
+
+	t.Run("MarkAllRead", func(t *testing.T) {
+		changed, err := env.service.MarkAllRead(ctx, "assignee")
+		require.NoError(t, err)
+		assert.Equal(t, 2, changed)
+
+		unread, err := env.service.UnreadCount(ctx, "assignee")
+		require.NoError(t, err)
+		assert.Zero(t, unread)
+		count, _ := env.publisher.count("assignee")
+		assert.Zero(t, count)
+	})
+}
+
+func TestNotificationEventBroadcaster_NotifiesOnBroadcast(t *testing.T) {
+	env := newNotificationTestEnv()
+	broadcaster := NewNotificationEventBroadcaster(NewEventBroadcaster(nil, EventBroadcasterConfig{}), env.service)
+	assignee := "assignee"
+
+	event, err := domain.NewTaskEvent(domain.TaskAssigned, "task1", "project1", "reporter", &domain.TaskAssignedData{
+		Task: notificationTask(), NewAssignee: &assignee, AssignedBy: "reporter",
+	})
+	require.NoError(t, err)
+	require.NoError(t, broadcaster.BroadcastEvent(context.Background(), event))
+
+	assert.Len(t, env.received("assignee"), 1)
+}
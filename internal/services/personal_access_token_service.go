@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
+)
+
+// lastUsedPrecision is how stale a token's last-used time may get, so busy scripts don't
+// write on every request
+const lastUsedPrecision = time.Minute
+
+// PersonalAccessTokenService manages the long-lived API tokens users create for scripts and the CLI.
+type PersonalAccessTokenService interface {
+	// CreateToken creates a token for a user. The returned token carries its raw value,
+	// which can't be retrieved again.
+	CreateToken(
+		ctx context.Context, userID string, req domain.CreatePersonalAccessTokenRequest,
+	) (*domain.PersonalAccessToken, error)
+
+	// ListTokens lists a user's tokens that haven't been revoked
+	ListTokens(ctx context.Context, userID string) ([]*domain.PersonalAccessToken, error)
+
+	// RevokeToken revokes one of a user's tokens
+	RevokeToken(ctx context.Context, userID string, tokenID string) error
+
+	// Authenticate returns the user a raw token belongs to, along with the token
+	Authenticate(ctx context.Context, rawToken string) (*domain.User, *domain.PersonalAccessToken, error)
+}
+
+type personalAccessTokenService struct {
+	tokenRepo repository.PersonalAccessTokenRepository
+	userRepo  repository.UserRepository
+}
+
+// NewPersonalAccessTokenService creates a new personal access token service.
+func NewPersonalAccessTokenService(
+	tokenRepo repository.PersonalAccessTokenRepository,
+	userRepo repository.UserRepository,
+) PersonalAccessTokenService {
+	return &personalAccessTokenService{
+		tokenRepo: tokenRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// CreateToken creates a token for a user.
+func (s *personalAccessTokenService) CreateToken(
+	ctx context.Context, userID string, req domain.CreatePersonalAccessTokenRequest,
+) (*domain.PersonalAccessToken, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	secret, err := generateSecureToken()
+	if err != nil {
+		return nil, domain.NewInternalError("TOKEN_GENERATION_FAILED", "Failed to generate token", err)
+	}
+	raw := domain.PersonalAccessTokenPrefix + secret
+
+	token := &domain.PersonalAccessToken{
+		UserID: userID,
+		Name:   req.Name,
+		Hint:   domain.TokenHint(raw),
+		Token:  raw,
+		Scope:  req.Scope,
+	}
+	if req.ExpiresInDays > 0 {
+		expiresAt := time.Now().UTC().AddDate(0, 0, req.ExpiresInDays)
+		token.ExpiresAt = &expiresAt
+	}
+
+	if err := s.tokenRepo.Create(ctx, token); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// ListTokens lists a user's tokens that haven't been revoked.
+func (s *personalAccessTokenService) ListTokens(
+	ctx context.Context, userID string,
+) ([]*domain.PersonalAccessToken, error) {
+	tokens, err := s.tokenRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	listed := make([]*domain.PersonalAccessToken, 0, len(tokens))
+	for _, token := range tokens {
+		if token.RevokedAt == nil {
+			listed = append(listed, token)
+		}
+	}
+
+	return listed, nil
+}
+
+// RevokeToken revokes one of a user's tokens.
+func (s *personalAccessTokenService) RevokeToken(ctx context.Context, userID string, tokenID string) error {
+	token, err := s.tokenRepo.GetByID(ctx, tokenID)
+	if err != nil {
+		return err
+	}
+
+	// Other users' tokens are reported missing rather than forbidden so IDs can't be probed
+	if token.UserID != userID || token.RevokedAt != nil {
+		return domain.NewNotFoundError("TOKEN_NOT_FOUND", "Personal access token not found")
+	}
+
+	token.Revoke()
+	return s.tokenRepo.Update(ctx, token)
+}
+
+// Authenticate returns the user a raw token belongs to, along with the token.
+func (s *personalAccessTokenService) Authenticate(
+	ctx context.Context, rawToken string,
+) (*domain.User, *domain.PersonalAccessToken, error) {
+	token, err := s.tokenRepo.GetByToken(ctx, rawToken)
+	if err != nil || !token.IsActive() {
+		return nil, nil, domain.NewAuthenticationError("INVALID_TOKEN", "Invalid or expired token")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, token.UserID)
+	if err != nil {
+		return nil, nil, domain.NewAuthenticationError("USER_NOT_FOUND", "User not found")
+	}
+
+	now := time.Now().UTC()
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= lastUsedPrecision {
+		token.LastUsedAt = &now
+		if err := s.tokenRepo.Update(ctx, token); err != nil {
+			// Failing to record use shouldn't fail the request
+			slog.Warn("Failed to record personal access token use", "token_id", token.ID, "error", err)
+		}
+	}
+
+	// Remove password hash from response
+	user.PasswordHash = ""
+
+	return user, token, nil
+}
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+func TestPersonalAccessTokenService(t *testing.T) {
+	ctx := context.Background()
+	userRepo := testutil.NewMockUserRepository()
+	tokenRepo := testutil.NewMockPersonalAccessTokenRepository()
+	tokens := NewPersonalAccessTokenService(tokenRepo, userRepo)
+
+	user := testutil.MockUser("user-1", "ada@example.com", "ada", "Ada")
+	user.PasswordHash = "hash"
+	userRepo.AddUser(user)
+
+	created, err := tokens.CreateToken(ctx, "user-1", domain.CreatePersonalAccessTokenRequest{
+		Name: "CI", Scope: domain.ScopeTasksWrite, ExpiresInDays: 30,
+	})
+	require.NoError(t, err)
+
+	t.Run("TokenIsShownOnceAndStoredWithoutIt", func(t *testing.T) {
+		assert.True(t, domain.IsPersonalAccessToken(created.Token))
+		assert.True(t, strings.HasPrefix(created.Token, created.Hint))
+		require.NotNil(t, created.ExpiresAt)
+		assert.WithinDuration(t, time.Now().AddDate(0, 0, 30), *created.ExpiresAt, time.Minute)
+
+		listed, err := tokens.ListTokens(ctx, "user-1")
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		assert.Empty(t, listed[0].Token)
+		assert.Equal(t, created.Hint, listed[0].Hint)
+	})
+
+	t.Run("Authenticate", func(t *testing.T) {
+		authenticated, token, err := tokens.Authenticate(ctx, created.Token)
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", authenticated.ID)
+		assert.Empty(t, authenticated.PasswordHash)
+		assert.Equal(t, domain.ScopeTasksWrite, token.Scope)
+
+		stored, err := tokenRepo.GetByID(ctx, created.ID)
+		require.NoError(t, err)
+		require.NotNil(t, stored.LastUsedAt)
+	})
+
+	t.Run("UnknownToken", func(t *testing.T) {
+		_, _, err := tokens.Authenticate(ctx, domain.PersonalAccessTokenPrefix+"nope")
+		assert.Equal(t, domain.AuthenticationError, webhookErrorType(err))
+	})
+
+	t.Run("ExpiredToken", func(t *testing.T) {
+		expired, err := tokens.CreateToken(ctx, "user-1", domain.CreatePersonalAccessTokenRequest{
+			Name: "Old", Scope: domain.ScopeReadOnly, ExpiresInDays: 1,
+		})
+		require.NoError(t, err)
+		past := time.Now().Add(-time.Minute)
+		tokenRepo.Tokens[expired.ID].ExpiresAt = &past
+
+		_, _, err = tokens.Authenticate(ctx, expired.Token)
+		assert.Equal(t, domain.AuthenticationError, webhookErrorType(err))
+	})
+
+	t.Run("OnlyTheOwnerRevokes", func(t *testing.T) {
+		err := tokens.RevokeToken(ctx, "user-2", created.ID)
+		assert.Equal(t, domain.NotFoundError, webhookErrorType(err))
+
+		require.NoError(t, tokens.RevokeToken(ctx, "user-1", created.ID))
+
+		_, _, err = tokens.Authenticate(ctx, created.Token)
+		assert.Equal(t, domain.AuthenticationError, webhookErrorType(err))
+
+		listed, err := tokens.ListTokens(ctx, "user-1")
+		require.NoError(t, err)
+		for _, token := range listed {
+			assert.NotEqual(t, created.ID, token.ID)
+		}
+
+		err = tokens.RevokeToken(ctx, "user-1", created.ID)
+		assert.Equal(t, domain.NotFoundError, webhookErrorType(err))
+	})
+}
@@ -33,8 +33,13 @@ type ProjectService interface {
 	// ListUserProjects lists projects for a user
 	ListUserProjects(ctx context.Context, userID string, offset, limit int) ([]*domain.Project, error)
 
-	// AddMember adds a user to a project
-	AddMember(ctx context.Context, projectID string, userID string, requesterID string) error
+	// AddMember adds a user to a project with a role; an empty role means domain.ProjectMember
+	AddMember(ctx context.Context, projectID string, userID string, role domain.ProjectRole, requesterID string) error
+
+	// SetMemberRole changes a project member's role
+	SetMemberRole(
+		ctx context.Context, projectID string, userID string, role domain.ProjectRole, requesterID string,
+	) (*domain.Project, error)
 
 	// RemoveMember removes a user from a project
 	RemoveMember(ctx context.Context, projectID string, userID string, requesterID string) error
@@ -47,13 +52,19 @@ type ProjectService interface {
 type projectService struct {
 	projectRepo repository.ProjectRepository
 	userRepo    repository.UserRepository
+	authz       AuthorizationService
 }
 
 // NewProjectService creates a new project service.
-func NewProjectService(projectRepo repository.ProjectRepository, userRepo repository.UserRepository) ProjectService {
+func NewProjectService(
+	projectRepo repository.ProjectRepository,
+	userRepo repository.UserRepository,
+	authz AuthorizationService,
+) ProjectService {
 	return &projectService{
 		projectRepo: projectRepo,
 		userRepo:    userRepo,
+		authz:       authz,
 	}
 }
 
@@ -111,6 +122,10 @@ func (s *projectService) CreateProject(
 		MemberIDs:   []string{ownerID}, // Owner is automatically a member
 	}
 
+	if err := project.Validate(); err != nil {
+		return nil, err
+	}
+
 	if err := s.projectRepo.Create(ctx, project); err != nil {
 		return nil, domain.NewInternalError("PROJECT_CREATE_FAILED", "Failed to create project", err)
 	}
@@ -130,8 +145,8 @@ func (s *projectService) GetProject(ctx context.Context, projectID string, userI
 	}
 
 	// Check if user has access
-	if !project.HasAccess(userID) && project.Settings.IsPrivate {
-		return nil, domain.NewAuthorizationError("ACCESS_DENIED", "You don't have access to this project")
+	if err := s.authz.Check(ctx, project, userID, domain.PermissionViewProject); err != nil {
+		return nil, err
 	}
 
 	return project, nil
@@ -149,8 +164,8 @@ func (s *projectService) GetProjectBySlug(ctx context.Context, slug string, user
 	}
 
 	// Check if user has access
-	if !project.HasAccess(userID) && project.Settings.IsPrivate {
-		return nil, domain.NewAuthorizationError("ACCESS_DENIED", "You don't have access to this project")
+	if err := s.authz.Check(ctx, project, userID, domain.PermissionViewProject); err != nil {
+		return nil, err
 	}
 
 	return project, nil
@@ -173,12 +188,11 @@ func (s *projectService) UpdateProject(
 		return nil, err
 	}
 
-	// Check if user is owner or admin
-	if !project.IsOwner(userID) {
-		// Check if user is admin member
+	// Check if user may manage the project or is an admin member
+	if authErr := s.authz.Check(ctx, project, userID, domain.PermissionManageProject); authErr != nil {
 		user, err := s.userRepo.GetByID(ctx, userID)
 		if err != nil || (!project.HasAccess(userID) || user.Role != domain.AdminRole) {
-			return nil, domain.NewAuthorizationError("ACCESS_DENIED", "Only project owners can update projects")
+			return nil, authErr
 		}
 	}
 
@@ -199,7 +213,10 @@ func (s *projectService) UpdateProject(
 		project.Status = *req.Status
 	}
 	if req.Settings != nil {
+		// The workflow moves tasks around when it changes, so it only changes through the workflow service
+		workflow := project.Settings.Workflow
 		project.Settings = *req.Settings
+		project.Settings.Workflow = workflow
 	}
 
 	// Validate updated project
@@ -227,9 +244,8 @@ func (s *projectService) DeleteProject(ctx context.Context, projectID string, us
 		return err
 	}
 
-	// Check if user is owner
-	if !project.IsOwner(userID) {
-		return domain.NewAuthorizationError("ACCESS_DENIED", "Only project owners can delete projects")
+	if err := s.authz.Check(ctx, project, userID, domain.PermissionDeleteProject); err != nil {
+		return err
 	}
 
 	// Delete from repository
@@ -266,14 +282,22 @@ func (s *projectService) ListUserProjects(
 	return projects, nil
 }
 
-// AddMember adds a user to a project.
-func (s *projectService) AddMember(ctx context.Context, projectID string, userID string, requesterID string) error {
+// AddMember adds a user to a project. Adding an existing member changes their role.
+func (s *projectService) AddMember(
+	ctx context.Context, projectID string, userID string, role domain.ProjectRole, requesterID string,
+) error {
 	if projectID == "" {
 		return domain.NewValidationError("INVALID_PROJECT_ID", "Project ID cannot be empty", nil)
 	}
 	if userID == "" {
 		return domain.NewValidationError("INVALID_USER_ID", "User ID cannot be empty", nil)
 	}
+	if role == "" {
+		role = domain.ProjectMember
+	}
+	if err := domain.ValidateAssignableRole(role); err != nil {
+		return err
+	}
 
 	// Get project
 	project, err := s.projectRepo.GetByID(ctx, projectID)
@@ -281,9 +305,8 @@ func (s *projectService) AddMember(ctx context.Context, projectID string, userID
 		return err
 	}
 
-	// Check if requester has permission to add members (only owners can add members)
-	if !project.IsOwner(requesterID) {
-		return domain.NewAuthorizationError("ACCESS_DENIED", "Only project owners can add members")
+	if err := s.authz.Check(ctx, project, requesterID, domain.PermissionManageMembers); err != nil {
+		return err
 	}
 
 	// Check if user exists
@@ -294,6 +317,7 @@ func (s *projectService) AddMember(ctx context.Context, projectID string, userID
 
 	// Add member
 	project.AddMember(userID)
+	project.SetMemberRole(userID, role)
 
 	// Update project
 	if err := s.projectRepo.Update(ctx, project); err != nil {
@@ -318,10 +342,11 @@ func (s *projectService) RemoveMember(ctx context.Context, projectID string, use
 		return err
 	}
 
-	// Check if requester has permission (owners can remove others, users can remove themselves)
-	canRemove := project.IsOwner(requesterID) || requesterID == userID
-	if !canRemove {
-		return domain.NewAuthorizationError("ACCESS_DENIED", "You don't have permission to remove this member")
+	// Users can always leave; removing anyone else takes permission to manage members
+	if requesterID != userID {
+		if err := s.authz.Check(ctx, project, requesterID, domain.PermissionManageMembers); err != nil {
+			return err
+		}
 	}
 
 	// Cannot remove owner
@@ -340,6 +365,45 @@ func (s *projectService) RemoveMember(ctx context.Context, projectID string, use
 	return nil
 }
 
+// SetMemberRole changes a project member's role.
+func (s *projectService) SetMemberRole(
+	ctx context.Context, projectID string, userID string, role domain.ProjectRole, requesterID string,
+) (*domain.Project, error) {
+	if projectID == "" {
+		return nil, domain.NewValidationError("INVALID_PROJECT_ID", "Project ID cannot be empty", nil)
+	}
+	if userID == "" {
+		return nil, domain.NewValidationError("INVALID_USER_ID", "User ID cannot be empty", nil)
+	}
+	if err := domain.ValidateAssignableRole(role); err != nil {
+		return nil, err
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authz.Check(ctx, project, requesterID, domain.PermissionManageMembers); err != nil {
+		return nil, err
+	}
+
+	if project.IsOwner(userID) {
+		return nil, domain.NewValidationError("CANNOT_CHANGE_OWNER_ROLE", "Project owner's role cannot be changed", nil)
+	}
+	if !project.IsMember(userID) {
+		return nil, domain.NewNotFoundError("MEMBER_NOT_FOUND", "User is not a member of this project")
+	}
+
+	project.SetMemberRole(userID, role)
+
+	if err := s.projectRepo.Update(ctx, project); err != nil {
+		return nil, domain.NewInternalError("MEMBER_ROLE_UPDATE_FAILED", "Failed to update member role", err)
+	}
+
+	return project, nil
+}
+
 // ListMembers lists project members.
 func (s *projectService) ListMembers(ctx context.Context, projectID string, userID string) ([]*domain.User, error) {
 	if projectID == "" {
@@ -353,8 +417,8 @@ func (s *projectService) ListMembers(ctx context.Context, projectID string, user
 	}
 
 	// Check if user has access to view members
-	if !project.HasAccess(userID) && project.Settings.IsPrivate {
-		return nil, domain.NewAuthorizationError("ACCESS_DENIED", "You don't have access to view project members")
+	if err := s.authz.Check(ctx, project, userID, domain.PermissionViewProject); err != nil {
+		return nil, err
 	}
 
 	// Get member details
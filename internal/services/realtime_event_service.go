@@ -19,6 +19,8 @@ type RealtimeEventService struct {
 	eventHandlers    map[domain.TaskEventType][]RealtimeEventHandler
 	handlersMux      sync.RWMutex
 	wsHandler        WebSocketBroadcaster // Interface for WebSocket broadcasting
+	cluster          ClusterTransport     // Relays events to and from other replicas
+	deduper          *eventDeduper        // Set with cluster; drops the echo of our own published events
 }
 
 // EventSubscription represents an active event subscription
@@ -54,6 +56,7 @@ type RealtimeEventConfig struct {
 	EnableEventHistory   bool
 	EnableMetrics        bool
 	WebSocketIntegration bool
+	Cluster              ClusterTransport // Relays events to and from other replicas (optional)
 }
 
 // EventMetrics tracks event system performance
@@ -75,6 +78,10 @@ func NewRealtimeEventService(eventBroadcaster EventBroadcaster, config RealtimeE
 		subscriptions:    make(map[string]*EventSubscription),
 		eventQueue:       make(chan *domain.TaskEvent, config.QueueSize),
 		eventHandlers:    make(map[domain.TaskEventType][]RealtimeEventHandler),
+		cluster:          config.Cluster,
+	}
+	if config.Cluster != nil {
+		service.deduper = newEventDeduper(clusterDedupeCapacity)
 	}
 
 	// Start event processing goroutine
@@ -92,7 +99,7 @@ func (s *RealtimeEventService) SetWebSocketBroadcaster(ws WebSocketBroadcaster)
 }
 
 // PublishTaskEvent publishes a task event to the real-time system
-func (s *RealtimeEventService) PublishTaskEvent(_ context.Context, event *domain.TaskEvent) error {
+func (s *RealtimeEventService) PublishTaskEvent(ctx context.Context, event *domain.TaskEvent) error {
 	if event == nil {
 		return domain.NewValidationError("INVALID_EVENT", "Event cannot be nil", nil)
 	}
@@ -107,7 +114,47 @@ func (s *RealtimeEventService) PublishTaskEvent(_ context.Context, event *domain
 		return err
 	}
 
-	// Add to event queue
+	if s.deduper != nil && !s.deduper.firstSeen(event.EventID) {
+		return nil
+	}
+
+	if err := s.enqueue(event); err != nil {
+		return err
+	}
+
+	// Other replicas deliver the event to their own subscriptions and WebSocket clients
+	if s.cluster != nil {
+		if err := s.cluster.Publish(ctx, event); err != nil {
+			log.Printf("Failed to publish event %s to cluster: %v", event.EventID, err)
+		}
+	}
+
+	return nil
+}
+
+// StartClusterSync queues events published on other replicas until ctx is done.
+// It does nothing when no cluster transport is configured.
+func (s *RealtimeEventService) StartClusterSync(ctx context.Context) error {
+	if s.cluster == nil {
+		return nil
+	}
+
+	return s.cluster.Subscribe(ctx, func(event *domain.TaskEvent) {
+		if err := s.validateEvent(event); err != nil {
+			log.Printf("Dropping invalid cluster event %s: %v", event.EventID, err)
+			return
+		}
+		if !s.deduper.firstSeen(event.EventID) {
+			return
+		}
+		if err := s.enqueue(event); err != nil {
+			log.Printf("Dropping cluster event %s: %v", event.EventID, err)
+		}
+	})
+}
+
+// enqueue adds an event to the processing queue
+func (s *RealtimeEventService) enqueue(event *domain.TaskEvent) error {
 	select {
 	case s.eventQueue <- event:
 		log.Printf("Event queued: %s for task %s", event.Type, event.TaskID)
@@ -310,6 +357,11 @@ func (s *RealtimeEventService) subscriptionMatchesEvent(subscription *EventSubsc
 		return false
 	}
 
+	// Events addressed to particular users only reach them
+	if !event.IsFor(subscription.UserID) {
+		return false
+	}
+
 	// Check additional filters
 	if len(subscription.Filters) > 0 {
 		return s.checkEventFilters(subscription.Filters, event)
@@ -419,6 +471,30 @@ func (s *RealtimeEventService) registerDefaultHandlers() {
 		log.Printf("Task commented: %s", event.TaskID)
 		return nil
 	})
+
+	// Task unblocked handler
+	s.RegisterEventHandler(domain.TaskUnblocked, func(_ context.Context, event *domain.TaskEvent) error {
+		log.Printf("Task unblocked: %s", event.TaskID)
+		return nil
+	})
+
+	// Task mentioned handler
+	s.RegisterEventHandler(domain.TaskMentioned, func(_ context.Context, event *domain.TaskEvent) error {
+		log.Printf("User mentioned on task: %s", event.TaskID)
+		return nil
+	})
+
+	// Comment reaction handler
+	s.RegisterEventHandler(domain.TaskCommentReacted, func(_ context.Context, event *domain.TaskEvent) error {
+		log.Printf("Comment reaction changed on task: %s", event.TaskID)
+		return nil
+	})
+
+	// Comment thread resolution handler
+	s.RegisterEventHandler(domain.TaskCommentResolved, func(_ context.Context, event *domain.TaskEvent) error {
+		log.Printf("Comment thread resolution changed on task: %s", event.TaskID)
+		return nil
+	})
 }
 
 // GetMetrics returns event system metrics
@@ -0,0 +1,624 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/markdown"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
+)
+
+const (
+	// recurrenceBatchSize bounds the series advanced per scheduler run
+	recurrenceBatchSize = 50
+	// recurrencePreviewSize is how many upcoming dates GetRecurrence lists
+	recurrencePreviewSize = 5
+)
+
+// RecurrenceService manages recurring tasks. A series only keeps its latest occurrence ahead
+// of time; the next one is created when the latest is completed or its date arrives.
+type RecurrenceService interface {
+	// SetRecurrence makes a task recur, or changes the rule of the series it belongs to
+	SetRecurrence(ctx context.Context, taskID string, rule domain.RecurrenceRule, userID string) (*Recurrence, error)
+	// GetRecurrence returns the series a task belongs to with its upcoming dates
+	GetRecurrence(ctx context.Context, taskID string, userID string) (*Recurrence, error)
+	// StopRecurrence ends the series a task belongs to; existing occurrences are kept
+	StopRecurrence(ctx context.Context, taskID string, userID string) error
+	// UpdateOccurrence updates an occurrence, and with the future scope the series and its later occurrences
+	UpdateOccurrence(
+		ctx context.Context, taskID string, scope domain.RecurrenceScope, req domain.UpdateTaskRequest, userID string,
+	) (*domain.Task, error)
+	// GenerateDue creates the next occurrence of every series whose latest occurrence's date has
+	// arrived and returns how many were created
+	GenerateDue(ctx context.Context) (int, error)
+	// HandleEvent creates the next occurrence when an event completes the latest one of a series
+	HandleEvent(ctx context.Context, event *domain.TaskEvent) error
+	// StartSchedulerRoutine runs GenerateDue every interval until the context is done
+	StartSchedulerRoutine(ctx context.Context, interval time.Duration)
+	// SetEventBroadcaster sets where the creation of new occurrences is broadcast
+	SetEventBroadcaster(broadcaster EventBroadcaster)
+}
+
+// Recurrence describes the series a task belongs to
+type Recurrence struct {
+	Series   *domain.TaskSeries `json:"series"`
+	RRule    string             `json:"rrule"`
+	Upcoming []time.Time        `json:"upcoming"` // dates of the next occurrences to be created
+}
+
+type recurrenceService struct {
+	taskService TaskService
+	taskRepo    repository.TaskRepository
+	projectRepo repository.ProjectRepository
+	seriesRepo  repository.TaskSeriesRepository
+	authz       AuthorizationService
+	broadcaster EventBroadcaster
+	logger      *slog.Logger
+	now         func() time.Time
+	// mu serializes changes to series so an occurrence is created once even when its
+	// completion and its date arrive together
+	mu sync.Mutex
+}
+
+// NewRecurrenceService creates a new recurrence service.
+// taskService should be the plain service; new occurrences are broadcast through SetEventBroadcaster.
+func NewRecurrenceService(
+	taskService TaskService,
+	taskRepo repository.TaskRepository,
+	projectRepo repository.ProjectRepository,
+	seriesRepo repository.TaskSeriesRepository,
+	authz AuthorizationService,
+) RecurrenceService {
+	return &recurrenceService{
+		taskService: taskService,
+		taskRepo:    taskRepo,
+		projectRepo: projectRepo,
+		seriesRepo:  seriesRepo,
+		authz:       authz,
+		logger:      slog.Default().With("component", "recurrence"),
+		now:         time.Now,
+	}
+}
+
+// SetEventBroadcaster sets where the creation of new occurrences is broadcast
+func (s *recurrenceService) SetEventBroadcaster(broadcaster EventBroadcaster) {
+	s.broadcaster = broadcaster
+}
+
+// SetRecurrence makes a task recur, counting occurrences from its due date. On a task that
+// already recurs, the new rule applies from the series' latest occurrence on, which is where
+// its count starts over; an ended series is resumed.
+func (s *recurrenceService) SetRecurrence(
+	ctx context.Context, taskID string, rule domain.RecurrenceRule, userID string,
+) (*Recurrence, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	task, project, err := s.modifiableTask(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	series, err := s.setRule(ctx, task, rule, userID)
+	var created *domain.Task
+	if err == nil {
+		created, err = s.advanceIfCompleted(ctx, series, project)
+	}
+	s.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	s.broadcastOccurrence(ctx, series, created)
+	return s.describe(series), nil
+}
+
+// setRule starts a series with the task or changes the rule of the one it belongs to
+func (s *recurrenceService) setRule(
+	ctx context.Context, task *domain.Task, rule domain.RecurrenceRule, userID string,
+) (*domain.TaskSeries, error) {
+	if task.SeriesID != nil {
+		series, err := s.series(ctx, *task.SeriesID)
+		if err != nil {
+			return nil, err
+		}
+
+		series.Rule = rule
+		series.StartsAt = series.LatestAt
+		series.Occurrences = 1
+		series.EndedAt = nil
+		if err := s.seriesRepo.Update(ctx, series); err != nil {
+			return nil, domain.NewInternalError("RECURRENCE_UPDATE_FAILED", "Failed to update recurrence", err)
+		}
+		return series, nil
+	}
+
+	if task.DueDate == nil {
+		return nil, domain.NewValidationError("RECURRENCE_NEEDS_DUE_DATE",
+			"A task needs a due date before it can recur", nil)
+	}
+
+	series := &domain.TaskSeries{
+		ProjectID:    task.ProjectID,
+		CreatedBy:    userID,
+		LatestTaskID: task.ID,
+		Rule:         rule,
+		Template:     domain.NewRecurrenceTemplate(task),
+		StartsAt:     *task.DueDate,
+		LatestAt:     *task.DueDate,
+		Occurrences:  1,
+	}
+	if err := s.seriesRepo.Create(ctx, series); err != nil {
+		return nil, domain.NewInternalError("RECURRENCE_CREATE_FAILED", "Failed to create recurrence", err)
+	}
+
+	task.SeriesID = &series.ID
+	if err := s.taskRepo.Update(ctx, task); err != nil {
+		return nil, domain.NewInternalError("TASK_UPDATE_FAILED", "Failed to link task to its recurrence", err)
+	}
+	return series, nil
+}
+
+// GetRecurrence returns the series a task belongs to with its upcoming dates
+func (s *recurrenceService) GetRecurrence(ctx context.Context, taskID string, userID string) (*Recurrence, error) {
+	task, err := s.taskService.GetTask(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if task.SeriesID == nil {
+		return nil, errTaskNotRecurring()
+	}
+
+	series, err := s.series(ctx, *task.SeriesID)
+	if err != nil {
+		return nil, err
+	}
+	return s.describe(series), nil
+}
+
+// StopRecurrence ends the series a task belongs to; existing occurrences are kept
+func (s *recurrenceService) StopRecurrence(ctx context.Context, taskID string, userID string) error {
+	task, _, err := s.modifiableTask(ctx, taskID, userID)
+	if err != nil {
+		return err
+	}
+	if task.SeriesID == nil {
+		return errTaskNotRecurring()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	series, err := s.series(ctx, *task.SeriesID)
+	if err != nil {
+		return err
+	}
+	if !series.IsActive() {
+		return nil
+	}
+
+	series.End(s.now().UTC())
+	if err := s.seriesRepo.Update(ctx, series); err != nil {
+		return domain.NewInternalError("RECURRENCE_UPDATE_FAILED", "Failed to stop recurrence", err)
+	}
+	return nil
+}
+
+// UpdateOccurrence updates an occurrence. With the future scope the change also goes to the
+// occurrences still to come and to the open ones after this one; moving the due date shifts
+// theirs by as much. Status changes only ever apply to the occurrence itself, and completing
+// the latest occurrence creates the next one.
+func (s *recurrenceService) UpdateOccurrence(
+	ctx context.Context, taskID string, scope domain.RecurrenceScope, req domain.UpdateTaskRequest, userID string,
+) (*domain.Task, error) {
+	if scope == "" {
+		scope = domain.RecurrenceScopeThis
+	}
+	if !scope.IsValid() {
+		return nil, domain.NewValidationError("INVALID_RECURRENCE_SCOPE",
+			"Scope must be \"this\" or \"future\"", map[string]interface{}{"scope": string(scope)})
+	}
+
+	var (
+		updated *domain.Task
+		err     error
+	)
+	if scope == domain.RecurrenceScopeThis {
+		updated, err = s.taskService.UpdateTask(ctx, taskID, req, userID)
+	} else {
+		updated, err = s.updateFuture(ctx, taskID, req, userID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Status != nil {
+		if err := s.completeOccurrence(ctx, updated.ID); err != nil {
+			s.logger.Error("Failed to advance recurring task", "error", err, "task_id", updated.ID)
+		}
+	}
+	return updated, nil
+}
+
+// updateFuture updates an occurrence, the series template and the open occurrences after it
+func (s *recurrenceService) updateFuture(
+	ctx context.Context, taskID string, req domain.UpdateTaskRequest, userID string,
+) (*domain.Task, error) {
+	task, _, err := s.modifiableTask(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if task.SeriesID == nil {
+		return nil, errTaskNotRecurring()
+	}
+	original := *task // the update may change the loaded task in place
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	series, err := s.series(ctx, *original.SeriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.taskService.UpdateTask(ctx, taskID, req, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var shift time.Duration
+	if req.DueDate != nil && original.DueDate != nil {
+		shift = req.DueDate.Sub(*original.DueDate)
+	}
+
+	if err := s.updateLaterOccurrences(ctx, series, &original, req, shift, userID); err != nil {
+		return nil, err
+	}
+
+	series.Template.Apply(req)
+	series.StartsAt = series.StartsAt.Add(shift)
+	series.LatestAt = series.LatestAt.Add(shift)
+	if err := s.seriesRepo.Update(ctx, series); err != nil {
+		return nil, domain.NewInternalError("RECURRENCE_UPDATE_FAILED", "Failed to update recurrence", err)
+	}
+
+	return updated, nil
+}
+
+// updateLaterOccurrences applies an update to the open occurrences due after the edited one
+func (s *recurrenceService) updateLaterOccurrences(
+	ctx context.Context,
+	series *domain.TaskSeries,
+	edited *domain.Task,
+	req domain.UpdateTaskRequest,
+	shift time.Duration,
+	userID string,
+) error {
+	if edited.DueDate == nil {
+		return nil
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, series.ProjectID)
+	if err != nil {
+		return domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
+	}
+
+	occurrences, err := s.taskRepo.GetByProject(ctx, series.ProjectID, repository.TaskFilters{SeriesID: &series.ID})
+	if err != nil {
+		return domain.NewInternalError("TASK_FETCH_FAILED", "Failed to fetch occurrences", err)
+	}
+
+	for _, occurrence := range occurrences {
+		if occurrence.ID == edited.ID || occurrence.DueDate == nil || !occurrence.DueDate.After(*edited.DueDate) ||
+			project.Workflow().IsDone(occurrence.Status) {
+			continue
+		}
+
+		later := req
+		later.Status = nil
+		later.OverrideBlocked = false
+		if shift != 0 {
+			due := occurrence.DueDate.Add(shift)
+			later.DueDate = &due
+		} else {
+			later.DueDate = nil
+		}
+		if _, err := s.taskService.UpdateTask(ctx, occurrence.ID, later, userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GenerateDue creates the next occurrence of every series whose latest occurrence's date has arrived
+func (s *recurrenceService) GenerateDue(ctx context.Context) (int, error) {
+	s.mu.Lock()
+
+	due, err := s.seriesRepo.ListDue(ctx, s.now().UTC(), recurrenceBatchSize)
+	if err != nil {
+		s.mu.Unlock()
+		return 0, err
+	}
+
+	type occurrence struct {
+		series *domain.TaskSeries
+		task   *domain.Task
+	}
+	var created []occurrence
+	for _, series := range due {
+		if ctx.Err() != nil {
+			break
+		}
+
+		project, err := s.projectRepo.GetByID(ctx, series.ProjectID)
+		if err != nil {
+			s.logger.Error("Failed to load project of recurring task", "error", err, "series_id", series.ID)
+			continue
+		}
+
+		task, err := s.generateNext(ctx, series, project)
+		if err != nil {
+			s.logger.Error("Failed to create next occurrence", "error", err, "series_id", series.ID)
+			continue
+		}
+		if task != nil {
+			created = append(created, occurrence{series: series, task: task})
+		}
+	}
+	s.mu.Unlock()
+
+	// Broadcast outside the lock; the broadcast comes back through HandleEvent
+	for _, c := range created {
+		s.broadcastOccurrence(ctx, c.series, c.task)
+	}
+	return len(created), nil
+}
+
+// HandleEvent creates the next occurrence when an event completes the latest one of a series.
+// Only moves, status updates and bulk updates can complete a task.
+func (s *recurrenceService) HandleEvent(ctx context.Context, event *domain.TaskEvent) error {
+	var taskIDs []string
+	switch event.Type {
+	case domain.TaskMoved, domain.TaskUpdated:
+		task, from, to, err := statusChange(event)
+		if err != nil {
+			return err
+		}
+		if task == nil || from == to || task.SeriesID == nil {
+			return nil
+		}
+		taskIDs = []string{task.ID}
+
+	case domain.TasksBulkUpdated:
+		var data domain.TasksBulkUpdatedData
+		if err := event.GetDataAs(&data); err != nil {
+			return fmt.Errorf("invalid %s event data: %w", event.Type, err)
+		}
+		taskIDs = data.TaskIDs
+
+	default:
+		return nil
+	}
+
+	for _, taskID := range taskIDs {
+		if err := s.completeOccurrence(ctx, taskID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// completeOccurrence creates the next occurrence if the task is the latest of its series and done
+func (s *recurrenceService) completeOccurrence(ctx context.Context, taskID string) error {
+	task, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil || task.SeriesID == nil {
+		return nil // deleted since, or not recurring
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, task.ProjectID)
+	if err != nil {
+		return fmt.Errorf("failed to load project %s: %w", task.ProjectID, err)
+	}
+
+	s.mu.Lock()
+	series, err := s.seriesRepo.GetByID(ctx, *task.SeriesID)
+	var created *domain.Task
+	if err == nil {
+		created, err = s.advanceIfCompleted(ctx, series, project)
+	}
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	s.broadcastOccurrence(ctx, series, created)
+	return nil
+}
+
+// advanceIfCompleted creates the next occurrence when the latest one is done. It returns the
+// created occurrence, or nil when none was due.
+func (s *recurrenceService) advanceIfCompleted(
+	ctx context.Context, series *domain.TaskSeries, project *domain.Project,
+) (*domain.Task, error) {
+	if !series.IsActive() {
+		return nil, nil
+	}
+
+	latest, err := s.taskRepo.GetByID(ctx, series.LatestTaskID)
+	if err != nil || !project.Workflow().IsDone(latest.Status) {
+		return nil, nil //nolint:nilerr // a deleted latest occurrence waits for its date instead
+	}
+	return s.generateNext(ctx, series, project)
+}
+
+// generateNext creates the occurrence after the latest one of a series, or ends the series when
+// its rule has run out. The caller must hold s.mu.
+func (s *recurrenceService) generateNext(
+	ctx context.Context, series *domain.TaskSeries, project *domain.Project,
+) (*domain.Task, error) {
+	now := s.now().UTC()
+	next, ok := series.NextOccurrence(now)
+	if !ok {
+		series.End(now)
+		if err := s.seriesRepo.Update(ctx, series); err != nil {
+			return nil, fmt.Errorf("failed to end series %s: %w", series.ID, err)
+		}
+		return nil, nil
+	}
+
+	// Occurrences are copies of the series template, the way duplicated tasks are
+	task := createTaskCopy(series.Template.Occurrence(series.ProjectID, next), project.Workflow().Initial(),
+		DuplicationOptions{NewTitle: series.Template.Title, ResetProgress: true, ResetTimeSpent: true})
+	task.ReporterID = series.CreatedBy
+	task.SeriesID = &series.ID
+	if task.AssigneeID != nil && !project.HasAccess(*task.AssigneeID) {
+		task.AssigneeID = nil // left the project since the series started
+	}
+	task.ApplyChecklist(markdown.Checklist(task.Description))
+	task.WatchParticipants()
+
+	if err := s.taskRepo.Create(ctx, task); err != nil {
+		return nil, fmt.Errorf("failed to create occurrence of series %s: %w", series.ID, err)
+	}
+
+	series.LatestTaskID = task.ID
+	series.LatestAt = next
+	series.Occurrences++
+	if err := s.seriesRepo.Update(ctx, series); err != nil {
+		return nil, fmt.Errorf("failed to update series %s: %w", series.ID, err)
+	}
+
+	return task, nil
+}
+
+// StartSchedulerRoutine runs GenerateDue every interval until the context is done
+func (s *recurrenceService) StartSchedulerRoutine(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		s.logger.Info("Started recurring task scheduler", "interval", interval)
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Stopping recurring task scheduler due to context cancellation")
+				return
+			case <-ticker.C:
+			}
+
+			if _, err := s.GenerateDue(ctx); err != nil && ctx.Err() == nil {
+				s.logger.Error("Failed to create due occurrences", "error", err)
+			}
+		}
+	}()
+}
+
+// broadcastOccurrence announces a new occurrence; a nil task is ignored
+func (s *recurrenceService) broadcastOccurrence(ctx context.Context, series *domain.TaskSeries, task *domain.Task) {
+	if task == nil || s.broadcaster == nil {
+		return
+	}
+
+	event, err := domain.NewTaskEvent(domain.TaskCreated, task.ID, task.ProjectID, series.CreatedBy,
+		&domain.TaskCreatedData{Task: task})
+	if err == nil {
+		err = s.broadcaster.BroadcastEvent(ctx, event)
+	}
+	if err != nil {
+		s.logger.Error("Failed to broadcast new occurrence", "error", err, "task_id", task.ID, "series_id", series.ID)
+	}
+}
+
+// describe lists a series with its rule in RRULE form and its upcoming dates
+func (s *recurrenceService) describe(series *domain.TaskSeries) *Recurrence {
+	return &Recurrence{
+		Series:   series,
+		RRule:    series.Rule.String(),
+		Upcoming: series.Upcoming(s.now().UTC(), recurrencePreviewSize),
+	}
+}
+
+// modifiableTask loads a task the user may change, with its project
+func (s *recurrenceService) modifiableTask(
+	ctx context.Context, taskID, userID string,
+) (*domain.Task, *domain.Project, error) {
+	if taskID == "" {
+		return nil, nil, domain.NewValidationError("INVALID_TASK_ID", "Task ID cannot be empty", nil)
+	}
+
+	task, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, nil, domain.NewNotFoundError("TASK_NOT_FOUND", "Task not found")
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, task.ProjectID)
+	if err != nil {
+		return nil, nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
+	}
+
+	if err := s.authz.Check(ctx, project, userID, domain.PermissionEditTasks); err != nil {
+		return nil, nil, err
+	}
+
+	return task, project, nil
+}
+
+// series loads a series by ID
+func (s *recurrenceService) series(ctx context.Context, seriesID string) (*domain.TaskSeries, error) {
+	series, err := s.seriesRepo.GetByID(ctx, seriesID)
+	if err != nil {
+		return nil, domain.NewNotFoundError("RECURRENCE_NOT_FOUND", "Recurrence not found")
+	}
+	return series, nil
+}
+
+// errTaskNotRecurring is returned for recurrence operations on a task outside any series
+func errTaskNotRecurring() error {
+	return domain.NewNotFoundError("RECURRENCE_NOT_FOUND", "Task does not recur")
+}
+
+// recurrenceEventBroadcaster creates the next occurrence of a series when an event it
+// broadcasts completes the latest one
+type recurrenceEventBroadcaster struct {
+	EventBroadcaster
+	recurrence RecurrenceService
+	logger     *slog.Logger
+}
+
+// NewRecurrenceEventBroadcaster wraps a broadcaster so that completing the latest occurrence
+// of a recurring task on this replica creates the next one. Events relayed from other
+// replicas are skipped, as they are for webhooks and notifications.
+func NewRecurrenceEventBroadcaster(broadcaster EventBroadcaster, recurrence RecurrenceService) EventBroadcaster {
+	return &recurrenceEventBroadcaster{
+		EventBroadcaster: broadcaster,
+		recurrence:       recurrence,
+		logger:           slog.Default().With("component", "recurrence"),
+	}
+}
+
+// BroadcastEvent broadcasts the event and advances any series it completes an occurrence of
+func (b *recurrenceEventBroadcaster) BroadcastEvent(ctx context.Context, event *domain.TaskEvent) error {
+	if err := b.EventBroadcaster.BroadcastEvent(ctx, event); err != nil {
+		return err
+	}
+
+	// The scheduler creates the occurrence once its date arrives if this fails
+	if err := b.recurrence.HandleEvent(ctx, event); err != nil {
+		b.logger.Error("Failed to advance recurring task",
+			"error", err,
+			"event_id", event.EventID,
+			"project_id", event.ProjectID)
+	}
+	return nil
+}
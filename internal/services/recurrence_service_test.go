@@ -0,0 +1,270 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+type recurrenceTestEnv struct {
+	service     *recurrenceService
+	tasks       *testutil.MockTaskRepository
+	series      *testutil.MockTaskSeriesRepository
+	broadcaster *mockEventBroadcaster
+	now         time.Time
+}
+
+// newRecurrenceTestEnv sets up a private project owned by the owner, with a member and a viewer,
+// and a standup task due on Monday 3 March 2098 at 09:30, assigned to the member. The clock
+// starts the Friday before; dates are far ahead because tasks can't be given due dates in the past.
+func newRecurrenceTestEnv(t *testing.T) *recurrenceTestEnv {
+	t.Helper()
+	env := &recurrenceTestEnv{
+		tasks:       testutil.NewMockTaskRepository(),
+		series:      testutil.NewMockTaskSeriesRepository(),
+		broadcaster: &mockEventBroadcaster{},
+		now:         time.Date(2098, time.February, 28, 12, 0, 0, 0, time.UTC),
+	}
+
+	projects := testutil.NewMockProjectRepository()
+	project := testutil.MockProject("project-1", "Project", "project", "owner")
+	project.MemberIDs = []string{"member", "viewer"}
+	project.MemberRoles = map[string]domain.ProjectRole{"viewer": domain.ProjectViewer}
+	project.Settings.IsPrivate = true
+	projects.AddProject(project)
+
+	due := time.Date(2098, time.March, 3, 9, 30, 0, 0, time.UTC)
+	assignee := "member"
+	standup := testutil.MockTask("task-1", "Standup", "project-1", "owner")
+	standup.DueDate = &due
+	standup.AssigneeID = &assignee
+	standup.Tags = []string{"team"}
+	env.tasks.AddTask(standup)
+
+	authz := NewAuthorizationService(projects)
+	taskService := NewTaskService(
+		env.tasks, projects, testutil.NewMockUserRepository(), testutil.NewMockTaskHistoryRepository(),
+		authz, nil, nil,
+	)
+	env.service = NewRecurrenceService(taskService, env.tasks, projects, env.series, authz).(*recurrenceService)
+	env.service.now = func() time.Time { return env.now }
+	env.service.SetEventBroadcaster(env.broadcaster)
+	return env
+}
+
+// occurrences returns the tasks of a series, earliest due first
+func (env *recurrenceTestEnv) occurrences(t *testing.T, seriesID string) []*domain.Task {
+	t.Helper()
+	tasks, err := env.tasks.GetByProject(context.Background(), "project-1",
+		repository.TaskFilters{SeriesID: &seriesID})
+	require.NoError(t, err)
+	for i := 1; i < len(tasks); i++ {
+		for j := i; j > 0 && tasks[j].DueDate.Before(*tasks[j-1].DueDate); j-- {
+			tasks[j], tasks[j-1] = tasks[j-1], tasks[j]
+		}
+	}
+	return tasks
+}
+
+func TestRecurrenceService_SetRecurrence(t *testing.T) {
+	ctx := context.Background()
+	env := newRecurrenceTestEnv(t)
+	weekdays := domain.RecurrenceRule{Frequency: domain.RecurrenceWeekly, Weekdays: []string{"MO", "WE", "FR"}}
+
+	t.Run("OutsiderCannotSet", func(t *testing.T) {
+		_, err := env.service.SetRecurrence(ctx, "task-1", weekdays, "outsider")
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+	})
+
+	t.Run("ViewerCannotSet", func(t *testing.T) {
+		_, err := env.service.SetRecurrence(ctx, "task-1", weekdays, "viewer")
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+	})
+
+	t.Run("NeedsDueDate", func(t *testing.T) {
+		env.tasks.AddTask(testutil.MockTask("task-2", "Someday", "project-1", "owner"))
+		_, err := env.service.SetRecurrence(ctx, "task-2", weekdays, "owner")
+		require.Error(t, err)
+		var domainErr *domain.Error
+		require.ErrorAs(t, err, &domainErr)
+		assert.Equal(t, "RECURRENCE_NEEDS_DUE_DATE", domainErr.Code)
+	})
+
+	recurrence, err := env.service.SetRecurrence(ctx, "task-1", weekdays, "member")
+	require.NoError(t, err)
+	assert.Equal(t, "FREQ=WEEKLY;BYDAY=MO,WE,FR", recurrence.RRule)
+	assert.Equal(t, "task-1", recurrence.Series.LatestTaskID)
+	assert.Equal(t, "Standup", recurrence.Series.Template.Title)
+	require.Len(t, recurrence.Upcoming, recurrencePreviewSize)
+	assert.Equal(t, time.Wednesday, recurrence.Upcoming[0].Weekday())
+
+	task, err := env.tasks.GetByID(ctx, "task-1")
+	require.NoError(t, err)
+	require.NotNil(t, task.SeriesID)
+	assert.Equal(t, recurrence.Series.ID, *task.SeriesID)
+
+	t.Run("Get", func(t *testing.T) {
+		got, err := env.service.GetRecurrence(ctx, "task-1", "owner")
+		require.NoError(t, err)
+		assert.Equal(t, recurrence.Series.ID, got.Series.ID)
+
+		_, err = env.service.GetRecurrence(ctx, "task-2", "owner")
+		assert.Equal(t, domain.NotFoundError, webhookErrorType(err))
+	})
+
+	t.Run("ChangeRuleRestartsFromLatest", func(t *testing.T) {
+		changed, err := env.service.SetRecurrence(ctx, "task-1",
+			domain.RecurrenceRule{Frequency: domain.RecurrenceDaily, Count: 3}, "owner")
+		require.NoError(t, err)
+		assert.Equal(t, recurrence.Series.ID, changed.Series.ID)
+		assert.Equal(t, "member", changed.Series.CreatedBy)
+		assert.Len(t, changed.Upcoming, 2)
+	})
+}
+
+func TestRecurrenceService_CompletingCreatesNext(t *testing.T) {
+	ctx := context.Background()
+	env := newRecurrenceTestEnv(t)
+
+	recurrence, err := env.service.SetRecurrence(ctx, "task-1",
+		domain.RecurrenceRule{Frequency: domain.RecurrenceDaily, Count: 2}, "owner")
+	require.NoError(t, err)
+
+	complete := domain.StatusComplete
+	_, err = env.service.UpdateOccurrence(ctx, "task-1", "", domain.UpdateTaskRequest{Status: &complete}, "member")
+	require.NoError(t, err)
+
+	occurrences := env.occurrences(t, recurrence.Series.ID)
+	require.Len(t, occurrences, 2)
+	next := occurrences[1]
+	assert.Equal(t, "Standup", next.Title)
+	assert.Equal(t, time.Date(2098, time.March, 4, 9, 30, 0, 0, time.UTC), *next.DueDate)
+	assert.Equal(t, domain.DefaultWorkflow().Initial(), next.Status)
+	assert.Equal(t, "owner", next.ReporterID)
+	require.NotNil(t, next.AssigneeID)
+	assert.Equal(t, "member", *next.AssigneeID)
+	assert.Equal(t, []string{"team"}, next.Tags)
+
+	require.Len(t, env.broadcaster.broadcastedEvents, 1)
+	assert.Equal(t, domain.TaskCreated, env.broadcaster.broadcastedEvents[0].Type)
+
+	t.Run("CompletingAnOlderOccurrenceAgainDoesNothing", func(t *testing.T) {
+		event, err := domain.NewTaskEvent(domain.TaskMoved, "task-1", "project-1", "member", &domain.TaskMovedData{
+			Task: occurrences[0], OldStatus: domain.StatusReview, NewStatus: domain.StatusComplete,
+		})
+		require.NoError(t, err)
+		require.NoError(t, env.service.HandleEvent(ctx, event))
+		assert.Len(t, env.occurrences(t, recurrence.Series.ID), 2)
+	})
+
+	t.Run("CountEndsTheSeries", func(t *testing.T) {
+		_, err := env.service.UpdateOccurrence(ctx, next.ID, "", domain.UpdateTaskRequest{Status: &complete}, "member")
+		require.NoError(t, err)
+		assert.Len(t, env.occurrences(t, recurrence.Series.ID), 2)
+
+		series, err := env.series.GetByID(ctx, recurrence.Series.ID)
+		require.NoError(t, err)
+		assert.False(t, series.IsActive())
+	})
+}
+
+func TestRecurrenceService_GenerateDue(t *testing.T) {
+	ctx := context.Background()
+	env := newRecurrenceTestEnv(t)
+
+	recurrence, err := env.service.SetRecurrence(ctx, "task-1",
+		domain.RecurrenceRule{Frequency: domain.RecurrenceWeekly, Weekdays: []string{"MO", "TH"}}, "owner")
+	require.NoError(t, err)
+
+	created, err := env.service.GenerateDue(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, created, "nothing is due before the first occurrence's date")
+
+	// Two weeks pass without the scheduler running; the missed dates are skipped
+	env.now = time.Date(2098, time.March, 17, 12, 0, 0, 0, time.UTC)
+	created, err = env.service.GenerateDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, created)
+
+	occurrences := env.occurrences(t, recurrence.Series.ID)
+	require.Len(t, occurrences, 2)
+	assert.Equal(t, time.Date(2098, time.March, 20, 9, 30, 0, 0, time.UTC), *occurrences[1].DueDate)
+
+	created, err = env.service.GenerateDue(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, created, "an occurrence is only created once")
+
+	t.Run("StoppedSeriesCreatesNothing", func(t *testing.T) {
+		require.NoError(t, env.service.StopRecurrence(ctx, occurrences[1].ID, "owner"))
+
+		env.now = time.Date(2098, time.March, 21, 12, 0, 0, 0, time.UTC)
+		created, err := env.service.GenerateDue(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, created)
+	})
+}
+
+func TestRecurrenceService_UpdateFutureOccurrences(t *testing.T) {
+	ctx := context.Background()
+	env := newRecurrenceTestEnv(t)
+
+	recurrence, err := env.service.SetRecurrence(ctx, "task-1",
+		domain.RecurrenceRule{Frequency: domain.RecurrenceDaily}, "owner")
+	require.NoError(t, err)
+
+	env.now = time.Date(2098, time.March, 3, 10, 0, 0, 0, time.UTC)
+	_, err = env.service.GenerateDue(ctx)
+	require.NoError(t, err)
+	occurrences := env.occurrences(t, recurrence.Series.ID)
+	require.Len(t, occurrences, 2)
+
+	t.Run("InvalidScope", func(t *testing.T) {
+		_, err := env.service.UpdateOccurrence(ctx, "task-1", "everything", domain.UpdateTaskRequest{}, "owner")
+		assert.Equal(t, domain.ValidationError, webhookErrorType(err))
+	})
+
+	t.Run("ThisOccurrenceOnly", func(t *testing.T) {
+		title := "Standup (remote)"
+		updated, err := env.service.UpdateOccurrence(ctx, "task-1", domain.RecurrenceScopeThis,
+			domain.UpdateTaskRequest{Title: &title}, "owner")
+		require.NoError(t, err)
+		assert.Equal(t, title, updated.Title)
+		assert.Equal(t, "Standup", occurrences[1].Title)
+
+		series, err := env.series.GetByID(ctx, recurrence.Series.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Standup", series.Template.Title)
+	})
+
+	t.Run("AllFutureOccurrences", func(t *testing.T) {
+		title := "Daily sync"
+		later := occurrences[0].DueDate.Add(30 * time.Minute)
+		_, err := env.service.UpdateOccurrence(ctx, "task-1", domain.RecurrenceScopeFuture,
+			domain.UpdateTaskRequest{Title: &title, DueDate: &later}, "owner")
+		require.NoError(t, err)
+
+		assert.Equal(t, title, occurrences[1].Title)
+		assert.Equal(t, time.Date(2098, time.March, 4, 10, 0, 0, 0, time.UTC), *occurrences[1].DueDate)
+
+		series, err := env.series.GetByID(ctx, recurrence.Series.ID)
+		require.NoError(t, err)
+		assert.Equal(t, title, series.Template.Title)
+		next, ok := series.NextOccurrence(env.now)
+		require.True(t, ok)
+		assert.Equal(t, time.Date(2098, time.March, 5, 10, 0, 0, 0, time.UTC), next)
+	})
+
+	t.Run("NotRecurring", func(t *testing.T) {
+		env.tasks.AddTask(testutil.MockTask("task-2", "One-off", "project-1", "owner"))
+		_, err := env.service.UpdateOccurrence(ctx, "task-2", domain.RecurrenceScopeFuture,
+			domain.UpdateTaskRequest{}, "owner")
+		assert.Equal(t, domain.NotFoundError, webhookErrorType(err))
+	})
+}
@@ -2,129 +2,158 @@ package services
 
 import (
 	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/redis/go-redis/v9"
+
 	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
 )
 
-// RedisCacheBackend implements CacheBackend using Redis
-// Note: This is a placeholder implementation that would require a Redis client library
-// In a real implementation, you would use github.com/redis/go-redis/v9 or similar
+// redisScanBatchSize bounds how many keys each SCAN round trip returns
+const redisScanBatchSize = 500
 
-// RedisCacheBackend provides a Redis-based cache implementation (placeholder)
+// RedisCacheBackend implements CacheBackend using Redis.
+// Every replica sharing the Redis instance sees the same entries, so an
+// invalidation on one replica is visible to all of them.
 type RedisCacheBackend struct {
-	// client redis.Client // Placeholder - would be actual Redis client
+	client *redis.Client
 	prefix string
 }
 
 // NewRedisCacheBackend creates a new Redis cache backend
-func NewRedisCacheBackend(_, _ string, _ int, prefix string) *RedisCacheBackend {
-	// In a real implementation:
-	// client := redis.NewClient(&redis.Options{
-	//     Addr:     addr,
-	//     Password: password,
-	//     DB:       db,
-	// })
-
+func NewRedisCacheBackend(addr, password string, db int, prefix string) *RedisCacheBackend {
 	return &RedisCacheBackend{
-		// client: client,
+		client: redis.NewClient(&redis.Options{
+			Addr:     addr,
+			Password: password,
+			DB:       db,
+		}),
 		prefix: prefix,
 	}
 }
 
-// Set stores a value in Redis with TTL
-func (r *RedisCacheBackend) Set(_ context.Context, _ string, _ []byte, _ time.Duration) error {
-	// Placeholder implementation
-	// In a real implementation:
-	// fullKey := r.prefix + key
-	// return r.client.Set(ctx, fullKey, value, ttl).Err()
+// Ping checks that the Redis server is reachable
+func (r *RedisCacheBackend) Ping(ctx context.Context) error {
+	return r.client.Ping(ctx).Err()
+}
 
-	return nil // Placeholder
+// Close releases the underlying Redis connections
+func (r *RedisCacheBackend) Close() error {
+	return r.client.Close()
 }
 
-// Get retrieves a value from Redis
-func (r *RedisCacheBackend) Get(_ context.Context, _ string) ([]byte, error) {
-	// Placeholder implementation
-	// In a real implementation:
-	// fullKey := r.prefix + key
-	// return r.client.Get(ctx, fullKey).Bytes()
+// Set stores a value in Redis with TTL
+func (r *RedisCacheBackend) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return r.client.Set(ctx, r.prefix+key, value, ttl).Err()
+}
 
-	return nil, domain.NewNotFoundError("CACHE_MISS", "Cache miss") // Placeholder
+// Get retrieves a value from Redis
+func (r *RedisCacheBackend) Get(ctx context.Context, key string) ([]byte, error) {
+	data, err := r.client.Get(ctx, r.prefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, domain.NewNotFoundError("CACHE_MISS", "Cache miss")
+	}
+	return data, err
 }
 
 // Delete removes a key from Redis
-func (r *RedisCacheBackend) Delete(_ context.Context, _ string) error {
-	// Placeholder implementation
-	// In a real implementation:
-	// fullKey := r.prefix + key
-	// return r.client.Del(ctx, fullKey).Err()
-
-	return nil // Placeholder
+func (r *RedisCacheBackend) Delete(ctx context.Context, key string) error {
+	return r.client.Del(ctx, r.prefix+key).Err()
 }
 
-// DeletePattern deletes keys matching a pattern
-func (r *RedisCacheBackend) DeletePattern(_ context.Context, _ string) error {
-	// Placeholder implementation
-	// In a real implementation:
-	// fullPattern := r.prefix + pattern
-	// keys, err := r.client.Keys(ctx, fullPattern).Result()
-	// if err != nil {
-	//     return err
-	// }
-	// if len(keys) > 0 {
-	//     return r.client.Del(ctx, keys...).Err()
-	// }
-
-	return nil // Placeholder
+// DeletePattern deletes keys matching a glob pattern.
+// SCAN is used instead of KEYS so large keyspaces don't block the server.
+func (r *RedisCacheBackend) DeletePattern(ctx context.Context, pattern string) error {
+	iter := r.client.Scan(ctx, 0, r.prefix+pattern, redisScanBatchSize).Iterator()
+
+	batch := make([]string, 0, redisScanBatchSize)
+	for iter.Next(ctx) {
+		batch = append(batch, iter.Val())
+		if len(batch) == redisScanBatchSize {
+			if err := r.client.Del(ctx, batch...).Err(); err != nil {
+				return err
+			}
+			batch = batch[:0]
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+
+	if len(batch) > 0 {
+		return r.client.Del(ctx, batch...).Err()
+	}
+	return nil
 }
 
 // Exists checks if a key exists in Redis
-func (r *RedisCacheBackend) Exists(_ context.Context, _ string) bool {
-	// Placeholder implementation
-	// In a real implementation:
-	// fullKey := r.prefix + key
-	// count, err := r.client.Exists(ctx, fullKey).Result()
-	// return err == nil && count > 0
-
-	return false // Placeholder
+func (r *RedisCacheBackend) Exists(ctx context.Context, key string) bool {
+	count, err := r.client.Exists(ctx, r.prefix+key).Result()
+	return err == nil && count > 0
 }
 
 // Flush clears all keys with the prefix
-func (r *RedisCacheBackend) Flush(_ context.Context) error {
-	// Placeholder implementation
-	// In a real implementation:
-	// pattern := r.prefix + "*"
-	// return r.DeletePattern(ctx, pattern)
-
-	return nil // Placeholder
+func (r *RedisCacheBackend) Flush(ctx context.Context) error {
+	return r.DeletePattern(ctx, "*")
 }
 
 // Stats returns Redis-specific statistics
-func (r *RedisCacheBackend) Stats(_ context.Context) (*BackendStats, error) {
-	// Placeholder implementation
-	// In a real implementation:
-	// info, err := r.client.Info(ctx, "memory", "keyspace").Result()
-	// if err != nil {
-	//     return nil, err
-	// }
-	// Parse info and return stats
-
-	return &BackendStats{
-		Connected: true,
-		Keys:      0,
-		Memory:    0,
+func (r *RedisCacheBackend) Stats(ctx context.Context) (*BackendStats, error) {
+	stats := &BackendStats{
 		Metadata: map[string]interface{}{
 			"backend": "redis",
 			"prefix":  r.prefix,
 		},
-	}, nil // Placeholder
+	}
+
+	if err := r.client.Ping(ctx).Err(); err != nil {
+		return stats, nil
+	}
+	stats.Connected = true
+
+	iter := r.client.Scan(ctx, 0, r.prefix+"*", redisScanBatchSize).Iterator()
+	for iter.Next(ctx) {
+		stats.Keys++
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	info, err := r.client.Info(ctx, "memory").Result()
+	if err != nil {
+		return nil, err
+	}
+	stats.Memory = parseRedisUsedMemory(info)
+
+	return stats, nil
+}
+
+// parseRedisUsedMemory extracts used_memory from the output of INFO memory
+func parseRedisUsedMemory(info string) int64 {
+	for _, line := range strings.Split(info, "\n") {
+		value, found := strings.CutPrefix(strings.TrimSpace(line), "used_memory:")
+		if !found {
+			continue
+		}
+		memory, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return 0
+		}
+		return memory
+	}
+	return 0
 }
 
 // MemoryCacheBackend implements CacheBackend using in-memory storage
-// This is a simple implementation for development/testing
+// It is safe for concurrent use and suits single-instance deployments
 type MemoryCacheBackend struct {
 	data   map[string]*cacheItem
 	prefix string
+	mu     sync.Mutex
 }
 
 type cacheItem struct {
@@ -142,6 +171,9 @@ func NewMemoryCacheBackend(prefix string) *MemoryCacheBackend {
 
 // Set stores a value in memory with TTL
 func (m *MemoryCacheBackend) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	fullKey := m.prefix + key
 	expiresAt := time.Now().Add(ttl)
 
@@ -155,6 +187,9 @@ func (m *MemoryCacheBackend) Set(_ context.Context, key string, value []byte, tt
 
 // Get retrieves a value from memory
 func (m *MemoryCacheBackend) Get(_ context.Context, key string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	fullKey := m.prefix + key
 	item, exists := m.data[fullKey]
 	if !exists {
@@ -172,6 +207,9 @@ func (m *MemoryCacheBackend) Get(_ context.Context, key string) ([]byte, error)
 
 // Delete removes a key from memory
 func (m *MemoryCacheBackend) Delete(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	fullKey := m.prefix + key
 	delete(m.data, fullKey)
 	return nil
@@ -179,6 +217,9 @@ func (m *MemoryCacheBackend) Delete(_ context.Context, key string) error {
 
 // DeletePattern deletes keys matching a pattern (simple implementation)
 func (m *MemoryCacheBackend) DeletePattern(_ context.Context, pattern string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	fullPattern := m.prefix + pattern
 
 	// Simple pattern matching - just check if key starts with pattern (without *)
@@ -203,6 +244,9 @@ func (m *MemoryCacheBackend) DeletePattern(_ context.Context, pattern string) er
 
 // Exists checks if a key exists in memory
 func (m *MemoryCacheBackend) Exists(_ context.Context, key string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	fullKey := m.prefix + key
 	item, exists := m.data[fullKey]
 	if !exists {
@@ -220,6 +264,9 @@ func (m *MemoryCacheBackend) Exists(_ context.Context, key string) bool {
 
 // Flush clears all keys with the prefix
 func (m *MemoryCacheBackend) Flush(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	var keysToDelete []string
 	for key := range m.data {
 		if key[:minInt(len(key), len(m.prefix))] == m.prefix {
@@ -236,6 +283,9 @@ func (m *MemoryCacheBackend) Flush(_ context.Context) error {
 
 // Stats returns memory cache statistics
 func (m *MemoryCacheBackend) Stats(_ context.Context) (*BackendStats, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	// Clean up expired items first
 	now := time.Now()
 	var keysToDelete []string
@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
+)
+
+const (
+	// DefaultSearchLimit is the page size used when a search does not set one
+	DefaultSearchLimit = 20
+	// MaxSearchLimit caps the page size of a single search
+	MaxSearchLimit = 100
+)
+
+// SearchService provides ranked full-text search over tasks and comments
+type SearchService interface {
+	// Search parses the query string and returns one page of ranked hits in a project
+	Search(ctx context.Context, req SearchRequest, userID string) (*SearchResponse, error)
+}
+
+// SearchRequest represents a search over one project
+type SearchRequest struct {
+	ProjectID string                      `json:"project_id"`
+	Query     string                      `json:"query"`
+	Types     []domain.SearchDocumentType `json:"types,omitempty"`
+	Limit     int                         `json:"limit"`
+	Offset    int                         `json:"offset"`
+}
+
+// SearchResponse is one page of search hits
+type SearchResponse struct {
+	Query   string                 `json:"query"`
+	Results []*domain.SearchResult `json:"results"`
+	Total   int                    `json:"total"`
+	Limit   int                    `json:"limit"`
+	Offset  int                    `json:"offset"`
+}
+
+type searchService struct {
+	searchRepo  repository.SearchRepository
+	projectRepo repository.ProjectRepository
+	userRepo    repository.UserRepository
+}
+
+// NewSearchService creates a new search service
+func NewSearchService(
+	searchRepo repository.SearchRepository,
+	projectRepo repository.ProjectRepository,
+	userRepo repository.UserRepository,
+) SearchService {
+	return &searchService{
+		searchRepo:  searchRepo,
+		projectRepo: projectRepo,
+		userRepo:    userRepo,
+	}
+}
+
+// Search parses the query string and returns one page of ranked hits in a project
+func (s *searchService) Search(ctx context.Context, req SearchRequest, userID string) (*SearchResponse, error) {
+	if req.ProjectID == "" {
+		return nil, domain.NewValidationError("INVALID_PROJECT_ID", "Project ID cannot be empty", nil)
+	}
+
+	terms, err := domain.ParseSearchQuery(req.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, req.ProjectID)
+	if err != nil {
+		return nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
+	}
+
+	if !project.HasAccess(userID) && project.Settings.IsPrivate {
+		return nil, domain.NewAuthorizationError("ACCESS_DENIED", "You don't have access to this project")
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = DefaultSearchLimit
+	}
+	if req.Limit > MaxSearchLimit {
+		req.Limit = MaxSearchLimit
+	}
+
+	query := domain.SearchQuery{
+		ProjectID: req.ProjectID,
+		Terms:     s.resolveAssignees(ctx, terms),
+		Types:     req.Types,
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+	}
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	results, err := s.searchRepo.Search(ctx, query)
+	if err != nil {
+		return nil, domain.NewInternalError("SEARCH_FAILED", "Failed to search project", err)
+	}
+
+	total, err := s.searchRepo.Count(ctx, query)
+	if err != nil {
+		return nil, domain.NewInternalError("SEARCH_FAILED", "Failed to count search results", err)
+	}
+
+	return &SearchResponse{
+		Query:   req.Query,
+		Results: results,
+		Total:   total,
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+	}, nil
+}
+
+// resolveAssignees rewrites assignee terms given as a username or email to the
+// user ID stored in the index. Unknown values are searched as given.
+func (s *searchService) resolveAssignees(ctx context.Context, terms []domain.SearchTerm) []domain.SearchTerm {
+	resolved := make([]domain.SearchTerm, len(terms))
+	for i, term := range terms {
+		resolved[i] = term
+		if term.Field != domain.SearchAssignee || term.Prefix || term.Phrase {
+			continue
+		}
+
+		var user *domain.User
+		var err error
+		if strings.Contains(term.Value, "@") {
+			user, err = s.userRepo.GetByEmail(ctx, term.Value)
+		} else {
+			user, err = s.userRepo.GetByUsername(ctx, term.Value)
+		}
+		if err == nil && user != nil {
+			resolved[i].Value = user.ID
+		}
+	}
+	return resolved
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+func TestSearchService(t *testing.T) {
+	ctx := context.Background()
+
+	searchRepo := testutil.NewMockSearchRepository()
+	projectRepo := testutil.NewMockProjectRepository()
+	userRepo := testutil.NewMockUserRepository()
+	service := NewSearchService(searchRepo, projectRepo, userRepo)
+
+	owner := testutil.MockUser("owner", "owner@test.com", "owner", "Owner")
+	alice := testutil.MockUser("alice-id", "alice@test.com", "alice", "Alice")
+	userRepo.AddUser(owner)
+	userRepo.AddUser(alice)
+
+	project := testutil.MockProject("search-proj", "Search Project", "search", owner.ID)
+	projectRepo.AddProject(project)
+	private := testutil.MockProject("private-proj", "Private Project", "private", owner.ID)
+	private.Settings.IsPrivate = true
+	projectRepo.AddProject(private)
+
+	searchRepo.AddResult(&domain.SearchResult{Type: domain.SearchDocTask, TaskID: "t1", ProjectID: project.ID})
+	searchRepo.AddResult(&domain.SearchResult{
+		Type: domain.SearchDocComment, TaskID: "t1", CommentID: "c1", ProjectID: project.ID,
+	})
+	searchRepo.AddResult(&domain.SearchResult{Type: domain.SearchDocTask, TaskID: "t9", ProjectID: private.ID})
+
+	t.Run("Search_ReturnsProjectHits", func(t *testing.T) {
+		response, err := service.Search(ctx, SearchRequest{ProjectID: project.ID, Query: "login"}, owner.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, response.Total)
+		assert.Len(t, response.Results, 2)
+		assert.Equal(t, DefaultSearchLimit, response.Limit)
+	})
+
+	t.Run("Search_FiltersByType", func(t *testing.T) {
+		response, err := service.Search(ctx, SearchRequest{
+			ProjectID: project.ID, Query: "login", Types: []domain.SearchDocumentType{domain.SearchDocComment},
+		}, owner.ID)
+		require.NoError(t, err)
+
+		require.Len(t, response.Results, 1)
+		assert.Equal(t, "c1", response.Results[0].CommentID)
+	})
+
+	t.Run("Search_ClampsLimit", func(t *testing.T) {
+		response, err := service.Search(ctx, SearchRequest{ProjectID: project.ID, Query: "login", Limit: 1000}, owner.ID)
+		require.NoError(t, err)
+		assert.Equal(t, MaxSearchLimit, response.Limit)
+	})
+
+	t.Run("Search_ResolvesAssigneeToUserID", func(t *testing.T) {
+		_, err := service.Search(ctx, SearchRequest{
+			ProjectID: project.ID, Query: "assignee:alice assignee:alice@test.com assignee:nobody",
+		}, owner.ID)
+		require.NoError(t, err)
+
+		terms := searchRepo.LastQuery.Terms
+		require.Len(t, terms, 3)
+		assert.Equal(t, alice.ID, terms[0].Value)
+		assert.Equal(t, alice.ID, terms[1].Value)
+		assert.Equal(t, "nobody", terms[2].Value, "unknown assignees are searched as given")
+	})
+
+	t.Run("Search_RejectsBadQuery", func(t *testing.T) {
+		_, err := service.Search(ctx, SearchRequest{ProjectID: project.ID, Query: `"unterminated`}, owner.ID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "UNTERMINATED_PHRASE")
+	})
+
+	t.Run("Search_RequiresProjectAccess", func(t *testing.T) {
+		_, err := service.Search(ctx, SearchRequest{ProjectID: private.ID, Query: "login"}, "outsider")
+		assert.Error(t, err)
+
+		_, err = service.Search(ctx, SearchRequest{ProjectID: "missing", Query: "login"}, owner.ID)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
+)
+
+// clientInfoKey is the context key for the device a request came from
+type clientInfoKey struct{}
+
+// WithClientInfo records the device a request came from, so sessions it starts or refreshes show it.
+func WithClientInfo(ctx context.Context, client domain.ClientInfo) context.Context {
+	return context.WithValue(ctx, clientInfoKey{}, client)
+}
+
+// clientInfoFromContext returns the device a request came from, if it was recorded
+func clientInfoFromContext(ctx context.Context) domain.ClientInfo {
+	client, _ := ctx.Value(clientInfoKey{}).(domain.ClientInfo)
+	return client
+}
+
+// SessionService lets users see where they're signed in and sign out single devices.
+// Sessions are started and refreshed by AuthService.
+type SessionService interface {
+	// ListSessions lists a user's active sessions, most recently seen first
+	ListSessions(ctx context.Context, userID string) ([]*domain.Session, error)
+
+	// RevokeSession signs one of a user's sessions out. Its access and refresh tokens stop
+	// working immediately.
+	RevokeSession(ctx context.Context, userID string, sessionID string) error
+}
+
+type sessionService struct {
+	sessionRepo repository.SessionRepository
+	userRepo    repository.UserRepository
+}
+
+// NewSessionService creates a new session service.
+func NewSessionService(
+	sessionRepo repository.SessionRepository,
+	userRepo repository.UserRepository,
+) SessionService {
+	return &sessionService{
+		sessionRepo: sessionRepo,
+		userRepo:    userRepo,
+	}
+}
+
+// ListSessions lists a user's active sessions, most recently seen first.
+func (s *sessionService) ListSessions(ctx context.Context, userID string) ([]*domain.Session, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, domain.NewNotFoundError("USER_NOT_FOUND", "User not found")
+	}
+
+	sessions, err := s.sessionRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sessions from before the user last signed out everywhere no longer work either
+	listed := make([]*domain.Session, 0, len(sessions))
+	for _, session := range sessions {
+		if session.IsActive() && session.TokenVersion >= user.TokenVersion {
+			listed = append(listed, session)
+		}
+	}
+
+	return listed, nil
+}
+
+// RevokeSession signs one of a user's sessions out.
+func (s *sessionService) RevokeSession(ctx context.Context, userID string, sessionID string) error {
+	session, err := s.sessionRepo.GetByID(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	// Other users' sessions are reported missing rather than forbidden so IDs can't be probed
+	if session.UserID != userID || session.RevokedAt != nil {
+		return domain.NewNotFoundError("SESSION_NOT_FOUND", "Session not found")
+	}
+
+	session.Revoke()
+	return s.sessionRepo.Update(ctx, session)
+}
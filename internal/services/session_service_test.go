@@ -0,0 +1,199 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+func TestAuthService_Sessions(t *testing.T) {
+	userRepo := testutil.NewMockUserRepository()
+	sessionRepo := testutil.NewMockSessionRepository()
+	cfg := &testConfig{jwtSecret: "test-secret-that-is-32-characters-long", jwtExpiration: time.Hour}
+	auth := NewAuthService(userRepo, newMockTokenBlacklistRepository(), newMockPasswordResetTokenRepository(),
+		cfg, newTestEmailOutbox(t))
+	auth.SetSessions(sessionRepo)
+	sessions := NewSessionService(sessionRepo, userRepo)
+
+	user := testutil.MockUser("ada", "ada@example.com", "ada", "Ada")
+	userRepo.AddUser(user)
+
+	// login signs in from a device. Validating tokens clears the stored user's password hash,
+	// so it's set again each time.
+	login := func(t *testing.T, userAgent string) *domain.TokenPair {
+		t.Helper()
+		require.NoError(t, user.SetPassword("password123"))
+		ctx := WithClientInfo(context.Background(), domain.ClientInfo{UserAgent: userAgent, IPAddress: "192.0.2.1"})
+		result, err := auth.Login(ctx, domain.LoginRequest{Email: "ada@example.com", Password: "password123"})
+		require.NoError(t, err)
+		return result.Tokens
+	}
+
+	t.Run("LoginStartsASession", func(t *testing.T) {
+		login(t, "Firefox on Linux")
+
+		listed, err := sessions.ListSessions(context.Background(), "ada")
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		assert.Equal(t, "Firefox on Linux", listed[0].UserAgent)
+		assert.Equal(t, "192.0.2.1", listed[0].IPAddress)
+		assert.False(t, listed[0].LastSeenAt.IsZero())
+	})
+
+	t.Run("RefreshRotates", func(t *testing.T) {
+		tokens := login(t, "Laptop")
+
+		ctx := WithClientInfo(context.Background(), domain.ClientInfo{IPAddress: "198.51.100.7"})
+		rotated, err := auth.RefreshToken(ctx, tokens.RefreshToken)
+		require.NoError(t, err)
+		assert.NotEqual(t, tokens.RefreshToken, rotated.RefreshToken)
+
+		_, err = auth.RefreshToken(context.Background(), rotated.RefreshToken)
+		require.NoError(t, err, "the latest refresh token works")
+
+		claims, err := auth.(*authService).parseToken(rotated.AccessToken)
+		require.NoError(t, err)
+		session, err := sessionRepo.GetByID(context.Background(), claims.SessionID)
+		require.NoError(t, err)
+		assert.Equal(t, "198.51.100.7", session.IPAddress, "refreshing updates the device")
+		assert.Equal(t, "Laptop", session.UserAgent)
+	})
+
+	t.Run("ReusedRefreshTokenRevokesTheFamily", func(t *testing.T) {
+		tokens := login(t, "Stolen laptop")
+		ctx := context.Background()
+
+		rotated, err := auth.RefreshToken(ctx, tokens.RefreshToken)
+		require.NoError(t, err)
+
+		// Replaying the first refresh token signs out whoever holds the latest one too
+		_, err = auth.RefreshToken(ctx, tokens.RefreshToken)
+		require.Error(t, err)
+		assert.Equal(t, "REFRESH_TOKEN_REUSED", errorCode(err))
+
+		_, err = auth.RefreshToken(ctx, rotated.RefreshToken)
+		assert.Equal(t, "INVALID_REFRESH_TOKEN", errorCode(err))
+
+		_, err = auth.ValidateToken(ctx, rotated.AccessToken)
+		assert.Equal(t, "SESSION_REVOKED", errorCode(err))
+	})
+
+	t.Run("ConcurrentReuseCantForkTheSession", func(t *testing.T) {
+		tokens := login(t, "Raced laptop")
+		claims, err := auth.(*authService).parseToken(tokens.RefreshToken)
+		require.NoError(t, err)
+
+		var wg sync.WaitGroup
+		var succeeded atomic.Int32
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := auth.RefreshToken(context.Background(), tokens.RefreshToken); err == nil {
+					succeeded.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.LessOrEqual(t, succeeded.Load(), int32(1), "only one refresh can win the race")
+		session, err := sessionRepo.GetByID(context.Background(), claims.SessionID)
+		require.NoError(t, err)
+		assert.NotNil(t, session.RevokedAt, "the losers are reuse, which revokes the session")
+	})
+
+	t.Run("TokensWithoutASessionSignInAgain", func(t *testing.T) {
+		legacy, err := auth.(*authService).generateTokenPair(user, nil)
+		require.NoError(t, err)
+		before := len(sessionRepo.Sessions)
+
+		_, err = auth.RefreshToken(context.Background(), legacy.RefreshToken)
+		assert.Equal(t, "INVALID_REFRESH_TOKEN", errorCode(err))
+		assert.Len(t, sessionRepo.Sessions, before, "no session is started for them")
+	})
+
+	t.Run("AccessTokensCantRefresh", func(t *testing.T) {
+		tokens := login(t, "Laptop")
+
+		_, err := auth.RefreshToken(context.Background(), tokens.AccessToken)
+		assert.Equal(t, "INVALID_REFRESH_TOKEN", errorCode(err))
+	})
+
+	t.Run("SignOutOneDevice", func(t *testing.T) {
+		for _, session := range sessionRepo.Sessions {
+			session.Revoke()
+		}
+		lost := login(t, "Lost laptop")
+		phone := login(t, "Phone")
+		ctx := context.Background()
+
+		listed, err := sessions.ListSessions(ctx, "ada")
+		require.NoError(t, err)
+		require.Len(t, listed, 2)
+
+		var lostID string
+		for _, session := range listed {
+			if session.UserAgent == "Lost laptop" {
+				lostID = session.ID
+			}
+		}
+		require.NotEmpty(t, lostID)
+
+		err = sessions.RevokeSession(ctx, "grace", lostID)
+		assert.Equal(t, domain.NotFoundError, webhookErrorType(err), "other users' sessions can't be revoked")
+
+		require.NoError(t, sessions.RevokeSession(ctx, "ada", lostID))
+
+		_, err = auth.ValidateToken(ctx, lost.AccessToken)
+		assert.Equal(t, "SESSION_REVOKED", errorCode(err))
+		_, err = auth.RefreshToken(ctx, lost.RefreshToken)
+		assert.Equal(t, "INVALID_REFRESH_TOKEN", errorCode(err))
+
+		_, err = auth.ValidateToken(ctx, phone.AccessToken)
+		require.NoError(t, err, "other devices stay signed in")
+
+		listed, err = sessions.ListSessions(ctx, "ada")
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		assert.Equal(t, "Phone", listed[0].UserAgent)
+	})
+
+	t.Run("LogoutEndsTheSession", func(t *testing.T) {
+		tokens := login(t, "Tablet")
+		ctx := context.Background()
+
+		require.NoError(t, auth.Logout(ctx, tokens.AccessToken))
+
+		_, err := auth.RefreshToken(ctx, tokens.RefreshToken)
+		assert.Equal(t, "INVALID_REFRESH_TOKEN", errorCode(err))
+	})
+
+	t.Run("SigningOutEverywhereHidesSessions", func(t *testing.T) {
+		login(t, "Desktop")
+		ctx := context.Background()
+
+		require.NoError(t, auth.InvalidateAllUserTokens(ctx, "ada"))
+
+		listed, err := sessions.ListSessions(ctx, "ada")
+		require.NoError(t, err)
+		assert.Empty(t, listed)
+	})
+}
+
+// errorCode returns a domain error's code, or "" for other errors
+func errorCode(err error) string {
+	var domainErr *domain.Error
+	if errors.As(err, &domainErr) {
+		return domainErr.Code
+	}
+	return ""
+}
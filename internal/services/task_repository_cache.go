@@ -0,0 +1,167 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
+)
+
+// cacheInvalidatingTaskRepository wraps a task repository and drops the cached
+// board state of every project touched by a task write. Boards show whether a card
+// is blocked, so writes also reach the projects of tasks depending on the changed ones.
+type cacheInvalidatingTaskRepository struct {
+	repository.TaskRepository
+	cache CacheManager
+}
+
+// NewCacheInvalidatingTaskRepository wraps taskRepo so task writes invalidate cached boards.
+// Wrapping at the repository level covers writes from every service, not just TaskService.
+func NewCacheInvalidatingTaskRepository(
+	taskRepo repository.TaskRepository,
+	cache CacheManager,
+) repository.TaskRepository {
+	return &cacheInvalidatingTaskRepository{
+		TaskRepository: taskRepo,
+		cache:          cache,
+	}
+}
+
+// Create creates a new task and invalidates its project's board
+func (r *cacheInvalidatingTaskRepository) Create(ctx context.Context, task *domain.Task) error {
+	if err := r.TaskRepository.Create(ctx, task); err != nil {
+		return err
+	}
+	r.invalidateProjects(ctx, task.ProjectID)
+	return nil
+}
+
+// Update updates an existing task and invalidates its project's board
+func (r *cacheInvalidatingTaskRepository) Update(ctx context.Context, task *domain.Task) error {
+	if err := r.TaskRepository.Update(ctx, task); err != nil {
+		return err
+	}
+	r.invalidateProjects(ctx, append(r.dependentProjectsOf(ctx, task.ID), task.ProjectID)...)
+	return nil
+}
+
+// Delete deletes a task and invalidates its project's board
+func (r *cacheInvalidatingTaskRepository) Delete(ctx context.Context, id string) error {
+	projectIDs := append(r.projectsOf(ctx, id), r.dependentProjectsOf(ctx, id)...)
+	if err := r.TaskRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.invalidateProjects(ctx, projectIDs...)
+	return nil
+}
+
+// Move moves a task and invalidates its project's board
+func (r *cacheInvalidatingTaskRepository) Move(
+	ctx context.Context, taskID string, newStatus domain.TaskStatus, position int,
+) error {
+	if err := r.TaskRepository.Move(ctx, taskID, newStatus, position); err != nil {
+		return err
+	}
+	r.invalidateProjects(ctx, append(r.projectsOf(ctx, taskID), r.dependentProjectsOf(ctx, taskID)...)...)
+	return nil
+}
+
+// BulkUpdate updates multiple tasks and invalidates every affected board
+func (r *cacheInvalidatingTaskRepository) BulkUpdate(ctx context.Context, tasks []*domain.Task) error {
+	if err := r.TaskRepository.BulkUpdate(ctx, tasks); err != nil {
+		return err
+	}
+	projectIDs := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		projectIDs = append(projectIDs, task.ProjectID)
+		projectIDs = append(projectIDs, r.dependentProjectsOf(ctx, task.ID)...)
+	}
+	r.invalidateProjects(ctx, projectIDs...)
+	return nil
+}
+
+// BulkDelete deletes multiple tasks and invalidates every affected board
+func (r *cacheInvalidatingTaskRepository) BulkDelete(ctx context.Context, ids []string) error {
+	projectIDs := append(r.projectsOf(ctx, ids...), r.dependentProjectsOf(ctx, ids...)...)
+	if err := r.TaskRepository.BulkDelete(ctx, ids); err != nil {
+		return err
+	}
+	r.invalidateProjects(ctx, projectIDs...)
+	return nil
+}
+
+// BulkUpdateStatus updates the status of multiple tasks and invalidates every affected board
+func (r *cacheInvalidatingTaskRepository) BulkUpdateStatus(
+	ctx context.Context, taskIDs []string, newStatus domain.TaskStatus,
+) error {
+	if err := r.TaskRepository.BulkUpdateStatus(ctx, taskIDs, newStatus); err != nil {
+		return err
+	}
+	r.invalidateProjects(ctx, append(r.projectsOf(ctx, taskIDs...), r.dependentProjectsOf(ctx, taskIDs...)...)...)
+	return nil
+}
+
+// ArchiveTask archives a task and invalidates its project's board
+func (r *cacheInvalidatingTaskRepository) ArchiveTask(ctx context.Context, id string) error {
+	if err := r.TaskRepository.ArchiveTask(ctx, id); err != nil {
+		return err
+	}
+	r.invalidateProjects(ctx, r.projectsOf(ctx, id)...)
+	return nil
+}
+
+// UnarchiveTask unarchives a task and invalidates its project's board
+func (r *cacheInvalidatingTaskRepository) UnarchiveTask(ctx context.Context, id string) error {
+	if err := r.TaskRepository.UnarchiveTask(ctx, id); err != nil {
+		return err
+	}
+	r.invalidateProjects(ctx, r.projectsOf(ctx, id)...)
+	return nil
+}
+
+// projectsOf looks up the projects owning the given tasks, skipping tasks that can't be loaded
+func (r *cacheInvalidatingTaskRepository) projectsOf(ctx context.Context, taskIDs ...string) []string {
+	projectIDs := make([]string, 0, len(taskIDs))
+	for _, id := range taskIDs {
+		task, err := r.TaskRepository.GetByID(ctx, id)
+		if err != nil {
+			continue
+		}
+		projectIDs = append(projectIDs, task.ProjectID)
+	}
+	return projectIDs
+}
+
+// dependentProjectsOf looks up the projects of tasks depending on the given tasks
+func (r *cacheInvalidatingTaskRepository) dependentProjectsOf(ctx context.Context, taskIDs ...string) []string {
+	var projectIDs []string
+	for _, id := range taskIDs {
+		dependents, err := r.TaskRepository.GetDependents(ctx, id)
+		if err != nil {
+			continue
+		}
+		for _, dependent := range dependents {
+			projectIDs = append(projectIDs, dependent.ProjectID)
+		}
+	}
+	return projectIDs
+}
+
+// invalidateProjects drops cached board state and statistics for each distinct project
+func (r *cacheInvalidatingTaskRepository) invalidateProjects(ctx context.Context, projectIDs ...string) {
+	seen := make(map[string]bool, len(projectIDs))
+	for _, projectID := range projectIDs {
+		if projectID == "" || seen[projectID] {
+			continue
+		}
+		seen[projectID] = true
+
+		if err := r.cache.InvalidateBoardState(ctx, projectID); err != nil {
+			slog.Warn("Failed to invalidate board cache", "project_id", projectID, "error", err)
+		}
+		if err := r.cache.InvalidateStatistics(ctx, projectID); err != nil {
+			slog.Warn("Failed to invalidate stats cache", "project_id", projectID, "error", err)
+		}
+	}
+}
@@ -3,8 +3,10 @@ package services
 import (
 	"context"
 	"fmt"
+	"log/slog"
 
 	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/markdown"
 	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
 )
 
@@ -54,6 +56,9 @@ type TaskService interface {
 	// GetTaskDependencies retrieves dependency tasks for a task
 	GetTaskDependencies(ctx context.Context, taskID string, userID string) ([]*domain.Task, error)
 
+	// GetTaskDependents retrieves the tasks that depend on a task, with their blocked state
+	GetTaskDependents(ctx context.Context, taskID string, userID string) ([]*domain.Task, error)
+
 	// DuplicateTask creates a copy of an existing task
 	DuplicateTask(ctx context.Context, taskID string, options DuplicationOptions, userID string) (*domain.Task, error)
 
@@ -70,6 +75,17 @@ type TaskService interface {
 
 	// RemoveDependency removes a dependency from a task
 	RemoveDependency(ctx context.Context, taskID string, dependencyID string, userID string) error
+
+	// GetTaskHistory retrieves the audit log for a task along with the total number of matching entries
+	GetTaskHistory(
+		ctx context.Context, taskID string, filter domain.TaskHistoryFilter, userID string,
+	) ([]*domain.TaskHistoryEntry, int, error)
+
+	// WatchTask makes the user follow a task
+	WatchTask(ctx context.Context, taskID string, userID string) (*domain.Task, error)
+
+	// UnwatchTask stops the user following a task
+	UnwatchTask(ctx context.Context, taskID string, userID string) (*domain.Task, error)
 }
 
 // MoveTaskRequest represents a request to move a task between columns/statuses
@@ -78,6 +94,10 @@ type MoveTaskRequest struct {
 	ProjectID   string            `json:"project_id" binding:"required"`
 	NewStatus   domain.TaskStatus `json:"new_status" binding:"required"`
 	NewPosition int               `json:"new_position" binding:"min=0"`
+	// WIPOverride allows a move past a hard WIP limit; ignored when the limit isn't reached
+	WIPOverride *WIPOverride `json:"wip_override,omitempty"`
+	// OverrideBlocked allows moving a task forward while its dependencies are still open
+	OverrideBlocked bool `json:"override_blocked,omitempty"`
 }
 
 // DuplicationOptions controls how a task is duplicated
@@ -95,18 +115,32 @@ type taskService struct {
 	taskRepo    repository.TaskRepository
 	projectRepo repository.ProjectRepository
 	userRepo    repository.UserRepository
+	historyRepo repository.TaskHistoryRepository
+	authz       AuthorizationService
+	wipManager  WIPManager
+	attachments repository.AttachmentRepository
 }
 
-// NewTaskService creates a new task service.
+// NewTaskService creates a new task service. What users may do with a project's tasks comes from authz.
+// Status changes are checked against the project's hard WIP limits; a nil wipManager disables the check.
+// Deleting a task removes the files attached to it and its comments through attachments, when given.
 func NewTaskService(
 	taskRepo repository.TaskRepository,
 	projectRepo repository.ProjectRepository,
 	userRepo repository.UserRepository,
+	historyRepo repository.TaskHistoryRepository,
+	authz AuthorizationService,
+	wipManager WIPManager,
+	attachments repository.AttachmentRepository,
 ) TaskService {
 	return &taskService{
 		taskRepo:    taskRepo,
 		projectRepo: projectRepo,
 		userRepo:    userRepo,
+		historyRepo: historyRepo,
+		authz:       authz,
+		wipManager:  wipManager,
+		attachments: attachments,
 	}
 }
 
@@ -127,8 +161,8 @@ func (s *taskService) CreateTask(
 		return nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
 	}
 
-	if !project.HasAccess(userID) {
-		return nil, domain.NewAuthorizationError("ACCESS_DENIED", "You don't have access to this project")
+	if err := s.authz.Check(ctx, project, userID, domain.PermissionCreateTasks); err != nil {
+		return nil, err
 	}
 
 	// Check if assignee exists and has access to project
@@ -163,7 +197,7 @@ func (s *taskService) CreateTask(
 		ProjectID:   req.ProjectID,
 		ReporterID:  userID,
 		AssigneeID:  assigneePtr,
-		Status:      domain.StatusTodo,
+		Status:      project.Workflow().Initial(),
 		Priority:    req.Priority,
 		DueDate:     req.DueDate,
 		Tags:        req.Tags,
@@ -171,6 +205,8 @@ func (s *taskService) CreateTask(
 		Progress:    0,
 		TimeSpent:   0.0,
 	}
+	task.ApplyChecklist(markdown.Checklist(task.Description))
+	task.WatchParticipants()
 
 	if err := s.taskRepo.Create(ctx, task); err != nil {
 		return nil, domain.NewInternalError("TASK_CREATE_FAILED", "Failed to create task", err)
@@ -196,8 +232,12 @@ func (s *taskService) GetTask(ctx context.Context, taskID string, userID string)
 		return nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
 	}
 
-	if !project.HasAccess(userID) && project.Settings.IsPrivate {
-		return nil, domain.NewAuthorizationError("ACCESS_DENIED", "You don't have access to this task")
+	if err := s.authz.Check(ctx, project, userID, domain.PermissionViewProject); err != nil {
+		return nil, err
+	}
+
+	if err := refreshBlocked(ctx, s.taskRepo, newWorkflowLookup(s.projectRepo, project), task); err != nil {
+		return nil, err
 	}
 
 	return task, nil
@@ -226,24 +266,39 @@ func (s *taskService) UpdateTask(
 		return nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
 	}
 
-	if !project.HasAccess(userID) {
-		return nil, domain.NewAuthorizationError("ACCESS_DENIED", "You don't have access to modify this task")
+	if err := s.authz.Check(ctx, project, userID, domain.PermissionEditTasks); err != nil {
+		return nil, err
 	}
 
+	// Keep a snapshot of the original values for the audit log
+	original := *task
+
 	// Apply updates
 	if req.Title != nil {
 		task.Title = *req.Title
 	}
 	if req.Description != nil {
 		task.Description = *req.Description
+		task.ApplyChecklist(markdown.Checklist(task.Description))
 	}
 	if req.AssigneeID != nil {
 		if err := s.validateAssigneeAccess(ctx, *req.AssigneeID, project); err != nil {
 			return nil, err
 		}
 		task.AssigneeID = req.AssigneeID
+		task.WatchParticipants()
 	}
 	if req.Status != nil {
+		if err := project.Workflow().ValidateStatus(*req.Status); err != nil {
+			return nil, err
+		}
+		workflows := newWorkflowLookup(s.projectRepo, project)
+		if err := checkBlockedMove(ctx, s.taskRepo, workflows, task, *req.Status, req.OverrideBlocked); err != nil {
+			return nil, err
+		}
+		if _, err := checkWIPMove(ctx, s.wipManager, task.ProjectID, task.Status, *req.Status, nil); err != nil {
+			return nil, err
+		}
 		task.Status = *req.Status
 	}
 	if req.Priority != nil {
@@ -268,6 +323,8 @@ func (s *taskService) UpdateTask(
 		return nil, domain.NewInternalError("TASK_UPDATE_FAILED", "Failed to update task", err)
 	}
 
+	s.recordTaskChanges(ctx, &original, task, userID)
+
 	return task, nil
 }
 
@@ -289,10 +346,8 @@ func (s *taskService) DeleteTask(ctx context.Context, taskID string, userID stri
 		return domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
 	}
 
-	// Only project owners or task creator can delete tasks
-	canDelete := project.IsOwner(userID) || task.ReporterID == userID
-	if !canDelete {
-		return domain.NewAuthorizationError("ACCESS_DENIED", "You don't have permission to delete this task")
+	if err := s.authz.CheckTaskDeletion(ctx, project, task, userID); err != nil {
+		return err
 	}
 
 	// Delete from repository
@@ -300,6 +355,15 @@ func (s *taskService) DeleteTask(ctx context.Context, taskID string, userID stri
 		return domain.NewInternalError("TASK_DELETE_FAILED", "Failed to delete task", err)
 	}
 
+	// The task is gone either way, so leftover files are logged rather than reported
+	if s.attachments != nil {
+		if err := s.attachments.RemoveTaskFiles(ctx, taskID); err != nil {
+			slog.Warn("Failed to remove task attachments", "task_id", taskID, "error", err)
+		}
+	}
+
+	s.recordTaskDeletion(ctx, task, userID)
+
 	return nil
 }
 
@@ -320,8 +384,8 @@ func (s *taskService) ListProjectTasks(
 		return nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
 	}
 
-	if !project.HasAccess(userID) && project.Settings.IsPrivate {
-		return nil, domain.NewAuthorizationError("ACCESS_DENIED", "You don't have access to this project")
+	if err := s.authz.Check(ctx, project, userID, domain.PermissionViewProject); err != nil {
+		return nil, err
 	}
 
 	if offset < 0 {
@@ -336,6 +400,10 @@ func (s *taskService) ListProjectTasks(
 		return nil, domain.NewInternalError("TASK_LIST_FAILED", "Failed to list tasks", err)
 	}
 
+	if err := markBlocked(ctx, s.taskRepo, newWorkflowLookup(s.projectRepo, project), tasks); err != nil {
+		return nil, err
+	}
+
 	return tasks, nil
 }
 
@@ -357,6 +425,10 @@ func (s *taskService) ListUserTasks(ctx context.Context, userID string, offset,
 		return nil, domain.NewInternalError("TASK_LIST_FAILED", "Failed to list tasks", err)
 	}
 
+	if err := markBlocked(ctx, s.taskRepo, newWorkflowLookup(s.projectRepo), tasks); err != nil {
+		return nil, err
+	}
+
 	return tasks, nil
 }
 
@@ -386,8 +458,8 @@ func (s *taskService) AssignTask(
 		return nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
 	}
 
-	if !project.HasAccess(userID) {
-		return nil, domain.NewAuthorizationError("ACCESS_DENIED", "You don't have access to modify this task")
+	if err := s.authz.Check(ctx, project, userID, domain.PermissionEditTasks); err != nil {
+		return nil, err
 	}
 
 	// Check if assignee exists and has access to project
@@ -401,13 +473,17 @@ func (s *taskService) AssignTask(
 	}
 
 	// Assign task
+	original := *task
 	task.AssigneeID = &assigneeID
+	task.WatchParticipants()
 
 	// Update in repository
 	if err := s.taskRepo.Update(ctx, task); err != nil {
 		return nil, domain.NewInternalError("TASK_ASSIGN_FAILED", "Failed to assign task", err)
 	}
 
+	s.recordTaskChanges(ctx, &original, task, userID)
+
 	return task, nil
 }
 
@@ -427,39 +503,50 @@ func (s *taskService) validateAssigneeAccess(ctx context.Context, assigneeID str
 	return nil
 }
 
-// validateTaskAccess validates task ID and user access
-func (s *taskService) validateTaskAccess(ctx context.Context, taskID string, userID string) (*domain.Task, error) {
+// validateTaskAccess validates task ID and that the user holds the permission in the task's project
+func (s *taskService) validateTaskAccess(
+	ctx context.Context, taskID string, userID string, permission domain.Permission,
+) (*domain.Task, error) {
+	task, _, err := s.taskWithProject(ctx, taskID, userID, permission)
+	return task, err
+}
+
+// taskWithProject loads a task along with its project, checking the user holds the permission there
+func (s *taskService) taskWithProject(
+	ctx context.Context, taskID string, userID string, permission domain.Permission,
+) (*domain.Task, *domain.Project, error) {
 	if taskID == "" {
-		return nil, domain.NewValidationError("INVALID_TASK_ID", "Task ID cannot be empty", nil)
+		return nil, nil, domain.NewValidationError("INVALID_TASK_ID", "Task ID cannot be empty", nil)
 	}
 
 	// Get task
 	task, err := s.taskRepo.GetByID(ctx, taskID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Check if user has access to the project
 	project, err := s.projectRepo.GetByID(ctx, task.ProjectID)
 	if err != nil {
-		return nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
+		return nil, nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
 	}
 
-	if !project.HasAccess(userID) {
-		return nil, domain.NewAuthorizationError("ACCESS_DENIED", "You don't have access to modify this task")
+	if err := s.authz.Check(ctx, project, userID, permission); err != nil {
+		return nil, nil, err
 	}
 
-	return task, nil
+	return task, project, nil
 }
 
 // UnassignTask removes assignment from a task.
 func (s *taskService) UnassignTask(ctx context.Context, taskID string, userID string) (*domain.Task, error) {
-	task, err := s.validateTaskAccess(ctx, taskID, userID)
+	task, err := s.validateTaskAccess(ctx, taskID, userID, domain.PermissionEditTasks)
 	if err != nil {
 		return nil, err
 	}
 
 	// Unassign task
+	original := *task
 	task.AssigneeID = nil
 
 	// Update in repository
@@ -467,6 +554,8 @@ func (s *taskService) UnassignTask(ctx context.Context, taskID string, userID st
 		return nil, domain.NewInternalError("TASK_UNASSIGN_FAILED", "Failed to unassign task", err)
 	}
 
+	s.recordTaskChanges(ctx, &original, task, userID)
+
 	return task, nil
 }
 
@@ -477,12 +566,26 @@ func (s *taskService) UpdateTaskStatus(
 	status domain.TaskStatus,
 	userID string,
 ) (*domain.Task, error) {
-	task, err := s.validateTaskAccess(ctx, taskID, userID)
+	task, project, err := s.taskWithProject(ctx, taskID, userID, domain.PermissionEditTasks)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := project.Workflow().ValidateStatus(status); err != nil {
+		return nil, err
+	}
+
+	workflows := newWorkflowLookup(s.projectRepo, project)
+	if err := checkBlockedMove(ctx, s.taskRepo, workflows, task, status, false); err != nil {
+		return nil, err
+	}
+
+	if _, err := checkWIPMove(ctx, s.wipManager, task.ProjectID, task.Status, status, nil); err != nil {
+		return nil, err
+	}
+
 	// Update status
+	original := *task
 	task.Status = status
 
 	// Update in repository
@@ -490,6 +593,8 @@ func (s *taskService) UpdateTaskStatus(
 		return nil, domain.NewInternalError("TASK_STATUS_UPDATE_FAILED", "Failed to update task status", err)
 	}
 
+	s.recordTaskChanges(ctx, &original, task, userID)
+
 	return task, nil
 }
 
@@ -507,7 +612,7 @@ func (s *taskService) MoveTask(ctx context.Context, req MoveTaskRequest, userID
 	}
 
 	// Validate user has access to the task
-	task, err := s.validateTaskAccess(ctx, req.TaskID, userID)
+	task, project, err := s.taskWithProject(ctx, req.TaskID, userID, domain.PermissionEditTasks)
 	if err != nil {
 		return err
 	}
@@ -517,11 +622,46 @@ func (s *taskService) MoveTask(ctx context.Context, req MoveTaskRequest, userID
 		return domain.NewValidationError("PROJECT_MISMATCH", "Task does not belong to specified project", nil)
 	}
 
+	if err := project.Workflow().ValidateStatus(req.NewStatus); err != nil {
+		return err
+	}
+
+	workflows := newWorkflowLookup(s.projectRepo, project)
+	if err := checkBlockedMove(ctx, s.taskRepo, workflows, task, req.NewStatus, req.OverrideBlocked); err != nil {
+		return err
+	}
+
+	overridden, err := checkWIPMove(ctx, s.wipManager, req.ProjectID, task.Status, req.NewStatus, req.WIPOverride)
+	if err != nil {
+		return err
+	}
+
+	// Record the override before moving so a bypassed limit never goes unaudited
+	if overridden {
+		override := *req.WIPOverride
+		override.UserID = userID
+		if err := s.wipManager.RecordOverride(ctx, req.ProjectID, task.ID, req.NewStatus, override); err != nil {
+			return err
+		}
+	}
+
+	original := *task
+
 	// Use repository's Move method which handles position calculation and validation
 	if err := s.taskRepo.Move(ctx, req.TaskID, req.NewStatus, req.NewPosition); err != nil {
 		return domain.NewInternalError("TASK_MOVE_FAILED", "Failed to move task", err)
 	}
 
+	// Reload to pick up the position the repository settled on
+	moved, err := s.taskRepo.GetByID(ctx, req.TaskID)
+	if err != nil {
+		fallback := original
+		fallback.Status = req.NewStatus
+		fallback.Position = req.NewPosition
+		moved = &fallback
+	}
+	s.recordTaskChanges(ctx, &original, moved, userID)
+
 	return nil
 }
 
@@ -542,8 +682,8 @@ func (s *taskService) GetProjectTasksFiltered(
 		return nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
 	}
 
-	if !project.HasAccess(userID) && project.Settings.IsPrivate {
-		return nil, domain.NewAuthorizationError("ACCESS_DENIED", "You don't have access to this project")
+	if err := s.authz.Check(ctx, project, userID, domain.PermissionViewProject); err != nil {
+		return nil, err
 	}
 
 	// Use repository's filtered query
@@ -552,6 +692,10 @@ func (s *taskService) GetProjectTasksFiltered(
 		return nil, domain.NewInternalError("TASK_FILTER_FAILED", "Failed to filter project tasks", err)
 	}
 
+	if err := markBlocked(ctx, s.taskRepo, newWorkflowLookup(s.projectRepo, project), tasks); err != nil {
+		return nil, err
+	}
+
 	return tasks, nil
 }
 
@@ -562,7 +706,7 @@ func (s *taskService) GetSubtasks(ctx context.Context, parentTaskID string, user
 	}
 
 	// Validate user has access to the parent task
-	_, err := s.validateTaskAccess(ctx, parentTaskID, userID)
+	_, err := s.validateTaskAccess(ctx, parentTaskID, userID, domain.PermissionViewProject)
 	if err != nil {
 		return nil, err
 	}
@@ -583,7 +727,7 @@ func (s *taskService) GetTaskDependencies(ctx context.Context, taskID string, us
 	}
 
 	// Validate user has access to the task
-	_, err := s.validateTaskAccess(ctx, taskID, userID)
+	_, err := s.validateTaskAccess(ctx, taskID, userID, domain.PermissionViewProject)
 	if err != nil {
 		return nil, err
 	}
@@ -609,14 +753,16 @@ func (s *taskService) DuplicateTask(
 	}
 
 	// Get the original task and validate access
-	originalTask, err := s.validateTaskAccess(ctx, taskID, userID)
+	originalTask, project, err := s.taskWithProject(ctx, taskID, userID, domain.PermissionCreateTasks)
 	if err != nil {
 		return nil, err
 	}
 
-	// Create new task from original
-	newTask := s.createTaskCopy(originalTask, options)
+	// Create new task from original; copies start over in the workflow's initial status
+	initialStatus := project.Workflow().Initial()
+	newTask := createTaskCopy(originalTask, initialStatus, options)
 	newTask.ReporterID = userID // Set the user as the reporter of the duplicated task
+	newTask.WatchParticipants()
 
 	// Create the new task
 	if err := s.taskRepo.Create(ctx, newTask); err != nil {
@@ -625,7 +771,7 @@ func (s *taskService) DuplicateTask(
 
 	// Handle subtasks if requested
 	if options.IncludeSubtasks {
-		if err := s.duplicateSubtasks(ctx, taskID, newTask.ID, options, userID); err != nil {
+		if err := s.duplicateSubtasks(ctx, taskID, newTask.ID, initialStatus, options, userID); err != nil {
 			// Log error but don't fail the main duplication
 			// In a production system, use structured logging here
 			_ = err // Explicitly ignore error to satisfy linter
@@ -655,8 +801,8 @@ func (s *taskService) CreateFromTemplate(
 		return nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
 	}
 
-	if !project.HasAccess(userID) {
-		return nil, domain.NewAuthorizationError("ACCESS_DENIED", "You don't have access to this project")
+	if err := s.authz.Check(ctx, project, userID, domain.PermissionCreateTasks); err != nil {
+		return nil, err
 	}
 
 	// Get the template task (assuming templates are just tasks marked as templates)
@@ -666,7 +812,8 @@ func (s *taskService) CreateFromTemplate(
 	}
 
 	// Create task from template
-	newTask := s.createTaskFromTemplate(templateTask, projectID, userID)
+	newTask := s.createTaskFromTemplate(templateTask, projectID, project.Workflow().Initial(), userID)
+	newTask.WatchParticipants()
 
 	// Create the task
 	if err := s.taskRepo.Create(ctx, newTask); err != nil {
@@ -678,8 +825,11 @@ func (s *taskService) CreateFromTemplate(
 
 // Helper methods for task duplication and templating
 
-// createTaskCopy creates a copy of a task with specified options
-func (s *taskService) createTaskCopy(original *domain.Task, options DuplicationOptions) *domain.Task {
+// createTaskCopy creates a copy of a task in the given status with specified options.
+// Recurring tasks create their occurrences from the series template with it too.
+func createTaskCopy(
+	original *domain.Task, status domain.TaskStatus, options DuplicationOptions,
+) *domain.Task {
 	title := options.NewTitle
 	if title == "" {
 		title = "Copy of " + original.Title
@@ -689,7 +839,7 @@ func (s *taskService) createTaskCopy(original *domain.Task, options DuplicationO
 		Title:          title,
 		Description:    original.Description,
 		ProjectID:      original.ProjectID,
-		Status:         domain.StatusTodo, // Reset to todo for duplicated tasks
+		Status:         status,
 		Priority:       original.Priority,
 		AssigneeID:     original.AssigneeID,
 		ParentTaskID:   original.ParentTaskID,
@@ -728,17 +878,19 @@ func (s *taskService) createTaskCopy(original *domain.Task, options DuplicationO
 	return newTask
 }
 
-// createTaskFromTemplate creates a new task from a template
-func (s *taskService) createTaskFromTemplate(template *domain.Task, projectID string, userID string) *domain.Task {
+// createTaskFromTemplate creates a new task in the given status from a template
+func (s *taskService) createTaskFromTemplate(
+	template *domain.Task, projectID string, status domain.TaskStatus, userID string,
+) *domain.Task {
 	// Copy tags properly
 	tags := make([]string, len(template.Tags))
 	copy(tags, template.Tags)
 
-	return &domain.Task{
+	task := &domain.Task{
 		Title:          template.Title,
 		Description:    template.Description,
 		ProjectID:      projectID,
-		Status:         domain.StatusTodo,
+		Status:         status,
 		Priority:       template.Priority,
 		ReporterID:     userID,
 		Tags:           tags,
@@ -747,6 +899,9 @@ func (s *taskService) createTaskFromTemplate(template *domain.Task, projectID st
 		TimeSpent:      0.0,
 		Position:       0, // Will be calculated by repository
 	}
+	task.ApplyChecklist(markdown.Checklist(task.Description))
+
+	return task
 }
 
 // duplicateSubtasks recursively duplicates subtasks
@@ -754,6 +909,7 @@ func (s *taskService) duplicateSubtasks(
 	ctx context.Context,
 	originalParentID string,
 	newParentID string,
+	status domain.TaskStatus,
 	options DuplicationOptions,
 	userID string,
 ) error {
@@ -764,9 +920,10 @@ func (s *taskService) duplicateSubtasks(
 
 	for _, subtask := range subtasks {
 		// Create copy of subtask
-		newSubtask := s.createTaskCopy(subtask, options)
+		newSubtask := createTaskCopy(subtask, status, options)
 		newSubtask.ParentTaskID = &newParentID
 		newSubtask.ReporterID = userID
+		newSubtask.WatchParticipants()
 
 		if err := s.taskRepo.Create(ctx, newSubtask); err != nil {
 			return fmt.Errorf("failed to create subtask copy: %w", err)
@@ -774,7 +931,7 @@ func (s *taskService) duplicateSubtasks(
 
 		// Recursively duplicate nested subtasks
 		if options.IncludeSubtasks {
-			if err := s.duplicateSubtasks(ctx, subtask.ID, newSubtask.ID, options, userID); err != nil {
+			if err := s.duplicateSubtasks(ctx, subtask.ID, newSubtask.ID, status, options, userID); err != nil {
 				// Log but continue with other subtasks
 				continue
 			}
@@ -796,7 +953,7 @@ func (s *taskService) CreateSubtask(
 	}
 
 	// Validate parent task exists and user has access
-	parentTask, err := s.validateTaskAccess(ctx, parentTaskID, userID)
+	parentTask, err := s.validateTaskAccess(ctx, parentTaskID, userID, domain.PermissionCreateTasks)
 	if err != nil {
 		return nil, err
 	}
@@ -835,21 +992,16 @@ func (s *taskService) AddDependency(
 	}
 
 	// Validate both tasks exist and user has access
-	task, err := s.validateTaskAccess(ctx, taskID, userID)
+	task, err := s.validateTaskAccess(ctx, taskID, userID, domain.PermissionEditTasks)
 	if err != nil {
 		return err
 	}
 
-	dependencyTask, err := s.validateTaskAccess(ctx, dependencyID, userID)
+	dependencyTask, err := s.validateTaskAccess(ctx, dependencyID, userID, domain.PermissionViewProject)
 	if err != nil {
 		return err
 	}
 
-	// Prevent circular dependencies - basic check
-	if taskID == dependencyID {
-		return domain.NewConflictError("CIRCULAR_DEPENDENCY", "Task cannot depend on itself")
-	}
-
 	// Check if dependency is already added
 	for _, depID := range task.Dependencies {
 		if depID == dependencyID {
@@ -857,9 +1009,19 @@ func (s *taskService) AddDependency(
 		}
 	}
 
-	// Ensure both tasks are in the same project
 	if task.ProjectID != dependencyTask.ProjectID {
-		return domain.NewValidationError("CROSS_PROJECT_DEPENDENCY", "Cannot add dependency from different project", nil)
+		if err := s.validateCrossProjectDependency(ctx, task.ProjectID); err != nil {
+			return err
+		}
+	}
+
+	// Reject dependencies that would close a cycle anywhere in the dependency graph
+	cycle, err := domain.FindDependencyCycle(taskID, dependencyID, s.dependencyLookup(ctx))
+	if err != nil {
+		return err
+	}
+	if cycle != nil {
+		return domain.NewDependencyCycleError(cycle)
 	}
 
 	// Add dependency
@@ -873,6 +1035,38 @@ func (s *taskService) AddDependency(
 	return nil
 }
 
+// validateCrossProjectDependency checks the dependent task's project allows depending on other projects
+func (s *taskService) validateCrossProjectDependency(ctx context.Context, projectID string) error {
+	project, err := s.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
+	}
+
+	if !project.AllowsCrossProjectDependencies() {
+		return domain.NewValidationError("CROSS_PROJECT_DEPENDENCY",
+			"Project does not allow dependencies on tasks of other projects", map[string]interface{}{
+				"field": "dependency_id",
+			})
+	}
+
+	return nil
+}
+
+// dependencyLookup reads a task's dependencies from the repository for cycle detection.
+// Tasks that no longer exist have no dependencies.
+func (s *taskService) dependencyLookup(ctx context.Context) domain.DependencyLookup {
+	return func(taskID string) ([]string, error) {
+		task, err := s.taskRepo.GetByID(ctx, taskID)
+		if err != nil {
+			if isTaskNotFound(err) {
+				return nil, nil
+			}
+			return nil, domain.NewInternalError("DEPENDENCY_CHECK_FAILED", "Failed to check dependencies", err)
+		}
+		return task.Dependencies, nil
+	}
+}
+
 // RemoveDependency removes a dependency from a task
 func (s *taskService) RemoveDependency(
 	ctx context.Context,
@@ -885,7 +1079,7 @@ func (s *taskService) RemoveDependency(
 	}
 
 	// Validate task exists and user has access
-	task, err := s.validateTaskAccess(ctx, taskID, userID)
+	task, err := s.validateTaskAccess(ctx, taskID, userID, domain.PermissionEditTasks)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
+)
+
+// GetTaskDependents retrieves the tasks that depend on a task, with their blocked state
+func (s *taskService) GetTaskDependents(ctx context.Context, taskID string, userID string) ([]*domain.Task, error) {
+	if taskID == "" {
+		return nil, domain.NewValidationError("INVALID_TASK_ID", "Task ID cannot be empty", nil)
+	}
+
+	if _, err := s.validateTaskAccess(ctx, taskID, userID, domain.PermissionViewProject); err != nil {
+		return nil, err
+	}
+
+	dependents, err := s.taskRepo.GetDependents(ctx, taskID)
+	if err != nil {
+		return nil, domain.NewInternalError("DEPENDENT_FETCH_FAILED", "Failed to fetch dependent tasks", err)
+	}
+
+	if err := markBlocked(ctx, s.taskRepo, newWorkflowLookup(s.projectRepo), dependents); err != nil {
+		return nil, err
+	}
+
+	return dependents, nil
+}
+
+// refreshBlocked computes the blocked state of a task from its dependencies.
+// A dependency stops blocking once it reaches a done status of its own project's workflow.
+func refreshBlocked(
+	ctx context.Context, taskRepo repository.TaskRepository, workflows *workflowLookup, task *domain.Task,
+) error {
+	dependencies, err := taskRepo.GetDependencies(ctx, task.ID)
+	if err != nil {
+		return domain.NewInternalError("DEPENDENCY_FETCH_FAILED", "Failed to fetch task dependencies", err)
+	}
+
+	task.SetBlockedBy(domain.BlockingDependencies(dependencies, func(dependency *domain.Task) bool {
+		return workflows.isDone(ctx, dependency)
+	}))
+	return nil
+}
+
+// checkBlockedMove refuses to move a blocked task forward in its project's workflow unless
+// the caller overrides it. The task's blocked state is refreshed either way.
+func checkBlockedMove(
+	ctx context.Context, taskRepo repository.TaskRepository, workflows *workflowLookup,
+	task *domain.Task, to domain.TaskStatus, override bool,
+) error {
+	if err := refreshBlocked(ctx, taskRepo, workflows, task); err != nil {
+		return err
+	}
+
+	workflow := workflows.forProject(ctx, task.ProjectID)
+	if task.Blocked && !override && workflow.IsForwardMove(task.Status, to) {
+		return domain.NewTaskBlockedError(task.BlockedBy)
+	}
+	return nil
+}
+
+// markBlocked computes the blocked state of a list of tasks. Dependencies inside the
+// list are resolved from it, so a whole board needs no extra lookups; dependencies
+// that no longer exist don't block.
+func markBlocked(
+	ctx context.Context, taskRepo repository.TaskRepository, workflows *workflowLookup, tasks []*domain.Task,
+) error {
+	done := make(map[string]bool, len(tasks))
+	for _, task := range tasks {
+		done[task.ID] = workflows.isDone(ctx, task)
+	}
+
+	for _, task := range tasks {
+		var blockedBy []string
+		for _, dependencyID := range task.Dependencies {
+			isDone, known := done[dependencyID]
+			if !known {
+				dependency, err := taskRepo.GetByID(ctx, dependencyID)
+				switch {
+				case err == nil:
+					isDone = workflows.isDone(ctx, dependency)
+				case isTaskNotFound(err):
+					isDone = true
+				default:
+					return domain.NewInternalError("DEPENDENCY_FETCH_FAILED", "Failed to fetch task dependencies", err)
+				}
+				done[dependencyID] = isDone
+			}
+
+			if !isDone {
+				blockedBy = append(blockedBy, dependencyID)
+			}
+		}
+		task.SetBlockedBy(blockedBy)
+	}
+
+	return nil
+}
+
+// isTaskNotFound reports whether a repository lookup failed because the task doesn't exist
+func isTaskNotFound(err error) bool {
+	var domainErr *domain.Error
+	return repository.IsNotFound(err) || (errors.As(err, &domainErr) && domainErr.Type == domain.NotFoundError)
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+func TestTaskService_AddDependency(t *testing.T) {
+	ctx := context.Background()
+
+	taskRepo := testutil.NewMockTaskRepository()
+	projectRepo := testutil.NewMockProjectRepository()
+	userRepo := testutil.NewMockUserRepository()
+	service := NewTaskService(
+		taskRepo, projectRepo, userRepo, testutil.NewMockTaskHistoryRepository(),
+		NewAuthorizationService(projectRepo), nil, nil,
+	)
+
+	owner := testutil.MockUser("owner", "owner@test.com", "owner", "Owner")
+	userRepo.AddUser(owner)
+
+	project := testutil.MockProject("dep-proj", "Dependency Project", "deps", owner.ID)
+	projectRepo.AddProject(project)
+	otherProject := testutil.MockProject("other-proj", "Other Project", "other", owner.ID)
+	projectRepo.AddProject(otherProject)
+
+	for _, id := range []string{"a", "b", "c", "d"} {
+		taskRepo.AddTask(testutil.MockTask(id, "Task "+id, project.ID, owner.ID))
+	}
+	taskRepo.AddTask(testutil.MockTask("foreign", "Foreign", otherProject.ID, owner.ID))
+
+	t.Run("BuildsChain", func(t *testing.T) {
+		require.NoError(t, service.AddDependency(ctx, "a", "b", owner.ID))
+		require.NoError(t, service.AddDependency(ctx, "b", "c", owner.ID))
+		require.NoError(t, service.AddDependency(ctx, "a", "d", owner.ID))
+	})
+
+	t.Run("RejectsTransitiveCycle", func(t *testing.T) {
+		err := service.AddDependency(ctx, "c", "a", owner.ID)
+		require.Error(t, err)
+
+		var domainErr *domain.Error
+		require.True(t, errors.As(err, &domainErr))
+		assert.Equal(t, "CIRCULAR_DEPENDENCY", domainErr.Code)
+		assert.Equal(t, []string{"c", "a", "b", "c"}, domainErr.Details["cycle"])
+
+		task, _ := taskRepo.GetByID(ctx, "c")
+		assert.Empty(t, task.Dependencies, "rejected dependency must not be stored")
+	})
+
+	t.Run("RejectsSelfDependency", func(t *testing.T) {
+		err := service.AddDependency(ctx, "a", "a", owner.ID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "CIRCULAR_DEPENDENCY")
+	})
+
+	t.Run("AllowsDiamond", func(t *testing.T) {
+		// d is reachable from a through two paths, which is not a cycle
+		require.NoError(t, service.AddDependency(ctx, "b", "d", owner.ID))
+	})
+
+	t.Run("CrossProject_DeniedByDefault", func(t *testing.T) {
+		err := service.AddDependency(ctx, "d", "foreign", owner.ID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "CROSS_PROJECT_DEPENDENCY")
+	})
+
+	t.Run("CrossProject_AllowedByPolicy", func(t *testing.T) {
+		project.Settings.CrossProjectDependencies = domain.CrossProjectDependenciesAllow
+		defer func() { project.Settings.CrossProjectDependencies = "" }()
+
+		require.NoError(t, service.AddDependency(ctx, "d", "foreign", owner.ID))
+
+		// Cycles are still detected across projects
+		otherProject.Settings.CrossProjectDependencies = domain.CrossProjectDependenciesAllow
+		defer func() { otherProject.Settings.CrossProjectDependencies = "" }()
+
+		err := service.AddDependency(ctx, "foreign", "a", owner.ID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "CIRCULAR_DEPENDENCY")
+	})
+}
+
+func TestTaskService_BlockedTasks(t *testing.T) {
+	ctx := context.Background()
+
+	taskRepo := testutil.NewMockTaskRepository()
+	projectRepo := testutil.NewMockProjectRepository()
+	userRepo := testutil.NewMockUserRepository()
+	service := NewTaskService(
+		taskRepo, projectRepo, userRepo, testutil.NewMockTaskHistoryRepository(),
+		NewAuthorizationService(projectRepo), nil, nil,
+	)
+
+	owner := testutil.MockUser("owner", "owner@test.com", "owner", "Owner")
+	userRepo.AddUser(owner)
+	project := testutil.MockProject("blocked-proj", "Blocked Project", "blocked", owner.ID)
+	projectRepo.AddProject(project)
+
+	blocker := testutil.MockTask("blocker", "Blocker", project.ID, owner.ID)
+	done := testutil.MockTask("done", "Done", project.ID, owner.ID)
+	done.Status = domain.StatusComplete
+	blocked := testutil.MockTask("blocked", "Blocked", project.ID, owner.ID)
+	blocked.Dependencies = []string{"blocker", "done"}
+	for _, task := range []*domain.Task{blocker, done, blocked} {
+		taskRepo.AddTask(task)
+	}
+
+	t.Run("GetTaskReportsBlockedState", func(t *testing.T) {
+		task, err := service.GetTask(ctx, "blocked", owner.ID)
+		require.NoError(t, err)
+		assert.True(t, task.Blocked)
+		assert.Equal(t, []string{"blocker"}, task.BlockedBy)
+	})
+
+	t.Run("ListsReportBlockedState", func(t *testing.T) {
+		tasks, err := service.ListProjectTasks(ctx, project.ID, owner.ID, 0, 20)
+		require.NoError(t, err)
+		for _, task := range tasks {
+			assert.Equal(t, task.ID == "blocked", task.Blocked, task.ID)
+		}
+	})
+
+	t.Run("RefusesForwardMove", func(t *testing.T) {
+		_, err := service.UpdateTaskStatus(ctx, "blocked", domain.StatusDeveloping, owner.ID)
+		require.Error(t, err)
+
+		var domainErr *domain.Error
+		require.True(t, errors.As(err, &domainErr))
+		assert.Equal(t, "TASK_BLOCKED", domainErr.Code)
+		assert.Equal(t, []string{"blocker"}, domainErr.Details["blocked_by"])
+
+		err = service.MoveTask(ctx, MoveTaskRequest{
+			TaskID: "blocked", ProjectID: project.ID, NewStatus: domain.StatusComplete,
+		}, owner.ID)
+		assert.Contains(t, err.Error(), "TASK_BLOCKED")
+	})
+
+	t.Run("AllowsPlanningMoves", func(t *testing.T) {
+		task, err := service.UpdateTaskStatus(ctx, "blocked", domain.StatusBacklog, owner.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.StatusBacklog, task.Status)
+	})
+
+	t.Run("OverrideAllowsForwardMove", func(t *testing.T) {
+		status := domain.StatusDeveloping
+		task, err := service.UpdateTask(ctx, "blocked", domain.UpdateTaskRequest{
+			Status: &status, OverrideBlocked: true,
+		}, owner.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.StatusDeveloping, task.Status)
+	})
+
+	t.Run("CompletingDependencyUnblocks", func(t *testing.T) {
+		_, err := service.UpdateTaskStatus(ctx, "blocker", domain.StatusComplete, owner.ID)
+		require.NoError(t, err)
+
+		dependents, err := service.GetTaskDependents(ctx, "blocker", owner.ID)
+		require.NoError(t, err)
+		require.Len(t, dependents, 1)
+		assert.Equal(t, "blocked", dependents[0].ID)
+		assert.False(t, dependents[0].Blocked)
+	})
+}
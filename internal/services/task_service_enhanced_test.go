@@ -26,7 +26,10 @@ func TestTaskService_EnhancedFeatures(t *testing.T) {
 	userRepo := testutil.NewMockUserRepository()
 
 	// Create service
-	service := NewTaskService(taskRepo, projectRepo, userRepo)
+	service := NewTaskService(
+		taskRepo, projectRepo, userRepo, testutil.NewMockTaskHistoryRepository(),
+		NewAuthorizationService(projectRepo), nil, nil,
+	)
 
 	// Create test users
 	owner := &domain.User{
@@ -111,7 +114,7 @@ func TestTaskService_EnhancedFeatures(t *testing.T) {
 		t.Run("Error_InvalidStatus", func(t *testing.T) {
 			req := MoveTaskRequest{
 				TaskID:      "task-1",
-				NewStatus:   "invalid-status",
+				NewStatus:   "Not A Status",
 				NewPosition: 1,
 				ProjectID:   project.ID,
 			}
@@ -760,7 +763,10 @@ func TestTaskService_AuthorizationEdgeCases(t *testing.T) {
 	taskRepo := testutil.NewMockTaskRepository()
 	projectRepo := testutil.NewMockProjectRepository()
 	userRepo := testutil.NewMockUserRepository()
-	service := NewTaskService(taskRepo, projectRepo, userRepo)
+	service := NewTaskService(
+		taskRepo, projectRepo, userRepo, testutil.NewMockTaskHistoryRepository(),
+		NewAuthorizationService(projectRepo), nil, nil,
+	)
 
 	// Create users
 	owner := &domain.User{ID: "owner", Email: "owner@test.com", Username: "owner"}
@@ -838,7 +844,10 @@ func TestTaskService_ValidationEdgeCases(t *testing.T) {
 	taskRepo := testutil.NewMockTaskRepository()
 	projectRepo := testutil.NewMockProjectRepository()
 	userRepo := testutil.NewMockUserRepository()
-	service := NewTaskService(taskRepo, projectRepo, userRepo)
+	service := NewTaskService(
+		taskRepo, projectRepo, userRepo, testutil.NewMockTaskHistoryRepository(),
+		NewAuthorizationService(projectRepo), nil, nil,
+	)
 
 	// Setup basic test data
 	owner := &domain.User{ID: "owner", Email: "owner@test.com", Username: "owner"}
@@ -897,7 +906,10 @@ func TestTaskService_ErrorHandling(t *testing.T) {
 	taskRepo := testutil.NewMockTaskRepository()
 	projectRepo := testutil.NewMockProjectRepository()
 	userRepo := testutil.NewMockUserRepository()
-	service := NewTaskService(taskRepo, projectRepo, userRepo)
+	service := NewTaskService(
+		taskRepo, projectRepo, userRepo, testutil.NewMockTaskHistoryRepository(),
+		NewAuthorizationService(projectRepo), nil, nil,
+	)
 
 	owner := &domain.User{ID: "owner", Email: "owner@test.com", Username: "owner"}
 	userRepo.AddUser(owner)
@@ -1016,7 +1028,10 @@ func TestTaskService_PerformanceConsiderations(t *testing.T) {
 		taskRepo := testutil.NewMockTaskRepository()
 		projectRepo := testutil.NewMockProjectRepository()
 		userRepo := testutil.NewMockUserRepository()
-		service := NewTaskService(taskRepo, projectRepo, userRepo)
+		service := NewTaskService(
+			taskRepo, projectRepo, userRepo, testutil.NewMockTaskHistoryRepository(),
+			NewAuthorizationService(projectRepo), nil, nil,
+		)
 
 		owner := &domain.User{ID: "owner", Email: "owner@test.com", Username: "owner"}
 		userRepo.AddUser(owner)
@@ -1069,7 +1084,10 @@ func TestTaskService_Integration(t *testing.T) {
 	taskRepo := testutil.NewMockTaskRepository()
 	projectRepo := testutil.NewMockProjectRepository()
 	userRepo := testutil.NewMockUserRepository()
-	service := NewTaskService(taskRepo, projectRepo, userRepo)
+	service := NewTaskService(
+		taskRepo, projectRepo, userRepo, testutil.NewMockTaskHistoryRepository(),
+		NewAuthorizationService(projectRepo), nil, nil,
+	)
 
 	// Create realistic test data
 	productOwner := &domain.User{ID: "po-1", Email: "po@company.com", Username: "product_owner"}
@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// taskFieldChange captures a single field modification for the audit log
+type taskFieldChange struct {
+	field    string
+	action   domain.TaskHistoryAction
+	oldValue interface{}
+	newValue interface{}
+}
+
+// GetTaskHistory retrieves the audit log for a task along with the total number of matching entries
+func (s *taskService) GetTaskHistory(
+	ctx context.Context,
+	taskID string,
+	filter domain.TaskHistoryFilter,
+	userID string,
+) ([]*domain.TaskHistoryEntry, int, error) {
+	if taskID == "" {
+		return nil, 0, domain.NewValidationError("INVALID_TASK_ID", "Task ID cannot be empty", nil)
+	}
+
+	// Viewing a task's history takes the same access as viewing the task, which may be deleted by now
+	projectID, err := s.historyProjectID(ctx, taskID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if _, err := s.authz.Authorize(ctx, projectID, userID, domain.PermissionViewProject); err != nil {
+		return nil, 0, err
+	}
+
+	filter.TaskID = &taskID
+	if err := filter.Validate(); err != nil {
+		return nil, 0, err
+	}
+
+	entries, err := s.historyRepo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, domain.NewInternalError("HISTORY_FETCH_FAILED", "Failed to fetch task history", err)
+	}
+
+	total, err := s.historyRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, domain.NewInternalError("HISTORY_COUNT_FAILED", "Failed to count task history", err)
+	}
+
+	return entries, total, nil
+}
+
+// historyProjectID returns the project a task is in or, once it is deleted, the project its
+// deletion entry recorded
+func (s *taskService) historyProjectID(ctx context.Context, taskID string) (string, error) {
+	task, err := s.taskRepo.GetByID(ctx, taskID)
+	if err == nil {
+		return task.ProjectID, nil
+	}
+
+	deletions, listErr := s.historyRepo.List(ctx, domain.TaskHistoryFilter{
+		TaskID:  &taskID,
+		Actions: []domain.TaskHistoryAction{domain.ActionDeleted},
+		Limit:   1,
+	})
+	if listErr != nil || len(deletions) == 0 {
+		return "", err
+	}
+
+	metadata, metadataErr := deletions[0].GetMetadata()
+	projectID, _ := metadata["project_id"].(string)
+	if metadataErr != nil || projectID == "" {
+		return "", err
+	}
+	return projectID, nil
+}
+
+// recordTaskChanges writes one history entry per field that differs between the two task states.
+// History is an audit trail, so a failed write is logged rather than failing the user's change.
+func (s *taskService) recordTaskChanges(ctx context.Context, original, updated *domain.Task, userID string) {
+	for _, change := range diffTaskFields(original, updated) {
+		entry := domain.NewTaskHistoryEntry(updated.ID, userID, change.action)
+		if err := entry.SetFieldChange(change.field, change.oldValue, change.newValue); err != nil {
+			slog.Warn("Failed to encode task history change", "task_id", updated.ID, "field", change.field, "error", err)
+			continue
+		}
+		s.saveHistoryEntry(ctx, entry)
+	}
+}
+
+// recordTaskDeletion writes a history entry for a deleted task with enough context to identify it later
+func (s *taskService) recordTaskDeletion(ctx context.Context, task *domain.Task, userID string) {
+	entry := domain.NewTaskHistoryEntry(task.ID, userID, domain.ActionDeleted)
+	if err := entry.SetMetadata(map[string]interface{}{
+		"title":      task.Title,
+		"project_id": task.ProjectID,
+		"status":     task.Status,
+	}); err != nil {
+		slog.Warn("Failed to encode task history metadata", "task_id", task.ID, "error", err)
+	}
+	s.saveHistoryEntry(ctx, entry)
+}
+
+// saveHistoryEntry persists a history entry, logging instead of failing on error
+func (s *taskService) saveHistoryEntry(ctx context.Context, entry *domain.TaskHistoryEntry) {
+	if err := s.historyRepo.Create(ctx, entry); err != nil {
+		slog.Warn("Failed to record task history",
+			"task_id", entry.TaskID,
+			"action", entry.Action,
+			"error", err)
+	}
+}
+
+// diffTaskFields compares the user-editable fields of two task states
+func diffTaskFields(original, updated *domain.Task) []taskFieldChange {
+	var changes []taskFieldChange
+
+	add := func(field string, action domain.TaskHistoryAction, oldValue, newValue interface{}) {
+		changes = append(changes, taskFieldChange{
+			field:    field,
+			action:   action,
+			oldValue: oldValue,
+			newValue: newValue,
+		})
+	}
+
+	if original.Title != updated.Title {
+		add("Title", domain.ActionUpdated, original.Title, updated.Title)
+	}
+	if original.Description != updated.Description {
+		add("Description", domain.ActionUpdated, original.Description, updated.Description)
+	}
+	if original.Status != updated.Status {
+		add("Status", domain.ActionMoved, original.Status, updated.Status)
+	}
+	if original.Position != updated.Position {
+		add("Position", domain.ActionMoved, original.Position, updated.Position)
+	}
+	if original.Priority != updated.Priority {
+		add("Priority", domain.ActionUpdated, original.Priority, updated.Priority)
+	}
+	if stringPtrChanged(original.AssigneeID, updated.AssigneeID) {
+		add("AssigneeID", domain.ActionAssigned, original.AssigneeID, updated.AssigneeID)
+	}
+	if timePtrChanged(original.DueDate, updated.DueDate) {
+		add("DueDate", domain.ActionUpdated, original.DueDate, updated.DueDate)
+	}
+	if !slices.Equal(original.Tags, updated.Tags) {
+		add("Tags", domain.ActionUpdated, original.Tags, updated.Tags)
+	}
+
+	return changes
+}
+
+// stringPtrChanged reports whether two optional string values differ
+func stringPtrChanged(original, updated *string) bool {
+	if (original == nil) != (updated == nil) {
+		return true
+	}
+	return original != nil && *original != *updated
+}
+
+// timePtrChanged reports whether two optional timestamps differ
+func timePtrChanged(original, updated *time.Time) bool {
+	if (original == nil) != (updated == nil) {
+		return true
+	}
+	return original != nil && !original.Equal(*updated)
+}
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+func TestTaskService_History(t *testing.T) {
+	ctx := context.Background()
+
+	taskRepo := testutil.NewMockTaskRepository()
+	projectRepo := testutil.NewMockProjectRepository()
+	userRepo := testutil.NewMockUserRepository()
+	historyRepo := testutil.NewMockTaskHistoryRepository()
+	service := NewTaskService(
+		taskRepo, projectRepo, userRepo, historyRepo, NewAuthorizationService(projectRepo), nil, nil,
+	)
+
+	owner := &domain.User{ID: "owner", Email: "owner@test.com", Username: "owner"}
+	member := &domain.User{ID: "member", Email: "member@test.com", Username: "member"}
+	outsider := &domain.User{ID: "outsider", Email: "outsider@test.com", Username: "outsider"}
+	userRepo.AddUser(owner)
+	userRepo.AddUser(member)
+	userRepo.AddUser(outsider)
+
+	projectRepo.AddProject(&domain.Project{
+		ID:        "history-proj",
+		Title:     "History Project",
+		Slug:      "history",
+		OwnerID:   owner.ID,
+		MemberIDs: []string{member.ID},
+		Settings:  domain.ProjectSettings{IsPrivate: true},
+		Status:    domain.ActiveProject,
+	})
+
+	newTask := func(id string) *domain.Task {
+		task := &domain.Task{
+			ID:         id,
+			Title:      "Original title",
+			ProjectID:  "history-proj",
+			ReporterID: owner.ID,
+			Status:     domain.StatusTodo,
+			Priority:   domain.PriorityMedium,
+			Position:   1,
+		}
+		taskRepo.AddTask(task)
+		return task
+	}
+
+	t.Run("UpdateTask_RecordsEachChangedField", func(t *testing.T) {
+		task := newTask("history-update")
+		title := "New title"
+		priority := domain.PriorityHigh
+
+		_, err := service.UpdateTask(ctx, task.ID, domain.UpdateTaskRequest{
+			Title:    &title,
+			Priority: &priority,
+		}, owner.ID)
+		require.NoError(t, err)
+
+		entries, total, err := service.GetTaskHistory(ctx, task.ID, domain.TaskHistoryFilter{}, owner.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+
+		byField := make(map[string]*domain.TaskHistoryEntry)
+		for _, entry := range entries {
+			require.NotNil(t, entry.FieldName)
+			byField[*entry.FieldName] = entry
+		}
+
+		require.Contains(t, byField, "Title")
+		assert.Equal(t, domain.ActionUpdated, byField["Title"].Action)
+		assert.Equal(t, owner.ID, byField["Title"].UserID)
+		oldValue, err := byField["Title"].GetOldValue()
+		require.NoError(t, err)
+		assert.Equal(t, "Original title", oldValue)
+		newValue, err := byField["Title"].GetNewValue()
+		require.NoError(t, err)
+		assert.Equal(t, "New title", newValue)
+
+		require.Contains(t, byField, "Priority")
+	})
+
+	t.Run("AssignAndMove_UseSpecificActions", func(t *testing.T) {
+		task := newTask("history-assign-move")
+
+		_, err := service.AssignTask(ctx, task.ID, member.ID, owner.ID)
+		require.NoError(t, err)
+
+		err = service.MoveTask(ctx, MoveTaskRequest{
+			TaskID:      task.ID,
+			ProjectID:   task.ProjectID,
+			NewStatus:   domain.StatusDeveloping,
+			NewPosition: 1,
+		}, member.ID)
+		require.NoError(t, err)
+
+		assigned, total, err := service.GetTaskHistory(ctx, task.ID, domain.TaskHistoryFilter{
+			Actions: []domain.TaskHistoryAction{domain.ActionAssigned},
+		}, owner.ID)
+		require.NoError(t, err)
+		require.Equal(t, 1, total)
+		assert.Equal(t, "AssigneeID", *assigned[0].FieldName)
+
+		field := "Status"
+		moved, total, err := service.GetTaskHistory(ctx, task.ID, domain.TaskHistoryFilter{
+			FieldName: &field,
+		}, owner.ID)
+		require.NoError(t, err)
+		require.Equal(t, 1, total)
+		assert.Equal(t, domain.ActionMoved, moved[0].Action)
+		assert.Equal(t, member.ID, moved[0].UserID)
+	})
+
+	t.Run("DeleteTask_RecordsDeletion", func(t *testing.T) {
+		task := newTask("history-delete")
+
+		require.NoError(t, service.DeleteTask(ctx, task.ID, owner.ID))
+
+		taskID := task.ID
+		entries, err := historyRepo.List(ctx, domain.TaskHistoryFilter{TaskID: &taskID})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, domain.ActionDeleted, entries[0].Action)
+
+		metadata, err := entries[0].GetMetadata()
+		require.NoError(t, err)
+		assert.Equal(t, "Original title", metadata["title"])
+	})
+
+	t.Run("GetTaskHistory_AfterDeletion", func(t *testing.T) {
+		task := newTask("history-deleted")
+		title := "Renamed before deletion"
+		_, err := service.UpdateTask(ctx, task.ID, domain.UpdateTaskRequest{Title: &title}, owner.ID)
+		require.NoError(t, err)
+		require.NoError(t, service.DeleteTask(ctx, task.ID, owner.ID))
+
+		entries, total, err := service.GetTaskHistory(ctx, task.ID, domain.TaskHistoryFilter{}, member.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Equal(t, domain.ActionDeleted, entries[0].Action)
+		assert.Equal(t, owner.ID, entries[0].UserID, "members can see who deleted the task")
+
+		_, _, err = service.GetTaskHistory(ctx, task.ID, domain.TaskHistoryFilter{}, outsider.ID)
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+
+		_, _, err = service.GetTaskHistory(ctx, "never-existed", domain.TaskHistoryFilter{}, owner.ID)
+		assert.Equal(t, domain.NotFoundError, webhookErrorType(err))
+	})
+
+	t.Run("GetTaskHistory_PaginatesAndChecksAccess", func(t *testing.T) {
+		task := newTask("history-paginate")
+		for _, title := range []string{"One", "Two", "Three"} {
+			title := title
+			_, err := service.UpdateTask(ctx, task.ID, domain.UpdateTaskRequest{Title: &title}, owner.ID)
+			require.NoError(t, err)
+		}
+
+		entries, total, err := service.GetTaskHistory(ctx, task.ID, domain.TaskHistoryFilter{
+			Limit:  2,
+			Offset: 0,
+		}, owner.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 3, total)
+		require.Len(t, entries, 2)
+		newest, err := entries[0].GetNewValue()
+		require.NoError(t, err)
+		assert.Equal(t, "Three", newest)
+
+		_, _, err = service.GetTaskHistory(ctx, task.ID, domain.TaskHistoryFilter{}, outsider.ID)
+		assert.Error(t, err)
+	})
+
+	t.Run("HistoryFailure_DoesNotFailUpdate", func(t *testing.T) {
+		task := newTask("history-failure")
+		historyRepo.ForceCreateError = true
+		defer func() { historyRepo.ForceCreateError = false }()
+
+		title := "Still saved"
+		updated, err := service.UpdateTask(ctx, task.ID, domain.UpdateTaskRequest{Title: &title}, owner.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Still saved", updated.Title)
+	})
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+func TestTaskService_DescriptionChecklist(t *testing.T) {
+	ctx := context.Background()
+	taskRepo := testutil.NewMockTaskRepository()
+	projectRepo := testutil.NewMockProjectRepository()
+	userRepo := testutil.NewMockUserRepository()
+	service := NewTaskService(
+		taskRepo, projectRepo, userRepo, testutil.NewMockTaskHistoryRepository(),
+		NewAuthorizationService(projectRepo), nil, nil,
+	)
+
+	userRepo.AddUser(testutil.MockUser("owner", "owner@example.com", "owner", "Owner"))
+	projectRepo.AddProject(testutil.MockProject("project-1", "Project", "project", "owner"))
+
+	task, err := service.CreateTask(ctx, domain.CreateTaskRequest{
+		Title:       "Checklist task",
+		Description: "- [x] design\n- [ ] build\n- [ ] ship\n- [ ] announce",
+		ProjectID:   "project-1",
+		Priority:    domain.PriorityMedium,
+	}, "owner")
+	require.NoError(t, err)
+	assert.Equal(t, 25, task.Progress)
+	assert.Contains(t, task.DescriptionHTML, `<input checked="" disabled="" type="checkbox">`)
+
+	t.Run("UpdatedDescriptionRecountsProgress", func(t *testing.T) {
+		description := "- [x] design\n- [x] build\n- [x] ship\n- [ ] announce"
+		updated, err := service.UpdateTask(ctx, task.ID, domain.UpdateTaskRequest{Description: &description}, "owner")
+		require.NoError(t, err)
+		assert.Equal(t, 75, updated.Progress)
+	})
+
+	t.Run("DescriptionWithoutChecklistKeepsProgress", func(t *testing.T) {
+		description := "No more <script>alert(1)</script> steps"
+		updated, err := service.UpdateTask(ctx, task.ID, domain.UpdateTaskRequest{Description: &description}, "owner")
+		require.NoError(t, err)
+		assert.Equal(t, 75, updated.Progress)
+		assert.NotContains(t, updated.DescriptionHTML, "<script>")
+	})
+}
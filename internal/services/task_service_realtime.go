@@ -20,13 +20,16 @@ type RealtimeTaskService interface {
 // realtimeTaskService wraps the existing task service with real-time capabilities
 type realtimeTaskService struct {
 	TaskService
+	workflows        WorkflowService
 	eventBroadcaster EventBroadcaster
 	logger           *slog.Logger
 }
 
-// NewRealtimeTaskService creates a task service with real-time event broadcasting
+// NewRealtimeTaskService creates a task service with real-time event broadcasting.
+// The workflow service tells which statuses finish a task; without one the default workflow is used.
 func NewRealtimeTaskService(
 	taskService TaskService,
+	workflows WorkflowService,
 	eventBroadcaster EventBroadcaster,
 	logger *slog.Logger,
 ) RealtimeTaskService {
@@ -36,11 +39,25 @@ func NewRealtimeTaskService(
 
 	return &realtimeTaskService{
 		TaskService:      taskService,
+		workflows:        workflows,
 		eventBroadcaster: eventBroadcaster,
 		logger:           logger,
 	}
 }
 
+// isCompletion reports whether a task moved from an open status into a done status of its project's workflow
+func (s *realtimeTaskService) isCompletion(
+	ctx context.Context, originalStatus domain.TaskStatus, updatedTask *domain.Task, userID string,
+) bool {
+	workflow := domain.DefaultWorkflow()
+	if s.workflows != nil {
+		if projectWorkflow, err := s.workflows.GetWorkflow(ctx, updatedTask.ProjectID, userID); err == nil {
+			workflow = projectWorkflow
+		}
+	}
+	return !workflow.IsDone(originalStatus) && workflow.IsDone(updatedTask.Status)
+}
+
 // GetEventBroadcaster returns the event broadcaster for external use
 func (s *realtimeTaskService) GetEventBroadcaster() EventBroadcaster {
 	return s.eventBroadcaster
@@ -178,6 +195,7 @@ func (s *realtimeTaskService) MoveTask(ctx context.Context, req MoveTaskRequest,
 	if err != nil {
 		return err
 	}
+	originalStatus := originalTask.Status
 
 	// Move task using base service
 	if moveErr := s.TaskService.MoveTask(ctx, req, userID); moveErr != nil {
@@ -200,6 +218,10 @@ func (s *realtimeTaskService) MoveTask(ctx context.Context, req MoveTaskRequest,
 			"error", err)
 	}
 
+	if s.isCompletion(ctx, originalStatus, updatedTask, userID) {
+		s.broadcastTasksUnblocked(ctx, updatedTask, userID)
+	}
+
 	return nil
 }
 
@@ -309,6 +331,7 @@ func (s *realtimeTaskService) broadcastTaskDeleted(ctx context.Context, task *do
 		TaskID:    task.ID,
 		TaskTitle: task.Title,
 		DeletedBy: userID,
+		Watchers:  task.Watchers,
 	}
 
 	event, err := domain.NewTaskEvent(domain.TaskDeleted, task.ID, task.ProjectID, userID, eventData)
@@ -319,6 +342,48 @@ func (s *realtimeTaskService) broadcastTaskDeleted(ctx context.Context, task *do
 	return s.eventBroadcaster.BroadcastEvent(ctx, event)
 }
 
+// broadcastTasksUnblocked broadcasts an unblocked event for each task the completed task was the last blocker of
+func (s *realtimeTaskService) broadcastTasksUnblocked(ctx context.Context, completed *domain.Task, userID string) {
+	if err := publishTasksUnblocked(ctx, s.TaskService, s.eventBroadcaster, completed, userID); err != nil {
+		s.logger.Error("Failed to broadcast task unblocked events",
+			"task_id", completed.ID,
+			"error", err)
+	}
+}
+
+// publishTasksUnblocked broadcasts TaskUnblocked for every dependent of a completed task
+// that has no other open dependency. Each event belongs to the dependent's project.
+func publishTasksUnblocked(
+	ctx context.Context, taskService TaskService, broadcaster EventBroadcaster, completed *domain.Task, userID string,
+) error {
+	dependents, err := taskService.GetTaskDependents(ctx, completed.ID, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, dependent := range dependents {
+		if dependent.Blocked {
+			continue
+		}
+
+		eventData := &domain.TaskUnblockedData{
+			Task:                  dependent,
+			CompletedDependencyID: completed.ID,
+		}
+
+		event, err := domain.NewTaskEvent(domain.TaskUnblocked, dependent.ID, dependent.ProjectID, userID, eventData)
+		if err != nil {
+			return err
+		}
+
+		if err := broadcaster.BroadcastEvent(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // broadcastTaskCommented broadcasts a task comment event
 func (s *realtimeTaskService) BroadcastTaskCommented(
 	ctx context.Context, task *domain.Task, commentID, comment, authorID string,
@@ -443,6 +508,7 @@ func (s *realtimeTaskService) updateTaskWithBroadcast(
 	if err != nil {
 		return nil, err
 	}
+	originalStatus := originalTask.Status
 
 	// Update task using provided function
 	updatedTask, err := updateFunc()
@@ -457,5 +523,9 @@ func (s *realtimeTaskService) updateTaskWithBroadcast(
 			"error", err)
 	}
 
+	if s.isCompletion(ctx, originalStatus, updatedTask, userID) {
+		s.broadcastTasksUnblocked(ctx, updatedTask, userID)
+	}
+
 	return updatedTask, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log/slog"
 	"os"
+	"slices"
 	"testing"
 	"time"
 
@@ -193,6 +194,25 @@ func (m *mockTaskService) GetTaskDependencies(_ context.Context, _ string, _ str
 	return []*domain.Task{}, nil
 }
 
+func (m *mockTaskService) GetTaskDependents(_ context.Context, taskID string, _ string) ([]*domain.Task, error) {
+	var dependents []*domain.Task
+	for _, task := range m.tasks {
+		if !slices.Contains(task.Dependencies, taskID) {
+			continue
+		}
+
+		var blockedBy []string
+		for _, depID := range task.Dependencies {
+			if dep, ok := m.tasks[depID]; ok && dep.Status != domain.StatusComplete {
+				blockedBy = append(blockedBy, depID)
+			}
+		}
+		task.SetBlockedBy(blockedBy)
+		dependents = append(dependents, task)
+	}
+	return dependents, nil
+}
+
 func (m *mockTaskService) DuplicateTask(
 	_ context.Context, _ string, _ DuplicationOptions, _ string,
 ) (*domain.Task, error) {
@@ -217,6 +237,30 @@ func (m *mockTaskService) RemoveDependency(_ context.Context, _ string, _ string
 	return nil
 }
 
+func (m *mockTaskService) GetTaskHistory(
+	_ context.Context, _ string, _ domain.TaskHistoryFilter, _ string,
+) ([]*domain.TaskHistoryEntry, int, error) {
+	return []*domain.TaskHistoryEntry{}, 0, nil
+}
+
+func (m *mockTaskService) WatchTask(ctx context.Context, taskID string, userID string) (*domain.Task, error) {
+	task, err := m.GetTask(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+	task.AddWatcher(userID)
+	return task, nil
+}
+
+func (m *mockTaskService) UnwatchTask(ctx context.Context, taskID string, userID string) (*domain.Task, error) {
+	task, err := m.GetTask(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+	task.RemoveWatcher(userID)
+	return task, nil
+}
+
 func generateTaskID(id int) string {
 	return "task_" + string(rune('0'+id))
 }
@@ -260,6 +304,14 @@ func (m *mockEventBroadcaster) Cleanup(_ context.Context) error {
 	return nil
 }
 
+func (m *mockEventBroadcaster) Listen(_ string) (<-chan *domain.TaskEvent, func(), error) {
+	return make(chan *domain.TaskEvent), func() {}, nil
+}
+
+func (m *mockEventBroadcaster) StartClusterSync(_ context.Context) error {
+	return nil
+}
+
 func TestRealtimeTaskService(t *testing.T) {
 	// Set up mocks
 	baseService := &mockTaskService{
@@ -273,7 +325,7 @@ func TestRealtimeTaskService(t *testing.T) {
 	}))
 
 	// Create realtime task service
-	realtimeService := NewRealtimeTaskService(baseService, eventBroadcaster, logger)
+	realtimeService := NewRealtimeTaskService(baseService, nil, eventBroadcaster, logger)
 
 	t.Run("CreateTaskBroadcastsEvent", func(t *testing.T) {
 		ctx := context.Background()
@@ -634,7 +686,7 @@ func TestRealtimeTaskService(t *testing.T) {
 			broadcastError: domain.NewInternalError("BROADCAST_FAILED", "Broadcast failed", nil),
 		}
 
-		failingRealtimeService := NewRealtimeTaskService(baseService, failingBroadcaster, logger)
+		failingRealtimeService := NewRealtimeTaskService(baseService, nil, failingBroadcaster, logger)
 
 		ctx := context.Background()
 
@@ -666,12 +718,67 @@ func TestRealtimeTaskService(t *testing.T) {
 	})
 }
 
+func TestRealtimeTaskService_TaskUnblocked(t *testing.T) {
+	ctx := context.Background()
+
+	baseService := &mockTaskService{
+		tasks: map[string]*domain.Task{
+			"blocker": {ID: "blocker", ProjectID: "project1", Status: domain.StatusReview},
+			"other":   {ID: "other", ProjectID: "project1", Status: domain.StatusTodo},
+			"freed":   {ID: "freed", ProjectID: "project2", Status: domain.StatusTodo, Dependencies: []string{"blocker"}},
+			"still": {
+				ID: "still", ProjectID: "project1", Status: domain.StatusTodo, Dependencies: []string{"blocker", "other"},
+			},
+		},
+	}
+	eventBroadcaster := &mockEventBroadcaster{}
+	realtimeService := NewRealtimeTaskService(baseService, nil, eventBroadcaster, nil)
+
+	if _, err := realtimeService.UpdateTaskStatus(ctx, "blocker", domain.StatusComplete, "user1"); err != nil {
+		t.Fatalf("Failed to complete task: %v", err)
+	}
+
+	var unblocked []*domain.TaskEvent
+	for _, event := range eventBroadcaster.broadcastedEvents {
+		if event.Type == domain.TaskUnblocked {
+			unblocked = append(unblocked, event)
+		}
+	}
+
+	// Only the dependent without other open dependencies is unblocked
+	if len(unblocked) != 1 {
+		t.Fatalf("Expected 1 unblocked event, got %d", len(unblocked))
+	}
+	if unblocked[0].TaskID != "freed" || unblocked[0].ProjectID != "project2" {
+		t.Errorf("Expected unblocked event for freed in project2, got %s in %s", unblocked[0].TaskID, unblocked[0].ProjectID)
+	}
+
+	var data domain.TaskUnblockedData
+	if err := unblocked[0].GetDataAs(&data); err != nil {
+		t.Fatalf("Failed to decode event data: %v", err)
+	}
+	if data.CompletedDependencyID != "blocker" {
+		t.Errorf("Expected completed dependency blocker, got %s", data.CompletedDependencyID)
+	}
+
+	// Moving an already complete task again doesn't repeat the events
+	eventBroadcaster.broadcastedEvents = nil
+	if _, err := realtimeService.UpdateTaskStatus(ctx, "blocker", domain.StatusComplete, "user1"); err != nil {
+		t.Fatalf("Failed to update task: %v", err)
+	}
+	for _, event := range eventBroadcaster.broadcastedEvents {
+		if event.Type == domain.TaskUnblocked {
+			t.Error("Expected no unblocked event for a task that was already complete")
+		}
+	}
+}
+
 func TestCalculateTaskChanges(t *testing.T) {
 	baseService := &mockTaskService{tasks: make(map[string]*domain.Task)}
 	eventBroadcaster := &mockEventBroadcaster{}
 	logger := slog.Default()
 
-	realtimeService := NewRealtimeTaskService(baseService, eventBroadcaster, logger).(*realtimeTaskService)
+	realtimeService := NewRealtimeTaskService(baseService, nil, eventBroadcaster, logger).(*realtimeTaskService)
 
 	t.Run("NoChanges", func(t *testing.T) {
 		task1 := &domain.Task{
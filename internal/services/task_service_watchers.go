@@ -0,0 +1,43 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+)
+
+// WatchTask makes the user follow a task. Anyone who can view the task may watch it.
+func (s *taskService) WatchTask(ctx context.Context, taskID string, userID string) (*domain.Task, error) {
+	task, err := s.GetTask(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !task.AddWatcher(userID) {
+		return task, nil
+	}
+
+	if err := s.taskRepo.Update(ctx, task); err != nil {
+		return nil, domain.NewInternalError("TASK_WATCH_FAILED", "Failed to watch task", err)
+	}
+
+	return task, nil
+}
+
+// UnwatchTask stops the user following a task
+func (s *taskService) UnwatchTask(ctx context.Context, taskID string, userID string) (*domain.Task, error) {
+	task, err := s.GetTask(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !task.RemoveWatcher(userID) {
+		return task, nil
+	}
+
+	if err := s.taskRepo.Update(ctx, task); err != nil {
+		return nil, domain.NewInternalError("TASK_UNWATCH_FAILED", "Failed to unwatch task", err)
+	}
+
+	return task, nil
+}
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+func TestTaskService_Watchers(t *testing.T) {
+	ctx := context.Background()
+	taskRepo := testutil.NewMockTaskRepository()
+	projectRepo := testutil.NewMockProjectRepository()
+	userRepo := testutil.NewMockUserRepository()
+	service := NewTaskService(
+		taskRepo, projectRepo, userRepo, testutil.NewMockTaskHistoryRepository(),
+		NewAuthorizationService(projectRepo), nil, nil,
+	)
+
+	userRepo.AddUser(testutil.MockUser("owner", "owner@example.com", "owner", "Owner"))
+	userRepo.AddUser(testutil.MockUser("member", "member@example.com", "member", "Member"))
+	userRepo.AddUser(testutil.MockUser("outsider", "outsider@example.com", "outsider", "Outsider"))
+
+	project := testutil.MockProject("project-1", "Project", "project", "owner")
+	project.MemberIDs = []string{"member"}
+	project.Settings.IsPrivate = true
+	projectRepo.AddProject(project)
+
+	task, err := service.CreateTask(ctx, domain.CreateTaskRequest{
+		Title: "Watched task", ProjectID: "project-1", Priority: domain.PriorityMedium,
+	}, "owner")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"owner"}, task.Watchers, "the reporter watches new tasks")
+
+	t.Run("AssigneeWatches", func(t *testing.T) {
+		assigned, err := service.AssignTask(ctx, task.ID, "member", "owner")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"owner", "member"}, assigned.Watchers)
+	})
+
+	t.Run("WatchAndUnwatch", func(t *testing.T) {
+		unwatched, err := service.UnwatchTask(ctx, task.ID, "owner")
+		require.NoError(t, err)
+		assert.False(t, unwatched.IsWatchedBy("owner"))
+
+		watched, err := service.WatchTask(ctx, task.ID, "owner")
+		require.NoError(t, err)
+		assert.True(t, watched.IsWatchedBy("owner"))
+
+		again, err := service.WatchTask(ctx, task.ID, "owner")
+		require.NoError(t, err)
+		assert.Len(t, again.Watchers, 2, "watching twice is a no-op")
+	})
+
+	t.Run("PrivateProjectOutsider", func(t *testing.T) {
+		_, err := service.WatchTask(ctx, task.ID, "outsider")
+		assert.Error(t, err)
+
+		stored, err := taskRepo.GetByID(ctx, task.ID)
+		require.NoError(t, err)
+		assert.False(t, stored.IsWatchedBy("outsider"))
+	})
+}
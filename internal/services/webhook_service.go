@@ -0,0 +1,698 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/netip"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
+)
+
+// Headers sent with every webhook delivery
+const (
+	// WebhookSignatureHeader carries "sha256=" and the hex HMAC-SHA256 of the body, keyed with the webhook secret
+	WebhookSignatureHeader = "X-SET-Signature-256"
+	// WebhookEventHeader carries the task event type
+	WebhookEventHeader = "X-SET-Event"
+	// WebhookDeliveryHeader carries the delivery ID, which stays the same across retries
+	WebhookDeliveryHeader = "X-SET-Delivery"
+)
+
+// Delivery log limits
+const (
+	DefaultWebhookDeliveryLimit = 50
+	MaxWebhookDeliveryLimit     = 200
+)
+
+// webhookResponseReadLimit bounds how much of an endpoint's response body is read
+const webhookResponseReadLimit = 64 << 10
+
+// WebhookService manages outbound webhooks and delivers task events to them.
+// Deliveries are queued in the database and retried with exponential backoff;
+// a webhook whose deliveries keep failing is disabled.
+type WebhookService interface {
+	// CreateWebhook registers an endpoint for the project's events. A secret is generated when none is given.
+	CreateWebhook(ctx context.Context, projectID string, req CreateWebhookRequest, userID string) (*domain.Webhook, error)
+
+	// ListWebhooks returns the project's webhooks
+	ListWebhooks(ctx context.Context, projectID string, userID string) ([]*domain.Webhook, error)
+
+	// GetWebhook returns one of the project's webhooks
+	GetWebhook(ctx context.Context, projectID, webhookID string, userID string) (*domain.Webhook, error)
+
+	// UpdateWebhook changes a webhook; activating a disabled webhook clears its failure count
+	UpdateWebhook(
+		ctx context.Context, projectID, webhookID string, req UpdateWebhookRequest, userID string,
+	) (*domain.Webhook, error)
+
+	// DeleteWebhook removes a webhook along with its delivery log
+	DeleteWebhook(ctx context.Context, projectID, webhookID string, userID string) error
+
+	// ListDeliveries returns a webhook's most recent deliveries, newest first
+	ListDeliveries(
+		ctx context.Context, projectID, webhookID string, limit int, userID string,
+	) ([]*domain.WebhookDelivery, error)
+
+	// Redeliver queues the payload of an earlier delivery again as a new delivery
+	Redeliver(
+		ctx context.Context, projectID, webhookID, deliveryID string, userID string,
+	) (*domain.WebhookDelivery, error)
+
+	// Enqueue queues a delivery of the event to every active webhook of its project that matches it
+	Enqueue(ctx context.Context, event *domain.TaskEvent) error
+
+	// DeliverDue attempts every delivery that is due and returns how many succeeded
+	DeliverDue(ctx context.Context) (int, error)
+
+	// StartDeliveryRoutine delivers due webhooks every interval until the context is done
+	StartDeliveryRoutine(ctx context.Context, interval time.Duration)
+}
+
+// CreateWebhookRequest represents a new webhook. An empty EventTypes receives every event type.
+type CreateWebhookRequest struct {
+	URL         string                 `json:"url" binding:"required"`
+	Description string                 `json:"description,omitempty"`
+	Secret      string                 `json:"secret,omitempty"`
+	EventTypes  []domain.TaskEventType `json:"event_types,omitempty"`
+}
+
+// UpdateWebhookRequest represents a webhook change; nil fields are left unchanged
+type UpdateWebhookRequest struct {
+	URL         *string                 `json:"url,omitempty"`
+	Description *string                 `json:"description,omitempty"`
+	Secret      *string                 `json:"secret,omitempty"`
+	EventTypes  *[]domain.TaskEventType `json:"event_types,omitempty"`
+	Active      *bool                   `json:"active,omitempty"`
+}
+
+// WebhookConfig configures webhook delivery
+type WebhookConfig struct {
+	HTTPClient    *http.Client  // client used for deliveries; redirects are not followed (default: Timeout)
+	RetryInterval time.Duration // delay before the first retry, doubled for every later one (default: 30s)
+	Timeout       time.Duration // per-attempt timeout of the default client (default: 10s)
+	Retention     time.Duration // how long finished deliveries stay in the log (default: 30 days)
+	MaxAttempts   int           // attempts before a delivery is given up (default: 8)
+	BatchSize     int           // deliveries attempted per run (default: 50)
+	// AllowPrivateNetworks lets the default client reach loopback, link-local and private
+	// addresses, for self-hosted servers whose endpoints run next to them (default: false)
+	AllowPrivateNetworks bool
+}
+
+// DefaultWebhookConfig returns the default webhook delivery configuration
+func DefaultWebhookConfig() WebhookConfig {
+	return WebhookConfig{
+		RetryInterval: 30 * time.Second,
+		Timeout:       10 * time.Second,
+		Retention:     30 * 24 * time.Hour,
+		MaxAttempts:   8,
+		BatchSize:     50,
+	}
+}
+
+type webhookService struct {
+	webhookRepo  repository.WebhookRepository
+	deliveryRepo repository.WebhookDeliveryRepository
+	authz        AuthorizationService
+	client       *http.Client
+	logger       *slog.Logger
+	wake         chan struct{}
+	config       WebhookConfig
+	deliverMu    sync.Mutex
+}
+
+// NewWebhookService creates a new webhook service
+func NewWebhookService(
+	webhookRepo repository.WebhookRepository,
+	deliveryRepo repository.WebhookDeliveryRepository,
+	authz AuthorizationService,
+	cfg WebhookConfig,
+) WebhookService {
+	defaults := DefaultWebhookConfig()
+	if cfg.RetryInterval <= 0 {
+		cfg.RetryInterval = defaults.RetryInterval
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaults.Timeout
+	}
+	if cfg.Retention <= 0 {
+		cfg.Retention = defaults.Retention
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaults.MaxAttempts
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaults.BatchSize
+	}
+
+	client := cfg.HTTPClient
+	if client == nil {
+		client = &http.Client{
+			Timeout:   cfg.Timeout,
+			Transport: newWebhookTransport(cfg.AllowPrivateNetworks),
+			// A redirect counts as a failed delivery rather than sending the payload elsewhere
+			CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+	}
+
+	return &webhookService{
+		webhookRepo:  webhookRepo,
+		deliveryRepo: deliveryRepo,
+		authz:        authz,
+		client:       client,
+		logger:       slog.Default().With("component", "webhooks"),
+		wake:         make(chan struct{}, 1),
+		config:       cfg,
+	}
+}
+
+// newWebhookTransport returns the transport of the default delivery client. Unless private networks
+// are allowed, it refuses to connect to addresses inside the server's network, checking the address
+// actually dialed so that host names resolving there, now or after the webhook was saved, are caught.
+func newWebhookTransport(allowPrivateNetworks bool) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if allowPrivateNetworks {
+		return transport
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   refusePrivateAddresses,
+	}
+	transport.DialContext = dialer.DialContext
+	// Through a proxy, only the proxy's address would be checked
+	transport.Proxy = nil
+	return transport
+}
+
+// errWebhookAddressNotAllowed is the delivery error for endpoints inside the server's network
+var errWebhookAddressNotAllowed = errors.New("endpoint address is not public; private networks are not allowed")
+
+// refusePrivateAddresses is a dialer Control hook that refuses non-public addresses
+func refusePrivateAddresses(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil || !domain.IsPublicWebhookAddress(addr) {
+		return errWebhookAddressNotAllowed
+	}
+	return nil
+}
+
+// SignWebhookPayload returns the signature header value for a payload: "sha256=" followed by
+// the hex HMAC-SHA256 of the payload, the same scheme GitHub uses for its webhooks
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// CreateWebhook registers an endpoint for the project's events
+func (s *webhookService) CreateWebhook(
+	ctx context.Context, projectID string, req CreateWebhookRequest, userID string,
+) (*domain.Webhook, error) {
+	if _, err := s.managedProject(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		generated, err := generateWebhookSecret()
+		if err != nil {
+			return nil, domain.NewInternalError("SECRET_GENERATION_FAILED", "Failed to generate webhook secret", err)
+		}
+		secret = generated
+	}
+
+	now := time.Now().UTC()
+	webhook := &domain.Webhook{
+		ProjectID:   projectID,
+		URL:         req.URL,
+		Description: req.Description,
+		Secret:      secret,
+		EventTypes:  req.EventTypes,
+		CreatedBy:   userID,
+		Active:      true,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := webhook.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.webhookRepo.Create(ctx, webhook); err != nil {
+		return nil, domain.NewInternalError("WEBHOOK_CREATE_FAILED", "Failed to create webhook", err)
+	}
+
+	return webhook, nil
+}
+
+// ListWebhooks returns the project's webhooks
+func (s *webhookService) ListWebhooks(ctx context.Context, projectID string, userID string) ([]*domain.Webhook, error) {
+	if _, err := s.managedProject(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	webhooks, err := s.webhookRepo.ListByProject(ctx, projectID)
+	if err != nil {
+		return nil, domain.NewInternalError("WEBHOOK_LIST_FAILED", "Failed to list webhooks", err)
+	}
+	return webhooks, nil
+}
+
+// GetWebhook returns one of the project's webhooks
+func (s *webhookService) GetWebhook(
+	ctx context.Context, projectID, webhookID string, userID string,
+) (*domain.Webhook, error) {
+	if _, err := s.managedProject(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+	return s.projectWebhook(ctx, projectID, webhookID)
+}
+
+// UpdateWebhook changes a webhook
+func (s *webhookService) UpdateWebhook(
+	ctx context.Context, projectID, webhookID string, req UpdateWebhookRequest, userID string,
+) (*domain.Webhook, error) {
+	if _, err := s.managedProject(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	webhook, err := s.projectWebhook(ctx, projectID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if req.URL != nil {
+		webhook.URL = *req.URL
+	}
+	if req.Description != nil {
+		webhook.Description = *req.Description
+	}
+	if req.Secret != nil {
+		webhook.Secret = *req.Secret
+	}
+	if req.EventTypes != nil {
+		webhook.EventTypes = *req.EventTypes
+	}
+	if req.Active != nil && *req.Active != webhook.Active {
+		if *req.Active {
+			webhook.Enable(now)
+		} else {
+			webhook.Disable(now, "Disabled manually")
+		}
+	}
+	webhook.UpdatedAt = now
+
+	if err := webhook.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.webhookRepo.Update(ctx, webhook); err != nil {
+		return nil, domain.NewInternalError("WEBHOOK_UPDATE_FAILED", "Failed to update webhook", err)
+	}
+
+	return webhook, nil
+}
+
+// DeleteWebhook removes a webhook along with its delivery log
+func (s *webhookService) DeleteWebhook(ctx context.Context, projectID, webhookID string, userID string) error {
+	if _, err := s.managedProject(ctx, projectID, userID); err != nil {
+		return err
+	}
+
+	if _, err := s.projectWebhook(ctx, projectID, webhookID); err != nil {
+		return err
+	}
+
+	if err := s.webhookRepo.Delete(ctx, webhookID); err != nil {
+		return domain.NewInternalError("WEBHOOK_DELETE_FAILED", "Failed to delete webhook", err)
+	}
+	return nil
+}
+
+// ListDeliveries returns a webhook's most recent deliveries, newest first
+func (s *webhookService) ListDeliveries(
+	ctx context.Context, projectID, webhookID string, limit int, userID string,
+) ([]*domain.WebhookDelivery, error) {
+	if _, err := s.managedProject(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.projectWebhook(ctx, projectID, webhookID); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 || limit > MaxWebhookDeliveryLimit {
+		limit = DefaultWebhookDeliveryLimit
+	}
+
+	deliveries, err := s.deliveryRepo.ListByWebhook(ctx, webhookID, limit)
+	if err != nil {
+		return nil, domain.NewInternalError("WEBHOOK_DELIVERY_LIST_FAILED", "Failed to list webhook deliveries", err)
+	}
+	return deliveries, nil
+}
+
+// Redeliver queues the payload of an earlier delivery again as a new delivery
+func (s *webhookService) Redeliver(
+	ctx context.Context, projectID, webhookID, deliveryID string, userID string,
+) (*domain.WebhookDelivery, error) {
+	if _, err := s.managedProject(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	webhook, err := s.projectWebhook(ctx, projectID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if !webhook.Active {
+		return nil, domain.NewConflictError("WEBHOOK_DISABLED", "Enable the webhook before redelivering to it")
+	}
+
+	original, err := s.deliveryRepo.GetByID(ctx, deliveryID)
+	if err != nil || original.WebhookID != webhook.ID {
+		return nil, domain.NewNotFoundError("WEBHOOK_DELIVERY_NOT_FOUND", "Webhook delivery not found")
+	}
+
+	now := time.Now().UTC()
+	delivery := &domain.WebhookDelivery{
+		WebhookID:     webhook.ID,
+		ProjectID:     webhook.ProjectID,
+		EventID:       original.EventID,
+		EventType:     original.EventType,
+		Payload:       original.Payload,
+		RedeliveryOf:  original.ID,
+		Status:        domain.WebhookDeliveryPending,
+		MaxAttempts:   s.config.MaxAttempts,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := s.deliveryRepo.Create(ctx, delivery); err != nil {
+		return nil, domain.NewInternalError("WEBHOOK_DELIVERY_QUEUE_FAILED", "Failed to queue webhook delivery", err)
+	}
+
+	s.nudge()
+	return delivery, nil
+}
+
+// Enqueue queues a delivery of the event to every active webhook of its project that matches it
+func (s *webhookService) Enqueue(ctx context.Context, event *domain.TaskEvent) error {
+	if event == nil || event.ProjectID == "" {
+		return nil
+	}
+
+	webhooks, err := s.webhookRepo.ListActiveByProject(ctx, event.ProjectID)
+	if err != nil {
+		return domain.NewInternalError("WEBHOOK_LIST_FAILED", "Failed to list webhooks", err)
+	}
+
+	var payload []byte
+	queued := 0
+	for _, webhook := range webhooks {
+		if !webhook.Matches(event) {
+			continue
+		}
+
+		if payload == nil {
+			if payload, err = event.ToJSON(); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now().UTC()
+		delivery := &domain.WebhookDelivery{
+			WebhookID:     webhook.ID,
+			ProjectID:     webhook.ProjectID,
+			EventID:       event.EventID,
+			EventType:     event.Type,
+			Payload:       payload,
+			Status:        domain.WebhookDeliveryPending,
+			MaxAttempts:   s.config.MaxAttempts,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+		if err := s.deliveryRepo.Create(ctx, delivery); err != nil {
+			return domain.NewInternalError("WEBHOOK_DELIVERY_QUEUE_FAILED", "Failed to queue webhook delivery", err)
+		}
+		queued++
+	}
+
+	if queued > 0 {
+		s.nudge()
+	}
+	return nil
+}
+
+// DeliverDue attempts every delivery that is due and returns how many succeeded
+func (s *webhookService) DeliverDue(ctx context.Context) (int, error) {
+	s.deliverMu.Lock()
+	defer s.deliverMu.Unlock()
+
+	deliveries, err := s.deliveryRepo.ListDue(ctx, time.Now().UTC(), s.config.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list due webhook deliveries: %w", err)
+	}
+
+	// Webhooks are loaded once per run so failure counts accumulate across its deliveries
+	webhooks := make(map[string]*domain.Webhook)
+	succeeded := 0
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return succeeded, ctx.Err()
+		}
+
+		webhook, loaded := webhooks[delivery.WebhookID]
+		if !loaded {
+			webhook, err = s.webhookRepo.GetByID(ctx, delivery.WebhookID)
+			if err != nil && !repository.IsNotFound(err) {
+				s.logger.Error("Failed to load webhook for delivery", "delivery_id", delivery.ID, "error", err)
+				continue
+			}
+			webhooks[delivery.WebhookID] = webhook
+		}
+
+		if s.attempt(ctx, webhook, delivery) {
+			succeeded++
+		}
+	}
+
+	return succeeded, nil
+}
+
+// attempt sends one delivery and records the outcome on it and its webhook
+func (s *webhookService) attempt(ctx context.Context, webhook *domain.Webhook, delivery *domain.WebhookDelivery) bool {
+	now := time.Now().UTC()
+	if webhook == nil || !webhook.Active {
+		delivery.GiveUp("Webhook is disabled or was removed", now)
+		s.saveDelivery(ctx, delivery)
+		return false
+	}
+
+	start := time.Now()
+	responseStatus, sendErr := s.send(ctx, webhook, delivery)
+	duration := time.Since(start)
+	now = time.Now().UTC()
+
+	failuresBefore := webhook.ConsecutiveFailures
+	if sendErr == nil {
+		delivery.MarkSucceeded(responseStatus, duration, now)
+		webhook.RecordSuccess(now)
+	} else {
+		delivery.MarkAttemptFailed(responseStatus, sendErr.Error(), duration, now, s.config.RetryInterval)
+		if webhook.RecordFailure(now) {
+			s.logger.Warn("Disabled webhook after repeated delivery failures",
+				"webhook_id", webhook.ID,
+				"project_id", webhook.ProjectID,
+				"failures", webhook.ConsecutiveFailures)
+		}
+		s.logger.Warn("Webhook delivery failed",
+			"webhook_id", webhook.ID,
+			"delivery_id", delivery.ID,
+			"attempts", delivery.Attempts,
+			"status", delivery.Status,
+			"error", sendErr)
+	}
+
+	s.saveDelivery(ctx, delivery)
+	if webhook.ConsecutiveFailures != failuresBefore {
+		if err := s.webhookRepo.Update(ctx, webhook); err != nil {
+			s.logger.Error("Failed to record webhook failure count", "webhook_id", webhook.ID, "error", err)
+		}
+	}
+
+	return sendErr == nil
+}
+
+// send POSTs the signed payload and returns the response status; any non-2xx status is an error
+func (s *webhookService) send(
+	ctx context.Context, webhook *domain.Webhook, delivery *domain.WebhookDelivery,
+) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "SimpleEasyTasks-Webhooks/1.0")
+	req.Header.Set(WebhookEventHeader, string(delivery.EventType))
+	req.Header.Set(WebhookDeliveryHeader, delivery.ID)
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(webhook.Secret, delivery.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// Drain a bounded amount so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, webhookResponseReadLimit))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("endpoint responded with HTTP %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// saveDelivery persists a delivery's state; a failure leaves it due to be attempted again
+func (s *webhookService) saveDelivery(ctx context.Context, delivery *domain.WebhookDelivery) {
+	if err := s.deliveryRepo.Update(ctx, delivery); err != nil {
+		s.logger.Error("Failed to record webhook delivery", "delivery_id", delivery.ID, "error", err)
+	}
+}
+
+// StartDeliveryRoutine delivers due webhooks every interval until the context is done.
+// Finished deliveries older than the retention period are pruned hourly.
+func (s *webhookService) StartDeliveryRoutine(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		pruneTicker := time.NewTicker(time.Hour)
+		defer pruneTicker.Stop()
+
+		s.logger.Info("Started webhook delivery routine", "interval", interval)
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Stopping webhook delivery routine due to context cancellation")
+				return
+			case <-pruneTicker.C:
+				s.pruneDeliveries(ctx)
+				continue
+			case <-ticker.C:
+			case <-s.wake:
+			}
+
+			if _, err := s.DeliverDue(ctx); err != nil && ctx.Err() == nil {
+				s.logger.Error("Failed to deliver webhooks", "error", err)
+			}
+		}
+	}()
+}
+
+// pruneDeliveries trims the delivery log to the retention period
+func (s *webhookService) pruneDeliveries(ctx context.Context) {
+	removed, err := s.deliveryRepo.DeleteBefore(ctx, time.Now().UTC().Add(-s.config.Retention))
+	if err != nil {
+		s.logger.Error("Failed to prune webhook deliveries", "error", err)
+		return
+	}
+	if removed > 0 {
+		s.logger.Info("Pruned webhook delivery log", "removed", removed)
+	}
+}
+
+// nudge wakes the delivery routine so new deliveries don't wait for the next tick
+func (s *webhookService) nudge() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// managedProject loads a project whose integrations the user manages; webhooks and their
+// secrets are limited to them
+func (s *webhookService) managedProject(ctx context.Context, projectID, userID string) (*domain.Project, error) {
+	if projectID == "" {
+		return nil, domain.NewValidationError("INVALID_PROJECT_ID", "Project ID cannot be empty", nil)
+	}
+
+	return s.authz.Authorize(ctx, projectID, userID, domain.PermissionManageIntegrations)
+}
+
+// projectWebhook loads a webhook that belongs to the project
+func (s *webhookService) projectWebhook(ctx context.Context, projectID, webhookID string) (*domain.Webhook, error) {
+	webhook, err := s.webhookRepo.GetByID(ctx, webhookID)
+	if err != nil || webhook.ProjectID != projectID {
+		return nil, domain.NewNotFoundError("WEBHOOK_NOT_FOUND", "Webhook not found")
+	}
+	return webhook, nil
+}
+
+// generateWebhookSecret returns a random hex signing secret
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+// webhookEventBroadcaster queues webhook deliveries for every event it broadcasts
+type webhookEventBroadcaster struct {
+	EventBroadcaster
+	webhooks WebhookService
+	logger   *slog.Logger
+}
+
+// NewWebhookEventBroadcaster wraps a broadcaster so that every event broadcast on this
+// replica is also queued for the project's webhooks. Events relayed from other replicas
+// are not, so each event is queued once across the cluster.
+func NewWebhookEventBroadcaster(broadcaster EventBroadcaster, webhooks WebhookService) EventBroadcaster {
+	return &webhookEventBroadcaster{
+		EventBroadcaster: broadcaster,
+		webhooks:         webhooks,
+		logger:           slog.Default().With("component", "webhooks"),
+	}
+}
+
+// BroadcastEvent broadcasts the event and queues it for the project's webhooks
+func (b *webhookEventBroadcaster) BroadcastEvent(ctx context.Context, event *domain.TaskEvent) error {
+	if err := b.EventBroadcaster.BroadcastEvent(ctx, event); err != nil {
+		return err
+	}
+
+	// Webhooks are best-effort from the caller's point of view; the change already happened
+	if err := b.webhooks.Enqueue(ctx, event); err != nil {
+		b.logger.Error("Failed to queue webhook deliveries",
+			"error", err,
+			"event_id", event.EventID,
+			"project_id", event.ProjectID)
+	}
+	return nil
+}
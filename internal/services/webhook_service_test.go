@@ -0,0 +1,316 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+// webhookReceiver is an endpoint that records the requests it receives
+type webhookReceiver struct {
+	server   *httptest.Server
+	requests []receivedWebhook
+	status   int
+	mu       sync.Mutex
+}
+
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+}
+
+func newWebhookReceiver(t *testing.T) *webhookReceiver {
+	receiver := &webhookReceiver{status: http.StatusNoContent}
+	receiver.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		receiver.mu.Lock()
+		defer receiver.mu.Unlock()
+		receiver.requests = append(receiver.requests, receivedWebhook{header: r.Header.Clone(), body: body})
+		w.WriteHeader(receiver.status)
+	}))
+	t.Cleanup(receiver.server.Close)
+	return receiver
+}
+
+func (r *webhookReceiver) respondWith(status int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = status
+}
+
+func (r *webhookReceiver) received() []receivedWebhook {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedWebhook(nil), r.requests...)
+}
+
+type webhookTestEnv struct {
+	service    WebhookService
+	webhooks   *testutil.MockWebhookRepository
+	deliveries *testutil.MockWebhookDeliveryRepository
+	receiver   *webhookReceiver
+}
+
+// newWebhookTestEnv sets up a webhook service whose endpoints are on loopback, so cfg
+// must allow private networks unless a test expects deliveries to be refused
+func newWebhookTestEnv(t *testing.T, cfg WebhookConfig) *webhookTestEnv {
+	projectRepo := testutil.NewMockProjectRepository()
+	project := testutil.MockProject("project1", "Project", "project", "owner1")
+	project.MemberIDs = []string{"member1", "maintainer1"}
+	project.MemberRoles = map[string]domain.ProjectRole{"maintainer1": domain.ProjectMaintainer}
+	projectRepo.AddProject(project)
+
+	env := &webhookTestEnv{
+		webhooks:   testutil.NewMockWebhookRepository(),
+		deliveries: testutil.NewMockWebhookDeliveryRepository(),
+		receiver:   newWebhookReceiver(t),
+	}
+	env.service = NewWebhookService(env.webhooks, env.deliveries, NewAuthorizationService(projectRepo), cfg)
+	return env
+}
+
+// localWebhookConfig delivers to the test receiver
+var localWebhookConfig = WebhookConfig{
+	RetryInterval:        time.Minute,
+	MaxAttempts:          3,
+	AllowPrivateNetworks: true,
+}
+
+// dueNow makes every pending delivery due so retries can be driven without waiting
+func (e *webhookTestEnv) dueNow() {
+	for _, delivery := range e.deliveries.Deliveries {
+		delivery.NextAttemptAt = time.Now().UTC().Add(-time.Second)
+	}
+}
+
+func (e *webhookTestEnv) createWebhook(t *testing.T, eventTypes ...domain.TaskEventType) *domain.Webhook {
+	t.Helper()
+	webhook, err := e.service.CreateWebhook(context.Background(), "project1", CreateWebhookRequest{
+		URL:        e.receiver.server.URL + "/hooks",
+		EventTypes: eventTypes,
+	}, "owner1")
+	require.NoError(t, err)
+	return webhook
+}
+
+func newProjectEvent(t *testing.T, eventType domain.TaskEventType) *domain.TaskEvent {
+	t.Helper()
+	event, err := domain.NewTaskEvent(eventType, "task1", "project1", "owner1", map[string]string{"title": "Ship it"})
+	require.NoError(t, err)
+	return event
+}
+
+func TestWebhookService_Management(t *testing.T) {
+	ctx := context.Background()
+	env := newWebhookTestEnv(t, localWebhookConfig)
+
+	t.Run("GeneratesSecret", func(t *testing.T) {
+		webhook := env.createWebhook(t, domain.TaskMoved)
+		assert.Len(t, webhook.Secret, 64)
+		assert.True(t, webhook.Active)
+		assert.Equal(t, "owner1", webhook.CreatedBy)
+	})
+
+	t.Run("MaintainersAndOwnersOnly", func(t *testing.T) {
+		_, err := env.service.CreateWebhook(ctx, "project1", CreateWebhookRequest{URL: "https://example.com"}, "member1")
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+
+		_, err = env.service.ListWebhooks(ctx, "project1", "member1")
+		assert.Equal(t, domain.AuthorizationError, webhookErrorType(err))
+
+		_, err = env.service.ListWebhooks(ctx, "project1", "maintainer1")
+		assert.NoError(t, err)
+	})
+
+	t.Run("RejectsInvalidWebhook", func(t *testing.T) {
+		_, err := env.service.CreateWebhook(ctx, "project1", CreateWebhookRequest{
+			URL:        "https://example.com/hooks",
+			EventTypes: []domain.TaskEventType{"task.exploded"},
+		}, "owner1")
+		assert.Equal(t, domain.ValidationError, webhookErrorType(err))
+	})
+
+	t.Run("ReenablingClearsFailures", func(t *testing.T) {
+		webhook := env.createWebhook(t)
+		inactive, active := false, true
+
+		updated, err := env.service.UpdateWebhook(
+			ctx, "project1", webhook.ID, UpdateWebhookRequest{Active: &inactive}, "owner1")
+		require.NoError(t, err)
+		assert.False(t, updated.Active)
+		assert.NotNil(t, updated.DisabledAt)
+
+		updated, err = env.service.UpdateWebhook(ctx, "project1", webhook.ID, UpdateWebhookRequest{Active: &active}, "owner1")
+		require.NoError(t, err)
+		assert.True(t, updated.Active)
+		assert.Nil(t, updated.DisabledAt)
+	})
+
+	t.Run("WebhookOfAnotherProject", func(t *testing.T) {
+		webhook := env.createWebhook(t)
+		err := env.service.DeleteWebhook(ctx, "project2", webhook.ID, "owner1")
+		assert.Error(t, err)
+
+		require.NoError(t, env.service.DeleteWebhook(ctx, "project1", webhook.ID, "owner1"))
+		_, err = env.service.GetWebhook(ctx, "project1", webhook.ID, "owner1")
+		assert.Equal(t, domain.NotFoundError, webhookErrorType(err))
+	})
+}
+
+func TestWebhookService_DeliversSignedEvents(t *testing.T) {
+	ctx := context.Background()
+	env := newWebhookTestEnv(t, localWebhookConfig)
+	webhook := env.createWebhook(t, domain.TaskMoved)
+
+	require.NoError(t, env.service.Enqueue(ctx, newProjectEvent(t, domain.TaskCommented)))
+	assert.Empty(t, env.deliveries.Deliveries, "unsubscribed event types are not queued")
+
+	event := newProjectEvent(t, domain.TaskMoved)
+	require.NoError(t, env.service.Enqueue(ctx, event))
+	require.Len(t, env.deliveries.Deliveries, 1)
+
+	sent, err := env.service.DeliverDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+
+	requests := env.receiver.received()
+	require.Len(t, requests, 1)
+	request := requests[0]
+	assert.Equal(t, SignWebhookPayload(webhook.Secret, request.body), request.header.Get(WebhookSignatureHeader))
+	assert.Equal(t, string(domain.TaskMoved), request.header.Get(WebhookEventHeader))
+	assert.Equal(t, "application/json", request.header.Get("Content-Type"))
+	assert.Contains(t, string(request.body), event.EventID)
+
+	deliveries, err := env.service.ListDeliveries(ctx, "project1", webhook.ID, 0, "owner1")
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, domain.WebhookDeliverySucceeded, deliveries[0].Status)
+	assert.Equal(t, http.StatusNoContent, deliveries[0].ResponseStatus)
+	assert.Equal(t, deliveries[0].ID, request.header.Get(WebhookDeliveryHeader))
+
+	t.Run("Redeliver", func(t *testing.T) {
+		redelivery, err := env.service.Redeliver(ctx, "project1", webhook.ID, deliveries[0].ID, "owner1")
+		require.NoError(t, err)
+		assert.Equal(t, deliveries[0].ID, redelivery.RedeliveryOf)
+		assert.NotEqual(t, deliveries[0].ID, redelivery.ID)
+
+		_, err = env.service.DeliverDue(ctx)
+		require.NoError(t, err)
+		requests := env.receiver.received()
+		require.Len(t, requests, 2)
+		assert.Equal(t, requests[0].body, requests[1].body, "the original payload is sent again")
+		assert.Equal(t, redelivery.ID, requests[1].header.Get(WebhookDeliveryHeader))
+	})
+}
+
+func TestWebhookService_RetriesWithBackoff(t *testing.T) {
+	ctx := context.Background()
+	env := newWebhookTestEnv(t, localWebhookConfig)
+	webhook := env.createWebhook(t)
+	env.receiver.respondWith(http.StatusInternalServerError)
+
+	require.NoError(t, env.service.Enqueue(ctx, newProjectEvent(t, domain.TaskUpdated)))
+
+	sent, err := env.service.DeliverDue(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, sent)
+
+	delivery := env.deliveries.Deliveries["delivery-001"]
+	assert.Equal(t, domain.WebhookDeliveryPending, delivery.Status)
+	assert.Equal(t, http.StatusInternalServerError, delivery.ResponseStatus)
+	assert.Contains(t, delivery.LastError, "HTTP 500")
+	assert.True(t, delivery.NextAttemptAt.After(time.Now()), "the retry waits for the backoff")
+
+	sent, err = env.service.DeliverDue(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, sent)
+	assert.Len(t, env.receiver.received(), 1, "nothing is sent before the retry is due")
+
+	for i := 0; i < 2; i++ {
+		env.dueNow()
+		_, err = env.service.DeliverDue(ctx)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, domain.WebhookDeliveryFailed, env.deliveries.Deliveries["delivery-001"].Status)
+	assert.Equal(t, 3, env.webhooks.Webhooks[webhook.ID].ConsecutiveFailures)
+
+	env.receiver.respondWith(http.StatusOK)
+	require.NoError(t, env.service.Enqueue(ctx, newProjectEvent(t, domain.TaskUpdated)))
+	_, err = env.service.DeliverDue(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, env.webhooks.Webhooks[webhook.ID].ConsecutiveFailures, "a success resets the failure count")
+}
+
+func TestWebhookService_DisablesFailingWebhook(t *testing.T) {
+	ctx := context.Background()
+	env := newWebhookTestEnv(t, localWebhookConfig)
+	webhook := env.createWebhook(t)
+	env.webhooks.Webhooks[webhook.ID].ConsecutiveFailures = domain.WebhookDisableThreshold - 1
+	env.receiver.respondWith(http.StatusGone)
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, env.service.Enqueue(ctx, newProjectEvent(t, domain.TaskUpdated)))
+	}
+	_, err := env.service.DeliverDue(ctx)
+	require.NoError(t, err)
+
+	stored := env.webhooks.Webhooks[webhook.ID]
+	assert.False(t, stored.Active)
+	assert.NotEmpty(t, stored.DisabledReason)
+	assert.Len(t, env.receiver.received(), 1, "deliveries stop once the webhook is disabled")
+	assert.Equal(t, domain.WebhookDeliveryFailed, env.deliveries.Deliveries["delivery-002"].Status)
+
+	require.NoError(t, env.service.Enqueue(ctx, newProjectEvent(t, domain.TaskUpdated)))
+	assert.Len(t, env.deliveries.Deliveries, 2, "disabled webhooks receive no new deliveries")
+
+	_, err = env.service.Redeliver(ctx, "project1", webhook.ID, "delivery-001", "owner1")
+	assert.Equal(t, domain.ConflictError, webhookErrorType(err))
+}
+
+func TestWebhookService_RefusesPrivateAddresses(t *testing.T) {
+	ctx := context.Background()
+	env := newWebhookTestEnv(t, WebhookConfig{RetryInterval: time.Minute, MaxAttempts: 3})
+	env.createWebhook(t)
+
+	require.NoError(t, env.service.Enqueue(ctx, newProjectEvent(t, domain.TaskUpdated)))
+
+	sent, err := env.service.DeliverDue(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, sent)
+	assert.Empty(t, env.receiver.received())
+	assert.Contains(t, env.deliveries.Deliveries["delivery-001"].LastError, "private networks are not allowed")
+}
+
+func TestWebhookEventBroadcaster_QueuesBroadcastEvents(t *testing.T) {
+	ctx := context.Background()
+	env := newWebhookTestEnv(t, localWebhookConfig)
+	env.createWebhook(t)
+
+	broadcaster := NewWebhookEventBroadcaster(NewEventBroadcaster(nil, EventBroadcasterConfig{}), env.service)
+	require.NoError(t, broadcaster.BroadcastEvent(ctx, newProjectEvent(t, domain.TaskCreated)))
+	assert.Len(t, env.deliveries.Deliveries, 1)
+
+	assert.Error(t, broadcaster.BroadcastEvent(ctx, &domain.TaskEvent{}))
+	assert.Len(t, env.deliveries.Deliveries, 1, "rejected events are not queued")
+}
+
+// webhookErrorType returns the domain error type of err, or "" for other errors
+func webhookErrorType(err error) domain.ErrorType {
+	var domainErr *domain.Error
+	if errors.As(err, &domainErr) {
+		return domainErr.Type
+	}
+	return ""
+}
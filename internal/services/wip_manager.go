@@ -2,7 +2,9 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
 	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
@@ -10,12 +12,16 @@ import (
 
 // WIPManager handles Work-in-Progress limit enforcement
 type WIPManager interface {
-	// ValidateWIPLimit checks if moving a task would violate WIP limits
+	// ValidateWIPLimit checks if moving a task would violate a hard WIP limit.
+	// Soft limits never block a move; they are reported by GetWIPStatus and CheckWIPViolations.
 	ValidateWIPLimit(ctx context.Context, projectID string, targetStatus domain.TaskStatus) error
 
 	// GetWIPLimits retrieves WIP limits for a project column
 	GetWIPLimits(ctx context.Context, projectID string, status domain.TaskStatus) (*WIPLimits, error)
 
+	// GetProjectWIPLimits retrieves WIP limits for every column of a project
+	GetProjectWIPLimits(ctx context.Context, projectID string) (map[domain.TaskStatus]*WIPLimits, error)
+
 	// SetWIPLimits configures WIP limits for a project column
 	SetWIPLimits(ctx context.Context, projectID string, status domain.TaskStatus, limits WIPLimits) error
 
@@ -24,6 +30,14 @@ type WIPManager interface {
 
 	// CheckWIPViolations identifies columns that are violating WIP limits
 	CheckWIPViolations(ctx context.Context, projectID string) ([]*WIPViolation, error)
+
+	// RecordOverride stores who moved a task past a hard WIP limit and why
+	RecordOverride(
+		ctx context.Context, projectID string, taskID string, status domain.TaskStatus, override WIPOverride,
+	) error
+
+	// ListOverrides retrieves recorded WIP overrides for a project, newest first
+	ListOverrides(ctx context.Context, projectID string, offset, limit int) ([]*domain.WIPLimitOverride, error)
 }
 
 // WIPStatus represents the current WIP status for a column
@@ -55,9 +69,23 @@ const (
 	OverrideHotfix WIPOverrideReason = "hotfix"
 	// OverrideBlocker allows bypassing WIP limits for blocking issues
 	OverrideBlocker WIPOverrideReason = "blocker"
-	// OverrideManagement allows bypassing WIP limits for management requests\n	OverrideManagement WIPOverrideReason = "management"
+	// OverrideManagement allows bypassing WIP limits for management requests
+	OverrideManagement WIPOverrideReason = "management"
 )
 
+// wipHardLimitViolatedCode is the error code returned when a move would exceed a hard limit
+const wipHardLimitViolatedCode = "WIP_HARD_LIMIT_VIOLATED"
+
+// IsValid checks if the override reason is one of the allowed values
+func (r WIPOverrideReason) IsValid() bool {
+	switch r {
+	case OverrideEmergency, OverrideHotfix, OverrideBlocker, OverrideManagement:
+		return true
+	default:
+		return false
+	}
+}
+
 // WIPOverride represents a WIP limit override request
 type WIPOverride struct {
 	Reason    WIPOverrideReason `json:"reason"`
@@ -66,22 +94,71 @@ type WIPOverride struct {
 	ExpiresAt *string           `json:"expires_at,omitempty"`
 }
 
+// Validate checks that the override has a known reason and has not expired
+func (o *WIPOverride) Validate() error {
+	if !o.Reason.IsValid() {
+		return domain.NewValidationError("INVALID_OVERRIDE_REASON",
+			"WIP override reason must be one of: emergency, hotfix, blocker, management", nil)
+	}
+	if o.ExpiresAt != nil && *o.ExpiresAt != "" {
+		expiresAt, err := time.Parse(time.RFC3339, *o.ExpiresAt)
+		if err != nil {
+			return domain.NewValidationError("INVALID_OVERRIDE_EXPIRY", "WIP override expiry must be an RFC 3339 timestamp", nil)
+		}
+		if expiresAt.Before(time.Now()) {
+			return domain.NewValidationError("WIP_OVERRIDE_EXPIRED", "WIP override has expired", nil)
+		}
+	}
+	return nil
+}
+
+// isWIPHardLimitViolation reports whether err is the hard limit rejection from ValidateWIPLimit
+func isWIPHardLimitViolation(err error) bool {
+	var domainErr *domain.Error
+	return errors.As(err, &domainErr) && domainErr.Code == wipHardLimitViolatedCode
+}
+
+// checkWIPMove validates a column change against the target column's hard WIP limit.
+// It reports whether the move only passes because of a valid override; moves within
+// a column never change its count and are always allowed.
+func checkWIPMove(
+	ctx context.Context, wm WIPManager, projectID string,
+	from, to domain.TaskStatus, override *WIPOverride,
+) (bool, error) {
+	if wm == nil || from == to {
+		return false, nil
+	}
+
+	if err := wm.ValidateWIPLimit(ctx, projectID, to); err != nil {
+		if override == nil || !isWIPHardLimitViolation(err) {
+			return false, err
+		}
+		if overrideErr := override.Validate(); overrideErr != nil {
+			return false, overrideErr
+		}
+		return true, nil
+	}
+
+	return false, nil
+}
+
 // wipManager implements WIP limit management
 type wipManager struct {
-	taskRepo    repository.TaskRepository
-	projectRepo repository.ProjectRepository
-	// In a real implementation, you'd have a WIP configuration repository
-	// wipConfigRepo repository.WIPConfigRepository
+	taskRepo     repository.TaskRepository
+	projectRepo  repository.ProjectRepository
+	wipLimitRepo repository.WIPLimitRepository
 }
 
 // NewWIPManager creates a new WIP manager
 func NewWIPManager(
 	taskRepo repository.TaskRepository,
 	projectRepo repository.ProjectRepository,
+	wipLimitRepo repository.WIPLimitRepository,
 ) WIPManager {
 	return &wipManager{
-		taskRepo:    taskRepo,
-		projectRepo: projectRepo,
+		taskRepo:     taskRepo,
+		projectRepo:  projectRepo,
+		wipLimitRepo: wipLimitRepo,
 	}
 }
 
@@ -108,25 +185,20 @@ func (wm *wipManager) ValidateWIPLimit(ctx context.Context, projectID string, ta
 		return err
 	}
 
-	// Check hard limit violation
+	// Check hard limit violation; soft limits are advisory and only reported
 	if limits.HardLimit > 0 && currentCount >= limits.HardLimit {
-		return domain.NewConflictError("WIP_HARD_LIMIT_VIOLATED",
-			fmt.Sprintf("Moving task would violate hard WIP limit (%d) for %s column",
-				limits.HardLimit, string(targetStatus)))
-	}
-
-	// Check soft limit violation (warning)
-	if limits.SoftLimit > 0 && currentCount >= limits.SoftLimit {
-		// Soft limit violation - could be allowed with warning or override
-		return domain.NewValidationError("WIP_SOFT_LIMIT_VIOLATED",
-			fmt.Sprintf("Moving task would exceed soft WIP limit (%d) for %s column",
-				limits.SoftLimit, string(targetStatus)),
-			map[string]interface{}{
+		return &domain.Error{
+			Type: domain.ConflictError,
+			Code: wipHardLimitViolatedCode,
+			Message: fmt.Sprintf("Moving task would violate hard WIP limit (%d) for %s column",
+				limits.HardLimit, string(targetStatus)),
+			Details: map[string]interface{}{
 				"current_count":      currentCount,
-				"soft_limit":         limits.SoftLimit,
+				"hard_limit":         limits.HardLimit,
 				"column":             string(targetStatus),
 				"override_available": true,
-			})
+			},
+		}
 	}
 
 	return nil
@@ -146,33 +218,87 @@ func (wm *wipManager) GetWIPLimits(
 		return nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
 	}
 
-	// TODO: In a real implementation, retrieve from WIP configuration storage
-	// For now, return default limits based on column type
-	limits := wm.getDefaultWIPLimits(status)
+	stored, err := wm.wipLimitRepo.GetByProjectAndStatus(ctx, projectID, status)
+	if err != nil {
+		if repository.IsNotFound(err) {
+			return wm.getDefaultWIPLimits(status), nil
+		}
+		return nil, domain.NewInternalError("WIP_LIMITS_LOAD_FAILED", "Failed to load WIP limits", err)
+	}
+
+	return limitsFromDomain(stored), nil
+}
+
+// GetProjectWIPLimits retrieves WIP limits for every column of a project's workflow,
+// falling back to disabled defaults for columns that have not been configured
+func (wm *wipManager) GetProjectWIPLimits(
+	ctx context.Context, projectID string,
+) (map[domain.TaskStatus]*WIPLimits, error) {
+	if projectID == "" {
+		return nil, domain.NewValidationError("INVALID_PROJECT_ID", "Project ID cannot be empty", nil)
+	}
+
+	project, err := wm.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
+	}
+	workflow := project.Workflow()
+
+	stored, err := wm.wipLimitRepo.ListByProject(ctx, projectID)
+	if err != nil {
+		return nil, domain.NewInternalError("WIP_LIMITS_LOAD_FAILED", "Failed to load WIP limits", err)
+	}
+
+	limits := make(map[domain.TaskStatus]*WIPLimits)
+	for _, status := range workflow.StatusIDs() {
+		limits[status] = wm.getDefaultWIPLimits(status)
+	}
+	for _, limit := range stored {
+		// Limits of statuses the workflow has since dropped no longer apply
+		if workflow.HasStatus(limit.Status) {
+			limits[limit.Status] = limitsFromDomain(limit)
+		}
+	}
 
 	return limits, nil
 }
 
 // SetWIPLimits configures WIP limits for a project column
-func (wm *wipManager) SetWIPLimits(ctx context.Context, projectID string, _ domain.TaskStatus, limits WIPLimits) error {
+func (wm *wipManager) SetWIPLimits(
+	ctx context.Context, projectID string, status domain.TaskStatus, limits WIPLimits,
+) error {
 	if projectID == "" {
 		return domain.NewValidationError("INVALID_PROJECT_ID", "Project ID cannot be empty", nil)
 	}
 
 	// Validate project exists
-	_, err := wm.projectRepo.GetByID(ctx, projectID)
+	project, err := wm.projectRepo.GetByID(ctx, projectID)
 	if err != nil {
 		return domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
 	}
 
+	if !status.IsValid() {
+		return domain.NewValidationError("INVALID_STATUS", "Invalid task status", nil)
+	}
+	if err := project.Workflow().ValidateStatus(status); err != nil {
+		return err
+	}
+
 	// Validate limits
 	if err := wm.validateWIPLimits(limits); err != nil {
 		return err
 	}
 
-	// TODO: In a real implementation, store in WIP configuration storage
-	// For now, this is a placeholder
-	// return wm.wipConfigRepo.SetLimits(ctx, projectID, status, limits)
+	limit := &domain.WIPLimit{
+		ProjectID: projectID,
+		Status:    status,
+		SoftLimit: limits.SoftLimit,
+		HardLimit: limits.HardLimit,
+		Enabled:   limits.Enabled,
+	}
+	if err := wm.wipLimitRepo.Upsert(ctx, limit); err != nil {
+		return domain.NewInternalError("WIP_LIMITS_SAVE_FAILED", "Failed to save WIP limits", err)
+	}
 
 	return nil
 }
@@ -184,53 +310,33 @@ func (wm *wipManager) GetWIPStatus(ctx context.Context, projectID string) (map[d
 	}
 
 	// Validate project exists
-	_, err := wm.projectRepo.GetByID(ctx, projectID)
+	project, err := wm.projectRepo.GetByID(ctx, projectID)
 	if err != nil {
 		return nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
 	}
 
-	statuses := []domain.TaskStatus{
-		domain.StatusBacklog,
-		domain.StatusTodo,
-		domain.StatusDeveloping,
-		domain.StatusReview,
-		domain.StatusComplete,
+	projectLimits, err := wm.GetProjectWIPLimits(ctx, projectID)
+	if err != nil {
+		return nil, err
 	}
 
 	wipStatus := make(map[domain.TaskStatus]*WIPStatus)
 
-	for _, status := range statuses {
+	for _, status := range project.Workflow().StatusIDs() {
 		// Get current task count
 		currentCount, err := wm.getColumnTaskCount(ctx, projectID, status)
 		if err != nil {
 			return nil, err
 		}
 
-		// Get WIP limits
-		limits, err := wm.GetWIPLimits(ctx, projectID, status)
-		if err != nil {
-			return nil, err
-		}
-
-		// Determine violation status
-		isViolating := false
-		violationType := ""
-
-		if limits.Enabled {
-			if limits.HardLimit > 0 && currentCount >= limits.HardLimit {
-				isViolating = true
-				violationType = "hard"
-			} else if limits.SoftLimit > 0 && currentCount >= limits.SoftLimit {
-				isViolating = true
-				violationType = "soft"
-			}
-		}
+		limits := projectLimits[status]
+		violationType := wipViolationType(currentCount, limits)
 
 		wipStatus[status] = &WIPStatus{
 			Status:        status,
 			CurrentCount:  currentCount,
 			Limits:        limits,
-			IsViolating:   isViolating,
+			IsViolating:   violationType != "",
 			ViolationType: violationType,
 		}
 	}
@@ -248,23 +354,7 @@ func (wm *wipManager) CheckWIPViolations(ctx context.Context, projectID string)
 	var violations []*WIPViolation
 
 	for status, statusInfo := range wipStatus {
-		if statusInfo.IsViolating {
-			violation := &WIPViolation{
-				ProjectID:     projectID,
-				Status:        status,
-				CurrentCount:  statusInfo.CurrentCount,
-				ViolationType: statusInfo.ViolationType,
-			}
-
-			// Set limit and severity based on violation type
-			if statusInfo.ViolationType == "hard" {
-				violation.Limit = statusInfo.Limits.HardLimit
-				violation.Severity = "error"
-			} else {
-				violation.Limit = statusInfo.Limits.SoftLimit
-				violation.Severity = "warning"
-			}
-
+		if violation := newWIPViolation(projectID, status, statusInfo.CurrentCount, statusInfo.Limits); violation != nil {
 			violations = append(violations, violation)
 		}
 	}
@@ -272,6 +362,64 @@ func (wm *wipManager) CheckWIPViolations(ctx context.Context, projectID string)
 	return violations, nil
 }
 
+// RecordOverride stores who moved a task past a hard WIP limit and why
+func (wm *wipManager) RecordOverride(
+	ctx context.Context, projectID string, taskID string, status domain.TaskStatus, override WIPOverride,
+) error {
+	if err := override.Validate(); err != nil {
+		return err
+	}
+
+	limits, err := wm.GetWIPLimits(ctx, projectID, status)
+	if err != nil {
+		return err
+	}
+
+	currentCount, err := wm.getColumnTaskCount(ctx, projectID, status)
+	if err != nil {
+		return err
+	}
+
+	record := &domain.WIPLimitOverride{
+		ProjectID:   projectID,
+		TaskID:      taskID,
+		UserID:      override.UserID,
+		Status:      status,
+		Reason:      string(override.Reason),
+		Comment:     override.Comment,
+		HardLimit:   limits.HardLimit,
+		ColumnCount: currentCount,
+	}
+	if err := wm.wipLimitRepo.RecordOverride(ctx, record); err != nil {
+		return domain.NewInternalError("WIP_OVERRIDE_RECORD_FAILED", "Failed to record WIP override", err)
+	}
+
+	return nil
+}
+
+// ListOverrides retrieves recorded WIP overrides for a project, newest first
+func (wm *wipManager) ListOverrides(
+	ctx context.Context, projectID string, offset, limit int,
+) ([]*domain.WIPLimitOverride, error) {
+	if projectID == "" {
+		return nil, domain.NewValidationError("INVALID_PROJECT_ID", "Project ID cannot be empty", nil)
+	}
+
+	if offset < 0 {
+		offset = 0
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+
+	overrides, err := wm.wipLimitRepo.ListOverrides(ctx, projectID, offset, limit)
+	if err != nil {
+		return nil, domain.NewInternalError("WIP_OVERRIDES_LOAD_FAILED", "Failed to load WIP overrides", err)
+	}
+
+	return overrides, nil
+}
+
 // Helper methods
 
 // getColumnTaskCount counts non-archived tasks in a specific column
@@ -297,34 +445,15 @@ func (wm *wipManager) getColumnTaskCount(ctx context.Context, projectID string,
 	return count, nil
 }
 
-// getDefaultWIPLimits returns sensible default WIP limits based on column type
+// getDefaultWIPLimits returns the suggested WIP limits for a column that has no stored limits.
+// Suggestions are never enabled: limits are only enforced once an owner configures them.
 func (wm *wipManager) getDefaultWIPLimits(status domain.TaskStatus) *WIPLimits {
 	defaults := map[domain.TaskStatus]*WIPLimits{
-		domain.StatusBacklog: {
-			SoftLimit: 50,
-			HardLimit: 100,
-			Enabled:   false, // Backlog typically doesn't have WIP limits
-		},
-		domain.StatusTodo: {
-			SoftLimit: 10,
-			HardLimit: 15,
-			Enabled:   false,
-		},
-		domain.StatusDeveloping: {
-			SoftLimit: 5,
-			HardLimit: 8,
-			Enabled:   true, // Development typically has WIP limits
-		},
-		domain.StatusReview: {
-			SoftLimit: 3,
-			HardLimit: 5,
-			Enabled:   true,
-		},
-		domain.StatusComplete: {
-			SoftLimit: 0,
-			HardLimit: 0,
-			Enabled:   false, // Complete column doesn't need limits
-		},
+		domain.StatusBacklog:    {SoftLimit: 50, HardLimit: 100},
+		domain.StatusTodo:       {SoftLimit: 10, HardLimit: 15},
+		domain.StatusDeveloping: {SoftLimit: 5, HardLimit: 8},
+		domain.StatusReview:     {SoftLimit: 3, HardLimit: 5},
+		domain.StatusComplete:   {SoftLimit: 0, HardLimit: 0},
 	}
 
 	if limits, exists := defaults[status]; exists {
@@ -339,6 +468,55 @@ func (wm *wipManager) getDefaultWIPLimits(status domain.TaskStatus) *WIPLimits {
 	}
 }
 
+// limitsFromDomain converts a stored WIP limit into the service representation
+func limitsFromDomain(limit *domain.WIPLimit) *WIPLimits {
+	return &WIPLimits{
+		SoftLimit: limit.SoftLimit,
+		HardLimit: limit.HardLimit,
+		Enabled:   limit.Enabled,
+	}
+}
+
+// wipViolationType returns "hard", "soft" or "" for a column with the given task count
+func wipViolationType(count int, limits *WIPLimits) string {
+	if limits == nil || !limits.Enabled {
+		return ""
+	}
+	if limits.HardLimit > 0 && count >= limits.HardLimit {
+		return "hard"
+	}
+	if limits.SoftLimit > 0 && count >= limits.SoftLimit {
+		return "soft"
+	}
+	return ""
+}
+
+// newWIPViolation describes a column's limit violation, or returns nil when the column is within limits
+func newWIPViolation(projectID string, status domain.TaskStatus, count int, limits *WIPLimits) *WIPViolation {
+	violationType := wipViolationType(count, limits)
+	if violationType == "" {
+		return nil
+	}
+
+	violation := &WIPViolation{
+		ProjectID:     projectID,
+		Status:        status,
+		CurrentCount:  count,
+		ViolationType: violationType,
+	}
+
+	// Set limit and severity based on violation type
+	if violationType == "hard" {
+		violation.Limit = limits.HardLimit
+		violation.Severity = "error"
+	} else {
+		violation.Limit = limits.SoftLimit
+		violation.Severity = "warning"
+	}
+
+	return violation
+}
+
 // validateWIPLimits validates WIP limit configuration
 func (wm *wipManager) validateWIPLimits(limits WIPLimits) error {
 	if limits.Enabled {
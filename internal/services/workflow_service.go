@@ -0,0 +1,188 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
+)
+
+// maxRemappedTasks caps how many tasks of one status a workflow change moves, matching the board
+const maxRemappedTasks = 1000
+
+// WorkflowService manages the status workflow of each project
+type WorkflowService interface {
+	// GetWorkflow returns the project's workflow, or the default one when it has not configured its own
+	GetWorkflow(ctx context.Context, projectID string, userID string) (*domain.Workflow, error)
+
+	// UpdateWorkflow replaces the project's workflow, moving tasks off the statuses it drops
+	UpdateWorkflow(
+		ctx context.Context, projectID string, req UpdateWorkflowRequest, userID string,
+	) (*domain.Workflow, error)
+}
+
+// UpdateWorkflowRequest represents a workflow change.
+// StatusMapping names the new status for tasks in each status the new workflow drops;
+// the change is refused while a dropped status still has unmapped tasks.
+type UpdateWorkflowRequest struct {
+	StatusMapping map[domain.TaskStatus]domain.TaskStatus `json:"status_mapping,omitempty"`
+	Workflow      domain.Workflow                         `json:"workflow" binding:"required"`
+}
+
+type workflowService struct {
+	taskRepo    repository.TaskRepository
+	projectRepo repository.ProjectRepository
+	authz       AuthorizationService
+	cache       CacheManager
+}
+
+// NewWorkflowService creates a new workflow service
+func NewWorkflowService(
+	taskRepo repository.TaskRepository,
+	projectRepo repository.ProjectRepository,
+	authz AuthorizationService,
+	cache CacheManager,
+) WorkflowService {
+	return &workflowService{
+		taskRepo:    taskRepo,
+		projectRepo: projectRepo,
+		authz:       authz,
+		cache:       cache,
+	}
+}
+
+// GetWorkflow returns the project's workflow, or the default one when it has not configured its own
+func (s *workflowService) GetWorkflow(
+	ctx context.Context, projectID string, userID string,
+) (*domain.Workflow, error) {
+	if projectID == "" {
+		return nil, domain.NewValidationError("INVALID_PROJECT_ID", "Project ID cannot be empty", nil)
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, domain.NewNotFoundError("PROJECT_NOT_FOUND", "Project not found")
+	}
+
+	if !project.HasAccess(userID) && project.Settings.IsPrivate {
+		return nil, domain.NewAuthorizationError("ACCESS_DENIED", "You don't have access to this project")
+	}
+
+	return project.Workflow(), nil
+}
+
+// UpdateWorkflow replaces the project's workflow. Only project owners may change it.
+// Tasks in statuses the new workflow drops are moved as the status mapping says; the
+// mapping is applied directly, without checking transitions, like a migration would.
+func (s *workflowService) UpdateWorkflow(
+	ctx context.Context, projectID string, req UpdateWorkflowRequest, userID string,
+) (*domain.Workflow, error) {
+	if projectID == "" {
+		return nil, domain.NewValidationError("INVALID_PROJECT_ID", "Project ID cannot be empty", nil)
+	}
+
+	project, err := s.authz.Authorize(ctx, projectID, userID, domain.PermissionManageProject)
+	if err != nil {
+		return nil, err
+	}
+
+	workflow := req.Workflow
+	if err := workflow.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Find the tasks stranded by the change before touching anything
+	remapped := make(map[domain.TaskStatus][]*domain.Task)
+	for _, status := range project.Workflow().StatusIDs() {
+		if workflow.HasStatus(status) {
+			continue
+		}
+
+		tasks, err := s.taskRepo.GetByProject(ctx, projectID, repository.TaskFilters{
+			Status: []domain.TaskStatus{status},
+			Limit:  maxRemappedTasks,
+		})
+		if err != nil {
+			return nil, domain.NewInternalError("TASK_LIST_FAILED", "Failed to load project tasks", err)
+		}
+		if len(tasks) == 0 {
+			continue
+		}
+
+		target, mapped := req.StatusMapping[status]
+		if !mapped {
+			conflictErr := domain.NewConflictError("WORKFLOW_STATUS_IN_USE",
+				"Tasks still use status "+string(status)+"; map it to a status of the new workflow")
+			conflictErr.Details = map[string]interface{}{
+				"status": string(status),
+				"tasks":  len(tasks),
+			}
+			return nil, conflictErr
+		}
+		if err := workflow.ValidateStatus(target); err != nil {
+			return nil, err
+		}
+		remapped[target] = append(remapped[target], tasks...)
+	}
+
+	for target, tasks := range remapped {
+		for _, task := range tasks {
+			task.Status = target
+			if err := s.taskRepo.Update(ctx, task); err != nil {
+				return nil, domain.NewInternalError("TASK_UPDATE_FAILED", "Failed to move task to the new workflow", err)
+			}
+		}
+	}
+
+	project.Settings.Workflow = &workflow
+	if err := s.projectRepo.Update(ctx, project); err != nil {
+		return nil, domain.NewInternalError("PROJECT_UPDATE_FAILED", "Failed to update project", err)
+	}
+
+	// Board columns come from the workflow, so the cached board is now stale
+	if err := s.cache.InvalidateBoardState(ctx, projectID); err != nil {
+		slog.Warn("Failed to invalidate board cache", "project_id", projectID, "error", err)
+	}
+
+	return &workflow, nil
+}
+
+// workflowLookup resolves the workflows of the projects a request touches, loading each
+// project at most once. Projects that can't be loaded fall back to the default workflow,
+// so a missing project never hides the state of the tasks that refer to it.
+type workflowLookup struct {
+	projectRepo repository.ProjectRepository
+	workflows   map[string]*domain.Workflow
+}
+
+// newWorkflowLookup creates a lookup, seeded with projects the caller already loaded
+func newWorkflowLookup(projectRepo repository.ProjectRepository, projects ...*domain.Project) *workflowLookup {
+	lookup := &workflowLookup{
+		projectRepo: projectRepo,
+		workflows:   make(map[string]*domain.Workflow, len(projects)),
+	}
+	for _, project := range projects {
+		lookup.workflows[project.ID] = project.Workflow()
+	}
+	return lookup
+}
+
+// forProject returns the workflow of a project
+func (l *workflowLookup) forProject(ctx context.Context, projectID string) *domain.Workflow {
+	if workflow, ok := l.workflows[projectID]; ok {
+		return workflow
+	}
+
+	workflow := domain.DefaultWorkflow()
+	if project, err := l.projectRepo.GetByID(ctx, projectID); err == nil {
+		workflow = project.Workflow()
+	}
+	l.workflows[projectID] = workflow
+	return workflow
+}
+
+// isDone reports whether the task is in a done status of its project's workflow
+func (l *workflowLookup) isDone(ctx context.Context, task *domain.Task) bool {
+	return l.forProject(ctx, task.ProjectID).IsDone(task.Status)
+}
@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/testutil"
+)
+
+// releaseWorkflow replaces review with a QA column and adds a won't-do status
+func releaseWorkflow() domain.Workflow {
+	return domain.Workflow{
+		Statuses: []domain.WorkflowStatus{
+			{ID: domain.StatusTodo, Name: "To Do"},
+			{ID: domain.StatusDeveloping, Name: "Building"},
+			{ID: "qa", Name: "QA"},
+			{ID: "released", Name: "Released", Done: true},
+			{ID: "wont_do", Name: "Won't Do", Done: true},
+		},
+		Transitions: map[domain.TaskStatus][]domain.TaskStatus{
+			domain.StatusTodo:       {domain.StatusDeveloping, "wont_do"},
+			domain.StatusDeveloping: {"qa", domain.StatusTodo},
+			"qa":                    {"released", domain.StatusDeveloping},
+		},
+	}
+}
+
+func TestWorkflowService_UpdateWorkflow(t *testing.T) {
+	ctx := context.Background()
+
+	mockTaskRepo := testutil.NewMockTaskRepository()
+	projectRepo := testutil.NewMockProjectRepository()
+	cache := NewCacheManager(NewMemoryCacheBackend("test:"), DefaultCacheConfig())
+	taskRepo := NewCacheInvalidatingTaskRepository(mockTaskRepo, cache)
+	service := NewWorkflowService(taskRepo, projectRepo, NewAuthorizationService(projectRepo), cache)
+
+	project := testutil.MockProject("wf-proj", "Workflow Project", "wf", "owner")
+	project.MemberIDs = []string{"member", "maintainer"}
+	project.MemberRoles = map[string]domain.ProjectRole{"maintainer": domain.ProjectMaintainer}
+	projectRepo.AddProject(project)
+
+	review := testutil.MockTask("review-1", "In review", project.ID, "owner")
+	review.Status = domain.StatusReview
+	mockTaskRepo.AddTask(review)
+	done := testutil.MockTask("done-1", "Shipped", project.ID, "owner")
+	done.Status = domain.StatusComplete
+	mockTaskRepo.AddTask(done)
+
+	t.Run("DefaultsToBuiltInWorkflow", func(t *testing.T) {
+		workflow, err := service.GetWorkflow(ctx, project.ID, "member")
+		require.NoError(t, err)
+		assert.Equal(t, domain.DefaultWorkflow(), workflow)
+	})
+
+	t.Run("OnlyOwner", func(t *testing.T) {
+		for _, userID := range []string{"member", "maintainer"} {
+			_, err := service.UpdateWorkflow(ctx, project.ID, UpdateWorkflowRequest{Workflow: releaseWorkflow()}, userID)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "ACCESS_DENIED")
+		}
+	})
+
+	t.Run("RejectsInvalidWorkflow", func(t *testing.T) {
+		invalid := releaseWorkflow()
+		invalid.Statuses[3].Done = false
+		invalid.Statuses[4].Done = false
+
+		_, err := service.UpdateWorkflow(ctx, project.ID, UpdateWorkflowRequest{Workflow: invalid}, "owner")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "INVALID_WORKFLOW")
+	})
+
+	t.Run("RefusesUnmappedStatusInUse", func(t *testing.T) {
+		_, err := service.UpdateWorkflow(ctx, project.ID, UpdateWorkflowRequest{Workflow: releaseWorkflow()}, "owner")
+		require.Error(t, err)
+
+		var domainErr *domain.Error
+		require.ErrorAs(t, err, &domainErr)
+		assert.Equal(t, "WORKFLOW_STATUS_IN_USE", domainErr.Code)
+		assert.Equal(t, string(domain.StatusReview), domainErr.Details["status"])
+		assert.Nil(t, project.Settings.Workflow, "the project keeps its workflow")
+		assert.Equal(t, domain.StatusReview, review.Status)
+	})
+
+	t.Run("RejectsMappingOutsideNewWorkflow", func(t *testing.T) {
+		_, err := service.UpdateWorkflow(ctx, project.ID, UpdateWorkflowRequest{
+			Workflow: releaseWorkflow(),
+			StatusMapping: map[domain.TaskStatus]domain.TaskStatus{
+				domain.StatusReview:   domain.StatusBacklog,
+				domain.StatusComplete: "released",
+			},
+		}, "owner")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "INVALID_STATUS")
+	})
+
+	t.Run("RemapsTasksOfDroppedStatuses", func(t *testing.T) {
+		workflow, err := service.UpdateWorkflow(ctx, project.ID, UpdateWorkflowRequest{
+			Workflow: releaseWorkflow(),
+			StatusMapping: map[domain.TaskStatus]domain.TaskStatus{
+				domain.StatusReview:   "qa",
+				domain.StatusComplete: "released",
+			},
+		}, "owner")
+		require.NoError(t, err)
+		assert.Equal(t, domain.StatusTodo, workflow.Initial())
+
+		assert.Equal(t, domain.TaskStatus("qa"), mockTaskRepo.Tasks["review-1"].Status)
+		assert.Equal(t, domain.TaskStatus("released"), mockTaskRepo.Tasks["done-1"].Status)
+
+		stored, err := service.GetWorkflow(ctx, project.ID, "member")
+		require.NoError(t, err)
+		assert.Equal(t, []domain.TaskStatus{"todo", "developing", "qa", "released", "wont_do"}, stored.StatusIDs())
+	})
+}
+
+func TestWorkflowService_CustomWorkflowOnBoard(t *testing.T) {
+	ctx := context.Background()
+
+	mockTaskRepo := testutil.NewMockTaskRepository()
+	projectRepo := testutil.NewMockProjectRepository()
+	userRepo := testutil.NewMockUserRepository()
+	wipRepo := testutil.NewMockWIPLimitRepository()
+	cache := NewCacheManager(NewMemoryCacheBackend("test:"), DefaultCacheConfig())
+	taskRepo := NewCacheInvalidatingTaskRepository(mockTaskRepo, cache)
+
+	wipManager := NewWIPManager(taskRepo, projectRepo, wipRepo)
+	taskService := NewTaskService(
+		taskRepo, projectRepo, userRepo, testutil.NewMockTaskHistoryRepository(),
+		NewAuthorizationService(projectRepo), wipManager, nil,
+	)
+	kanban := NewKanbanService(
+		taskRepo, projectRepo, taskService, wipManager, cache, NewAuthorizationService(projectRepo),
+	)
+
+	owner := testutil.MockUser("owner", "owner@test.com", "owner", "Owner")
+	userRepo.AddUser(owner)
+
+	workflow := releaseWorkflow()
+	workflow.InitialStatus = domain.StatusTodo
+	project := testutil.MockProject("custom-proj", "Custom Project", "custom", owner.ID)
+	project.Settings.Workflow = &workflow
+	projectRepo.AddProject(project)
+
+	task, err := taskService.CreateTask(ctx, domain.CreateTaskRequest{
+		Title:     "Release notes",
+		ProjectID: project.ID,
+	}, owner.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusTodo, task.Status)
+
+	t.Run("BoardColumnsFollowWorkflow", func(t *testing.T) {
+		board, err := kanban.GetBoard(ctx, project.ID, owner.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, workflow.StatusIDs(), board.ColumnOrder)
+		require.Len(t, board.Columns, 5)
+		assert.Equal(t, "QA", board.Columns["qa"].Title)
+		assert.NotContains(t, board.Columns, domain.StatusReview)
+		assert.Equal(t, 1, board.Columns[domain.StatusTodo].Count)
+	})
+
+	t.Run("StatusesOutsideWorkflowRejected", func(t *testing.T) {
+		_, err := taskService.UpdateTaskStatus(ctx, task.ID, domain.StatusReview, owner.ID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "INVALID_STATUS")
+
+		err = kanban.UpdateWIPLimits(ctx, project.ID, domain.StatusReview,
+			WIPLimits{SoftLimit: 1, HardLimit: 2, Enabled: true}, owner.ID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "INVALID_STATUS")
+	})
+
+	t.Run("ValidateMoveFollowsTransitions", func(t *testing.T) {
+		err := kanban.ValidateMove(ctx, MoveTaskRequest{
+			TaskID:    task.ID,
+			ProjectID: project.ID,
+			NewStatus: "qa",
+		}, owner.ID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "INVALID_TRANSITION")
+
+		err = kanban.ValidateMove(ctx, MoveTaskRequest{
+			TaskID:    task.ID,
+			ProjectID: project.ID,
+			NewStatus: "wont_do",
+		}, owner.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("DoneStatusesCountAsFinished", func(t *testing.T) {
+		dependent, err := taskService.CreateTask(ctx, domain.CreateTaskRequest{
+			Title:     "Announce release",
+			ProjectID: project.ID,
+		}, owner.ID)
+		require.NoError(t, err)
+		dependent.Dependencies = []string{task.ID}
+
+		_, err = taskService.UpdateTaskStatus(ctx, dependent.ID, domain.StatusDeveloping, owner.ID)
+		require.Error(t, err, "an open dependency blocks starting work")
+
+		_, err = taskService.UpdateTaskStatus(ctx, task.ID, "wont_do", owner.ID)
+		require.NoError(t, err)
+
+		_, err = taskService.UpdateTaskStatus(ctx, dependent.ID, domain.StatusDeveloping, owner.ID)
+		assert.NoError(t, err, "a dependency in any done status no longer blocks")
+	})
+}
@@ -804,7 +804,7 @@ func TestTaskRepository_EnhancedFeatures_Integration(t *testing.T) {
 	})
 
 	t.Run("Move_InvalidStatus_ReturnsError", func(t *testing.T) {
-		err := taskRepo.Move(context.Background(), "task123", domain.TaskStatus("invalid"), 1)
+		err := taskRepo.Move(context.Background(), "task123", domain.TaskStatus("Not A Status"), 1)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "invalid task status")
 	})
@@ -893,7 +893,7 @@ func TestTaskRepository_EnhancedFeatures_Integration(t *testing.T) {
 
 	t.Run("BulkUpdateStatus_InvalidStatus_ReturnsError", func(t *testing.T) {
 		taskIDs := []string{"task1", "task2"}
-		err := taskRepo.BulkUpdateStatus(context.Background(), taskIDs, domain.TaskStatus("invalid"))
+		err := taskRepo.BulkUpdateStatus(context.Background(), taskIDs, domain.TaskStatus("Not A Status"))
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "invalid task status")
 	})
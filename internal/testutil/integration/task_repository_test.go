@@ -522,7 +522,7 @@ func TestTaskRepository_Integration(t *testing.T) {
 	})
 
 	t.Run("ListByStatus_InvalidStatus_ReturnsError", func(t *testing.T) {
-		_, err := taskRepo.ListByStatus(context.Background(), domain.TaskStatus("invalid"), 0, 10)
+		_, err := taskRepo.ListByStatus(context.Background(), domain.TaskStatus("Not A Status"), 0, 10)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "invalid task status")
 	})
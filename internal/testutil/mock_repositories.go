@@ -3,12 +3,17 @@ package testutil
 
 //nolint:gofumpt
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/markdown"
 	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
 )
 
@@ -401,6 +406,7 @@ func (m *MockTaskRepository) Create(_ context.Context, task *domain.Task) error
 		task.ID = fmt.Sprintf("task-%d", len(m.Tasks)+1)
 	}
 
+	task.DescriptionHTML = markdown.Render(task.Description)
 	m.Tasks[task.ID] = task
 	return nil
 }
@@ -426,6 +432,7 @@ func (m *MockTaskRepository) Update(_ context.Context, task *domain.Task) error
 		return domain.NewNotFoundError("TASK_NOT_FOUND", "Task not found")
 	}
 
+	task.DescriptionHTML = markdown.Render(task.Description)
 	m.Tasks[task.ID] = task
 	return nil
 }
@@ -478,6 +485,12 @@ func (m *MockTaskRepository) GetByProject(
 			}
 		}
 
+		if filters.SeriesID != nil {
+			if task.SeriesID == nil || *task.SeriesID != *filters.SeriesID {
+				continue
+			}
+		}
+
 		tasks = append(tasks, task)
 	}
 
@@ -662,13 +675,39 @@ func (m *MockTaskRepository) GetDependencies(_ context.Context, taskID string) (
 	}
 
 	dependencies, exists := m.DependenciesByTask[taskID]
-	if !exists {
-		return []*domain.Task{}, nil
+	if exists {
+		return dependencies, nil
 	}
 
+	// Fall back to the IDs stored on the task, as the real repository does
+	dependencies = []*domain.Task{}
+	if task, ok := m.Tasks[taskID]; ok {
+		for _, depID := range task.Dependencies {
+			if dep, ok := m.Tasks[depID]; ok {
+				dependencies = append(dependencies, dep)
+			}
+		}
+	}
 	return dependencies, nil
 }
 
+// GetDependents retrieves the tasks that depend on a task.
+func (m *MockTaskRepository) GetDependents(_ context.Context, taskID string) ([]*domain.Task, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	dependents := []*domain.Task{}
+	for _, task := range m.Tasks {
+		for _, depID := range task.Dependencies {
+			if depID == taskID {
+				dependents = append(dependents, task)
+				break
+			}
+		}
+	}
+	return dependents, nil
+}
+
 // GetTasksByFilter retrieves tasks using advanced filters.
 func (m *MockTaskRepository) GetTasksByFilter(
 	_ context.Context,
@@ -854,9 +893,1727 @@ func (m *MockTaskRepository) UnarchiveTask(_ context.Context, id string) error {
 	return nil
 }
 
+// MockTaskHistoryRepository implements TaskHistoryRepository for testing.
+type MockTaskHistoryRepository struct {
+	Entries          []*domain.TaskHistoryEntry
+	ForceCreateError bool
+	mu               sync.RWMutex
+}
+
+// NewMockTaskHistoryRepository creates a new mock task history repository.
+func NewMockTaskHistoryRepository() *MockTaskHistoryRepository {
+	return &MockTaskHistoryRepository{}
+}
+
+// Create appends a history entry.
+func (m *MockTaskHistoryRepository) Create(_ context.Context, entry *domain.TaskHistoryEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.ForceCreateError {
+		return domain.NewInternalError("MOCK_CREATE_ERROR", "Forced create error for testing", nil)
+	}
+
+	if entry.ID == "" {
+		entry.ID = fmt.Sprintf("history-%d", len(m.Entries)+1)
+	}
+
+	m.Entries = append(m.Entries, entry)
+	return nil
+}
+
+// List retrieves history entries matching the filter, newest first.
+func (m *MockTaskHistoryRepository) List(
+	_ context.Context, filter domain.TaskHistoryFilter,
+) ([]*domain.TaskHistoryEntry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	matched := m.filterEntries(filter)
+
+	start := filter.Offset
+	if start > len(matched) {
+		start = len(matched)
+	}
+	end := len(matched)
+	if filter.Limit > 0 && start+filter.Limit < end {
+		end = start + filter.Limit
+	}
+
+	return matched[start:end], nil
+}
+
+// Count returns the number of history entries matching the filter.
+func (m *MockTaskHistoryRepository) Count(_ context.Context, filter domain.TaskHistoryFilter) (int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return len(m.filterEntries(filter)), nil
+}
+
+// filterEntries returns matching entries in reverse insertion order.
+func (m *MockTaskHistoryRepository) filterEntries(filter domain.TaskHistoryFilter) []*domain.TaskHistoryEntry {
+	var matched []*domain.TaskHistoryEntry
+	for i := len(m.Entries) - 1; i >= 0; i-- {
+		entry := m.Entries[i]
+		if filter.TaskID != nil && entry.TaskID != *filter.TaskID {
+			continue
+		}
+		if filter.UserID != nil && entry.UserID != *filter.UserID {
+			continue
+		}
+		if filter.FieldName != nil && (entry.FieldName == nil || *entry.FieldName != *filter.FieldName) {
+			continue
+		}
+		if filter.StartDate != nil && entry.CreatedAt.Before(*filter.StartDate) {
+			continue
+		}
+		if filter.EndDate != nil && entry.CreatedAt.After(*filter.EndDate) {
+			continue
+		}
+		if len(filter.Actions) > 0 && !containsAction(filter.Actions, entry.Action) {
+			continue
+		}
+		matched = append(matched, entry)
+	}
+	return matched
+}
+
+func containsAction(actions []domain.TaskHistoryAction, action domain.TaskHistoryAction) bool {
+	for _, a := range actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// MockWIPLimitRepository implements WIPLimitRepository for testing.
+type MockWIPLimitRepository struct {
+	Limits    map[string]*domain.WIPLimit
+	Overrides []*domain.WIPLimitOverride
+	mu        sync.RWMutex
+}
+
+// NewMockWIPLimitRepository creates a new mock WIP limit repository.
+func NewMockWIPLimitRepository() *MockWIPLimitRepository {
+	return &MockWIPLimitRepository{
+		Limits: make(map[string]*domain.WIPLimit),
+	}
+}
+
+func wipLimitKey(projectID string, status domain.TaskStatus) string {
+	return projectID + "/" + string(status)
+}
+
+// ListByProject retrieves all configured WIP limits for a project.
+func (m *MockWIPLimitRepository) ListByProject(_ context.Context, projectID string) ([]*domain.WIPLimit, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var limits []*domain.WIPLimit
+	for _, limit := range m.Limits {
+		if limit.ProjectID == projectID {
+			limits = append(limits, limit)
+		}
+	}
+	return limits, nil
+}
+
+// GetByProjectAndStatus retrieves the WIP limit for a single column.
+func (m *MockWIPLimitRepository) GetByProjectAndStatus(
+	_ context.Context, projectID string, status domain.TaskStatus,
+) (*domain.WIPLimit, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	limit, exists := m.Limits[wipLimitKey(projectID, status)]
+	if !exists {
+		return nil, repository.ErrNotFound
+	}
+	return limit, nil
+}
+
+// Upsert creates or replaces a WIP limit.
+func (m *MockWIPLimitRepository) Upsert(_ context.Context, limit *domain.WIPLimit) error {
+	if err := limit.Validate(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Limits[wipLimitKey(limit.ProjectID, limit.Status)] = limit
+	return nil
+}
+
+// RecordOverride stores a WIP override record.
+func (m *MockWIPLimitRepository) RecordOverride(_ context.Context, override *domain.WIPLimitOverride) error {
+	if err := override.Validate(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Overrides = append(m.Overrides, override)
+	return nil
+}
+
+// ListOverrides retrieves recorded WIP overrides for a project.
+func (m *MockWIPLimitRepository) ListOverrides(
+	_ context.Context, projectID string, _, _ int,
+) ([]*domain.WIPLimitOverride, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var overrides []*domain.WIPLimitOverride
+	for i := len(m.Overrides) - 1; i >= 0; i-- {
+		if m.Overrides[i].ProjectID == projectID {
+			overrides = append(overrides, m.Overrides[i])
+		}
+	}
+	return overrides, nil
+}
+
+// MockSearchRepository implements SearchRepository for testing. It returns the
+// stored results of the queried project and records the last query it saw.
+type MockSearchRepository struct {
+	Results   []*domain.SearchResult
+	LastQuery domain.SearchQuery
+	mu        sync.RWMutex
+}
+
+// NewMockSearchRepository creates a new mock search repository.
+func NewMockSearchRepository() *MockSearchRepository {
+	return &MockSearchRepository{}
+}
+
+// AddResult adds a search hit to the mock repository.
+func (m *MockSearchRepository) AddResult(result *domain.SearchResult) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Results = append(m.Results, result)
+}
+
+// Search returns the stored hits of the query's project and types.
+func (m *MockSearchRepository) Search(_ context.Context, query domain.SearchQuery) ([]*domain.SearchResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.LastQuery = query
+
+	matched := m.matching(query)
+	if query.Offset >= len(matched) {
+		return []*domain.SearchResult{}, nil
+	}
+	matched = matched[query.Offset:]
+	if query.Limit > 0 && query.Limit < len(matched) {
+		matched = matched[:query.Limit]
+	}
+	return matched, nil
+}
+
+// Count returns the number of stored hits of the query's project and types.
+func (m *MockSearchRepository) Count(_ context.Context, query domain.SearchQuery) (int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.matching(query)), nil
+}
+
+func (m *MockSearchRepository) matching(query domain.SearchQuery) []*domain.SearchResult {
+	matched := []*domain.SearchResult{}
+	for _, result := range m.Results {
+		if result.ProjectID != query.ProjectID {
+			continue
+		}
+		if len(query.Types) > 0 && !containsSearchType(query.Types, result.Type) {
+			continue
+		}
+		matched = append(matched, result)
+	}
+	return matched
+}
+
+func containsSearchType(types []domain.SearchDocumentType, docType domain.SearchDocumentType) bool {
+	for _, t := range types {
+		if t == docType {
+			return true
+		}
+	}
+	return false
+}
+
+// MockEmailOutboxRepository implements EmailOutboxRepository for testing.
+type MockEmailOutboxRepository struct {
+	Emails map[string]*domain.OutboxEmail
+	mu     sync.RWMutex
+}
+
+// NewMockEmailOutboxRepository creates a new mock email outbox repository.
+func NewMockEmailOutboxRepository() *MockEmailOutboxRepository {
+	return &MockEmailOutboxRepository{
+		Emails: make(map[string]*domain.OutboxEmail),
+	}
+}
+
+// Create stores a copy of a new email.
+func (m *MockEmailOutboxRepository) Create(_ context.Context, email *domain.OutboxEmail) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.Emails[email.ID]; exists {
+		return fmt.Errorf("email with ID %s already exists", email.ID)
+	}
+	stored := *email
+	m.Emails[email.ID] = &stored
+	return nil
+}
+
+// Update stores a copy of the email's delivery state.
+func (m *MockEmailOutboxRepository) Update(_ context.Context, email *domain.OutboxEmail) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.Emails[email.ID]; !exists {
+		return fmt.Errorf("email with ID %s not found", email.ID)
+	}
+	stored := *email
+	m.Emails[email.ID] = &stored
+	return nil
+}
+
+// ListDue retrieves copies of the pending emails that are due, oldest first.
+func (m *MockEmailOutboxRepository) ListDue(_ context.Context, now time.Time, limit int) ([]*domain.OutboxEmail, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var due []*domain.OutboxEmail
+	for _, email := range m.Emails {
+		if email.IsDue(now) {
+			copied := *email
+			due = append(due, &copied)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].NextAttemptAt.Before(due[j].NextAttemptAt)
+	})
+	if limit > 0 && len(due) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
+
+// MockEventLogRepository implements EventLogRepository for testing.
+type MockEventLogRepository struct {
+	Events []*domain.TaskEvent
+	mu     sync.RWMutex
+}
+
+// NewMockEventLogRepository creates a new mock event log repository.
+func NewMockEventLogRepository() *MockEventLogRepository {
+	return &MockEventLogRepository{}
+}
+
+// Append stores a copy of the event under the next sequence.
+func (m *MockEventLogRepository) Append(_ context.Context, event *domain.TaskEvent) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var latest int64
+	if len(m.Events) > 0 {
+		latest = m.Events[len(m.Events)-1].Sequence
+	}
+	event.Sequence = latest + 1
+	stored := *event
+	m.Events = append(m.Events, &stored)
+	return nil
+}
+
+// ListAfter retrieves copies of up to limit events after the given sequence.
+func (m *MockEventLogRepository) ListAfter(
+	_ context.Context, afterSequence int64, limit int,
+) ([]*domain.TaskEvent, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	events := []*domain.TaskEvent{}
+	for _, event := range m.Events {
+		if event.Sequence <= afterSequence {
+			continue
+		}
+		if limit > 0 && len(events) == limit {
+			break
+		}
+		copied := *event
+		events = append(events, &copied)
+	}
+	return events, nil
+}
+
+// Bounds returns the oldest and latest stored sequence.
+func (m *MockEventLogRepository) Bounds(_ context.Context) (int64, int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if len(m.Events) == 0 {
+		return 0, 0, nil
+	}
+	return m.Events[0].Sequence, m.Events[len(m.Events)-1].Sequence, nil
+}
+
+// DeleteUpTo removes the events at or below the given sequence.
+func (m *MockEventLogRepository) DeleteUpTo(_ context.Context, sequence int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	kept := m.Events[:0]
+	for _, event := range m.Events {
+		if event.Sequence > sequence {
+			kept = append(kept, event)
+		}
+	}
+	m.Events = kept
+	return nil
+}
+
+// MockWebhookRepository implements WebhookRepository for testing.
+type MockWebhookRepository struct {
+	Webhooks map[string]*domain.Webhook
+	mu       sync.RWMutex
+	nextID   int
+}
+
+// NewMockWebhookRepository creates a new mock webhook repository.
+func NewMockWebhookRepository() *MockWebhookRepository {
+	return &MockWebhookRepository{
+		Webhooks: make(map[string]*domain.Webhook),
+	}
+}
+
+// Create stores a copy of a new webhook, assigning an ID when it has none.
+func (m *MockWebhookRepository) Create(_ context.Context, webhook *domain.Webhook) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if webhook.ID == "" {
+		m.nextID++
+		webhook.ID = fmt.Sprintf("webhook-%d", m.nextID)
+	}
+	if _, exists := m.Webhooks[webhook.ID]; exists {
+		return fmt.Errorf("webhook with ID %s already exists", webhook.ID)
+	}
+	stored := *webhook
+	m.Webhooks[webhook.ID] = &stored
+	return nil
+}
+
+// GetByID retrieves a copy of a webhook.
+func (m *MockWebhookRepository) GetByID(_ context.Context, id string) (*domain.Webhook, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	webhook, exists := m.Webhooks[id]
+	if !exists {
+		return nil, fmt.Errorf("webhook %s: %w", id, repository.ErrNotFound)
+	}
+	copied := *webhook
+	return &copied, nil
+}
+
+// Update stores a copy of the webhook.
+func (m *MockWebhookRepository) Update(_ context.Context, webhook *domain.Webhook) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.Webhooks[webhook.ID]; !exists {
+		return fmt.Errorf("webhook %s: %w", webhook.ID, repository.ErrNotFound)
+	}
+	stored := *webhook
+	m.Webhooks[webhook.ID] = &stored
+	return nil
+}
+
+// Delete removes a webhook.
+func (m *MockWebhookRepository) Delete(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.Webhooks[id]; !exists {
+		return fmt.Errorf("webhook %s: %w", id, repository.ErrNotFound)
+	}
+	delete(m.Webhooks, id)
+	return nil
+}
+
+// ListByProject retrieves copies of a project's webhooks, oldest first.
+func (m *MockWebhookRepository) ListByProject(_ context.Context, projectID string) ([]*domain.Webhook, error) {
+	return m.list(projectID, false), nil
+}
+
+// ListActiveByProject retrieves copies of a project's active webhooks.
+func (m *MockWebhookRepository) ListActiveByProject(_ context.Context, projectID string) ([]*domain.Webhook, error) {
+	return m.list(projectID, true), nil
+}
+
+func (m *MockWebhookRepository) list(projectID string, activeOnly bool) []*domain.Webhook {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	webhooks := []*domain.Webhook{}
+	for _, webhook := range m.Webhooks {
+		if webhook.ProjectID == projectID && (!activeOnly || webhook.Active) {
+			copied := *webhook
+			webhooks = append(webhooks, &copied)
+		}
+	}
+	sort.Slice(webhooks, func(i, j int) bool { return webhooks[i].ID < webhooks[j].ID })
+	return webhooks
+}
+
+// MockWebhookDeliveryRepository implements WebhookDeliveryRepository for testing.
+type MockWebhookDeliveryRepository struct {
+	Deliveries map[string]*domain.WebhookDelivery
+	mu         sync.RWMutex
+	nextID     int
+}
+
+// NewMockWebhookDeliveryRepository creates a new mock webhook delivery repository.
+func NewMockWebhookDeliveryRepository() *MockWebhookDeliveryRepository {
+	return &MockWebhookDeliveryRepository{
+		Deliveries: make(map[string]*domain.WebhookDelivery),
+	}
+}
+
+// Create stores a copy of a new delivery, assigning an ID when it has none.
+func (m *MockWebhookDeliveryRepository) Create(_ context.Context, delivery *domain.WebhookDelivery) error {
+	if err := delivery.Validate(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for delivery.ID == "" || m.Deliveries[delivery.ID] != nil {
+		m.nextID++
+		delivery.ID = fmt.Sprintf("delivery-%03d", m.nextID)
+	}
+	stored := *delivery
+	m.Deliveries[delivery.ID] = &stored
+	return nil
+}
+
+// GetByID retrieves a copy of a delivery.
+func (m *MockWebhookDeliveryRepository) GetByID(_ context.Context, id string) (*domain.WebhookDelivery, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	delivery, exists := m.Deliveries[id]
+	if !exists {
+		return nil, fmt.Errorf("webhook delivery %s: %w", id, repository.ErrNotFound)
+	}
+	copied := *delivery
+	return &copied, nil
+}
+
+// Update stores a copy of the delivery's state.
+func (m *MockWebhookDeliveryRepository) Update(_ context.Context, delivery *domain.WebhookDelivery) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.Deliveries[delivery.ID]; !exists {
+		return fmt.Errorf("webhook delivery %s: %w", delivery.ID, repository.ErrNotFound)
+	}
+	stored := *delivery
+	m.Deliveries[delivery.ID] = &stored
+	return nil
+}
+
+// ListByWebhook retrieves copies of a webhook's deliveries, newest first.
+func (m *MockWebhookDeliveryRepository) ListByWebhook(
+	_ context.Context, webhookID string, limit int,
+) ([]*domain.WebhookDelivery, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	deliveries := []*domain.WebhookDelivery{}
+	for _, delivery := range m.Deliveries {
+		if delivery.WebhookID == webhookID {
+			copied := *delivery
+			deliveries = append(deliveries, &copied)
+		}
+	}
+	sort.Slice(deliveries, func(i, j int) bool { return deliveries[i].ID > deliveries[j].ID })
+	if limit > 0 && len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+	}
+	return deliveries, nil
+}
+
+// ListDue retrieves copies of the pending deliveries that are due, oldest first.
+func (m *MockWebhookDeliveryRepository) ListDue(
+	_ context.Context, now time.Time, limit int,
+) ([]*domain.WebhookDelivery, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var due []*domain.WebhookDelivery
+	for _, delivery := range m.Deliveries {
+		if delivery.IsDue(now) {
+			copied := *delivery
+			due = append(due, &copied)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].ID < due[j].ID })
+	if limit > 0 && len(due) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
+
+// DeleteBefore removes finished deliveries created before the cutoff.
+func (m *MockWebhookDeliveryRepository) DeleteBefore(_ context.Context, cutoff time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	removed := 0
+	for id, delivery := range m.Deliveries {
+		if delivery.Status != domain.WebhookDeliveryPending && delivery.CreatedAt.Before(cutoff) {
+			delete(m.Deliveries, id)
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+// MockNotificationRepository is an in-memory NotificationRepository for tests.
+// Notifications are kept in creation order.
+type MockNotificationRepository struct {
+	Notifications []*domain.Notification
+	mu            sync.RWMutex
+}
+
+// NewMockNotificationRepository creates a new mock notification repository.
+func NewMockNotificationRepository() *MockNotificationRepository {
+	return &MockNotificationRepository{}
+}
+
+// Create validates and stores a copy of a new notification, assigning its ID and creation time.
+func (m *MockNotificationRepository) Create(_ context.Context, notification *domain.Notification) error {
+	if err := notification.Validate(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	notification.ID = fmt.Sprintf("notification-%d", len(m.Notifications)+1)
+	notification.CreatedAt = time.Now().UTC()
+	stored := *notification
+	m.Notifications = append(m.Notifications, &stored)
+	return nil
+}
+
+// GetByID retrieves a copy of a notification.
+func (m *MockNotificationRepository) GetByID(_ context.Context, id string) (*domain.Notification, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, notification := range m.Notifications {
+		if notification.ID == id {
+			copied := *notification
+			return &copied, nil
+		}
+	}
+	return nil, fmt.Errorf("notification %s: %w", id, repository.ErrNotFound)
+}
+
+// Update stores the read state of a notification.
+func (m *MockNotificationRepository) Update(_ context.Context, notification *domain.Notification) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, stored := range m.Notifications {
+		if stored.ID == notification.ID {
+			stored.ReadAt = notification.ReadAt
+			return nil
+		}
+	}
+	return fmt.Errorf("notification %s: %w", notification.ID, repository.ErrNotFound)
+}
+
+// ListByUser retrieves a page of a user's notifications, newest first.
+func (m *MockNotificationRepository) ListByUser(
+	_ context.Context, userID string, unreadOnly bool, offset, limit int,
+) ([]*domain.Notification, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var matching []*domain.Notification
+	for i := len(m.Notifications) - 1; i >= 0; i-- {
+		notification := m.Notifications[i]
+		if notification.UserID == userID && (!unreadOnly || !notification.IsRead()) {
+			copied := *notification
+			matching = append(matching, &copied)
+		}
+	}
+
+	if offset >= len(matching) {
+		return []*domain.Notification{}, nil
+	}
+	matching = matching[offset:]
+	if limit > 0 && limit < len(matching) {
+		matching = matching[:limit]
+	}
+	return matching, nil
+}
+
+// CountByUser counts a user's notifications, or only the unread ones.
+func (m *MockNotificationRepository) CountByUser(_ context.Context, userID string, unreadOnly bool) (int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	count := 0
+	for _, notification := range m.Notifications {
+		if notification.UserID == userID && (!unreadOnly || !notification.IsRead()) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// MarkAllRead marks every unread notification of a user read.
+func (m *MockNotificationRepository) MarkAllRead(_ context.Context, userID string, readAt time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	changed := 0
+	for _, notification := range m.Notifications {
+		if notification.UserID == userID && notification.MarkRead(readAt) {
+			changed++
+		}
+	}
+	return changed, nil
+}
+
+// MockCommentRepository is an in-memory CommentRepository for tests.
+// Comments are kept in creation order.
+type MockCommentRepository struct {
+	Comments []*domain.Comment
+	nextID   int
+	mu       sync.RWMutex
+}
+
+// NewMockCommentRepository creates a new mock comment repository.
+func NewMockCommentRepository() *MockCommentRepository {
+	return &MockCommentRepository{}
+}
+
+// Create validates and stores a copy of a new comment, assigning its ID and timestamps.
+func (m *MockCommentRepository) Create(_ context.Context, comment *domain.Comment) error {
+	if err := comment.Validate(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.nextID++
+	comment.ID = fmt.Sprintf("comment-%d", m.nextID)
+	comment.CreatedAt = time.Now().UTC()
+	comment.UpdatedAt = comment.CreatedAt
+	comment.ContentHTML = markdown.Render(comment.Content)
+	stored := *comment
+	m.Comments = append(m.Comments, &stored)
+	return nil
+}
+
+// GetByID retrieves a copy of a comment.
+func (m *MockCommentRepository) GetByID(_ context.Context, id string) (*domain.Comment, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if index := m.indexOf(id); index >= 0 {
+		copied := *m.Comments[index]
+		return &copied, nil
+	}
+	return nil, fmt.Errorf("comment %s: %w", id, repository.ErrNotFound)
+}
+
+// Update stores a copy of an existing comment.
+func (m *MockCommentRepository) Update(_ context.Context, comment *domain.Comment) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	index := m.indexOf(comment.ID)
+	if index < 0 {
+		return fmt.Errorf("comment %s: %w", comment.ID, repository.ErrNotFound)
+	}
+	comment.UpdatedAt = time.Now().UTC()
+	comment.ContentHTML = markdown.Render(comment.Content)
+	stored := *comment
+	m.Comments[index] = &stored
+	return nil
+}
+
+// Delete removes a comment.
+func (m *MockCommentRepository) Delete(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	index := m.indexOf(id)
+	if index < 0 {
+		return fmt.Errorf("comment %s: %w", id, repository.ErrNotFound)
+	}
+	m.Comments = append(m.Comments[:index], m.Comments[index+1:]...)
+	return nil
+}
+
+// BulkDelete removes several comments, ignoring unknown IDs.
+func (m *MockCommentRepository) BulkDelete(ctx context.Context, ids []string) error {
+	for _, id := range ids {
+		_ = m.Delete(ctx, id)
+	}
+	return nil
+}
+
+// DeleteByTask removes every comment on a task.
+func (m *MockCommentRepository) DeleteByTask(_ context.Context, taskID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	kept := m.Comments[:0]
+	for _, comment := range m.Comments {
+		if comment.TaskID != taskID {
+			kept = append(kept, comment)
+		}
+	}
+	m.Comments = kept
+	return nil
+}
+
+// SoftDelete only checks the comment exists; the mock keeps no deleted state.
+func (m *MockCommentRepository) SoftDelete(ctx context.Context, id string) error {
+	_, err := m.GetByID(ctx, id)
+	return err
+}
+
+// Restore only checks the comment exists; the mock keeps no deleted state.
+func (m *MockCommentRepository) Restore(ctx context.Context, id string) error {
+	_, err := m.GetByID(ctx, id)
+	return err
+}
+
+// ListByTask retrieves a page of the comments on a task, oldest first.
+func (m *MockCommentRepository) ListByTask(
+	_ context.Context, taskID string, offset, limit int,
+) ([]*domain.Comment, error) {
+	return m.page(func(comment *domain.Comment) bool { return comment.TaskID == taskID }, offset, limit), nil
+}
+
+// ListOpenByTask retrieves a page of the comments on a task outside resolved threads, oldest first.
+func (m *MockCommentRepository) ListOpenByTask(
+	_ context.Context, taskID string, offset, limit int,
+) ([]*domain.Comment, error) {
+	m.mu.RLock()
+	resolved := make(map[string]bool)
+	for _, comment := range m.Comments {
+		if comment.IsResolved() {
+			resolved[comment.ID] = true
+		}
+	}
+	m.mu.RUnlock()
+
+	return m.page(func(comment *domain.Comment) bool {
+		return comment.TaskID == taskID && !resolved[comment.ID] &&
+			(comment.ParentCommentID == nil || !resolved[*comment.ParentCommentID])
+	}, offset, limit), nil
+}
+
+// ListByAuthor retrieves a page of the comments by an author, oldest first.
+func (m *MockCommentRepository) ListByAuthor(
+	_ context.Context, authorID string, offset, limit int,
+) ([]*domain.Comment, error) {
+	return m.page(func(comment *domain.Comment) bool { return comment.AuthorID == authorID }, offset, limit), nil
+}
+
+// ListReplies retrieves the replies to a comment, oldest first.
+func (m *MockCommentRepository) ListReplies(_ context.Context, parentID string) ([]*domain.Comment, error) {
+	return m.page(func(comment *domain.Comment) bool {
+		return comment.ParentCommentID != nil && *comment.ParentCommentID == parentID
+	}, 0, 0), nil
+}
+
+// GetThread retrieves a comment followed by its replies.
+func (m *MockCommentRepository) GetThread(ctx context.Context, rootCommentID string) ([]*domain.Comment, error) {
+	root, err := m.GetByID(ctx, rootCommentID)
+	if err != nil {
+		return nil, err
+	}
+	replies, _ := m.ListReplies(ctx, rootCommentID)
+	return append([]*domain.Comment{root}, replies...), nil
+}
+
+// Count returns the number of comments.
+func (m *MockCommentRepository) Count(_ context.Context) (int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.Comments), nil
+}
+
+// CountByTask returns the number of comments on a task.
+func (m *MockCommentRepository) CountByTask(ctx context.Context, taskID string) (int, error) {
+	comments, _ := m.ListByTask(ctx, taskID, 0, 0)
+	return len(comments), nil
+}
+
+// CountByAuthor returns the number of comments by an author.
+func (m *MockCommentRepository) CountByAuthor(ctx context.Context, authorID string) (int, error) {
+	comments, _ := m.ListByAuthor(ctx, authorID, 0, 0)
+	return len(comments), nil
+}
+
+// ExistsByID checks whether a comment exists.
+func (m *MockCommentRepository) ExistsByID(_ context.Context, id string) (bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.indexOf(id) >= 0, nil
+}
+
+// Search retrieves a page of the comments whose content contains the query, optionally on one task.
+func (m *MockCommentRepository) Search(
+	_ context.Context, query string, taskID string, offset, limit int,
+) ([]*domain.Comment, error) {
+	query = strings.ToLower(query)
+	return m.page(func(comment *domain.Comment) bool {
+		return (taskID == "" || comment.TaskID == taskID) && strings.Contains(strings.ToLower(comment.Content), query)
+	}, offset, limit), nil
+}
+
+// indexOf finds a comment's position; callers hold the lock.
+func (m *MockCommentRepository) indexOf(id string) int {
+	for i, comment := range m.Comments {
+		if comment.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// page returns copies of the matching comments, skipping offset and keeping at most limit when positive.
+func (m *MockCommentRepository) page(match func(*domain.Comment) bool, offset, limit int) []*domain.Comment {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	matching := []*domain.Comment{}
+	for _, comment := range m.Comments {
+		if match(comment) {
+			copied := *comment
+			matching = append(matching, &copied)
+		}
+	}
+
+	if offset >= len(matching) {
+		return []*domain.Comment{}
+	}
+	matching = matching[offset:]
+	if limit > 0 && limit < len(matching) {
+		matching = matching[:limit]
+	}
+	return matching
+}
+
+// MockCommentMentionRepository is an in-memory CommentMentionRepository for tests.
+// Mentions are kept in creation order.
+type MockCommentMentionRepository struct {
+	Mentions []*domain.CommentMention
+	nextID   int
+	mu       sync.RWMutex
+}
+
+// NewMockCommentMentionRepository creates a new mock comment mention repository.
+func NewMockCommentMentionRepository() *MockCommentMentionRepository {
+	return &MockCommentMentionRepository{}
+}
+
+// ReplaceForComment replaces the mentions recorded for a comment, assigning IDs to the new ones.
+func (m *MockCommentMentionRepository) ReplaceForComment(
+	_ context.Context, commentID string, mentions []*domain.CommentMention,
+) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	kept := m.Mentions[:0]
+	for _, mention := range m.Mentions {
+		if mention.CommentID != commentID {
+			kept = append(kept, mention)
+		}
+	}
+	m.Mentions = kept
+
+	for _, mention := range mentions {
+		m.nextID++
+		mention.ID = fmt.Sprintf("mention-%d", m.nextID)
+		mention.CommentID = commentID
+		mention.CreatedAt = time.Now().UTC()
+		stored := *mention
+		m.Mentions = append(m.Mentions, &stored)
+	}
+	return nil
+}
+
+// ListByComments retrieves the mentions of several comments, keyed by comment ID.
+func (m *MockCommentMentionRepository) ListByComments(
+	_ context.Context, commentIDs []string,
+) (map[string][]*domain.CommentMention, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	wanted := make(map[string]bool, len(commentIDs))
+	for _, id := range commentIDs {
+		wanted[id] = true
+	}
+
+	mentions := make(map[string][]*domain.CommentMention)
+	for _, mention := range m.Mentions {
+		if wanted[mention.CommentID] {
+			copied := *mention
+			mentions[mention.CommentID] = append(mentions[mention.CommentID], &copied)
+		}
+	}
+	return mentions, nil
+}
+
+// ListByUser retrieves a page of the mentions of a user, newest first.
+func (m *MockCommentMentionRepository) ListByUser(
+	_ context.Context, userID string, offset, limit int,
+) ([]*domain.CommentMention, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var matching []*domain.CommentMention
+	for i := len(m.Mentions) - 1; i >= 0; i-- {
+		if m.Mentions[i].UserID == userID {
+			copied := *m.Mentions[i]
+			matching = append(matching, &copied)
+		}
+	}
+
+	if offset >= len(matching) {
+		return []*domain.CommentMention{}, nil
+	}
+	matching = matching[offset:]
+	if limit > 0 && limit < len(matching) {
+		matching = matching[:limit]
+	}
+	return matching, nil
+}
+
+// CountByUser counts the mentions of a user.
+func (m *MockCommentMentionRepository) CountByUser(_ context.Context, userID string) (int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	count := 0
+	for _, mention := range m.Mentions {
+		if mention.UserID == userID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// MockCommentReactionRepository is an in-memory CommentReactionRepository for tests.
+// Reactions are kept in creation order.
+type MockCommentReactionRepository struct {
+	Reactions []*domain.CommentReaction
+	nextID    int
+	mu        sync.RWMutex
+}
+
+// NewMockCommentReactionRepository creates a new mock comment reaction repository.
+func NewMockCommentReactionRepository() *MockCommentReactionRepository {
+	return &MockCommentReactionRepository{}
+}
+
+// Add stores a copy of a reaction unless the user already reacted with that emoji.
+func (m *MockCommentReactionRepository) Add(_ context.Context, reaction *domain.CommentReaction) (bool, error) {
+	if err := domain.ValidateReactionEmoji(reaction.Emoji); err != nil {
+		return false, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.indexOf(reaction.CommentID, reaction.UserID, reaction.Emoji) >= 0 {
+		return false, nil
+	}
+
+	m.nextID++
+	reaction.ID = fmt.Sprintf("reaction-%d", m.nextID)
+	reaction.CreatedAt = time.Now().UTC()
+	stored := *reaction
+	m.Reactions = append(m.Reactions, &stored)
+	return true, nil
+}
+
+// Remove deletes a user's reaction, reporting false when there was none.
+func (m *MockCommentReactionRepository) Remove(_ context.Context, commentID, userID, emoji string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	index := m.indexOf(commentID, userID, emoji)
+	if index < 0 {
+		return false, nil
+	}
+	m.Reactions = append(m.Reactions[:index], m.Reactions[index+1:]...)
+	return true, nil
+}
+
+// ListByComments retrieves the reactions to several comments in the order they were made, keyed by comment ID.
+func (m *MockCommentReactionRepository) ListByComments(
+	_ context.Context, commentIDs []string,
+) (map[string][]*domain.CommentReaction, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	wanted := make(map[string]bool, len(commentIDs))
+	for _, id := range commentIDs {
+		wanted[id] = true
+	}
+
+	reactions := make(map[string][]*domain.CommentReaction)
+	for _, reaction := range m.Reactions {
+		if wanted[reaction.CommentID] {
+			copied := *reaction
+			reactions[reaction.CommentID] = append(reactions[reaction.CommentID], &copied)
+		}
+	}
+	return reactions, nil
+}
+
+// indexOf finds a reaction's position; callers hold the lock.
+func (m *MockCommentReactionRepository) indexOf(commentID, userID, emoji string) int {
+	for i, reaction := range m.Reactions {
+		if reaction.CommentID == commentID && reaction.UserID == userID && reaction.Emoji == emoji {
+			return i
+		}
+	}
+	return -1
+}
+
+// MockAttachmentRepository is an in-memory AttachmentRepository for tests. Files are
+// listed on the tasks and comments of the given mock repositories, as storage does
+// with the records they are attached to.
+type MockAttachmentRepository struct {
+	Files            map[string]*domain.AttachmentUpload
+	RemovedTaskFiles []string
+	tasks            *MockTaskRepository
+	comments         *MockCommentRepository
+	nextID           int
+	mu               sync.Mutex
+}
+
+// NewMockAttachmentRepository creates a new mock attachment repository.
+func NewMockAttachmentRepository(
+	tasks *MockTaskRepository, comments *MockCommentRepository,
+) *MockAttachmentRepository {
+	return &MockAttachmentRepository{
+		Files:    make(map[string]*domain.AttachmentUpload),
+		tasks:    tasks,
+		comments: comments,
+	}
+}
+
+// Add stores a copy of an upload under a unique name and lists it on its owner.
+func (m *MockAttachmentRepository) Add(
+	_ context.Context, owner domain.AttachmentOwner, upload *domain.AttachmentUpload,
+) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.nextID++
+	name := fmt.Sprintf("%d_%s", m.nextID, upload.Name)
+	if err := m.updateOwner(owner, func(names []string) []string { return append(names, name) }); err != nil {
+		return "", err
+	}
+
+	stored := *upload
+	m.Files[attachmentKey(owner, name)] = &stored
+	return name, nil
+}
+
+// Open returns an attachment's content. Thumbnails are the original content under a thumbnail name.
+func (m *MockAttachmentRepository) Open(
+	_ context.Context, owner domain.AttachmentOwner, name, thumbSize string,
+) (*domain.AttachmentContent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	upload, exists := m.Files[attachmentKey(owner, name)]
+	if !exists {
+		return nil, fmt.Errorf("attachment %s: %w", name, repository.ErrNotFound)
+	}
+
+	servedName := name
+	if thumbSize != "" && domain.IsImageAttachment(name) {
+		servedName = thumbSize + "_" + name
+	}
+
+	return &domain.AttachmentContent{
+		ReadSeekCloser: nopReadSeekCloser{bytes.NewReader(upload.Content)},
+		ModTime:        time.Now().UTC(),
+		Name:           servedName,
+		ContentType:    upload.ContentType,
+	}, nil
+}
+
+// Remove deletes an attachment and takes it off its owner.
+func (m *MockAttachmentRepository) Remove(_ context.Context, owner domain.AttachmentOwner, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := attachmentKey(owner, name)
+	if _, exists := m.Files[key]; !exists {
+		return fmt.Errorf("attachment %s: %w", name, repository.ErrNotFound)
+	}
+	delete(m.Files, key)
+
+	return m.updateOwner(owner, func(names []string) []string {
+		return slices.DeleteFunc(slices.Clone(names), func(existing string) bool { return existing == name })
+	})
+}
+
+// RemoveTaskFiles records the task and deletes the files attached to it.
+// Files of its comments are left alone, since the mock doesn't know which task they belong to.
+func (m *MockAttachmentRepository) RemoveTaskFiles(_ context.Context, taskID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.RemovedTaskFiles = append(m.RemovedTaskFiles, taskID)
+	prefix := attachmentKey(domain.TaskAttachments(taskID), "")
+	for key := range m.Files {
+		if strings.HasPrefix(key, prefix) {
+			delete(m.Files, key)
+		}
+	}
+	return nil
+}
+
+// updateOwner changes the attachments listed on a mock task or comment; callers hold the lock.
+func (m *MockAttachmentRepository) updateOwner(owner domain.AttachmentOwner, update func([]string) []string) error {
+	switch owner.Type {
+	case domain.AttachmentOwnerTask:
+		if m.tasks == nil {
+			return fmt.Errorf("no task repository to attach to")
+		}
+		m.tasks.mu.Lock()
+		defer m.tasks.mu.Unlock()
+
+		task, exists := m.tasks.Tasks[owner.ID]
+		if !exists {
+			return fmt.Errorf("task %s: %w", owner.ID, repository.ErrNotFound)
+		}
+		task.Attachments = update(task.Attachments)
+	case domain.AttachmentOwnerComment:
+		if m.comments == nil {
+			return fmt.Errorf("no comment repository to attach to")
+		}
+		m.comments.mu.Lock()
+		defer m.comments.mu.Unlock()
+
+		index := m.comments.indexOf(owner.ID)
+		if index < 0 {
+			return fmt.Errorf("comment %s: %w", owner.ID, repository.ErrNotFound)
+		}
+		m.comments.Comments[index].Attachments = update(m.comments.Comments[index].Attachments)
+	default:
+		return fmt.Errorf("invalid attachment owner %s", owner.Type)
+	}
+	return nil
+}
+
+// attachmentKey identifies a stored file
+func attachmentKey(owner domain.AttachmentOwner, name string) string {
+	return string(owner.Type) + "/" + owner.ID + "/" + name
+}
+
+// MockTaskSeriesRepository implements TaskSeriesRepository for testing.
+type MockTaskSeriesRepository struct {
+	Series map[string]*domain.TaskSeries
+	mu     sync.RWMutex
+	nextID int
+}
+
+// NewMockTaskSeriesRepository creates a new mock task series repository.
+func NewMockTaskSeriesRepository() *MockTaskSeriesRepository {
+	return &MockTaskSeriesRepository{
+		Series: make(map[string]*domain.TaskSeries),
+	}
+}
+
+// Create stores a copy of a new series, assigning an ID when it has none.
+func (m *MockTaskSeriesRepository) Create(_ context.Context, series *domain.TaskSeries) error {
+	if err := series.Validate(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if series.ID == "" {
+		m.nextID++
+		series.ID = fmt.Sprintf("series-%d", m.nextID)
+	}
+	if _, exists := m.Series[series.ID]; exists {
+		return fmt.Errorf("task series with ID %s already exists", series.ID)
+	}
+	stored := *series
+	m.Series[series.ID] = &stored
+	return nil
+}
+
+// GetByID retrieves a copy of a series.
+func (m *MockTaskSeriesRepository) GetByID(_ context.Context, id string) (*domain.TaskSeries, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	series, exists := m.Series[id]
+	if !exists {
+		return nil, fmt.Errorf("task series %s: %w", id, repository.ErrNotFound)
+	}
+	copied := *series
+	return &copied, nil
+}
+
+// Update stores a copy of the series.
+func (m *MockTaskSeriesRepository) Update(_ context.Context, series *domain.TaskSeries) error {
+	if err := series.Validate(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.Series[series.ID]; !exists {
+		return fmt.Errorf("task series %s: %w", series.ID, repository.ErrNotFound)
+	}
+	stored := *series
+	m.Series[series.ID] = &stored
+	return nil
+}
+
+// ListDue retrieves copies of the active series that are due, earliest first.
+func (m *MockTaskSeriesRepository) ListDue(_ context.Context, now time.Time, limit int) ([]*domain.TaskSeries, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var due []*domain.TaskSeries
+	for _, series := range m.Series {
+		if series.IsDue(now) {
+			copied := *series
+			due = append(due, &copied)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].LatestAt.Before(due[j].LatestAt)
+	})
+	if limit > 0 && len(due) > limit {
+		due = due[:limit]
+	}
+	return due, nil
+}
+
+// MockProjectInvitationRepository implements ProjectInvitationRepository for testing.
+// Like the real repository it keeps tokens apart from the invitations, so stored copies
+// never carry the raw token.
+type MockProjectInvitationRepository struct {
+	Invitations map[string]*domain.ProjectInvitation
+	tokens      map[string]string // raw token -> invitation ID
+	mu          sync.RWMutex
+	nextID      int
+}
+
+// NewMockProjectInvitationRepository creates a new mock project invitation repository.
+func NewMockProjectInvitationRepository() *MockProjectInvitationRepository {
+	return &MockProjectInvitationRepository{
+		Invitations: make(map[string]*domain.ProjectInvitation),
+		tokens:      make(map[string]string),
+	}
+}
+
+// Create stores a copy of a new invitation without its raw token.
+func (m *MockProjectInvitationRepository) Create(_ context.Context, invitation *domain.ProjectInvitation) error {
+	if err := invitation.Validate(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.nextID++
+	invitation.ID = fmt.Sprintf("invitation-%d", m.nextID)
+	invitation.CreatedAt = time.Now().UTC()
+	invitation.UpdatedAt = invitation.CreatedAt
+
+	stored := *invitation
+	stored.Token = ""
+	m.Invitations[invitation.ID] = &stored
+	m.tokens[invitation.Token] = invitation.ID
+	return nil
+}
+
+// GetByID retrieves a copy of an invitation.
+func (m *MockProjectInvitationRepository) GetByID(_ context.Context, id string) (*domain.ProjectInvitation, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	invitation, exists := m.Invitations[id]
+	if !exists {
+		return nil, domain.NewNotFoundError("INVITATION_NOT_FOUND", "Invitation not found")
+	}
+	copied := *invitation
+	return &copied, nil
+}
+
+// GetByToken retrieves a copy of the invitation with the raw token.
+func (m *MockProjectInvitationRepository) GetByToken(
+	ctx context.Context, token string,
+) (*domain.ProjectInvitation, error) {
+	m.mu.RLock()
+	id, exists := m.tokens[token]
+	m.mu.RUnlock()
+	if !exists {
+		return nil, domain.NewNotFoundError("INVITATION_NOT_FOUND", "Invitation not found")
+	}
+	return m.GetByID(ctx, id)
+}
+
+// Update stores the invitation's status and use count.
+func (m *MockProjectInvitationRepository) Update(_ context.Context, invitation *domain.ProjectInvitation) error {
+	if err := invitation.Validate(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, exists := m.Invitations[invitation.ID]
+	if !exists {
+		return domain.NewNotFoundError("INVITATION_NOT_FOUND", "Invitation not found")
+	}
+	stored.Status = invitation.Status
+	stored.UseCount = invitation.UseCount
+	stored.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// ListPendingByProject retrieves copies of a project's pending invitations, newest first.
+func (m *MockProjectInvitationRepository) ListPendingByProject(
+	_ context.Context, projectID string,
+) ([]*domain.ProjectInvitation, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	invitations := make([]*domain.ProjectInvitation, 0)
+	for _, invitation := range m.Invitations {
+		if invitation.ProjectID == projectID && invitation.Status == domain.InvitationPending {
+			copied := *invitation
+			invitations = append(invitations, &copied)
+		}
+	}
+	sort.Slice(invitations, func(i, j int) bool {
+		return invitations[i].CreatedAt.After(invitations[j].CreatedAt)
+	})
+	return invitations, nil
+}
+
+// MockPersonalAccessTokenRepository implements PersonalAccessTokenRepository for testing.
+// Like the real repository it keeps raw tokens apart from the stored copies.
+type MockPersonalAccessTokenRepository struct {
+	Tokens map[string]*domain.PersonalAccessToken
+	raw    map[string]string // raw token -> token ID
+	mu     sync.RWMutex
+	nextID int
+}
+
+// NewMockPersonalAccessTokenRepository creates a new mock personal access token repository.
+func NewMockPersonalAccessTokenRepository() *MockPersonalAccessTokenRepository {
+	return &MockPersonalAccessTokenRepository{
+		Tokens: make(map[string]*domain.PersonalAccessToken),
+		raw:    make(map[string]string),
+	}
+}
+
+// Create stores a copy of a new token without its raw value.
+func (m *MockPersonalAccessTokenRepository) Create(_ context.Context, token *domain.PersonalAccessToken) error {
+	if err := token.Validate(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.nextID++
+	token.ID = fmt.Sprintf("pat-%d", m.nextID)
+	token.CreatedAt = time.Now().UTC()
+	token.UpdatedAt = token.CreatedAt
+
+	stored := *token
+	stored.Token = ""
+	m.Tokens[token.ID] = &stored
+	m.raw[token.Token] = token.ID
+	return nil
+}
+
+// GetByID retrieves a copy of a token.
+func (m *MockPersonalAccessTokenRepository) GetByID(_ context.Context, id string) (*domain.PersonalAccessToken, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	token, exists := m.Tokens[id]
+	if !exists {
+		return nil, domain.NewNotFoundError("TOKEN_NOT_FOUND", "Personal access token not found")
+	}
+	copied := *token
+	return &copied, nil
+}
+
+// GetByToken retrieves a copy of the token with the raw value.
+func (m *MockPersonalAccessTokenRepository) GetByToken(
+	ctx context.Context, token string,
+) (*domain.PersonalAccessToken, error) {
+	m.mu.RLock()
+	id, exists := m.raw[token]
+	m.mu.RUnlock()
+	if !exists {
+		return nil, domain.NewNotFoundError("TOKEN_NOT_FOUND", "Personal access token not found")
+	}
+	return m.GetByID(ctx, id)
+}
+
+// Update stores when the token was last used and revoked.
+func (m *MockPersonalAccessTokenRepository) Update(_ context.Context, token *domain.PersonalAccessToken) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, exists := m.Tokens[token.ID]
+	if !exists {
+		return domain.NewNotFoundError("TOKEN_NOT_FOUND", "Personal access token not found")
+	}
+	stored.LastUsedAt = token.LastUsedAt
+	stored.RevokedAt = token.RevokedAt
+	stored.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// ListByUser retrieves copies of a user's tokens, newest first.
+func (m *MockPersonalAccessTokenRepository) ListByUser(
+	_ context.Context, userID string,
+) ([]*domain.PersonalAccessToken, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	tokens := make([]*domain.PersonalAccessToken, 0)
+	for _, token := range m.Tokens {
+		if token.UserID == userID {
+			copied := *token
+			tokens = append(tokens, &copied)
+		}
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].CreatedAt.After(tokens[j].CreatedAt)
+	})
+	return tokens, nil
+}
+
+// MockUserMFARepository implements UserMFARepository for testing.
+type MockUserMFARepository struct {
+	Records map[string]*domain.UserMFA // by user ID
+	mu      sync.RWMutex
+}
+
+// NewMockUserMFARepository creates a new mock two-factor authentication repository.
+func NewMockUserMFARepository() *MockUserMFARepository {
+	return &MockUserMFARepository{Records: make(map[string]*domain.UserMFA)}
+}
+
+// GetByUserID retrieves a copy of a user's two-factor authentication.
+func (m *MockUserMFARepository) GetByUserID(_ context.Context, userID string) (*domain.UserMFA, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	mfa, exists := m.Records[userID]
+	if !exists {
+		return nil, domain.NewNotFoundError("MFA_NOT_FOUND", "Two-factor authentication is not set up")
+	}
+	copied := *mfa
+	copied.RecoveryCodes = append([]string(nil), mfa.RecoveryCodes...)
+	return &copied, nil
+}
+
+// Save stores a copy of a user's two-factor authentication.
+func (m *MockUserMFARepository) Save(_ context.Context, mfa *domain.UserMFA) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now().UTC()
+	if existing, exists := m.Records[mfa.UserID]; exists {
+		mfa.ID = existing.ID
+		mfa.CreatedAt = existing.CreatedAt
+	} else {
+		mfa.ID = "mfa-" + mfa.UserID
+		mfa.CreatedAt = now
+	}
+	mfa.UpdatedAt = now
+
+	stored := *mfa
+	stored.RecoveryCodes = append([]string(nil), mfa.RecoveryCodes...)
+	m.Records[mfa.UserID] = &stored
+	return nil
+}
+
+// Delete removes a user's two-factor authentication.
+func (m *MockUserMFARepository) Delete(_ context.Context, userID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.Records, userID)
+	return nil
+}
+
+// MockSessionRepository implements SessionRepository for testing.
+type MockSessionRepository struct {
+	Sessions map[string]*domain.Session
+	mu       sync.RWMutex
+	nextID   int
+}
+
+// NewMockSessionRepository creates a new mock session repository.
+func NewMockSessionRepository() *MockSessionRepository {
+	return &MockSessionRepository{Sessions: make(map[string]*domain.Session)}
+}
+
+// Create stores a copy of a session.
+func (m *MockSessionRepository) Create(_ context.Context, session *domain.Session) error {
+	if err := session.Validate(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.nextID++
+	session.ID = fmt.Sprintf("session-%d", m.nextID)
+	session.CreatedAt = time.Now().UTC()
+
+	stored := *session
+	m.Sessions[session.ID] = &stored
+	return nil
+}
+
+// GetByID retrieves a copy of a session.
+func (m *MockSessionRepository) GetByID(_ context.Context, id string) (*domain.Session, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	session, exists := m.Sessions[id]
+	if !exists {
+		return nil, domain.NewNotFoundError("SESSION_NOT_FOUND", "Session not found")
+	}
+	copied := *session
+	return &copied, nil
+}
+
+// Update stores a copy of a session.
+func (m *MockSessionRepository) Update(_ context.Context, session *domain.Session) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.Sessions[session.ID]; !exists {
+		return domain.NewNotFoundError("SESSION_NOT_FOUND", "Session not found")
+	}
+	stored := *session
+	m.Sessions[session.ID] = &stored
+	return nil
+}
+
+// Rotate stores a copy of a session if its refresh token is still previousRefreshTokenID.
+func (m *MockSessionRepository) Rotate(
+	_ context.Context, session *domain.Session, previousRefreshTokenID string,
+) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, exists := m.Sessions[session.ID]
+	if !exists || stored.RevokedAt != nil || stored.RefreshTokenID != previousRefreshTokenID {
+		return false, nil
+	}
+	updated := *session
+	m.Sessions[session.ID] = &updated
+	return true, nil
+}
+
+// ListByUser retrieves copies of a user's sessions, most recently seen first.
+func (m *MockSessionRepository) ListByUser(_ context.Context, userID string) ([]*domain.Session, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	sessions := make([]*domain.Session, 0)
+	for _, session := range m.Sessions {
+		if session.UserID == userID {
+			copied := *session
+			sessions = append(sessions, &copied)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeenAt.After(sessions[j].LastSeenAt)
+	})
+	return sessions, nil
+}
+
+// DeleteExpired removes sessions whose refresh tokens have expired.
+func (m *MockSessionRepository) DeleteExpired(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, session := range m.Sessions {
+		if session.IsExpired() {
+			delete(m.Sessions, id)
+		}
+	}
+	return nil
+}
+
+// nopReadSeekCloser lets in-memory content stand in for an open file
+type nopReadSeekCloser struct {
+	*bytes.Reader
+}
+
+// Close does nothing
+func (nopReadSeekCloser) Close() error {
+	return nil
+}
+
 // Ensure interfaces are implemented
 var (
-	_ repository.UserRepository    = (*MockUserRepository)(nil)
-	_ repository.ProjectRepository = (*MockProjectRepository)(nil)
-	_ repository.TaskRepository    = (*MockTaskRepository)(nil)
+	_ repository.UserRepository                = (*MockUserRepository)(nil)
+	_ repository.ProjectRepository             = (*MockProjectRepository)(nil)
+	_ repository.TaskRepository                = (*MockTaskRepository)(nil)
+	_ repository.TaskHistoryRepository         = (*MockTaskHistoryRepository)(nil)
+	_ repository.WIPLimitRepository            = (*MockWIPLimitRepository)(nil)
+	_ repository.SearchRepository              = (*MockSearchRepository)(nil)
+	_ repository.EmailOutboxRepository         = (*MockEmailOutboxRepository)(nil)
+	_ repository.EventLogRepository            = (*MockEventLogRepository)(nil)
+	_ repository.WebhookRepository             = (*MockWebhookRepository)(nil)
+	_ repository.WebhookDeliveryRepository     = (*MockWebhookDeliveryRepository)(nil)
+	_ repository.NotificationRepository        = (*MockNotificationRepository)(nil)
+	_ repository.CommentRepository             = (*MockCommentRepository)(nil)
+	_ repository.CommentMentionRepository      = (*MockCommentMentionRepository)(nil)
+	_ repository.CommentReactionRepository     = (*MockCommentReactionRepository)(nil)
+	_ repository.AttachmentRepository          = (*MockAttachmentRepository)(nil)
+	_ repository.TaskSeriesRepository          = (*MockTaskSeriesRepository)(nil)
+	_ repository.ProjectInvitationRepository   = (*MockProjectInvitationRepository)(nil)
+	_ repository.PersonalAccessTokenRepository = (*MockPersonalAccessTokenRepository)(nil)
+	_ repository.UserMFARepository             = (*MockUserMFARepository)(nil)
+	_ repository.SessionRepository             = (*MockSessionRepository)(nil)
 )
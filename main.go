@@ -10,6 +10,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/markdown"
 )
 
 const (
@@ -474,6 +476,8 @@ func taskListHandler(w http.ResponseWriter, _ *http.Request) {
 // Helper functions
 func generateTaskCardHTML(task Task) string {
 	priorityClass := getPriorityClass(task.Priority)
+	title := template.HTMLEscapeString(task.Title)
+	priority := template.HTMLEscapeString(task.Priority)
 
 	return fmt.Sprintf(`
 <div class="bg-gray-50 p-3 rounded-lg border border-gray-200 task-card %s" 
@@ -486,7 +490,7 @@ func generateTaskCardHTML(task Task) string {
         <h3 class="text-sm font-medium text-gray-900">%s</h3>
         <span class="px-2 py-1 text-xs rounded-full %s" aria-label="%s priority">%s</span>
     </div>
-    <p id="task-%d-description" class="text-sm text-gray-600">%s</p>
+    <div id="task-%d-description" class="text-sm text-gray-600 task-description">%s</div>
     <div class="mt-2 text-xs text-gray-500">
         <time datetime="%s">Created: %s</time>
     </div>
@@ -494,14 +498,14 @@ func generateTaskCardHTML(task Task) string {
 		priorityClass,
 		task.ID,
 		task.ID,
-		task.Title,
-		task.Priority,
-		task.Title,
+		title,
+		priority,
+		title,
 		getPriorityBadgeClass(task.Priority),
-		task.Priority,
-		task.Priority,
+		priority,
+		priority,
 		task.ID,
-		task.Description,
+		markdown.Render(task.Description),
 		task.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		task.CreatedAt.Format("Jan 2, 15:04"))
 }
@@ -0,0 +1,62 @@
+package migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Keep task history after the task itself is deleted so audits can still
+		// see who deleted it. The task relation is cleared on delete instead of
+		// cascading, and task_id keeps the original task ID for filtering.
+
+		collection, err := app.FindCollectionByNameOrId("task_history")
+		if err != nil {
+			return err
+		}
+
+		if taskField, ok := collection.Fields.GetByName("task").(*core.RelationField); ok {
+			taskField.CascadeDelete = false
+			taskField.Required = false
+		}
+
+		collection.Fields.Add(&core.TextField{
+			Id:   "task_id_field",
+			Name: "task_id",
+			Max:  50,
+		})
+
+		collection.AddIndex("idx_task_history_task_id_created", false, "task_id, created", "")
+
+		if err := app.Save(collection); err != nil {
+			return err
+		}
+
+		// Backfill task_id for entries written before this migration
+		_, err = app.DB().NewQuery("UPDATE task_history SET task_id = task WHERE task_id = '' OR task_id IS NULL").
+			Execute()
+		return err
+	}, func(app core.App) error {
+		// Rollback: restore cascading deletes and drop task_id. Entries whose
+		// task is already gone cannot satisfy the required relation again.
+		if _, err := app.DB().NewQuery("DELETE FROM task_history WHERE task = '' OR task IS NULL").Execute(); err != nil {
+			return err
+		}
+
+		collection, err := app.FindCollectionByNameOrId("task_history")
+		if err != nil {
+			return err
+		}
+
+		if taskField, ok := collection.Fields.GetByName("task").(*core.RelationField); ok {
+			taskField.CascadeDelete = true
+			taskField.Required = true
+		}
+
+		collection.RemoveIndex("idx_task_history_task_id_created")
+		collection.Fields.RemoveByName("task_id")
+
+		return app.Save(collection)
+	})
+}
@@ -0,0 +1,76 @@
+package migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		projects, err := app.FindCollectionByNameOrId("projects")
+		if err != nil {
+			return err
+		}
+		tasks, err := app.FindCollectionByNameOrId("tasks")
+		if err != nil {
+			return err
+		}
+		users, err := app.FindCollectionByNameOrId("users")
+		if err != nil {
+			return err
+		}
+
+		// Per-project, per-status WIP limits; removed along with their project
+		limits := core.NewBaseCollection("wip_limits")
+		limits.Fields.Add(
+			&core.RelationField{
+				Id: "wip_project", Name: "project", CollectionId: projects.Id,
+				Required: true, CascadeDelete: true, MaxSelect: 1,
+			},
+			&core.TextField{Id: "wip_status", Name: "status", Required: true, Max: 50},
+			&core.NumberField{Id: "wip_soft_limit", Name: "soft_limit", OnlyInt: true},
+			&core.NumberField{Id: "wip_hard_limit", Name: "hard_limit", OnlyInt: true},
+			&core.BoolField{Id: "wip_enabled", Name: "enabled"},
+			&core.AutodateField{Id: "wip_created", Name: "created", OnCreate: true},
+			&core.AutodateField{Id: "wip_updated", Name: "updated", OnCreate: true, OnUpdate: true},
+		)
+		limits.AddIndex("idx_wip_limits_project_status", true, "project, status", "")
+
+		if err := app.Save(limits); err != nil {
+			return err
+		}
+
+		// Audit trail of moves that bypassed a hard limit. The trail goes with its
+		// project; deleting the task or user only clears the reference.
+		overrides := core.NewBaseCollection("wip_limit_overrides")
+		overrides.Fields.Add(
+			&core.RelationField{
+				Id: "wipo_project", Name: "project", CollectionId: projects.Id,
+				Required: true, CascadeDelete: true, MaxSelect: 1,
+			},
+			&core.RelationField{Id: "wipo_task", Name: "task", CollectionId: tasks.Id, MaxSelect: 1},
+			&core.RelationField{Id: "wipo_user", Name: "user", CollectionId: users.Id, MaxSelect: 1},
+			&core.TextField{Id: "wipo_status", Name: "status", Required: true, Max: 50},
+			&core.TextField{Id: "wipo_reason", Name: "reason", Required: true, Max: 50},
+			&core.TextField{Id: "wipo_comment", Name: "comment", Max: 1000},
+			&core.NumberField{Id: "wipo_hard_limit", Name: "hard_limit", OnlyInt: true},
+			&core.NumberField{Id: "wipo_column_count", Name: "column_count", OnlyInt: true},
+			&core.AutodateField{Id: "wipo_created", Name: "created", OnCreate: true},
+		)
+		overrides.AddIndex("idx_wip_limit_overrides_project_created", false, "project, created", "")
+
+		return app.Save(overrides)
+	}, func(app core.App) error {
+		// Rollback: drop both collections
+		for _, name := range []string{"wip_limit_overrides", "wip_limits"} {
+			collection, err := app.FindCollectionByNameOrId(name)
+			if err != nil {
+				continue // Collection doesn't exist, nothing to rollback
+			}
+			if err := app.Delete(collection); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
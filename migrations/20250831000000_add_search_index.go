@@ -0,0 +1,99 @@
+package migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+// searchIndexStatements create the FTS5 index over tasks and comments. Triggers
+// keep it in sync with every write, whichever code path performs it. Column
+// order matters to the repository's highlight, snippet and bm25 calls.
+var searchIndexStatements = []string{
+	`CREATE VIRTUAL TABLE search_index USING fts5(
+		doc_type UNINDEXED,
+		doc_id UNINDEXED,
+		task_id UNINDEXED,
+		project_id UNINDEXED,
+		title,
+		body,
+		tags,
+		assignee,
+		custom_fields,
+		tokenize = 'unicode61 remove_diacritics 2',
+		prefix = '2 3'
+	)`,
+
+	`CREATE TRIGGER search_index_task_insert AFTER INSERT ON tasks BEGIN
+		INSERT INTO search_index (doc_type, doc_id, task_id, project_id, title, body, tags, assignee, custom_fields)
+		VALUES ('task', NEW.id, NEW.id, NEW.project, NEW.title, NEW.description, ` + searchTagsExpr("NEW.tags") + `, NEW.assignee, NEW.custom_fields);
+	END`,
+
+	`CREATE TRIGGER search_index_task_update AFTER UPDATE ON tasks BEGIN
+		DELETE FROM search_index WHERE doc_type = 'task' AND doc_id = OLD.id;
+		INSERT INTO search_index (doc_type, doc_id, task_id, project_id, title, body, tags, assignee, custom_fields)
+		VALUES ('task', NEW.id, NEW.id, NEW.project, NEW.title, NEW.description, ` + searchTagsExpr("NEW.tags") + `, NEW.assignee, NEW.custom_fields);
+		UPDATE search_index SET project_id = NEW.project
+			WHERE doc_type = 'comment' AND task_id = NEW.id AND NEW.project IS NOT OLD.project;
+	END`,
+
+	`CREATE TRIGGER search_index_task_delete AFTER DELETE ON tasks BEGIN
+		DELETE FROM search_index WHERE task_id = OLD.id;
+	END`,
+
+	`CREATE TRIGGER search_index_comment_insert AFTER INSERT ON comments BEGIN
+		INSERT INTO search_index (doc_type, doc_id, task_id, project_id, body)
+		VALUES ('comment', NEW.id, NEW.task, COALESCE((SELECT project FROM tasks WHERE id = NEW.task), ''), NEW.content);
+	END`,
+
+	`CREATE TRIGGER search_index_comment_update AFTER UPDATE ON comments BEGIN
+		DELETE FROM search_index WHERE doc_type = 'comment' AND doc_id = OLD.id;
+		INSERT INTO search_index (doc_type, doc_id, task_id, project_id, body)
+		VALUES ('comment', NEW.id, NEW.task, COALESCE((SELECT project FROM tasks WHERE id = NEW.task), ''), NEW.content);
+	END`,
+
+	`CREATE TRIGGER search_index_comment_delete AFTER DELETE ON comments BEGIN
+		DELETE FROM search_index WHERE doc_type = 'comment' AND doc_id = OLD.id;
+	END`,
+
+	// Backfill existing data
+	`INSERT INTO search_index (doc_type, doc_id, task_id, project_id, title, body, tags, assignee, custom_fields)
+		SELECT 'task', id, id, project, title, description, ` + searchTagsExpr("tags") + `, assignee, custom_fields FROM tasks`,
+
+	`INSERT INTO search_index (doc_type, doc_id, task_id, project_id, body)
+		SELECT 'comment', c.id, c.task, COALESCE(t.project, ''), c.content
+		FROM comments c LEFT JOIN tasks t ON t.id = c.task`,
+}
+
+// searchTagsExpr flattens a tasks.tags JSON array into space separated words,
+// so tag matches highlight as plain text rather than JSON
+func searchTagsExpr(column string) string {
+	return "CASE WHEN json_valid(" + column + ") THEN " +
+		"(SELECT group_concat(value, ' ') FROM json_each(" + column + ")) ELSE '' END"
+}
+
+func init() {
+	m.Register(func(app core.App) error {
+		for _, statement := range searchIndexStatements {
+			if _, err := app.DB().NewQuery(statement).Execute(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, func(app core.App) error {
+		// Rollback: dropping the table leaves plain LIKE search in place
+		for _, statement := range []string{
+			"DROP TRIGGER IF EXISTS search_index_task_insert",
+			"DROP TRIGGER IF EXISTS search_index_task_update",
+			"DROP TRIGGER IF EXISTS search_index_task_delete",
+			"DROP TRIGGER IF EXISTS search_index_comment_insert",
+			"DROP TRIGGER IF EXISTS search_index_comment_update",
+			"DROP TRIGGER IF EXISTS search_index_comment_delete",
+			"DROP TABLE IF EXISTS search_index",
+		} {
+			if _, err := app.DB().NewQuery(statement).Execute(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,63 @@
+package migrations
+
+import (
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+// defaultWorkflowStatuses are the statuses of the default project workflow
+var defaultWorkflowStatuses = []interface{}{"backlog", "todo", "developing", "review", "complete"}
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Projects define their own statuses now, so the fixed select becomes free text.
+		// Both store a single value in a TEXT column, so existing values are kept as they are.
+		collection, err := app.FindCollectionByNameOrId("tasks")
+		if err != nil {
+			return err
+		}
+
+		collection.Fields.Add(&core.TextField{
+			Id:      "status_field",
+			Name:    "status",
+			Max:     50,
+			Pattern: `^[a-z][a-z0-9_-]*$`,
+		})
+
+		if err := app.Save(collection); err != nil {
+			return err
+		}
+
+		// Map existing tasks onto the default workflow: the select allowed an empty
+		// status, which the default workflow doesn't have, so those tasks start in todo
+		_, err = app.DB().Update("tasks",
+			dbx.Params{"status": "todo"},
+			dbx.Not(dbx.In("status", defaultWorkflowStatuses...)),
+		).Execute()
+		return err
+	}, func(app core.App) error {
+		// Rollback: custom statuses can't be represented by the select, so their tasks go back to todo
+		_, err := app.DB().Update("tasks",
+			dbx.Params{"status": "todo"},
+			dbx.Not(dbx.In("status", defaultWorkflowStatuses...)),
+		).Execute()
+		if err != nil {
+			return err
+		}
+
+		collection, err := app.FindCollectionByNameOrId("tasks")
+		if err != nil {
+			return err
+		}
+
+		collection.Fields.Add(&core.SelectField{
+			Id:        "status_field",
+			Name:      "status",
+			MaxSelect: 1,
+			Values:    []string{"backlog", "todo", "developing", "review", "complete"},
+		})
+
+		return app.Save(collection)
+	})
+}
@@ -0,0 +1,42 @@
+package migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Rendered emails waiting for delivery, retried with backoff until sent or given up.
+		// No API rules: bodies can hold one-time links, so only the server reads the outbox.
+		outbox := core.NewBaseCollection("email_outbox")
+		outbox.Fields.Add(
+			&core.EmailField{Id: "outbox_to", Name: "to", Required: true},
+			&core.TextField{Id: "outbox_subject", Name: "subject", Required: true, Max: 200},
+			&core.TextField{Id: "outbox_text_body", Name: "text_body", Max: 100000},
+			&core.TextField{Id: "outbox_html_body", Name: "html_body", Max: 200000},
+			&core.TextField{Id: "outbox_template", Name: "template", Max: 100},
+			&core.SelectField{
+				Id: "outbox_status", Name: "status", Required: true, MaxSelect: 1,
+				Values: []string{"pending", "sent", "failed"},
+			},
+			&core.NumberField{Id: "outbox_attempts", Name: "attempts", OnlyInt: true},
+			&core.NumberField{Id: "outbox_max_attempts", Name: "max_attempts", OnlyInt: true},
+			&core.TextField{Id: "outbox_last_error", Name: "last_error", Max: 500},
+			&core.DateField{Id: "outbox_next_attempt_at", Name: "next_attempt_at"},
+			&core.DateField{Id: "outbox_sent_at", Name: "sent_at"},
+			&core.AutodateField{Id: "outbox_created", Name: "created", OnCreate: true},
+			&core.AutodateField{Id: "outbox_updated", Name: "updated", OnCreate: true, OnUpdate: true},
+		)
+		outbox.AddIndex("idx_email_outbox_status_next_attempt", false, "status, next_attempt_at", "")
+
+		return app.Save(outbox)
+	}, func(app core.App) error {
+		// Rollback: drop the outbox
+		collection, err := app.FindCollectionByNameOrId("email_outbox")
+		if err != nil {
+			return nil // Collection doesn't exist, nothing to rollback
+		}
+		return app.Delete(collection)
+	})
+}
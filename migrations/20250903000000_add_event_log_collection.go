@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		// Bounded log of broadcast task events, keyed by a monotonic sequence that SSE
+		// clients send back as Last-Event-ID to replay what they missed while disconnected.
+		// No API rules: replay goes through the realtime handler, which applies subscriptions.
+		eventLog := core.NewBaseCollection("event_log")
+		eventLog.Fields.Add(
+			&core.NumberField{Id: "event_log_sequence", Name: "sequence", Required: true, OnlyInt: true},
+			&core.TextField{Id: "event_log_event_id", Name: "event_id", Required: true, Max: 100},
+			&core.TextField{Id: "event_log_type", Name: "type", Required: true, Max: 50},
+			&core.TextField{Id: "event_log_project_id", Name: "project_id", Max: 50},
+			&core.JSONField{Id: "event_log_payload", Name: "payload", Required: true, MaxSize: 1 << 20},
+			&core.AutodateField{Id: "event_log_created", Name: "created", OnCreate: true},
+		)
+		eventLog.AddIndex("idx_event_log_sequence", true, "sequence", "")
+
+		return app.Save(eventLog)
+	}, func(app core.App) error {
+		// Rollback: drop the event log
+		collection, err := app.FindCollectionByNameOrId("event_log")
+		if err != nil {
+			return nil // Collection doesn't exist, nothing to rollback
+		}
+		return app.Delete(collection)
+	})
+}
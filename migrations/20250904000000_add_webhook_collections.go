@@ -0,0 +1,91 @@
+package migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		projects, err := app.FindCollectionByNameOrId("projects")
+		if err != nil {
+			return err
+		}
+		users, err := app.FindCollectionByNameOrId("users")
+		if err != nil {
+			return err
+		}
+
+		// Outbound webhook endpoints per project; removed along with their project.
+		// No API rules: the signing secret is only ever read by the server.
+		webhooks := core.NewBaseCollection("webhooks")
+		webhooks.Fields.Add(
+			&core.RelationField{
+				Id: "webhook_project", Name: "project", CollectionId: projects.Id,
+				Required: true, CascadeDelete: true, MaxSelect: 1,
+			},
+			&core.TextField{Id: "webhook_url", Name: "url", Required: true, Max: 2000},
+			&core.TextField{Id: "webhook_description", Name: "description", Max: 200},
+			&core.TextField{Id: "webhook_secret", Name: "secret", Required: true, Max: 200, Hidden: true},
+			&core.JSONField{Id: "webhook_event_types", Name: "event_types", MaxSize: 10000},
+			&core.BoolField{Id: "webhook_active", Name: "active"},
+			&core.NumberField{Id: "webhook_consecutive_failures", Name: "consecutive_failures", OnlyInt: true},
+			&core.DateField{Id: "webhook_disabled_at", Name: "disabled_at"},
+			&core.TextField{Id: "webhook_disabled_reason", Name: "disabled_reason", Max: 200},
+			&core.RelationField{Id: "webhook_created_by", Name: "created_by", CollectionId: users.Id, MaxSelect: 1},
+			&core.AutodateField{Id: "webhook_created", Name: "created", OnCreate: true},
+			&core.AutodateField{Id: "webhook_updated", Name: "updated", OnCreate: true, OnUpdate: true},
+		)
+		webhooks.AddIndex("idx_webhooks_project_active", false, "project, active", "")
+
+		if err := app.Save(webhooks); err != nil {
+			return err
+		}
+
+		// Queued and attempted deliveries, which double as each webhook's delivery log
+		deliveries := core.NewBaseCollection("webhook_deliveries")
+		deliveries.Fields.Add(
+			&core.RelationField{
+				Id: "delivery_webhook", Name: "webhook", CollectionId: webhooks.Id,
+				Required: true, CascadeDelete: true, MaxSelect: 1,
+			},
+			&core.RelationField{
+				Id: "delivery_project", Name: "project", CollectionId: projects.Id,
+				Required: true, CascadeDelete: true, MaxSelect: 1,
+			},
+			&core.TextField{Id: "delivery_event_id", Name: "event_id", Required: true, Max: 100},
+			&core.TextField{Id: "delivery_event_type", Name: "event_type", Required: true, Max: 50},
+			&core.TextField{Id: "delivery_payload", Name: "payload", Required: true, Max: 1 << 20},
+			&core.SelectField{
+				Id: "delivery_status", Name: "status", Required: true, MaxSelect: 1,
+				Values: []string{"pending", "succeeded", "failed"},
+			},
+			&core.NumberField{Id: "delivery_attempts", Name: "attempts", OnlyInt: true},
+			&core.NumberField{Id: "delivery_max_attempts", Name: "max_attempts", OnlyInt: true},
+			&core.NumberField{Id: "delivery_response_status", Name: "response_status", OnlyInt: true},
+			&core.NumberField{Id: "delivery_duration_ms", Name: "duration_ms", OnlyInt: true},
+			&core.TextField{Id: "delivery_last_error", Name: "last_error", Max: 500},
+			&core.TextField{Id: "delivery_redelivery_of", Name: "redelivery_of", Max: 50},
+			&core.DateField{Id: "delivery_next_attempt_at", Name: "next_attempt_at"},
+			&core.DateField{Id: "delivery_delivered_at", Name: "delivered_at"},
+			&core.AutodateField{Id: "delivery_created", Name: "created", OnCreate: true},
+			&core.AutodateField{Id: "delivery_updated", Name: "updated", OnCreate: true, OnUpdate: true},
+		)
+		deliveries.AddIndex("idx_webhook_deliveries_status_next_attempt", false, "status, next_attempt_at", "")
+		deliveries.AddIndex("idx_webhook_deliveries_webhook_created", false, "webhook, created", "")
+
+		return app.Save(deliveries)
+	}, func(app core.App) error {
+		// Rollback: drop both collections
+		for _, name := range []string{"webhook_deliveries", "webhooks"} {
+			collection, err := app.FindCollectionByNameOrId(name)
+			if err != nil {
+				continue // Collection doesn't exist, nothing to rollback
+			}
+			if err := app.Delete(collection); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,55 @@
+package migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		projects, err := app.FindCollectionByNameOrId("projects")
+		if err != nil {
+			return err
+		}
+		users, err := app.FindCollectionByNameOrId("users")
+		if err != nil {
+			return err
+		}
+
+		// Per-user notification inbox; entries go away with their recipient or project.
+		// task_id is plain text so notifications about deleted tasks remain readable.
+		notifications := core.NewBaseCollection("notifications")
+		notifications.Fields.Add(
+			&core.RelationField{
+				Id: "notification_user", Name: "user", CollectionId: users.Id,
+				Required: true, CascadeDelete: true, MaxSelect: 1,
+			},
+			&core.RelationField{
+				Id: "notification_project", Name: "project", CollectionId: projects.Id,
+				Required: true, CascadeDelete: true, MaxSelect: 1,
+			},
+			&core.TextField{Id: "notification_task_id", Name: "task_id", Max: 50},
+			&core.TextField{Id: "notification_actor", Name: "actor", Max: 50},
+			&core.TextField{Id: "notification_event_id", Name: "event_id", Max: 100},
+			&core.SelectField{
+				Id: "notification_kind", Name: "kind", Required: true, MaxSelect: 1,
+				Values: []string{"assigned", "commented", "status_changed", "mentioned"},
+			},
+			&core.TextField{Id: "notification_task_title", Name: "task_title", Max: 200},
+			&core.TextField{Id: "notification_message", Name: "message", Required: true, Max: 500},
+			&core.DateField{Id: "notification_read_at", Name: "read_at"},
+			&core.AutodateField{Id: "notification_created", Name: "created", OnCreate: true},
+		)
+		notifications.AddIndex("idx_notifications_user_created", false, "user, created", "")
+		notifications.AddIndex("idx_notifications_user_read_at", false, "user, read_at", "")
+
+		return app.Save(notifications)
+	}, func(app core.App) error {
+		// Rollback: drop the notifications collection
+		collection, err := app.FindCollectionByNameOrId("notifications")
+		if err != nil {
+			return nil // Collection doesn't exist, nothing to rollback
+		}
+		return app.Delete(collection)
+	})
+}
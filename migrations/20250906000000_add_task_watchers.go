@@ -0,0 +1,47 @@
+package migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		tasks, err := app.FindCollectionByNameOrId("tasks")
+		if err != nil {
+			return err
+		}
+		users, err := app.FindCollectionByNameOrId("users")
+		if err != nil {
+			return err
+		}
+
+		// Users following a task; deleting a user drops them from the list
+		tasks.Fields.Add(&core.RelationField{
+			Id: "watchers_field", Name: "watchers", CollectionId: users.Id, MaxSelect: 999,
+		})
+
+		if err := app.Save(tasks); err != nil {
+			return err
+		}
+
+		// Reporters and assignees watch the tasks they already have, as they do for new ones
+		_, err = app.DB().NewQuery(`
+			UPDATE tasks SET watchers = CASE
+				WHEN assignee != '' AND assignee != reporter THEN json_array(reporter, assignee)
+				ELSE json_array(reporter)
+			END
+			WHERE reporter != ''
+		`).Execute()
+		return err
+	}, func(app core.App) error {
+		tasks, err := app.FindCollectionByNameOrId("tasks")
+		if err != nil {
+			return err
+		}
+
+		tasks.Fields.RemoveByName("watchers")
+
+		return app.Save(tasks)
+	})
+}
@@ -0,0 +1,53 @@
+package migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		comments, err := app.FindCollectionByNameOrId("comments")
+		if err != nil {
+			return err
+		}
+		tasks, err := app.FindCollectionByNameOrId("tasks")
+		if err != nil {
+			return err
+		}
+		users, err := app.FindCollectionByNameOrId("users")
+		if err != nil {
+			return err
+		}
+
+		// Users @mentioned in comments; a mention goes away with its comment, task or user
+		mentions := core.NewBaseCollection("comment_mentions")
+		mentions.Fields.Add(
+			&core.RelationField{
+				Id: "mention_comment", Name: "comment", CollectionId: comments.Id,
+				Required: true, CascadeDelete: true, MaxSelect: 1,
+			},
+			&core.RelationField{
+				Id: "mention_task", Name: "task", CollectionId: tasks.Id,
+				Required: true, CascadeDelete: true, MaxSelect: 1,
+			},
+			&core.RelationField{
+				Id: "mention_user", Name: "user", CollectionId: users.Id,
+				Required: true, CascadeDelete: true, MaxSelect: 1,
+			},
+			&core.TextField{Id: "mention_username", Name: "username", Max: 100},
+			&core.AutodateField{Id: "mention_created", Name: "created", OnCreate: true},
+		)
+		mentions.AddIndex("idx_comment_mentions_comment_user", true, "comment, user", "")
+		mentions.AddIndex("idx_comment_mentions_user_created", false, "user, created", "")
+
+		return app.Save(mentions)
+	}, func(app core.App) error {
+		// Rollback: drop the comment_mentions collection
+		collection, err := app.FindCollectionByNameOrId("comment_mentions")
+		if err != nil {
+			return nil // Collection doesn't exist, nothing to rollback
+		}
+		return app.Delete(collection)
+	})
+}
@@ -0,0 +1,68 @@
+package migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		comments, err := app.FindCollectionByNameOrId("comments")
+		if err != nil {
+			return err
+		}
+		users, err := app.FindCollectionByNameOrId("users")
+		if err != nil {
+			return err
+		}
+
+		// Resolved state of the thread a root comment starts
+		comments.Fields.Add(
+			&core.DateField{Id: "comment_resolved_at", Name: "resolved_at"},
+			&core.RelationField{
+				Id: "comment_resolved_by", Name: "resolved_by", CollectionId: users.Id, MaxSelect: 1,
+			},
+		)
+		comments.AddIndex("idx_comments_task_resolved_at", false, "task, resolved_at", "")
+
+		if err := app.Save(comments); err != nil {
+			return err
+		}
+
+		// One row per user and emoji; reactions go away with their comment or user
+		reactions := core.NewBaseCollection("comment_reactions")
+		reactions.Fields.Add(
+			&core.RelationField{
+				Id: "reaction_comment", Name: "comment", CollectionId: comments.Id,
+				Required: true, CascadeDelete: true, MaxSelect: 1,
+			},
+			&core.RelationField{
+				Id: "reaction_user", Name: "user", CollectionId: users.Id,
+				Required: true, CascadeDelete: true, MaxSelect: 1,
+			},
+			&core.TextField{Id: "reaction_emoji", Name: "emoji", Required: true, Max: 64},
+			&core.AutodateField{Id: "reaction_created", Name: "created", OnCreate: true},
+		)
+		reactions.AddIndex("idx_comment_reactions_comment_user_emoji", true, "comment, user, emoji", "")
+
+		return app.Save(reactions)
+	}, func(app core.App) error {
+		// Rollback: drop the comment_reactions collection and the resolution fields
+		if collection, err := app.FindCollectionByNameOrId("comment_reactions"); err == nil {
+			if err := app.Delete(collection); err != nil {
+				return err
+			}
+		}
+
+		comments, err := app.FindCollectionByNameOrId("comments")
+		if err != nil {
+			return nil // Collection doesn't exist, nothing to rollback
+		}
+
+		comments.RemoveIndex("idx_comments_task_resolved_at")
+		comments.Fields.RemoveByName("resolved_at")
+		comments.Fields.RemoveByName("resolved_by")
+
+		return app.Save(comments)
+	})
+}
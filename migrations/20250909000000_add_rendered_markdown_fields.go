@@ -0,0 +1,45 @@
+package migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+// renderedFields lists the markdown fields whose rendering is cached next to them. Existing
+// records are left without one; the repositories render those when they read them.
+var renderedFields = []struct{ collection, field string }{
+	{"tasks", "description"},
+	{"comments", "content"},
+}
+
+func init() {
+	m.Register(func(app core.App) error {
+		for _, rendered := range renderedFields {
+			name, field := rendered.collection, rendered.field
+			collection, err := app.FindCollectionByNameOrId(name)
+			if err != nil {
+				return err
+			}
+
+			collection.Fields.Add(&core.EditorField{Id: name + "_" + field + "_html", Name: field + "_html"})
+			if err := app.Save(collection); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, func(app core.App) error {
+		for _, rendered := range renderedFields {
+			name, field := rendered.collection, rendered.field
+			collection, err := app.FindCollectionByNameOrId(name)
+			if err != nil {
+				continue // Collection doesn't exist, nothing to rollback
+			}
+
+			collection.Fields.RemoveByName(field + "_html")
+			if err := app.Save(collection); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,65 @@
+package migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+// attachmentCollections are the collections that hold file attachments
+var attachmentCollections = []string{"tasks", "comments"}
+
+// attachmentMimeTypes matches the content types the API accepts. JSON and markdown are
+// listed because storage detects them more precisely than the API does.
+var attachmentMimeTypes = []string{
+	"image/jpeg",
+	"image/png",
+	"image/webp",
+	"image/gif",
+	"application/pdf",
+	"text/plain",
+	"text/markdown",
+	"application/json",
+}
+
+func init() {
+	m.Register(func(app core.App) error {
+		for _, name := range attachmentCollections {
+			collection, err := app.FindCollectionByNameOrId(name)
+			if err != nil {
+				return err
+			}
+
+			// Replaces the field of the same ID, keeping the files already stored in it
+			collection.Fields.Add(&core.FileField{
+				Id:        "attachments_field",
+				Name:      "attachments",
+				MaxSelect: 10,
+				MaxSize:   5 << 20,
+				MimeTypes: attachmentMimeTypes,
+				Thumbs:    []string{"100x100"},
+				Protected: true,
+			})
+
+			if err := app.Save(collection); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, func(app core.App) error {
+		for _, name := range attachmentCollections {
+			collection, err := app.FindCollectionByNameOrId(name)
+			if err != nil {
+				continue // Collection doesn't exist, nothing to rollback
+			}
+
+			if field, ok := collection.Fields.GetByName("attachments").(*core.FileField); ok {
+				field.Thumbs = nil
+			}
+
+			if err := app.Save(collection); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,70 @@
+package migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		projects, err := app.FindCollectionByNameOrId("projects")
+		if err != nil {
+			return err
+		}
+		tasks, err := app.FindCollectionByNameOrId("tasks")
+		if err != nil {
+			return err
+		}
+
+		// Recurring task series; they go away with their project. latest_task is plain text
+		// so deleting the latest occurrence doesn't take the series with it.
+		series := core.NewBaseCollection("task_series")
+		series.Fields.Add(
+			&core.RelationField{
+				Id: "task_series_project", Name: "project", CollectionId: projects.Id,
+				Required: true, CascadeDelete: true, MaxSelect: 1,
+			},
+			&core.TextField{Id: "task_series_created_by", Name: "created_by", Required: true, Max: 50},
+			&core.JSONField{Id: "task_series_rule", Name: "rule", Required: true, MaxSize: 2000},
+			&core.JSONField{Id: "task_series_template", Name: "template", Required: true, MaxSize: 200000},
+			&core.DateField{Id: "task_series_starts_at", Name: "starts_at", Required: true},
+			&core.DateField{Id: "task_series_latest_at", Name: "latest_at", Required: true},
+			&core.TextField{Id: "task_series_latest_task", Name: "latest_task", Max: 50},
+			&core.NumberField{Id: "task_series_occurrences", Name: "occurrences", OnlyInt: true},
+			&core.DateField{Id: "task_series_ended_at", Name: "ended_at"},
+			&core.AutodateField{Id: "task_series_created", Name: "created", OnCreate: true},
+			&core.AutodateField{Id: "task_series_updated", Name: "updated", OnCreate: true, OnUpdate: true},
+		)
+		// The scheduler looks for active series whose latest occurrence's date has arrived
+		series.AddIndex("idx_task_series_ended_at_latest_at", false, "ended_at, latest_at", "")
+
+		if err := app.Save(series); err != nil {
+			return err
+		}
+
+		// Occurrences point back at their series; ending a series leaves them in place
+		tasks.Fields.Add(&core.RelationField{
+			Id: "tasks_series", Name: "series", CollectionId: series.Id, MaxSelect: 1,
+		})
+		tasks.AddIndex("idx_tasks_series", false, "series", "")
+
+		return app.Save(tasks)
+	}, func(app core.App) error {
+		tasks, err := app.FindCollectionByNameOrId("tasks")
+		if err != nil {
+			return err
+		}
+
+		tasks.Fields.RemoveByName("series")
+		tasks.RemoveIndex("idx_tasks_series")
+		if err := app.Save(tasks); err != nil {
+			return err
+		}
+
+		collection, err := app.FindCollectionByNameOrId("task_series")
+		if err != nil {
+			return nil // Collection doesn't exist, nothing to rollback
+		}
+		return app.Delete(collection)
+	})
+}
@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"encoding/json"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		projects, err := app.FindCollectionByNameOrId("projects")
+		if err != nil {
+			return err
+		}
+
+		// Each member's project role, keyed by user ID; the owner's role isn't stored
+		projects.Fields.Add(&core.JSONField{Id: "projects_member_roles", Name: "member_roles", MaxSize: 100000})
+		if err := app.Save(projects); err != nil {
+			return err
+		}
+
+		// Everyone who is already a member keeps what they could do as a plain member
+		records, err := app.FindAllRecords("projects")
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			roles := make(map[string]string)
+			for _, memberID := range record.GetStringSlice("members") {
+				if memberID != record.GetString("owner") {
+					roles[memberID] = "member"
+				}
+			}
+
+			encoded, err := json.Marshal(roles)
+			if err != nil {
+				return err
+			}
+			_, err = app.DB().Update("projects",
+				dbx.Params{"member_roles": string(encoded)},
+				dbx.HashExp{"id": record.Id},
+			).Execute()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}, func(app core.App) error {
+		projects, err := app.FindCollectionByNameOrId("projects")
+		if err != nil {
+			return nil // Collection doesn't exist, nothing to rollback
+		}
+
+		projects.Fields.RemoveByName("member_roles")
+		return app.Save(projects)
+	})
+}
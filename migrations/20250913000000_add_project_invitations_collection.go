@@ -0,0 +1,51 @@
+package migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		projects, err := app.FindCollectionByNameOrId("projects")
+		if err != nil {
+			return err
+		}
+
+		// Invitations to join a project by email or shareable link; they go away with their
+		// project. Tokens are stored as HMAC hashes, like password reset tokens.
+		invitations := core.NewBaseCollection("project_invitations")
+		invitations.Fields.Add(
+			&core.RelationField{
+				Id: "project_invitations_project", Name: "project", CollectionId: projects.Id,
+				Required: true, CascadeDelete: true, MaxSelect: 1,
+			},
+			&core.TextField{Id: "project_invitations_inviter", Name: "inviter", Required: true, Max: 50},
+			&core.TextField{Id: "project_invitations_email", Name: "email", Max: 255},
+			&core.TextField{Id: "project_invitations_token", Name: "token", Required: true, Max: 64},
+			&core.SelectField{
+				Id: "project_invitations_role", Name: "role", Required: true, MaxSelect: 1,
+				Values: []string{"viewer", "commenter", "member", "maintainer"},
+			},
+			&core.SelectField{
+				Id: "project_invitations_status", Name: "status", Required: true, MaxSelect: 1,
+				Values: []string{"pending", "accepted", "declined", "revoked"},
+			},
+			&core.NumberField{Id: "project_invitations_max_uses", Name: "max_uses", OnlyInt: true},
+			&core.NumberField{Id: "project_invitations_use_count", Name: "use_count", OnlyInt: true},
+			&core.DateField{Id: "project_invitations_expires_at", Name: "expires_at", Required: true},
+			&core.AutodateField{Id: "project_invitations_created", Name: "created", OnCreate: true},
+			&core.AutodateField{Id: "project_invitations_updated", Name: "updated", OnCreate: true, OnUpdate: true},
+		)
+		invitations.AddIndex("idx_project_invitations_token", true, "token", "")
+		invitations.AddIndex("idx_project_invitations_project_status", false, "project, status", "")
+
+		return app.Save(invitations)
+	}, func(app core.App) error {
+		collection, err := app.FindCollectionByNameOrId("project_invitations")
+		if err != nil {
+			return nil // Collection doesn't exist, nothing to rollback
+		}
+		return app.Delete(collection)
+	})
+}
@@ -0,0 +1,47 @@
+package migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		users, err := app.FindCollectionByNameOrId("users")
+		if err != nil {
+			return err
+		}
+
+		// User-managed API tokens; they go away with their user. Tokens are stored as HMAC
+		// hashes, like password reset tokens, with a short hint to tell them apart.
+		tokens := core.NewBaseCollection("personal_access_tokens")
+		tokens.Fields.Add(
+			&core.RelationField{
+				Id: "personal_access_tokens_user", Name: "user", CollectionId: users.Id,
+				Required: true, CascadeDelete: true, MaxSelect: 1,
+			},
+			&core.TextField{Id: "personal_access_tokens_name", Name: "name", Required: true, Max: 100},
+			&core.TextField{Id: "personal_access_tokens_hint", Name: "hint", Max: 20},
+			&core.TextField{Id: "personal_access_tokens_token", Name: "token", Required: true, Max: 64},
+			&core.SelectField{
+				Id: "personal_access_tokens_scope", Name: "scope", Required: true, MaxSelect: 1,
+				Values: []string{"read", "tasks:write", "admin"},
+			},
+			&core.DateField{Id: "personal_access_tokens_expires_at", Name: "expires_at"},
+			&core.DateField{Id: "personal_access_tokens_last_used_at", Name: "last_used_at"},
+			&core.DateField{Id: "personal_access_tokens_revoked_at", Name: "revoked_at"},
+			&core.AutodateField{Id: "personal_access_tokens_created", Name: "created", OnCreate: true},
+			&core.AutodateField{Id: "personal_access_tokens_updated", Name: "updated", OnCreate: true, OnUpdate: true},
+		)
+		tokens.AddIndex("idx_personal_access_tokens_token", true, "token", "")
+		tokens.AddIndex("idx_personal_access_tokens_user", false, "user", "")
+
+		return app.Save(tokens)
+	}, func(app core.App) error {
+		collection, err := app.FindCollectionByNameOrId("personal_access_tokens")
+		if err != nil {
+			return nil // Collection doesn't exist, nothing to rollback
+		}
+		return app.Delete(collection)
+	})
+}
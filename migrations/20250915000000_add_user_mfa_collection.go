@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		users, err := app.FindCollectionByNameOrId("users")
+		if err != nil {
+			return err
+		}
+
+		// One TOTP two-factor setup per user; it goes away with its user. Recovery codes are
+		// stored as hashes, and enabled_at stays empty until enrollment is confirmed.
+		mfa := core.NewBaseCollection("user_mfa")
+		mfa.Fields.Add(
+			&core.RelationField{
+				Id: "user_mfa_user", Name: "user", CollectionId: users.Id,
+				Required: true, CascadeDelete: true, MaxSelect: 1,
+			},
+			&core.TextField{Id: "user_mfa_secret", Name: "secret", Required: true, Max: 64},
+			&core.JSONField{Id: "user_mfa_recovery_codes", Name: "recovery_codes", MaxSize: 4096},
+			&core.NumberField{Id: "user_mfa_last_used_step", Name: "last_used_step", OnlyInt: true},
+			&core.DateField{Id: "user_mfa_enabled_at", Name: "enabled_at"},
+			&core.AutodateField{Id: "user_mfa_created", Name: "created", OnCreate: true},
+			&core.AutodateField{Id: "user_mfa_updated", Name: "updated", OnCreate: true, OnUpdate: true},
+		)
+		mfa.AddIndex("idx_user_mfa_user", true, "user", "")
+
+		return app.Save(mfa)
+	}, func(app core.App) error {
+		collection, err := app.FindCollectionByNameOrId("user_mfa")
+		if err != nil {
+			return nil // Collection doesn't exist, nothing to rollback
+		}
+		return app.Delete(collection)
+	})
+}
@@ -0,0 +1,44 @@
+package migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		users, err := app.FindCollectionByNameOrId("users")
+		if err != nil {
+			return err
+		}
+
+		// One record per sign-in on a device; it goes away with its user. refresh_token_id is the
+		// JWT ID of the only refresh token the session will accept next.
+		sessions := core.NewBaseCollection("sessions")
+		sessions.Fields.Add(
+			&core.RelationField{
+				Id: "sessions_user", Name: "user", CollectionId: users.Id,
+				Required: true, CascadeDelete: true, MaxSelect: 1,
+			},
+			&core.TextField{Id: "sessions_refresh_token_id", Name: "refresh_token_id", Required: true, Max: 64},
+			&core.NumberField{Id: "sessions_token_version", Name: "token_version", OnlyInt: true},
+			&core.TextField{Id: "sessions_user_agent", Name: "user_agent", Max: 512},
+			&core.TextField{Id: "sessions_ip_address", Name: "ip_address", Max: 64},
+			&core.DateField{Id: "sessions_last_seen_at", Name: "last_seen_at"},
+			&core.DateField{Id: "sessions_expires_at", Name: "expires_at", Required: true},
+			&core.DateField{Id: "sessions_revoked_at", Name: "revoked_at"},
+			&core.AutodateField{Id: "sessions_created", Name: "created", OnCreate: true},
+			&core.AutodateField{Id: "sessions_updated", Name: "updated", OnCreate: true, OnUpdate: true},
+		)
+		sessions.AddIndex("idx_sessions_user", false, "user", "")
+		sessions.AddIndex("idx_sessions_expires_at", false, "expires_at", "")
+
+		return app.Save(sessions)
+	}, func(app core.App) error {
+		collection, err := app.FindCollectionByNameOrId("sessions")
+		if err != nil {
+			return nil // Collection doesn't exist, nothing to rollback
+		}
+		return app.Delete(collection)
+	})
+}
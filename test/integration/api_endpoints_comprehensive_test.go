@@ -77,7 +77,7 @@ func setupAPITestSuite(t *testing.T) *APIEndpointsTestSuite {
 	projectHandler := api.NewProjectHandler(projectService, projectRepo)
 	taskHandler := api.NewTaskHandler(taskService, taskRepo)
 	healthHandler := api.NewHealthHandler(healthService.(*services.HealthService))
-	realtimeHandler := api.NewRealtimeHandler(subscriptionManager, eventBroadcaster, app)
+	realtimeHandler := api.NewRealtimeHandler(subscriptionManager, eventBroadcaster, nil, app)
 
 	// Register routes
 	apiGroup := router.Group("/api")
@@ -122,7 +122,7 @@ func (s *APIEndpointsTestSuite) setupTestData(t *testing.T) {
 
 	tokenPair, err := s.GetAuthService(t).Login(ctx, loginReq)
 	require.NoError(t, err)
-	s.authToken = tokenPair.AccessToken
+	s.authToken = tokenPair.Tokens.AccessToken
 
 	// Create admin user
 	adminReq := domain.CreateUserRequest{
@@ -148,7 +148,7 @@ func (s *APIEndpointsTestSuite) setupTestData(t *testing.T) {
 
 	adminTokenPair, err := s.GetAuthService(t).Login(ctx, adminLoginReq)
 	require.NoError(t, err)
-	s.adminToken = adminTokenPair.AccessToken
+	s.adminToken = adminTokenPair.Tokens.AccessToken
 
 	// Create test project
 	project := &domain.Project{
@@ -263,6 +263,14 @@ func (s *APIPerformanceBenchmarkSuite) benchmarkRefreshTokenEndpoint(b *testing.
 
 				if err == nil && resp.StatusCode == 200 {
 					atomic.AddInt64(&successRequests, 1)
+
+					// Refresh tokens rotate, so each one can only be used once
+					var result map[string]interface{}
+					if s.parseResponse(resp, &result) == nil {
+						if data, ok := result["data"].(map[string]interface{}); ok {
+							refreshToken, _ = data["refresh_token"].(string)
+						}
+					}
 				}
 
 				latencyMutex.Lock()
@@ -94,7 +94,7 @@ func (s *SecurityAuditTestSuite) setupMaliciousUser(t *testing.T) {
 
 	tokenPair, err := s.GetAuthService(t).Login(ctx, loginReq)
 	require.NoError(t, err)
-	s.maliciousToken = tokenPair.AccessToken
+	s.maliciousToken = tokenPair.Tokens.AccessToken
 }
 
 // TestComprehensiveSecurityAudit runs a complete security audit
@@ -0,0 +1,177 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ericfisherdev/simple-easy-tasks/internal/domain"
+	"github.com/ericfisherdev/simple-easy-tasks/internal/repository"
+	testutil "github.com/ericfisherdev/simple-easy-tasks/internal/testutil/integration"
+)
+
+// setupTaskHistoryCollection creates task_history as it stands after migration
+// 20250829000000: the task relation no longer cascades and task_id keeps the
+// original task ID once the relation is cleared
+func setupTaskHistoryCollection(t *testing.T, app core.App) {
+	tasks, err := app.FindCollectionByNameOrId("tasks")
+	require.NoError(t, err)
+
+	collection := core.NewBaseCollection("task_history")
+	collection.Fields.Add(
+		&core.RelationField{
+			Name:          "task",
+			CollectionId:  tasks.Id,
+			CascadeDelete: false,
+			MaxSelect:     1,
+		},
+		&core.TextField{Name: "task_id", Max: 50},
+		&core.TextField{Name: "user", Required: true},
+		&core.SelectField{
+			Name:      "action",
+			Required:  true,
+			MaxSelect: 1,
+			Values:    []string{"created", "updated", "moved", "assigned", "commented", "deleted"},
+		},
+		&core.TextField{Name: "field_name"},
+		&core.JSONField{Name: "old_value"},
+		&core.JSONField{Name: "new_value"},
+		&core.JSONField{Name: "metadata"},
+		&core.AutodateField{Name: "created", OnCreate: true},
+	)
+	collection.AddIndex("idx_task_history_task_id_created", false, "task_id, created", "")
+	require.NoError(t, app.Save(collection))
+}
+
+// TestTaskHistoryRepository_Integration tests persisted task history filtering and retention
+func TestTaskHistoryRepository_Integration(t *testing.T) {
+	tc := NewTestContainer(t)
+	defer tc.Cleanup()
+
+	// Create test database suite for factory access
+	suite := testutil.SetupDatabaseTest(t)
+	defer suite.Cleanup()
+
+	ctx := context.Background()
+	app := tc.GetPocketBaseApp(t)
+	setupTaskHistoryCollection(t, app)
+
+	historyRepo := repository.NewPocketBaseTaskHistoryRepository(app)
+	taskRepo := tc.GetTaskRepository(t)
+
+	user := suite.Factory.CreateUser()
+	require.NoError(t, tc.GetUserRepository(t).Create(ctx, user))
+	otherUser := suite.Factory.CreateUser()
+	require.NoError(t, tc.GetUserRepository(t).Create(ctx, otherUser))
+
+	project := suite.Factory.CreateProject(user)
+	require.NoError(t, tc.GetProjectRepository(t).Create(ctx, project))
+
+	task := suite.Factory.CreateTask(project, user)
+	require.NoError(t, taskRepo.Create(ctx, task))
+	otherTask := suite.Factory.CreateTask(project, user)
+	require.NoError(t, taskRepo.Create(ctx, otherTask))
+
+	base := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	statusField := "status"
+	record := func(taskID, userID string, action domain.TaskHistoryAction, at time.Time, field *string) {
+		entry := domain.NewTaskHistoryEntry(taskID, userID, action)
+		entry.CreatedAt = at
+		entry.FieldName = field
+		require.NoError(t, historyRepo.Create(ctx, entry))
+		assert.NotEmpty(t, entry.ID)
+	}
+
+	record(task.ID, user.ID, domain.ActionCreated, base, nil)
+	record(task.ID, user.ID, domain.ActionMoved, base.Add(10*time.Minute), &statusField)
+	record(task.ID, otherUser.ID, domain.ActionUpdated, base.Add(20*time.Minute), nil)
+	record(task.ID, otherUser.ID, domain.ActionMoved, base.Add(30*time.Minute), &statusField)
+	record(otherTask.ID, user.ID, domain.ActionCreated, base.Add(5*time.Minute), nil)
+
+	t.Run("List_ByTask_NewestFirst", func(t *testing.T) {
+		filter := domain.TaskHistoryFilter{TaskID: &task.ID}
+
+		entries, err := historyRepo.List(ctx, filter)
+		require.NoError(t, err)
+		require.Len(t, entries, 4)
+		for i := 1; i < len(entries); i++ {
+			assert.False(t, entries[i].CreatedAt.After(entries[i-1].CreatedAt))
+		}
+		assert.Equal(t, domain.ActionMoved, entries[0].Action)
+		assert.Equal(t, domain.ActionCreated, entries[3].Action)
+
+		total, err := historyRepo.Count(ctx, filter)
+		require.NoError(t, err)
+		assert.Equal(t, 4, total)
+	})
+
+	t.Run("List_ByUserActionAndField", func(t *testing.T) {
+		filter := domain.TaskHistoryFilter{
+			TaskID:    &task.ID,
+			UserID:    &otherUser.ID,
+			FieldName: &statusField,
+			Actions:   []domain.TaskHistoryAction{domain.ActionMoved},
+		}
+
+		entries, err := historyRepo.List(ctx, filter)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, otherUser.ID, entries[0].UserID)
+
+		total, err := historyRepo.Count(ctx, filter)
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+	})
+
+	t.Run("List_ByDateRange", func(t *testing.T) {
+		since := base.Add(5 * time.Minute)
+		until := base.Add(25 * time.Minute)
+		filter := domain.TaskHistoryFilter{TaskID: &task.ID, StartDate: &since, EndDate: &until}
+
+		entries, err := historyRepo.List(ctx, filter)
+		require.NoError(t, err)
+		assert.Len(t, entries, 2)
+
+		total, err := historyRepo.Count(ctx, filter)
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+	})
+
+	t.Run("Count_IgnoresPagination", func(t *testing.T) {
+		filter := domain.TaskHistoryFilter{TaskID: &task.ID, Limit: 2, Offset: 1}
+
+		entries, err := historyRepo.List(ctx, filter)
+		require.NoError(t, err)
+		assert.Len(t, entries, 2)
+
+		total, err := historyRepo.Count(ctx, filter)
+		require.NoError(t, err)
+		assert.Equal(t, 4, total)
+	})
+
+	t.Run("History_SurvivesTaskDeletion", func(t *testing.T) {
+		require.NoError(t, taskRepo.Delete(ctx, task.ID))
+		record(task.ID, user.ID, domain.ActionDeleted, base.Add(40*time.Minute), nil)
+
+		filter := domain.TaskHistoryFilter{TaskID: &task.ID}
+		entries, err := historyRepo.List(ctx, filter)
+		require.NoError(t, err)
+		require.Len(t, entries, 5)
+		assert.Equal(t, domain.ActionDeleted, entries[0].Action)
+		for _, entry := range entries {
+			assert.Equal(t, task.ID, entry.TaskID)
+		}
+
+		// Other tasks' history is untouched
+		total, err := historyRepo.Count(ctx, domain.TaskHistoryFilter{TaskID: &otherTask.ID})
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+	})
+}
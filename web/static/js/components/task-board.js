@@ -5,6 +5,7 @@
 function taskBoard() {
     return {
         // State management
+        board: null,
         tasks: [],
         columns: {
             backlog: { title: 'Backlog', tasks: [], color: 'gray' },
@@ -44,7 +45,7 @@ function taskBoard() {
                 return;
             }
             
-            this.loadTasks();
+            this.loadBoard();
             this.setupDragDrop();
             
             if (this.enableRealtime) {
@@ -55,47 +56,41 @@ function taskBoard() {
             this.setupKeyboardShortcuts();
         },
         
-        // Task Management
-        async loadTasks() {
+        // Board Management
+        async loadBoard() {
             this.isLoading = true;
             this.error = null;
             
             try {
-                const response = await fetch(`/api/projects/${this.projectId}/tasks`);
+                const response = await fetch(`/api/projects/${this.projectId}/board`, {
+                    headers: this.getAuthHeaders()
+                });
                 if (!response.ok) {
                     throw new Error(`HTTP error! status: ${response.status}`);
                 }
                 
-                const data = await response.json();
-                this.tasks = data.tasks || [];
-                this.organizeTasks();
+                const result = await response.json();
+                this.applyBoard(result.data.board);
                 
-                console.log(`Loaded ${this.tasks.length} tasks`);
+                console.log(`Loaded board with ${this.tasks.length} tasks`);
             } catch (error) {
-                console.error('Failed to load tasks:', error);
+                console.error('Failed to load board:', error);
                 this.error = 'Failed to load tasks. Please try again.';
             } finally {
                 this.isLoading = false;
             }
         },
         
-        organizeTasks() {
-            // Reset all columns
-            Object.keys(this.columns).forEach(key => {
-                this.columns[key].tasks = [];
-            });
-            
-            // Organize tasks by status
-            this.tasks.forEach(task => {
-                const status = task.status?.toLowerCase() || 'backlog';
-                if (this.columns[status]) {
-                    this.columns[status].tasks.push(task);
-                }
-            });
+        // The server groups and orders tasks per column; the client only renders them
+        applyBoard(board) {
+            this.board = board;
+            this.tasks = [];
             
-            // Sort tasks by position within each column
             Object.keys(this.columns).forEach(key => {
-                this.columns[key].tasks.sort((a, b) => (a.position || 0) - (b.position || 0));
+                const column = board.columns?.[key];
+                this.columns[key].tasks = column?.tasks || [];
+                this.columns[key].wipLimits = column?.wip_limits || null;
+                this.tasks.push(...this.columns[key].tasks);
             });
         },
         
@@ -106,7 +101,8 @@ function taskBoard() {
                     method: 'POST',
                     headers: {
                         'Content-Type': 'application/json',
-                        'X-CSRFToken': this.getCSRFToken()
+                        'X-CSRFToken': this.getCSRFToken(),
+                        ...this.getAuthHeaders()
                     },
                     body: JSON.stringify(formData)
                 });
@@ -139,7 +135,8 @@ function taskBoard() {
                     method: 'PUT',
                     headers: {
                         'Content-Type': 'application/json',
-                        'X-CSRFToken': this.getCSRFToken()
+                        'X-CSRFToken': this.getCSRFToken(),
+                        ...this.getAuthHeaders()
                     },
                     body: JSON.stringify(updates)
                 });
@@ -170,7 +167,8 @@ function taskBoard() {
                 const response = await fetch(`/api/projects/${this.projectId}/tasks/${taskId}`, {
                     method: 'DELETE',
                     headers: {
-                        'X-CSRFToken': this.getCSRFToken()
+                        'X-CSRFToken': this.getCSRFToken(),
+                        ...this.getAuthHeaders()
                     }
                 });
                 
@@ -186,22 +184,17 @@ function taskBoard() {
         },
         
         // Task State Management
-        addTask(task) {
-            this.tasks.push(task);
-            this.organizeTasks();
+        // Any task change invalidates the server-side board, so refetch it rather than regroup locally
+        addTask(_task) {
+            this.loadBoard();
         },
         
-        replaceTask(updatedTask) {
-            const index = this.tasks.findIndex(t => t.id === updatedTask.id);
-            if (index !== -1) {
-                this.tasks[index] = updatedTask;
-                this.organizeTasks();
-            }
+        replaceTask(_updatedTask) {
+            this.loadBoard();
         },
         
-        removeTask(taskId) {
-            this.tasks = this.tasks.filter(t => t.id !== taskId);
-            this.organizeTasks();
+        removeTask(_taskId) {
+            this.loadBoard();
         },
         
         // Drag and Drop
@@ -313,13 +306,14 @@ function taskBoard() {
         
         async moveTask(taskId, newStatus) {
             try {
-                const response = await fetch(`/api/projects/${this.projectId}/tasks/${taskId}/move`, {
+                const response = await fetch(`/api/projects/${this.projectId}/board/move`, {
                     method: 'POST',
                     headers: {
                         'Content-Type': 'application/json',
-                        'X-CSRFToken': this.getCSRFToken()
+                        'X-CSRFToken': this.getCSRFToken(),
+                        ...this.getAuthHeaders()
                     },
-                    body: JSON.stringify({ status: newStatus })
+                    body: JSON.stringify({ task_id: taskId, new_status: newStatus })
                 });
                 
                 if (!response.ok) {
@@ -328,7 +322,7 @@ function taskBoard() {
                 
                 const result = await response.json();
                 if (result.success) {
-                    this.updateTaskStatus(taskId, newStatus);
+                    this.applyBoard(result.data.board);
                     this.animateTaskMove(taskId, newStatus);
                 }
             } catch (error) {
@@ -336,14 +330,6 @@ function taskBoard() {
             }
         },
         
-        updateTaskStatus(taskId, newStatus) {
-            const task = this.tasks.find(t => t.id == taskId);
-            if (task) {
-                task.status = newStatus;
-                this.organizeTasks();
-            }
-        },
-        
         // Utility Functions
         isOverlapping(rect1, rect2) {
             return !(rect1.right < rect2.left || 
@@ -424,7 +410,7 @@ function taskBoard() {
                     this.replaceTask(data.task);
                     break;
                 case 'task.moved':
-                    this.updateTaskStatus(data.task_id, data.new_status);
+                    this.loadBoard();
                     break;
                 case 'task.deleted':
                     this.removeTask(data.task_id);
@@ -480,6 +466,11 @@ function taskBoard() {
             return meta ? meta.getAttribute('content') : '';
         },
         
+        getAuthHeaders() {
+            const token = localStorage.getItem('access_token') || sessionStorage.getItem('access_token');
+            return token ? { 'Authorization': `Bearer ${token}` } : {};
+        },
+        
         formatDate(dateString) {
             if (!dateString) return '';
             const date = new Date(dateString);
@@ -700,7 +691,9 @@ function taskBoard() {
             // This will be handled by the search input's HTMX functionality
             // but we can add client-side filtering as backup
             if (!this.searchQuery.trim()) {
-                this.organizeTasks();
+                if (this.board) {
+                    this.applyBoard(this.board);
+                }
                 return;
             }
             